@@ -0,0 +1,217 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	_ "net/http/pprof"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/scionproto/scion/ca"
+	"github.com/scionproto/scion/ca/config"
+	cstrust "github.com/scionproto/scion/control/trust"
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/log"
+	libmetrics "github.com/scionproto/scion/pkg/metrics"
+	"github.com/scionproto/scion/pkg/private/prom"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/private/app"
+	"github.com/scionproto/scion/private/app/launcher"
+	caconfig "github.com/scionproto/scion/private/ca/config"
+	"github.com/scionproto/scion/private/ca/renewal"
+	"github.com/scionproto/scion/private/mgmtapi/jwtauth"
+	"github.com/scionproto/scion/private/periodic"
+	"github.com/scionproto/scion/private/pkcs11"
+	"github.com/scionproto/scion/private/service"
+	"github.com/scionproto/scion/private/storage"
+	"github.com/scionproto/scion/private/trust"
+)
+
+// trcLoadInterval is the interval between loading the TRCs from disk.
+const trcLoadInterval = time.Minute
+
+var globalCfg config.Config
+
+func main() {
+	application := launcher.Application{
+		ApplicationBase: launcher.ApplicationBase{
+			TOMLConfig: &globalCfg,
+			ShortName:  "SCION CA",
+			Main:       realMain,
+		},
+	}
+	application.Run()
+}
+
+func realMain(ctx context.Context) error {
+	trustDB, err := storage.NewTrustStorage(globalCfg.TrustDB)
+	if err != nil {
+		return serrors.Wrap("initializing trust storage", err)
+	}
+	defer trustDB.Close()
+	certsDir := filepath.Join(globalCfg.General.ConfigDir, "certs")
+	if err := loadTRCs(ctx, certsDir, trustDB); err != nil {
+		return err
+	}
+	//nolint:staticcheck // SA1019: fix later (https://github.com/scionproto/scion/issues/4776).
+	trcLoader := periodic.Start(periodic.Func{
+		TaskName: "ca_trc_loader",
+		Task: func(ctx context.Context) {
+			if err := loadTRCs(ctx, certsDir, trustDB); err != nil {
+				log.FromCtx(ctx).Info("Failed to load TRCs", "err", err)
+			}
+		},
+	}, trcLoadInterval, trcLoadInterval)
+	defer trcLoader.Stop()
+
+	caDir := filepath.Join(globalCfg.General.ConfigDir, "crypto/ca")
+	var keyRing trust.KeyRing = cstrust.LoadingRing{Dir: caDir}
+	if globalCfg.CA.Keys != "" {
+		if keyRing, err = pkcs11.NewKeyRing(globalCfg.CA.Keys); err != nil {
+			return serrors.Wrap("initializing CA key ring", err)
+		}
+	}
+
+	issuanceLog, err := ca.OpenIssuanceLog(globalCfg.CA.IssuanceLog)
+	if err != nil {
+		return err
+	}
+	defer issuanceLog.Close()
+
+	clients := make(map[string]jwtauth.KeyFunc, len(globalCfg.CA.Clients))
+	for id, path := range globalCfg.CA.Clients {
+		clients[id] = clientSecret(path)
+	}
+	asValidity := make(map[addr.IA]time.Duration, len(globalCfg.Policy.ASValidity))
+	for ia, validity := range globalCfg.Policy.ASValidity {
+		asValidity[ia] = validity.Duration
+	}
+	requests := libmetrics.NewPromCounter(promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ca_renewal_requests_total",
+			Help: "Total number of certificate renewal requests handled by the CA.",
+		},
+		[]string{prom.LabelResult},
+	))
+	server := &ca.Server{
+		IA:       globalCfg.CA.IA,
+		Verifier: renewal.RequestVerifier{TRCFetcher: trustDB},
+		PolicyGen: &renewal.CachingPolicyGen{
+			PolicyGen: renewal.LoadingPolicyGen{
+				KeyRing: keyRing,
+				CertProvider: renewal.CACertLoader{
+					IA:  globalCfg.CA.IA,
+					DB:  trustDB,
+					Dir: caDir,
+				},
+			},
+		},
+		Validity: ca.ValidityPolicy{
+			Validity:    globalCfg.Policy.Validity.Duration,
+			ASValidity:  asValidity,
+			MinValidity: globalCfg.Policy.MinValidity.Duration,
+		},
+		RateLimit: ca.RateLimit{
+			Issuances: globalCfg.Policy.MaxIssuances,
+			Period:    globalCfg.Policy.IssuancePeriod.Duration,
+		},
+		Log:           issuanceLog,
+		SharedSecret:  caconfig.NewPEMSymmetricKey(globalCfg.CA.SharedSecret).Get,
+		TokenLifetime: globalCfg.CA.TokenLifetime.Duration,
+		Clients:       clients,
+		Metrics: ca.Metrics{
+			Requests: func(result string) libmetrics.Counter {
+				return libmetrics.CounterWith(requests, prom.LabelResult, result)
+			},
+		},
+	}
+
+	g, errCtx := errgroup.WithContext(ctx)
+	var cleanup app.Cleanup
+	apiServer := &http.Server{
+		Addr:    globalCfg.CA.Addr,
+		Handler: server.Handler(),
+	}
+	log.Info("Exposing CA service API", "addr", globalCfg.CA.Addr,
+		"tls", globalCfg.CA.TLSCert != "")
+	g.Go(func() error {
+		defer log.HandlePanic()
+		var err error
+		if globalCfg.CA.TLSCert != "" {
+			err = apiServer.ListenAndServeTLS(globalCfg.CA.TLSCert, globalCfg.CA.TLSKey)
+		} else {
+			err = apiServer.ListenAndServe()
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return serrors.Wrap("serving CA service API", err)
+		}
+		return nil
+	})
+	cleanup.Add(apiServer.Close)
+
+	statusPages := service.StatusPages{
+		"info":      service.NewInfoStatusPage(),
+		"config":    service.NewConfigStatusPage(globalCfg),
+		"log/level": service.NewLogLevelStatusPage(),
+	}
+	if err := statusPages.Register(http.DefaultServeMux, globalCfg.General.ID); err != nil {
+		return serrors.Wrap("registering status pages", err)
+	}
+
+	g.Go(func() error {
+		defer log.HandlePanic()
+		return globalCfg.Metrics.ServePrometheus(errCtx)
+	})
+
+	g.Go(func() error {
+		defer log.HandlePanic()
+		<-errCtx.Done()
+		return cleanup.Do()
+	})
+
+	return g.Wait()
+}
+
+// clientSecret returns a function that reads the client secret from the file.
+func clientSecret(path string) jwtauth.KeyFunc {
+	return func() ([]byte, error) {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, serrors.Wrap("reading client secret", err, "file", path)
+		}
+		return bytes.TrimSpace(raw), nil
+	}
+}
+
+// loadTRCs loads the TRCs from the directory into the database.
+func loadTRCs(ctx context.Context, dir string, db trust.DB) error {
+	loaded, err := trust.LoadTRCs(ctx, dir, db)
+	if err != nil {
+		return serrors.Wrap("loading TRCs from disk", err)
+	}
+	if len(loaded.Loaded) > 0 {
+		log.FromCtx(ctx).Info("TRCs loaded", "files", loaded.Loaded)
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package config contains the configuration of the SCION CA service.
+package config
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/private/util"
+	"github.com/scionproto/scion/private/config"
+	"github.com/scionproto/scion/private/env"
+	"github.com/scionproto/scion/private/mgmtapi/jwtauth"
+	"github.com/scionproto/scion/private/pkcs11"
+	"github.com/scionproto/scion/private/storage"
+)
+
+const (
+	// DefaultAddr is the default address the CA service API is exposed on.
+	DefaultAddr = ":8443"
+	// DefaultIssuanceLog is the default path of the issuance log.
+	DefaultIssuanceLog = "/share/data/ca.issuance.log"
+	// DefaultValidity is the default validity period of the issued AS
+	// certificates.
+	DefaultValidity = 3 * 24 * time.Hour
+	// DefaultMaxIssuances is the default number of AS certificates that are
+	// issued to an AS per issuance period.
+	DefaultMaxIssuances = 10
+	// DefaultIssuancePeriod is the default period the issued AS certificates
+	// are counted in for the rate limit.
+	DefaultIssuancePeriod = 24 * time.Hour
+)
+
+var _ config.Config = (*Config)(nil)
+
+// Config is the CA service configuration.
+type Config struct {
+	General env.General      `toml:"general,omitempty"`
+	Logging log.Config       `toml:"log,omitempty"`
+	Metrics env.Metrics      `toml:"metrics,omitempty"`
+	TrustDB storage.DBConfig `toml:"trust_db,omitempty"`
+	CA      CAConfig         `toml:"ca,omitempty"`
+	Policy  PolicyConfig     `toml:"policy,omitempty"`
+}
+
+func (cfg *Config) InitDefaults() {
+	config.InitAll(
+		&cfg.General,
+		&cfg.Logging,
+		&cfg.Metrics,
+		cfg.TrustDB.WithDefault(fmt.Sprintf(storage.DefaultTrustDBPath, "ca")),
+		&cfg.CA,
+		&cfg.Policy,
+	)
+}
+
+func (cfg *Config) Validate() error {
+	return config.ValidateAll(
+		&cfg.General,
+		&cfg.Logging,
+		&cfg.Metrics,
+		&cfg.TrustDB,
+		&cfg.CA,
+		&cfg.Policy,
+	)
+}
+
+func (cfg *Config) Sample(dst io.Writer, path config.Path, _ config.CtxMap) {
+	config.WriteSample(dst, path, config.CtxMap{config.ID: idSample},
+		&cfg.General,
+		&cfg.Logging,
+		&cfg.Metrics,
+		config.OverrideName(
+			config.FormatData(
+				&cfg.TrustDB,
+				fmt.Sprintf(storage.DefaultTrustDBPath, "ca"),
+			),
+			"trust_db",
+		),
+		&cfg.CA,
+		&cfg.Policy,
+	)
+}
+
+var _ config.Config = (*CAConfig)(nil)
+
+// CAConfig is the configuration of the CA and its API.
+type CAConfig struct {
+	// IA is the ISD-AS of the CA. Certificates are issued for the ASes in the
+	// same ISD.
+	IA addr.IA `toml:"isd_as,omitempty"`
+	// Addr is the address the CA service API is exposed on.
+	Addr string `toml:"addr,omitempty"`
+	// TLSCert is the path of the PEM-encoded TLS certificate of the API. If it
+	// is empty, the API is served over plain HTTP.
+	TLSCert string `toml:"tls_cert,omitempty"`
+	// TLSKey is the path of the PEM-encoded TLS private key of the API.
+	TLSKey string `toml:"tls_key,omitempty"`
+	// SharedSecret is the path to the PEM-encoded shared secret that is used to
+	// verify and create JWT tokens.
+	SharedSecret string `toml:"shared_secret,omitempty"`
+	// TokenLifetime is the validity period of the JWT tokens that are issued
+	// to the clients.
+	TokenLifetime util.DurWrap `toml:"token_lifetime,omitempty"`
+	// Clients maps the client IDs to the path of the files that contain the
+	// client secrets that are accepted on the token endpoint.
+	Clients map[string]string `toml:"clients,omitempty"`
+	// Keys is the PKCS#11 URI of the CA private keys. If it is empty, the keys
+	// are loaded from the crypto/ca directory.
+	Keys string `toml:"keys,omitempty"`
+	// IssuanceLog is the path of the log of the issued certificates.
+	IssuanceLog string `toml:"issuance_log,omitempty"`
+}
+
+func (cfg *CAConfig) InitDefaults() {
+	if cfg.Addr == "" {
+		cfg.Addr = DefaultAddr
+	}
+	if cfg.TokenLifetime.Duration == 0 {
+		cfg.TokenLifetime.Duration = jwtauth.DefaultTokenLifetime
+	}
+	if cfg.IssuanceLog == "" {
+		cfg.IssuanceLog = DefaultIssuanceLog
+	}
+}
+
+func (cfg *CAConfig) Validate() error {
+	if cfg.IA.IsZero() || cfg.IA.IsWildcard() {
+		return serrors.New("isd_as must be set to the ISD-AS of the CA", "isd_as", cfg.IA)
+	}
+	if cfg.SharedSecret == "" {
+		return serrors.New("shared_secret not specified")
+	}
+	if (cfg.TLSCert == "") != (cfg.TLSKey == "") {
+		return serrors.New("tls_cert and tls_key must be specified together")
+	}
+	if cfg.TokenLifetime.Duration <= 0 {
+		return serrors.New("token_lifetime must be positive")
+	}
+	if cfg.Keys != "" {
+		if _, err := pkcs11.ParseURI(cfg.Keys); err != nil {
+			return serrors.Wrap("parsing keys", err)
+		}
+	}
+	return nil
+}
+
+func (cfg *CAConfig) Sample(dst io.Writer, _ config.Path, _ config.CtxMap) {
+	config.WriteString(dst, caSample)
+}
+
+func (cfg *CAConfig) ConfigName() string {
+	return "ca"
+}
+
+var _ config.Config = (*PolicyConfig)(nil)
+
+// PolicyConfig is the issuance policy of the CA.
+type PolicyConfig struct {
+	// Validity is the validity period of the issued AS certificates.
+	Validity util.DurWrap `toml:"validity,omitempty"`
+	// MinValidity is the minimum validity period of AS certificates that are
+	// truncated to the expiration time of the CA certificate. If it is zero,
+	// AS certificates are not truncated.
+	MinValidity util.DurWrap `toml:"min_validity,omitempty"`
+	// ASValidity overrides the validity period for individual ASes.
+	ASValidity map[addr.IA]util.DurWrap `toml:"as_validity,omitempty"`
+	// MaxIssuances is the number of AS certificates that are issued to an AS
+	// per issuance period.
+	MaxIssuances int `toml:"max_issuances,omitempty"`
+	// IssuancePeriod is the period the issued AS certificates are counted in.
+	IssuancePeriod util.DurWrap `toml:"issuance_period,omitempty"`
+}
+
+func (cfg *PolicyConfig) InitDefaults() {
+	if cfg.Validity.Duration == 0 {
+		cfg.Validity.Duration = DefaultValidity
+	}
+	if cfg.MaxIssuances == 0 {
+		cfg.MaxIssuances = DefaultMaxIssuances
+	}
+	if cfg.IssuancePeriod.Duration == 0 {
+		cfg.IssuancePeriod.Duration = DefaultIssuancePeriod
+	}
+}
+
+func (cfg *PolicyConfig) Validate() error {
+	if cfg.Validity.Duration <= 0 {
+		return serrors.New("validity must be positive")
+	}
+	if cfg.MinValidity.Duration < 0 || cfg.MinValidity.Duration > cfg.Validity.Duration {
+		return serrors.New("min_validity must be between zero and validity",
+			"min_validity", cfg.MinValidity, "validity", cfg.Validity)
+	}
+	for ia, validity := range cfg.ASValidity {
+		if validity.Duration <= 0 {
+			return serrors.New("as_validity must be positive", "isd_as", ia)
+		}
+	}
+	if cfg.MaxIssuances <= 0 {
+		return serrors.New("max_issuances must be positive")
+	}
+	if cfg.IssuancePeriod.Duration <= 0 {
+		return serrors.New("issuance_period must be positive")
+	}
+	return nil
+}
+
+func (cfg *PolicyConfig) Sample(dst io.Writer, _ config.Path, _ config.CtxMap) {
+	config.WriteString(dst, policySample)
+}
+
+func (cfg *PolicyConfig) ConfigName() string {
+	return "policy"
+}
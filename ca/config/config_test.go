@@ -0,0 +1,122 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/log/logtest"
+	"github.com/scionproto/scion/pkg/private/util"
+	"github.com/scionproto/scion/private/env/envtest"
+	"github.com/scionproto/scion/private/storage"
+)
+
+func TestConfigSample(t *testing.T) {
+	var sample bytes.Buffer
+	var cfg Config
+	cfg.Sample(&sample, nil, nil)
+
+	InitTestConfig(&cfg)
+	err := toml.NewDecoder(bytes.NewReader(sample.Bytes())).DisallowUnknownFields().Decode(&cfg)
+	assert.NoError(t, err, "config: \n%s", sample.String())
+	CheckTestConfig(t, &cfg, idSample)
+}
+
+func TestPolicyConfigValidate(t *testing.T) {
+	testCases := map[string]struct {
+		Modify    func(*PolicyConfig)
+		Assertion assert.ErrorAssertionFunc
+	}{
+		"defaults": {
+			Modify:    func(*PolicyConfig) {},
+			Assertion: assert.NoError,
+		},
+		"min validity exceeds validity": {
+			Modify: func(cfg *PolicyConfig) {
+				cfg.MinValidity = util.DurWrap{Duration: 4 * 24 * time.Hour}
+			},
+			Assertion: assert.Error,
+		},
+		"zero AS validity": {
+			Modify: func(cfg *PolicyConfig) {
+				cfg.ASValidity = map[addr.IA]util.DurWrap{addr.MustParseIA("1-ff00:0:111"): {}}
+			},
+			Assertion: assert.Error,
+		},
+		"negative max issuances": {
+			Modify:    func(cfg *PolicyConfig) { cfg.MaxIssuances = -1 },
+			Assertion: assert.Error,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var cfg PolicyConfig
+			cfg.InitDefaults()
+			tc.Modify(&cfg)
+			tc.Assertion(t, cfg.Validate())
+		})
+	}
+}
+
+func InitTestConfig(cfg *Config) {
+	envtest.InitTest(&cfg.General, &cfg.Metrics, nil, nil)
+	logtest.InitTestLogging(&cfg.Logging)
+	InitTestCAConfig(&cfg.CA)
+	InitTestPolicyConfig(&cfg.Policy)
+}
+
+func InitTestCAConfig(cfg *CAConfig) {
+	cfg.Addr = "garbage"
+	cfg.Keys = "garbage"
+}
+
+func InitTestPolicyConfig(cfg *PolicyConfig) {
+	cfg.MaxIssuances = 42
+}
+
+func CheckTestConfig(t *testing.T, cfg *Config, id string) {
+	envtest.CheckTest(t, &cfg.General, &cfg.Metrics, nil, nil, id)
+	logtest.CheckTestLogging(t, &cfg.Logging, id)
+	assert.Equal(t, fmt.Sprintf(storage.DefaultTrustDBPath, "ca"), cfg.TrustDB.Connection)
+	CheckTestCAConfig(t, &cfg.CA)
+	CheckTestPolicyConfig(t, &cfg.Policy)
+}
+
+func CheckTestCAConfig(t *testing.T, cfg *CAConfig) {
+	assert.Equal(t, addr.MustParseIA("1-ff00:0:110"), cfg.IA)
+	assert.Equal(t, DefaultAddr, cfg.Addr)
+	assert.Empty(t, cfg.TLSCert)
+	assert.Empty(t, cfg.TLSKey)
+	assert.Equal(t, "/etc/scion/ca/shared_secret.key", cfg.SharedSecret)
+	assert.Equal(t, 10*time.Minute, cfg.TokenLifetime.Duration)
+	assert.Empty(t, cfg.Clients)
+	assert.Empty(t, cfg.Keys)
+	assert.Equal(t, DefaultIssuanceLog, cfg.IssuanceLog)
+}
+
+func CheckTestPolicyConfig(t *testing.T, cfg *PolicyConfig) {
+	assert.Equal(t, DefaultValidity, cfg.Validity.Duration)
+	assert.Zero(t, cfg.MinValidity.Duration)
+	assert.Empty(t, cfg.ASValidity)
+	assert.Equal(t, DefaultMaxIssuances, cfg.MaxIssuances)
+	assert.Equal(t, DefaultIssuancePeriod, cfg.IssuancePeriod.Duration)
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+const idSample = "ca-1"
+
+const caSample = `
+# The ISD-AS of the CA. AS certificates are issued for the ASes in the same ISD.
+# The CA certificates and keys are loaded from the crypto/ca directory in the
+# configuration directory, the TRCs from the certs directory. (required)
+isd_as = "1-ff00:0:110"
+
+# The address to expose the CA service API on (host:port or ip:port).
+# (default ":8443")
+addr = ":8443"
+
+# The paths of the PEM-encoded TLS certificate and private key of the API. If
+# they are not set, the API is served over plain HTTP. (default "")
+tls_cert = ""
+tls_key = ""
+
+# The path to the PEM-encoded shared secret that is used to verify the JWT
+# tokens of the clients. It is the same secret that is configured in
+# ca.service.shared_secret of the control services. (required)
+shared_secret = "/etc/scion/ca/shared_secret.key"
+
+# The validity period of the JWT tokens that are issued on the token endpoint.
+# (default 10m)
+token_lifetime = "10m"
+
+# The clients that can request a JWT token on the token endpoint, mapped to the
+# path of the file that contains their client secret. Control services do not
+# need an entry, they create their tokens with the shared secret. For example:
+# clients = { "monitoring" = "/etc/scion/ca/clients/monitoring.secret" }
+# (default {})
+clients = {}
+
+# The PKCS#11 URI (RFC 7512) of the CA private keys. If it is not set, the keys
+# are loaded from the crypto/ca directory. (default "")
+keys = ""
+
+# The path of the issuance log. Every issued AS certificate is recorded in the
+# log. (default "/share/data/ca.issuance.log")
+issuance_log = "/share/data/ca.issuance.log"
+`
+
+const policySample = `
+# The validity period of the issued AS certificates. (default 3d)
+validity = "3d"
+
+# The minimum validity period of the issued AS certificates. If the CA
+# certificate expires before the end of the validity period, the AS certificate
+# is truncated to the expiration time of the CA certificate, as long as the
+# remaining validity period is at least min_validity. If it is not set, the AS
+# certificates are not truncated and no certificates are issued until a CA
+# certificate with sufficient validity is available. (default 0s)
+min_validity = "0s"
+
+# The validity period of the issued AS certificates for individual ASes. For
+# example:
+# as_validity = { "1-ff00:0:111" = "1d" }
+# (default {})
+as_validity = {}
+
+# The number of AS certificates that are issued to an AS per issuance period.
+# Further requests are rejected until the period has passed. (default 10)
+max_issuances = 10
+
+# The period the issued AS certificates are counted in. (default 24h)
+issuance_period = "24h"
+`
@@ -0,0 +1,144 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ca
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/serrors"
+)
+
+// Issuance is an entry of the issuance log.
+type Issuance struct {
+	// Time is the time the certificate was issued.
+	Time time.Time `json:"time"`
+	// IA is the ISD-AS of the subject.
+	IA addr.IA `json:"isd_as"`
+	// Client is the subject of the JWT token that authorized the request.
+	Client string `json:"client,omitempty"`
+	// Serial is the hex encoded serial number of the certificate.
+	Serial string `json:"serial"`
+	// NotBefore is the start of the certificate validity period.
+	NotBefore time.Time `json:"not_before"`
+	// NotAfter is the end of the certificate validity period.
+	NotAfter time.Time `json:"not_after"`
+	// Certificate is the DER encoded AS certificate.
+	Certificate []byte `json:"certificate"`
+}
+
+// NewIssuance creates the issuance log entry for the certificate.
+func NewIssuance(now time.Time, ia addr.IA, client string, cert *x509.Certificate) Issuance {
+	return Issuance{
+		Time:        now,
+		IA:          ia,
+		Client:      client,
+		Serial:      cert.SerialNumber.Text(16),
+		NotBefore:   cert.NotBefore,
+		NotAfter:    cert.NotAfter,
+		Certificate: cert.Raw,
+	}
+}
+
+// IssuanceLog is an append-only log of the issued certificates. Every entry is
+// a JSON object on a single line. The log is persisted to disk before the
+// certificate is handed out, such that the issuance history survives
+// restarts.
+type IssuanceLog struct {
+	mtx    sync.Mutex
+	file   *os.File
+	issued map[addr.IA][]time.Time
+}
+
+// OpenIssuanceLog opens the issuance log at the path. If the file does not
+// exist, it is created. An incomplete last entry, e.g., from a crash during
+// writing, is discarded.
+func OpenIssuanceLog(path string) (*IssuanceLog, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, serrors.Wrap("reading issuance log", err, "file", path)
+	}
+	complete := raw[:bytes.LastIndexByte(raw, '\n')+1]
+	issued := make(map[addr.IA][]time.Time)
+	for i, line := range bytes.Split(complete, []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+		var entry Issuance
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return nil, serrors.Wrap("parsing issuance log entry", err,
+				"file", path, "line", i+1)
+		}
+		issued[entry.IA] = append(issued[entry.IA], entry.Time)
+	}
+	if len(complete) != len(raw) {
+		log.Info("Discarding incomplete issuance log entry", "file", path)
+		if err := os.Truncate(path, int64(len(complete))); err != nil {
+			return nil, serrors.Wrap("truncating issuance log", err, "file", path)
+		}
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, serrors.Wrap("opening issuance log", err, "file", path)
+	}
+	return &IssuanceLog{
+		file:   file,
+		issued: issued,
+	}, nil
+}
+
+// Append appends the entry to the log. The entry is synced to disk before the
+// call returns.
+func (l *IssuanceLog) Append(entry Issuance) error {
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return serrors.Wrap("encoding issuance log entry", err)
+	}
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if _, err := l.file.Write(append(raw, '\n')); err != nil {
+		return serrors.Wrap("writing issuance log entry", err)
+	}
+	if err := l.file.Sync(); err != nil {
+		return serrors.Wrap("syncing issuance log", err)
+	}
+	l.issued[entry.IA] = append(l.issued[entry.IA], entry.Time)
+	return nil
+}
+
+// Count returns the number of certificates that were issued to the AS since
+// the given time.
+func (l *IssuanceLog) Count(ia addr.IA, since time.Time) int {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	count := 0
+	for _, t := range l.issued[ia] {
+		if !t.Before(since) {
+			count++
+		}
+	}
+	return count
+}
+
+// Close closes the log file.
+func (l *IssuanceLog) Close() error {
+	return l.file.Close()
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ca_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/ca"
+)
+
+func TestIssuanceLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issuance.log")
+	now := time.Now()
+
+	l, err := ca.OpenIssuanceLog(path)
+	require.NoError(t, err)
+	require.NoError(t, l.Append(ca.Issuance{IA: asIA, Time: now.Add(-2 * time.Hour)}))
+	require.NoError(t, l.Append(ca.Issuance{IA: asIA, Time: now}))
+	require.NoError(t, l.Append(ca.Issuance{IA: caIA, Time: now}))
+	assert.Equal(t, 1, l.Count(asIA, now.Add(-time.Hour)))
+	require.NoError(t, l.Close())
+
+	// Simulate a crash while writing an entry.
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"time":`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	l, err = ca.OpenIssuanceLog(path)
+	require.NoError(t, err)
+	defer l.Close()
+	assert.Equal(t, 2, l.Count(asIA, now.Add(-3*time.Hour)))
+	assert.Equal(t, 1, l.Count(caIA, now.Add(-3*time.Hour)))
+	require.NoError(t, l.Append(ca.Issuance{IA: asIA, Time: now}))
+	assert.Equal(t, 2, l.Count(asIA, now.Add(-time.Hour)))
+
+	// The incomplete entry has been discarded.
+	_, err = ca.OpenIssuanceLog(path)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ca
+
+import (
+	"crypto/x509"
+	"time"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/serrors"
+)
+
+// ValidityPolicy determines the validity period of the issued AS
+// certificates.
+type ValidityPolicy struct {
+	// Validity is the validity period of the issued AS certificates.
+	Validity time.Duration
+	// ASValidity overrides the validity period for individual ASes.
+	ASValidity map[addr.IA]time.Duration
+	// MinValidity is the minimum validity period of the issued AS
+	// certificates. If the CA certificate expires before the end of the
+	// regular validity period, the AS certificate is truncated to the
+	// expiration time of the CA certificate, as long as the remaining validity
+	// period is at least MinValidity. If it is zero, the AS certificates are
+	// never truncated.
+	MinValidity time.Duration
+}
+
+// ValidityFor returns the validity period of the AS certificate that is
+// issued to the AS at the given time with the CA certificate.
+func (p ValidityPolicy) ValidityFor(
+	ia addr.IA,
+	ca *x509.Certificate,
+	now time.Time,
+) (time.Duration, error) {
+
+	validity := p.Validity
+	if v, ok := p.ASValidity[ia]; ok {
+		validity = v
+	}
+	remaining := ca.NotAfter.Sub(now)
+	if validity <= remaining {
+		return validity, nil
+	}
+	if p.MinValidity == 0 || remaining < p.MinValidity {
+		return 0, serrors.New("CA certificate expires too early",
+			"validity", validity, "min_validity", p.MinValidity, "ca_not_after", ca.NotAfter)
+	}
+	return remaining, nil
+}
+
+// RateLimit limits the number of certificates that are issued per AS.
+type RateLimit struct {
+	// Issuances is the number of certificates that are issued to an AS per
+	// period. If it is zero, the number of issued certificates is not limited.
+	Issuances int
+	// Period is the period the issuances are counted in.
+	Period time.Duration
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ca_test
+
+import (
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/scionproto/scion/ca"
+	"github.com/scionproto/scion/pkg/addr"
+)
+
+func TestValidityPolicy(t *testing.T) {
+	now := time.Now()
+	policy := ca.ValidityPolicy{
+		Validity:    3 * 24 * time.Hour,
+		ASValidity:  map[addr.IA]time.Duration{asIA: 24 * time.Hour},
+		MinValidity: 12 * time.Hour,
+	}
+	testCases := map[string]struct {
+		Policy    ca.ValidityPolicy
+		IA        addr.IA
+		CAExpiry  time.Duration
+		Expected  time.Duration
+		Assertion assert.ErrorAssertionFunc
+	}{
+		"default validity": {
+			Policy:    policy,
+			IA:        caIA,
+			CAExpiry:  7 * 24 * time.Hour,
+			Expected:  3 * 24 * time.Hour,
+			Assertion: assert.NoError,
+		},
+		"AS validity": {
+			Policy:    policy,
+			IA:        asIA,
+			CAExpiry:  7 * 24 * time.Hour,
+			Expected:  24 * time.Hour,
+			Assertion: assert.NoError,
+		},
+		"truncated": {
+			Policy:    policy,
+			IA:        caIA,
+			CAExpiry:  24 * time.Hour,
+			Expected:  24 * time.Hour,
+			Assertion: assert.NoError,
+		},
+		"below min validity": {
+			Policy:    policy,
+			IA:        caIA,
+			CAExpiry:  6 * time.Hour,
+			Assertion: assert.Error,
+		},
+		"truncation disabled": {
+			Policy:    ca.ValidityPolicy{Validity: 3 * 24 * time.Hour},
+			IA:        caIA,
+			CAExpiry:  24 * time.Hour,
+			Assertion: assert.Error,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cert := &x509.Certificate{NotAfter: now.Add(tc.CAExpiry)}
+			validity, err := tc.Policy.ValidityFor(tc.IA, cert, now)
+			tc.Assertion(t, err)
+			assert.Equal(t, tc.Expected, validity)
+		})
+	}
+}
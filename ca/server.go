@@ -0,0 +1,340 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ca implements the CA service API that is used by the control
+// service in the delegating CA mode. The CA service issues AS certificates
+// for the ASes of its ISD.
+package ca
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/metrics"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	"github.com/scionproto/scion/private/ca/api"
+	"github.com/scionproto/scion/private/ca/renewal"
+	"github.com/scionproto/scion/private/mgmtapi/jwtauth"
+)
+
+// Problem types of the error responses.
+const (
+	badRequest         = "/problems/bad-request"
+	unauthorized       = "/problems/unauthorized"
+	notFound           = "/problems/not-found"
+	internalError      = "/problems/internal-error"
+	serviceUnavailable = "/problems/service-unavailable"
+)
+
+var (
+	errRateLimited = serrors.New("rate limit exceeded")
+	errUnavailable = serrors.New("CA unavailable")
+)
+
+// RequestVerifier verifies the CMS signed renewal requests.
+type RequestVerifier interface {
+	VerifyCMSSignedRenewalRequest(context.Context, []byte) (*x509.CertificateRequest, error)
+}
+
+// Metrics contains the metrics of the CA service.
+type Metrics struct {
+	// Requests counts the renewal requests, labeled by the result.
+	Requests func(result string) metrics.Counter
+}
+
+// Server implements the CA service API.
+type Server struct {
+	// IA is the ISD-AS of the CA. Only ASes in the same ISD are served.
+	IA addr.IA
+	// Verifier verifies the renewal requests.
+	Verifier RequestVerifier
+	// PolicyGen provides the CA certificate and key that issue the AS
+	// certificates.
+	PolicyGen renewal.PolicyGen
+	// Validity determines the validity period of the issued certificates.
+	Validity ValidityPolicy
+	// RateLimit limits the number of issued certificates per AS.
+	RateLimit RateLimit
+	// Log records the issued certificates.
+	Log *IssuanceLog
+	// SharedSecret returns the key that JWT tokens are signed with.
+	SharedSecret jwtauth.KeyFunc
+	// TokenLifetime is the lifetime of the tokens that are issued on the
+	// /auth/token endpoint.
+	TokenLifetime time.Duration
+	// Clients returns the secrets of the clients that can request a JWT token
+	// on the /auth/token endpoint, indexed by the client ID.
+	Clients map[string]jwtauth.KeyFunc
+	// Metrics contains the metrics. It is safe to pass nil-counters.
+	Metrics Metrics
+
+	// mtx serializes the issuance, such that the rate limit cannot be
+	// exceeded by concurrent requests.
+	mtx sync.Mutex
+}
+
+// Handler returns the HTTP handler of the CA service API. The operations that
+// require authorization are only served for requests with a valid JWT bearer
+// token that is signed with the shared secret.
+func (s *Server) Handler() http.Handler {
+	verifier := &jwtauth.HTTPVerifier{
+		Generator: s.SharedSecret,
+		Logger:    log.New("component", "jwt"),
+	}
+	return api.HandlerWithOptions(s, api.ChiServerOptions{
+		BaseRouter:  chi.NewRouter(),
+		Middlewares: []api.MiddlewareFunc{authorization(verifier)},
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			writeProblem(w, http.StatusBadRequest, badRequest, "malformed request", err)
+		},
+	})
+}
+
+// authorization enforces the JWT authorization for the operations that declare
+// the bearer authentication scheme.
+func authorization(verifier *jwtauth.HTTPVerifier) api.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		authorized := verifier.AddAuthorization(next, time.Now)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Context().Value(api.BearerAuthScopes) == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			authorized.ServeHTTP(w, r)
+		})
+	}
+}
+
+// PostCertificateRenewal issues a renewed AS certificate.
+func (s *Server) PostCertificateRenewal(
+	w http.ResponseWriter,
+	r *http.Request,
+	isdNumber int,
+	asNumber api.AS,
+) {
+
+	ctx := r.Context()
+	logger := log.FromCtx(ctx)
+
+	ia, err := parseIA(isdNumber, asNumber)
+	if err != nil {
+		s.incRequests("err_parse")
+		writeProblem(w, http.StatusBadRequest, badRequest, "malformed ISD-AS", err)
+		return
+	}
+	if ia.ISD() != s.IA.ISD() {
+		s.incRequests("err_not_found")
+		writeProblem(w, http.StatusNotFound, notFound, "ISD not served by this CA", nil)
+		return
+	}
+	var body api.RenewalRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.incRequests("err_parse")
+		writeProblem(w, http.StatusBadRequest, badRequest, "malformed request body", err)
+		return
+	}
+	csr, err := s.Verifier.VerifyCMSSignedRenewalRequest(ctx, body.Csr)
+	if err != nil {
+		logger.Info("Failed to verify certificate renewal request", "isd_as", ia, "err", err)
+		s.incRequests("err_verify")
+		writeProblem(w, http.StatusBadRequest, badRequest, "verifying request", err)
+		return
+	}
+	subject, err := cppki.ExtractIA(csr.Subject)
+	if err != nil || !subject.Equal(ia) {
+		s.incRequests("err_verify")
+		writeProblem(w, http.StatusBadRequest, badRequest,
+			"request subject does not match ISD-AS", nil)
+		return
+	}
+
+	chain, err := s.issue(ctx, ia, clientID(r), csr)
+	if err != nil {
+		logger.Info("Failed to issue AS certificate", "isd_as", ia, "err", err)
+		switch {
+		case errors.Is(err, errRateLimited):
+			s.incRequests("err_rate_limit")
+			writeProblem(w, http.StatusServiceUnavailable, serviceUnavailable,
+				"rate limit exceeded", err)
+		case errors.Is(err, errUnavailable):
+			s.incRequests("err_unavailable")
+			writeProblem(w, http.StatusServiceUnavailable, serviceUnavailable,
+				"issuing certificate", err)
+		default:
+			s.incRequests("err_internal")
+			writeProblem(w, http.StatusInternalServerError, internalError,
+				"issuing certificate", err)
+		}
+		return
+	}
+	logger.Info("Issued AS certificate", "isd_as", ia,
+		"serial", chain[0].SerialNumber.Text(16), "not_after", chain[0].NotAfter)
+	s.incRequests("ok_success")
+
+	var rep api.RenewalResponse
+	if err := rep.CertificateChain.FromCertificateChain(api.CertificateChain{
+		AsCertificate: chain[0].Raw,
+		CaCertificate: chain[1].Raw,
+	}); err != nil {
+		writeProblem(w, http.StatusInternalServerError, internalError,
+			"encoding response", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(rep)
+}
+
+// issue creates the AS certificate and records it in the issuance log.
+func (s *Server) issue(
+	ctx context.Context,
+	ia addr.IA,
+	client string,
+	csr *x509.CertificateRequest,
+) ([]*x509.Certificate, error) {
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	now := time.Now()
+	if s.RateLimit.Issuances > 0 {
+		issued := s.Log.Count(ia, now.Add(-s.RateLimit.Period))
+		if issued >= s.RateLimit.Issuances {
+			return nil, serrors.JoinNoStack(errRateLimited, nil,
+				"issued", issued, "period", s.RateLimit.Period)
+		}
+	}
+	policy, err := s.PolicyGen.Generate(ctx)
+	if err != nil {
+		return nil, serrors.JoinNoStack(errUnavailable, err)
+	}
+	validity, err := s.Validity.ValidityFor(ia, policy.Certificate, now)
+	if err != nil {
+		return nil, serrors.JoinNoStack(errUnavailable, err)
+	}
+	policy.Validity = validity
+	policy.CurrentTime = now
+	chain, err := policy.CreateChain(csr)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Log.Append(NewIssuance(now, ia, client, chain[0])); err != nil {
+		return nil, err
+	}
+	return chain, nil
+}
+
+// PostAuthToken issues a JWT token for a client that authenticates with its
+// client secret.
+func (s *Server) PostAuthToken(w http.ResponseWriter, r *http.Request) {
+	var creds api.AccessCredentials
+	if err := json.NewDecoder(r.Body).Decode(&creds); err != nil {
+		writeProblem(w, http.StatusBadRequest, badRequest, "malformed request body", err)
+		return
+	}
+	secret, ok := s.Clients[creds.ClientId]
+	if !ok {
+		writeProblem(w, http.StatusUnauthorized, unauthorized, "invalid credentials", nil)
+		return
+	}
+	expected, err := secret()
+	if err != nil {
+		log.FromCtx(r.Context()).Info("Failed to load client secret",
+			"client_id", creds.ClientId, "err", err)
+		writeProblem(w, http.StatusInternalServerError, internalError,
+			"loading client secret", nil)
+		return
+	}
+	if subtle.ConstantTimeCompare(expected, []byte(creds.ClientSecret)) != 1 {
+		writeProblem(w, http.StatusUnauthorized, unauthorized, "invalid credentials", nil)
+		return
+	}
+	lifetime := s.TokenLifetime
+	if lifetime == 0 {
+		lifetime = jwtauth.DefaultTokenLifetime
+	}
+	token, err := (&jwtauth.JWTTokenSource{
+		Subject:   creds.ClientId,
+		Lifetime:  lifetime,
+		Generator: s.SharedSecret,
+	}).Token()
+	if err != nil {
+		writeProblem(w, http.StatusInternalServerError, internalError, "creating token", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(api.AccessToken{
+		AccessToken: token.String(),
+		TokenType:   api.Bearer,
+		ExpiresIn:   int(lifetime.Seconds()),
+	})
+}
+
+// GetHealthcheck reports whether the CA is able to issue certificates.
+func (s *Server) GetHealthcheck(w http.ResponseWriter, r *http.Request) {
+	status := api.Available
+	if _, err := s.PolicyGen.Generate(r.Context()); err != nil {
+		status = api.Unavailable
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(api.HealthCheckStatus{Status: status})
+}
+
+func (s *Server) incRequests(result string) {
+	if s.Metrics.Requests != nil {
+		metrics.CounterInc(s.Metrics.Requests(result))
+	}
+}
+
+func parseIA(isd int, as api.AS) (addr.IA, error) {
+	return addr.ParseIA(fmt.Sprintf("%d-%s", isd, as))
+}
+
+// clientID returns the subject of the JWT token that authorized the request.
+func clientID(r *http.Request) string {
+	token, err := jwt.ParseRequest(r, jwt.WithVerify(false), jwt.WithValidate(false))
+	if err != nil {
+		return ""
+	}
+	subject, _ := token.Subject()
+	return subject
+}
+
+func writeProblem(w http.ResponseWriter, status int, typ, title string, err error) {
+	problem := api.Problem{
+		Status: status,
+		Title:  title,
+		Type:   typ,
+	}
+	if err != nil {
+		detail := err.Error()
+		problem.Detail = &detail
+	}
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(problem)
+}
@@ -0,0 +1,297 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ca_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/ca"
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/xtest"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	"github.com/scionproto/scion/pkg/scrypto/signed"
+	"github.com/scionproto/scion/private/ca/api"
+	"github.com/scionproto/scion/private/ca/renewal"
+	"github.com/scionproto/scion/private/mgmtapi/jwtauth"
+	"github.com/scionproto/scion/private/trust"
+)
+
+var (
+	caIA = addr.MustParseIA("1-ff00:0:110")
+	asIA = addr.MustParseIA("1-ff00:0:111")
+
+	sharedSecret = bytes.Repeat([]byte{1}, 32)
+	clientSecret = bytes.Repeat([]byte{2}, 32)
+)
+
+func TestServerRenewal(t *testing.T) {
+	cryptoDir := genCrypto(t)
+	signedTRC := xtest.LoadTRC(t, filepath.Join(cryptoDir, "trcs/ISD1-B1-S1.trc"))
+	caDir := filepath.Join(cryptoDir, "ISD1/ASff00_0_110/crypto/ca")
+	policy := cppki.CAPolicy{
+		Certificate: xtest.LoadChain(t, filepath.Join(caDir, "ISD1-ASff00_0_110.ca.crt"))[0],
+		Signer:      xtest.LoadSigner(t, filepath.Join(caDir, "cp-ca.key")),
+	}
+	asDir := filepath.Join(cryptoDir, "ISD1/ASff00_0_111/crypto/as")
+	chain := xtest.LoadChain(t, filepath.Join(asDir, "ISD1-ASff00_0_111.pem"))
+	request := newRequest(t, trust.Signer{
+		PrivateKey:   xtest.LoadSigner(t, filepath.Join(asDir, "cp-as.key")),
+		Algorithm:    signed.ECDSAWithSHA256,
+		IA:           asIA,
+		TRCID:        signedTRC.TRC.ID,
+		SubjectKeyID: chain[0].SubjectKeyId,
+		Expiration:   chain[0].NotAfter,
+		ChainValidity: cppki.Validity{
+			NotBefore: chain[0].NotBefore,
+			NotAfter:  chain[0].NotAfter,
+		},
+		Subject: chain[0].Subject,
+		Chain:   chain,
+	})
+
+	testCases := map[string]struct {
+		ISD          int
+		AS           string
+		Token        jwtauth.TokenSource
+		MaxIssuances int
+		Status       int
+	}{
+		"renewed": {
+			ISD:    1,
+			AS:     "ff00:0:111",
+			Token:  &jwtauth.JWTTokenSource{Subject: "cs1", Generator: staticKey(sharedSecret)},
+			Status: http.StatusOK,
+		},
+		"no token": {
+			ISD:    1,
+			AS:     "ff00:0:111",
+			Status: http.StatusUnauthorized,
+		},
+		"wrong secret": {
+			ISD:    1,
+			AS:     "ff00:0:111",
+			Token:  &jwtauth.JWTTokenSource{Subject: "cs1", Generator: staticKey(clientSecret)},
+			Status: http.StatusUnauthorized,
+		},
+		"other ISD": {
+			ISD:    2,
+			AS:     "ff00:0:111",
+			Token:  &jwtauth.JWTTokenSource{Subject: "cs1", Generator: staticKey(sharedSecret)},
+			Status: http.StatusNotFound,
+		},
+		"subject mismatch": {
+			ISD:    1,
+			AS:     "ff00:0:112",
+			Token:  &jwtauth.JWTTokenSource{Subject: "cs1", Generator: staticKey(sharedSecret)},
+			Status: http.StatusBadRequest,
+		},
+		"rate limited": {
+			ISD:          1,
+			AS:           "ff00:0:111",
+			Token:        &jwtauth.JWTTokenSource{Subject: "cs1", Generator: staticKey(sharedSecret)},
+			MaxIssuances: -1,
+			Status:       http.StatusServiceUnavailable,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			issuanceLog, err := ca.OpenIssuanceLog(filepath.Join(t.TempDir(), "issuance.log"))
+			require.NoError(t, err)
+			defer issuanceLog.Close()
+			if tc.MaxIssuances < 0 {
+				require.NoError(t, issuanceLog.Append(ca.Issuance{IA: asIA, Time: time.Now()}))
+			}
+			s := &ca.Server{
+				IA:           caIA,
+				Verifier:     renewal.RequestVerifier{TRCFetcher: staticTRCs{trc: signedTRC}},
+				PolicyGen:    staticPolicyGen{policy: policy},
+				Validity:     ca.ValidityPolicy{Validity: 24 * time.Hour},
+				RateLimit:    ca.RateLimit{Issuances: 1, Period: time.Hour},
+				Log:          issuanceLog,
+				SharedSecret: staticKey(sharedSecret),
+			}
+			srv := httptest.NewServer(s.Handler())
+			defer srv.Close()
+			client, err := api.NewClient(srv.URL,
+				api.WithHTTPClient(jwtauth.NewHTTPClient(tc.Token)))
+			require.NoError(t, err)
+
+			rep, err := client.PostCertificateRenewal(context.Background(), tc.ISD, tc.AS,
+				api.RenewalRequest{Csr: request})
+			require.NoError(t, err)
+			defer rep.Body.Close()
+			require.Equal(t, tc.Status, rep.StatusCode)
+			if tc.Status != http.StatusOK {
+				return
+			}
+			var body api.RenewalResponse
+			require.NoError(t, json.NewDecoder(rep.Body).Decode(&body))
+			renewed, err := body.CertificateChain.AsCertificateChain()
+			require.NoError(t, err)
+			as, err := x509.ParseCertificate(renewed.AsCertificate)
+			require.NoError(t, err)
+			assert.Equal(t, 24*time.Hour, as.NotAfter.Sub(as.NotBefore))
+			assert.NoError(t, cppki.VerifyChain([]*x509.Certificate{as, policy.Certificate},
+				cppki.VerifyOptions{TRC: []*cppki.TRC{&signedTRC.TRC}}))
+			assert.Equal(t, 1, issuanceLog.Count(asIA, time.Now().Add(-time.Minute)))
+		})
+	}
+}
+
+func TestServerAuthToken(t *testing.T) {
+	s := &ca.Server{
+		SharedSecret: staticKey(sharedSecret),
+		Clients:      map[string]jwtauth.KeyFunc{"client": staticKey(clientSecret)},
+	}
+	srv := httptest.NewServer(s.Handler())
+	defer srv.Close()
+	client, err := api.NewClient(srv.URL)
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		Credentials api.AccessCredentials
+		Status      int
+	}{
+		"valid": {
+			Credentials: api.AccessCredentials{
+				ClientId:     "client",
+				ClientSecret: string(clientSecret),
+			},
+			Status: http.StatusOK,
+		},
+		"wrong secret": {
+			Credentials: api.AccessCredentials{
+				ClientId:     "client",
+				ClientSecret: string(sharedSecret),
+			},
+			Status: http.StatusUnauthorized,
+		},
+		"unknown client": {
+			Credentials: api.AccessCredentials{
+				ClientId:     "other",
+				ClientSecret: string(clientSecret),
+			},
+			Status: http.StatusUnauthorized,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rep, err := client.PostAuthToken(context.Background(), tc.Credentials)
+			require.NoError(t, err)
+			defer rep.Body.Close()
+			require.Equal(t, tc.Status, rep.StatusCode)
+			if tc.Status != http.StatusOK {
+				return
+			}
+			var token api.AccessToken
+			require.NoError(t, json.NewDecoder(rep.Body).Decode(&token))
+			assert.Equal(t, api.Bearer, token.TokenType)
+			assert.Equal(t, int(jwtauth.DefaultTokenLifetime.Seconds()), token.ExpiresIn)
+
+			// The token is accepted by the server.
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+			var authorized bool
+			verifier := &jwtauth.HTTPVerifier{Generator: staticKey(sharedSecret)}
+			verifier.AddAuthorization(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+				authorized = true
+			}), time.Now).ServeHTTP(httptest.NewRecorder(), req)
+			assert.True(t, authorized)
+		})
+	}
+}
+
+func TestServerHealthcheck(t *testing.T) {
+	testCases := map[string]struct {
+		PolicyGen renewal.PolicyGen
+		Status    api.HealthCheckStatusStatus
+	}{
+		"available": {
+			PolicyGen: staticPolicyGen{},
+			Status:    api.Available,
+		},
+		"unavailable": {
+			PolicyGen: staticPolicyGen{err: assert.AnError},
+			Status:    api.Unavailable,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			s := &ca.Server{PolicyGen: tc.PolicyGen}
+			srv := httptest.NewServer(s.Handler())
+			defer srv.Close()
+			client, err := api.NewClient(srv.URL)
+			require.NoError(t, err)
+
+			rep, err := client.GetHealthcheck(context.Background())
+			require.NoError(t, err)
+			defer rep.Body.Close()
+			require.Equal(t, http.StatusOK, rep.StatusCode)
+			var status api.HealthCheckStatus
+			require.NoError(t, json.NewDecoder(rep.Body).Decode(&status))
+			assert.Equal(t, tc.Status, status.Status)
+		})
+	}
+}
+
+func newRequest(t *testing.T, signer trust.Signer) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	subject := signer.Subject
+	subject.ExtraNames = subject.Names
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{ExtraNames: subject.ExtraNames},
+	}, key)
+	require.NoError(t, err)
+	req, err := renewal.NewChainRenewalRequest(context.Background(), csr, signer)
+	require.NoError(t, err)
+	return req.CmsSignedRequest
+}
+
+func staticKey(key []byte) jwtauth.KeyFunc {
+	return func() ([]byte, error) { return key, nil }
+}
+
+type staticTRCs struct {
+	trc cppki.SignedTRC
+}
+
+func (s staticTRCs) SignedTRC(context.Context, cppki.TRCID) (cppki.SignedTRC, error) {
+	return s.trc, nil
+}
+
+type staticPolicyGen struct {
+	policy cppki.CAPolicy
+	err    error
+}
+
+func (g staticPolicyGen) Generate(context.Context) (cppki.CAPolicy, error) {
+	return g.policy, g.err
+}
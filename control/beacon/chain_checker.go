@@ -17,6 +17,7 @@ package beacon
 import (
 	"context"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"net"
 	"time"
@@ -32,6 +33,7 @@ import (
 	"github.com/scionproto/scion/pkg/scrypto/signed"
 	infra "github.com/scionproto/scion/private/segment/verifier"
 	"github.com/scionproto/scion/private/trust"
+	trustmetrics "github.com/scionproto/scion/private/trust/metrics"
 )
 
 type ChainProvider interface {
@@ -47,6 +49,10 @@ var _ infra.Verifier = chainChecker{}
 
 // chainChecker checks that the certificate chain is available locally. This is used
 // to ensure we do not propagate beacons that are not verifiable.
+//
+// The cache is not invalidated when a new CRL is inserted. A chain that is
+// revoked after it has been cached is accepted until the cache entry expires,
+// i.e., for at most defaultCacheHitExpiration.
 type chainChecker struct {
 	BoundValidity cppki.Validity
 	Engine        ChainProvider
@@ -131,6 +137,13 @@ func (v chainChecker) checkChains(ctx context.Context, q trust.ChainQuery) error
 	}
 
 	chains, err := v.Engine.GetChains(ctx, q)
+	if errors.Is(err, trust.ErrRevoked) {
+		// Cache the revoked chains as a miss, such that the beacons of the AS
+		// are rejected without looking up the chains for every beacon.
+		trustmetrics.RevokedChainChecksTotal.Inc()
+		v.cacheChains(key, nil, defaultCacheMissExpiration)
+		return err
+	}
 	if err != nil {
 		return err
 	}
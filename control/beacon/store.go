@@ -16,6 +16,7 @@ package beacon
 
 import (
 	"context"
+	"sync"
 
 	"github.com/scionproto/scion/pkg/log"
 	"github.com/scionproto/scion/pkg/private/serrors"
@@ -88,18 +89,22 @@ func NewBeaconStore(policies Policies, db DB, opts ...StoreOption) (*Store, erro
 // BeaconsToPropagate returns a slice of all beacons to propagate at the time of the call.
 // The selection is based on the configured propagation policy.
 func (s *Store) BeaconsToPropagate(ctx context.Context) ([]Beacon, error) {
-	return s.getBeacons(ctx, &s.policies.Prop)
+	s.mu.RLock()
+	policy := s.policies.Prop
+	s.mu.RUnlock()
+	return s.getBeacons(ctx, &policy)
 }
 
 // SegmentsToRegister returns a channel that provides all beacons to register at
 // the time of the call. The selections are based on the configured policy for
 // the requested segment type.
 func (s *Store) SegmentsToRegister(ctx context.Context, segType seg.Type) ([]Beacon, error) {
+	policies := s.Policies()
 	switch segType {
 	case seg.TypeDown:
-		return s.getBeacons(ctx, &s.policies.DownReg)
+		return s.getBeacons(ctx, &policies.DownReg)
 	case seg.TypeUp:
-		return s.getBeacons(ctx, &s.policies.UpReg)
+		return s.getBeacons(ctx, &policies.UpReg)
 	default:
 		return nil, serrors.New("Unsupported segment type", "type", segType)
 	}
@@ -118,6 +123,8 @@ func (s *Store) getBeacons(ctx context.Context, policy *Policy) ([]Beacon, error
 
 // MaxExpTime returns the segment maximum expiration time for the given policy.
 func (s *Store) MaxExpTime(policyType PolicyType) uint8 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	switch policyType {
 	case UpRegPolicy:
 		return *s.policies.UpReg.MaxExpTime
@@ -129,6 +136,28 @@ func (s *Store) MaxExpTime(policyType PolicyType) uint8 {
 	return DefaultMaxExpTime
 }
 
+// Policies returns the policies that are currently in use.
+func (s *Store) Policies() Policies {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.policies
+}
+
+// SetPolicies replaces the policies of the store. The policies are validated
+// before they are put in use. Beacons that are already in the database are
+// kept, the new policies only apply to subsequently inserted and selected
+// beacons.
+func (s *Store) SetPolicies(policies Policies) error {
+	policies.InitDefaults()
+	if err := policies.Validate(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.policies = policies
+	return nil
+}
+
 // CoreStore provides abstracted access to the beacon database in a core AS. The
 // store helps to insert beacons and revocations, and selects the best beacons
 // for given purposes based on the configured policies. It should not be used in
@@ -159,7 +188,10 @@ func NewCoreBeaconStore(policies CorePolicies, db DB, opts ...StoreOption) (*Cor
 // BeaconsToPropagate returns a slice of all beacons to propagate at the time of the call.
 // The selection is based on the configured propagation policy.
 func (s *CoreStore) BeaconsToPropagate(ctx context.Context) ([]Beacon, error) {
-	return s.getBeacons(ctx, &s.policies.Prop)
+	s.mu.RLock()
+	policy := s.policies.Prop
+	s.mu.RUnlock()
+	return s.getBeacons(ctx, &policy)
 }
 
 // SegmentsToRegister returns a slice of all beacons to register at the time of the call.
@@ -169,7 +201,10 @@ func (s *CoreStore) SegmentsToRegister(ctx context.Context, segType seg.Type) ([
 	if segType != seg.TypeCore {
 		return nil, serrors.New("Unsupported segment type", "type", segType)
 	}
-	return s.getBeacons(ctx, &s.policies.CoreReg)
+	s.mu.RLock()
+	policy := s.policies.CoreReg
+	s.mu.RUnlock()
+	return s.getBeacons(ctx, &policy)
 }
 
 // getBeacons fetches the candidate beacons from the database and serves the
@@ -196,6 +231,8 @@ func (s *CoreStore) getBeacons(ctx context.Context, policy *Policy) ([]Beacon, e
 
 // MaxExpTime returns the segment maximum expiration time for the given policy.
 func (s *CoreStore) MaxExpTime(policyType PolicyType) uint8 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	switch policyType {
 	case CoreRegPolicy:
 		return *s.policies.CoreReg.MaxExpTime
@@ -205,17 +242,44 @@ func (s *CoreStore) MaxExpTime(policyType PolicyType) uint8 {
 	return DefaultMaxExpTime
 }
 
+// Policies returns the policies that are currently in use.
+func (s *CoreStore) Policies() CorePolicies {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.policies
+}
+
+// SetPolicies replaces the policies of the store. The policies are validated
+// before they are put in use. Beacons that are already in the database are
+// kept, the new policies only apply to subsequently inserted and selected
+// beacons.
+func (s *CoreStore) SetPolicies(policies CorePolicies) error {
+	policies.InitDefaults()
+	if err := policies.Validate(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.policies = policies
+	return nil
+}
+
 // baseStore is the basis for the beacon store.
 type baseStore struct {
-	db     DB
+	db   DB
+	algo selectionAlgorithm
+	// mu protects the policies referenced by usager, which can be replaced at
+	// runtime.
+	mu     sync.RWMutex
 	usager usager
-	algo   selectionAlgorithm
 }
 
 // PreFilter indicates whether the beacon will be filtered on insert by
 // returning an error with the reason. This allows the caller to drop
 // ignored beacons.
 func (s *baseStore) PreFilter(beacon Beacon) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.usager.Filter(beacon)
 }
 
@@ -223,7 +287,9 @@ func (s *baseStore) PreFilter(beacon Beacon) error {
 // Beacon that contains revoked interfaces is inserted and does not cause an error.
 // If the beacon does not match any policy, it is not inserted, but does not cause an error.
 func (s *baseStore) InsertBeacon(ctx context.Context, beacon Beacon) (InsertStats, error) {
+	s.mu.RLock()
 	usage := s.usager.Usage(beacon)
+	s.mu.RUnlock()
 	if usage.None() {
 		return InsertStats{Filtered: 1}, nil
 	}
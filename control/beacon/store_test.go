@@ -261,6 +261,48 @@ func testCoreStoreSelection(t *testing.T,
 	}
 }
 
+func TestStoreSetPolicies(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	store, err := beacon.NewBeaconStore(beacon.Policies{}, mock_beacon.NewMockDB(mctrl))
+	require.NoError(t, err)
+	require.Equal(t, beacon.DefaultMaxExpTime, store.MaxExpTime(beacon.UpRegPolicy))
+
+	maxExpTime := uint8(42)
+	err = store.SetPolicies(beacon.Policies{
+		UpReg: beacon.Policy{MaxExpTime: &maxExpTime},
+	})
+	require.NoError(t, err)
+	require.Equal(t, maxExpTime, store.MaxExpTime(beacon.UpRegPolicy))
+	require.Equal(t, beacon.DefaultMaxExpTime, store.MaxExpTime(beacon.DownRegPolicy))
+
+	err = store.SetPolicies(beacon.Policies{
+		UpReg: beacon.Policy{Type: beacon.PropPolicy},
+	})
+	require.Error(t, err)
+	require.Equal(t, maxExpTime, store.MaxExpTime(beacon.UpRegPolicy))
+}
+
+func TestCoreStoreSetPolicies(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	store, err := beacon.NewCoreBeaconStore(beacon.CorePolicies{},
+		mock_beacon.NewMockDB(mctrl))
+	require.NoError(t, err)
+
+	maxExpTime := uint8(42)
+	err = store.SetPolicies(beacon.CorePolicies{
+		CoreReg: beacon.Policy{MaxExpTime: &maxExpTime},
+	})
+	require.NoError(t, err)
+	require.Equal(t, maxExpTime, store.MaxExpTime(beacon.CoreRegPolicy))
+	require.Equal(t, maxExpTime, *store.Policies().CoreReg.MaxExpTime)
+
+	err = store.SetPolicies(beacon.CorePolicies{
+		Prop: beacon.Policy{Type: beacon.CoreRegPolicy},
+	})
+	require.Error(t, err)
+	require.Equal(t, maxExpTime, store.MaxExpTime(beacon.CoreRegPolicy))
+}
+
 func testBeacon(g *graph.Graph, desc ...uint16) beacon.Beacon {
 	pseg := testSegment(g, desc)
 	asEntry := pseg.ASEntries[pseg.MaxIdx()]
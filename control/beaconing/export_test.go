@@ -15,12 +15,14 @@
 package beaconing
 
 import (
+	"time"
+
 	"github.com/scionproto/scion/pkg/segment/extensions/staticinfo"
 	"github.com/scionproto/scion/pkg/segment/iface"
 	"github.com/scionproto/scion/private/topology"
 )
 
 func (cfg StaticInfoCfg) TestGenerate(ifType map[iface.ID]topology.LinkType,
-	ingress, egress iface.ID) *staticinfo.Extension {
-	return cfg.generate(ifType, ingress, egress)
+	measured map[iface.ID]time.Duration, ingress, egress iface.ID) *staticinfo.Extension {
+	return cfg.generate(ifType, measured, ingress, egress)
 }
@@ -201,18 +201,22 @@ func symmetrizeHops(hops map[iface.ID]InterfaceHops) {
 
 // Generate creates a StaticInfoExtn struct and
 // populates it with data extracted from the configuration.
+//
+// The inter-AS latency is taken from the measurements recorded on the
+// interfaces, unless the configuration specifies a value for the interface.
 func (cfg StaticInfoCfg) Generate(intfs *ifstate.Interfaces,
 	ingress, egress uint16) *staticinfo.Extension {
 
 	ifType := interfaceTypeTable(intfs)
-	return cfg.generate(ifType, iface.ID(ingress), iface.ID(egress))
+	measured := interfaceLatencyTable(intfs)
+	return cfg.generate(ifType, measured, iface.ID(ingress), iface.ID(egress))
 }
 
 func (cfg StaticInfoCfg) generate(ifType map[iface.ID]topology.LinkType,
-	ingress, egress iface.ID) *staticinfo.Extension {
+	measured map[iface.ID]time.Duration, ingress, egress iface.ID) *staticinfo.Extension {
 
 	return &staticinfo.Extension{
-		Latency:      cfg.generateLatency(ifType, ingress, egress),
+		Latency:      cfg.generateLatency(ifType, measured, ingress, egress),
 		Bandwidth:    cfg.generateBandwidth(ifType, ingress, egress),
 		Geo:          cfg.generateGeo(ifType, ingress, egress),
 		LinkType:     cfg.generateLinkType(ifType, egress),
@@ -222,9 +226,10 @@ func (cfg StaticInfoCfg) generate(ifType map[iface.ID]topology.LinkType,
 }
 
 // generateLatency creates the LatencyInfo by extracting the relevant values from
-// the config.
+// the config and the measured inter-AS latencies. A non-zero inter-AS latency in
+// the config overrides the measured value.
 func (cfg StaticInfoCfg) generateLatency(ifType map[iface.ID]topology.LinkType,
-	ingress, egress iface.ID) staticinfo.LatencyInfo {
+	measured map[iface.ID]time.Duration, ingress, egress iface.ID) staticinfo.LatencyInfo {
 
 	l := staticinfo.LatencyInfo{
 		Intra: make(map[iface.ID]time.Duration),
@@ -235,10 +240,18 @@ func (cfg StaticInfoCfg) generateLatency(ifType map[iface.ID]topology.LinkType,
 			l.Intra[ifID] = v.Duration
 		}
 	}
+	for ifID, v := range measured {
+		t := ifType[ifID]
+		if ifID == egress || t == topology.Peer {
+			l.Inter[ifID] = v
+		}
+	}
 	for ifID, v := range cfg.Latency {
 		t := ifType[ifID]
 		if ifID == egress || t == topology.Peer {
-			l.Inter[ifID] = v.Inter.Duration
+			if _, ok := l.Inter[ifID]; !ok || v.Inter.Duration != 0 {
+				l.Inter[ifID] = v.Inter.Duration
+			}
 		}
 	}
 	return l
@@ -355,3 +368,13 @@ func interfaceTypeTable(intfs *ifstate.Interfaces) map[iface.ID]topology.LinkTyp
 	}
 	return ifTypes
 }
+
+func interfaceLatencyTable(intfs *ifstate.Interfaces) map[iface.ID]time.Duration {
+	latencies := make(map[iface.ID]time.Duration)
+	for ifID, intf := range intfs.All() {
+		if latency := intf.Latency(); latency != 0 {
+			latencies[iface.ID(ifID)] = latency
+		}
+	}
+	return latencies
+}
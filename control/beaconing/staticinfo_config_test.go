@@ -565,8 +565,80 @@ func TestGenerateStaticInfo(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			actual := cfg.TestGenerate(tc.ifType, tc.ingress, tc.egress)
+			actual := cfg.TestGenerate(tc.ifType, nil, tc.ingress, tc.egress)
 			assert.Equal(t, tc.expected, *actual)
 		})
 	}
 }
+
+func TestGenerateStaticInfoMeasuredLatency(t *testing.T) {
+	cfg := beaconing.StaticInfoCfg{
+		Latency: map[iface.ID]beaconing.InterfaceLatencies{
+			1: {
+				Inter: util.DurWrap{Duration: latency_inter_1},
+			},
+			2: {
+				Intra: map[iface.ID]util.DurWrap{
+					3: {Duration: latency_intra_2_3},
+				},
+			},
+		},
+	}
+	ifType := map[iface.ID]topology.LinkType{
+		1: topology.Child,
+		2: topology.Child,
+		3: topology.Parent,
+		5: topology.Peer,
+		6: topology.Child,
+	}
+	measured := map[iface.ID]time.Duration{
+		1: 3 * time.Millisecond,
+		2: 4 * time.Millisecond,
+		5: 5 * time.Millisecond,
+		6: 6 * time.Millisecond,
+	}
+
+	testCases := map[string]struct {
+		egress   iface.ID
+		expected staticinfo.LatencyInfo
+	}{
+		"configured value overrides measurement": {
+			egress: 1,
+			expected: staticinfo.LatencyInfo{
+				Intra: map[iface.ID]time.Duration{},
+				Inter: map[iface.ID]time.Duration{
+					1: latency_inter_1,
+					5: 5 * time.Millisecond,
+				},
+			},
+		},
+		"measurement used if only intra-AS latency is configured": {
+			egress: 2,
+			expected: staticinfo.LatencyInfo{
+				Intra: map[iface.ID]time.Duration{
+					3: latency_intra_2_3,
+				},
+				Inter: map[iface.ID]time.Duration{
+					2: 4 * time.Millisecond,
+					5: 5 * time.Millisecond,
+				},
+			},
+		},
+		"measurement used if nothing is configured": {
+			egress: 6,
+			expected: staticinfo.LatencyInfo{
+				Intra: map[iface.ID]time.Duration{},
+				Inter: map[iface.ID]time.Duration{
+					5: 5 * time.Millisecond,
+					6: 6 * time.Millisecond,
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := cfg.TestGenerate(ifType, measured, 3, tc.egress)
+			assert.Equal(t, tc.expected, actual.Latency)
+		})
+	}
+}
@@ -19,12 +19,14 @@ import (
 	"crypto/x509"
 	"encoding/json"
 	"errors"
+	"net"
 	"net/http"
 	_ "net/http/pprof"
 	"net/netip"
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -46,6 +48,7 @@ import (
 	"github.com/scionproto/scion/control/ifstate"
 	api "github.com/scionproto/scion/control/mgmtapi"
 	"github.com/scionproto/scion/control/onehop"
+	"github.com/scionproto/scion/control/revocation"
 	segreggrpc "github.com/scionproto/scion/control/segreg/grpc"
 	"github.com/scionproto/scion/control/segreq"
 	segreqgrpc "github.com/scionproto/scion/control/segreq/grpc"
@@ -64,6 +67,7 @@ import (
 	"github.com/scionproto/scion/pkg/scrypto/cppki"
 	"github.com/scionproto/scion/pkg/segment/iface"
 	"github.com/scionproto/scion/pkg/snet"
+	"github.com/scionproto/scion/pkg/snet/squic"
 	"github.com/scionproto/scion/private/app"
 	infraenv "github.com/scionproto/scion/private/app/appnet"
 	"github.com/scionproto/scion/private/app/command"
@@ -79,6 +83,7 @@ import (
 	"github.com/scionproto/scion/private/mgmtapi/jwtauth"
 	segapi "github.com/scionproto/scion/private/mgmtapi/segments/api"
 	"github.com/scionproto/scion/private/periodic"
+	revgrpc "github.com/scionproto/scion/private/revcache/grpc"
 	segfetchergrpc "github.com/scionproto/scion/private/segment/segfetcher/grpc"
 	"github.com/scionproto/scion/private/segment/seghandler"
 	"github.com/scionproto/scion/private/service"
@@ -92,6 +97,7 @@ import (
 	"github.com/scionproto/scion/private/topology"
 	"github.com/scionproto/scion/private/trust"
 	"github.com/scionproto/scion/private/trust/compat"
+	"github.com/scionproto/scion/private/trust/expiry"
 	trustgrpc "github.com/scionproto/scion/private/trust/grpc"
 	trustmetrics "github.com/scionproto/scion/private/trust/metrics"
 )
@@ -112,6 +118,7 @@ func main() {
 }
 
 func realMain(ctx context.Context) error {
+	scrypto.EnableEd25519(globalCfg.Features.ExperimentalEd25519)
 	metrics := cs.NewMetrics()
 
 	topo, err := topology.NewLoader(topology.LoaderCfg{
@@ -161,7 +168,7 @@ func realMain(ctx context.Context) error {
 	})
 	defer pathDB.Close()
 
-	macGen, err := cs.MACGenFactory(globalCfg.General.ConfigDir)
+	macGen, err := cs.NewMACGen(globalCfg.General.ConfigDir)
 	if err != nil {
 		return err
 	}
@@ -192,6 +199,10 @@ func realMain(ctx context.Context) error {
 			},
 		},
 	)
+	// TRCs that are newly inserted are announced to the neighboring ASes and
+	// the daemons. The announcer is started below.
+	newTRCs := make(chan cppki.TRCID, 16)
+	trustDB = cstrust.WrapAnnouncingDB(trustDB, newTRCs)
 	trustDB = truststoragemetrics.WrapDB(trustDB, truststoragemetrics.Config{
 		Driver:       string(storage.BackendSqlite),
 		QueriesTotal: libmetrics.NewPromCounter(metrics.TrustDBQueriesTotal),
@@ -199,6 +210,11 @@ func realMain(ctx context.Context) error {
 	if err := cs.LoadTrustMaterial(ctx, globalCfg.General.ConfigDir, trustDB); err != nil {
 		return err
 	}
+	asKeys, err := cs.NewKeyRing(globalCfg.Crypto.ASKeys,
+		filepath.Join(globalCfg.General.ConfigDir, "crypto/as"))
+	if err != nil {
+		return serrors.Wrap("initializing AS key ring", err)
+	}
 
 	// FIXME: readability would be improved if we could be consistent with address
 	// representations in NetworkConfig (string or cooked, chose one).
@@ -208,10 +224,10 @@ func realMain(ctx context.Context) error {
 		QUIC: infraenv.QUIC{
 			TLSVerifier: trust.NewTLSCryptoVerifier(trustDB),
 			GetCertificate: cs.NewTLSCertificateLoader(
-				topo.IA(), x509.ExtKeyUsageServerAuth, trustDB, globalCfg.General.ConfigDir,
+				topo.IA(), x509.ExtKeyUsageServerAuth, trustDB, globalCfg.General.ConfigDir, asKeys,
 			).GetCertificate,
 			GetClientCertificate: cs.NewTLSCertificateLoader(
-				topo.IA(), x509.ExtKeyUsageClientAuth, trustDB, globalCfg.General.ConfigDir,
+				topo.IA(), x509.ExtKeyUsageClientAuth, trustDB, globalCfg.General.ConfigDir, asKeys,
 			).GetClientCertificate,
 		},
 		SVCResolver: topo,
@@ -224,6 +240,14 @@ func realMain(ctx context.Context) error {
 		MTU:                    topo.MTU(),
 		Topology:               adaptTopology(topo),
 	}
+	var quicPathSelector *squic.RouterSelector
+	if globalCfg.Features.ExperimentalQUICMigration {
+		// XXX: cyclic dependency on the router, which uses the QUIC stack. It
+		// is set below, before the first connection is dialed.
+		quicPathSelector = &squic.RouterSelector{}
+		nc.QUICPathSelector = quicPathSelector
+	}
+	nc.PathMTUDiscovery = globalCfg.Features.ExperimentalPathMTUDiscovery
 	quicStack, err := nc.QUICStack(ctx)
 	if err != nil {
 		return serrors.Wrap("initializing QUIC stack", err)
@@ -235,7 +259,7 @@ func realMain(ctx context.Context) error {
 	dialer := &libgrpc.QUICDialer{
 		Rewriter: &onehop.AddressRewriter{
 			Rewriter: nc.AddressRewriter(),
-			MAC:      macGen(),
+			MAC:      macGen.New,
 		},
 		Dialer: quicStack.InsecureDialer,
 	}
@@ -313,6 +337,9 @@ func realMain(ctx context.Context) error {
 		DB:     trustDB,
 		Router: segreq.NewRouter(fetcherCfg),
 	}
+	if quicPathSelector != nil {
+		quicPathSelector.Router = segreq.NewRouter(fetcherCfg)
+	}
 
 	quicServer := grpc.NewServer(
 		grpc.Creds(libgrpc.PassThroughCredentials{}),
@@ -326,12 +353,16 @@ func realMain(ctx context.Context) error {
 
 	// Register trust material related handlers.
 	trustServer := &cstrustgrpc.MaterialServer{
-		Provider: provider,
-		IA:       topo.IA(),
-		Requests: libmetrics.NewPromCounter(cstrustmetrics.Handler.Requests),
+		Provider:    provider,
+		IA:          topo.IA(),
+		CRLProvider: trustDB,
+		Requests:    libmetrics.NewPromCounter(cstrustmetrics.Handler.Requests),
 	}
 	cppb.RegisterTrustMaterialServiceServer(quicServer, trustServer)
 	cppb.RegisterTrustMaterialServiceServer(tcpServer, trustServer)
+	cppb.RegisterTRCAnnouncementServiceServer(quicServer, trustgrpc.TRCAnnouncementServer{
+		Notifier: provider,
+	})
 
 	// Handle beaconing.
 	cppb.RegisterSegmentCreationServiceServer(quicServer, &beaconinggrpc.SegmentCreationServer{
@@ -397,7 +428,7 @@ func realMain(ctx context.Context) error {
 
 	ctxSigner, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
-	signer := cs.NewSigner(ctxSigner, topo.IA(), trustDB, globalCfg.General.ConfigDir)
+	signer := cs.NewSigner(ctxSigner, topo.IA(), trustDB, globalCfg.General.ConfigDir, asKeys)
 
 	var chainBuilder renewal.ChainBuilder
 	var caClient *caapi.Client
@@ -424,6 +455,11 @@ func realMain(ctx context.Context) error {
 				libmetrics.NewPromCounter(metrics.RenewalHandledRequestsTotal),
 				"type", "in-process",
 			)
+			caKeys, err := cs.NewKeyRing(globalCfg.Crypto.CAKeys,
+				filepath.Join(globalCfg.General.ConfigDir, "crypto/ca"))
+			if err != nil {
+				return serrors.Wrap("initializing CA key ring", err)
+			}
 			chainBuilder = cs.NewChainBuilder(
 				cs.ChainBuilderConfig{
 					IA:                   topo.IA(),
@@ -431,6 +467,7 @@ func realMain(ctx context.Context) error {
 					MaxValidity:          globalCfg.CA.MaxASValidity.Duration,
 					ConfigDir:            globalCfg.General.ConfigDir,
 					Metrics:              metrics.RenewalMetrics,
+					KeyRing:              caKeys,
 					ForceECDSAWithSHA512: !globalCfg.Features.AppropriateDigest,
 				},
 			)
@@ -568,16 +605,141 @@ func realMain(ctx context.Context) error {
 	)
 	trcRunner.TriggerRun()
 
+	//nolint:staticcheck // SA1019: fix later (https://github.com/scionproto/scion/issues/4776).
+	crlRunner := periodic.Start(
+		&cstrust.CRLUpdater{
+			IA:      topo.IA(),
+			Core:    topo.Core(),
+			Dir:     filepath.Join(globalCfg.General.ConfigDir, "certs"),
+			DB:      trustDB,
+			TRCs:    trustDB,
+			Fetcher: provider,
+		},
+		time.Minute,
+		30*time.Second,
+	)
+	crlRunner.TriggerRun()
+
+	expiryChecker := &expiry.Checker{
+		IA:           topo.IA(),
+		DB:           trustDB,
+		ChainDir:     filepath.Join(globalCfg.General.ConfigDir, "crypto/as"),
+		CADir:        filepath.Join(globalCfg.General.ConfigDir, "crypto/ca"),
+		WarnBefore:   globalCfg.TrustEngine.Expiry.WarnBefore.Duration,
+		TimeToExpiry: trustmetrics.TimeToExpirySeconds,
+	}
+	//nolint:staticcheck // SA1019: fix later (https://github.com/scionproto/scion/issues/4776).
+	expiryRunner := periodic.Start(expiryChecker, time.Minute, 30*time.Second)
+	expiryRunner.TriggerRun()
+
+	if len(globalCfg.Renewal.CAs) > 0 {
+		chainRenewer := &cstrust.ChainRenewer{
+			IA:        topo.IA(),
+			Dir:       filepath.Join(globalCfg.General.ConfigDir, "crypto/as"),
+			SignerGen: signer.SignerGen,
+			TRCs:      trustDB,
+			Requester: cstrustgrpc.ChainRenewalRequester{
+				IA:     topo.IA(),
+				Dialer: dialer,
+				Router: segreq.NewRouter(fetcherCfg),
+			},
+			CAs:      globalCfg.Renewal.CAs,
+			LeadTime: globalCfg.Renewal.LeadTime.Duration,
+		}
+		// Keys on a PKCS#11 token are generated on the token.
+		if keyGen, ok := asKeys.(cstrust.KeyGenerator); ok {
+			chainRenewer.KeyGenerator = keyGen
+		}
+		//nolint:staticcheck // SA1019: fix later (https://github.com/scionproto/scion/issues/4776).
+		renewer := periodic.Start(
+			chainRenewer,
+			globalCfg.Renewal.CheckInterval.Duration,
+			30*time.Second,
+		)
+		renewer.TriggerRun()
+	}
+
 	ds := discovery.Topology{
 		Information: topo,
 		Requests:    libmetrics.NewPromCounter(metrics.DiscoveryRequestsTotal),
 	}
 	dpb.RegisterDiscoveryServiceServer(quicServer, ds)
+	// End hosts without a topology file, e.g., with an embedded daemon, fetch the
+	// topology over TCP. The topology is only served on the TCP server, which
+	// is only reachable from within the AS, unlike the QUIC server.
+	tcpDS := ds
+	tcpDS.ServeTopology = true
+	dpb.RegisterDiscoveryServiceServer(tcpServer, tcpDS)
 
 	dsHealth := health.NewServer()
 	dsHealth.SetServingStatus("discovery", healthpb.HealthCheckResponse_SERVING)
 	healthpb.RegisterHealthServer(tcpServer, dsHealth)
 
+	// Handle revocations and TRC announcements.
+	daemons := make([]net.Addr, 0, len(globalCfg.PS.Daemons))
+	for _, d := range globalCfg.PS.Daemons {
+		a, err := net.ResolveTCPAddr("tcp", d)
+		if err != nil {
+			return serrors.Wrap("resolving daemon address", err, "address", d)
+		}
+		daemons = append(daemons, a)
+	}
+	revPropagator := &revocation.DefaultPropagator{
+		Interfaces: func() []*ifstate.Interface {
+			return intfs.Filtered(func(intf *ifstate.Interface) bool {
+				linkType := intf.TopoInfo().LinkType
+				return linkType == topology.Core || linkType == topology.Child
+			})
+		},
+		NextHopper: topo,
+		Neighbors:  revgrpc.Sender{Dialer: dialer},
+		Daemons:    daemons,
+		Local:      revgrpc.Sender{Dialer: libgrpc.SimpleDialer{}},
+	}
+	cppb.RegisterRevocationServiceServer(quicServer, revgrpc.RevocationServer{
+		Verifier: verifier,
+		Handler: revocation.Handler{
+			RevCache:   revCache,
+			Propagator: revPropagator,
+		},
+	})
+	revIssuer := &revocation.Issuer{
+		IA:         topo.IA(),
+		Interfaces: intfs,
+		RevCache:   revCache,
+		Signer:     signer,
+		Propagator: revPropagator,
+		TTL:        globalCfg.PS.RevocationTTL.Duration,
+	}
+
+	// Announce new TRCs, and pick up TRCs that are dropped into the certs
+	// directory.
+	trcAnnouncer := &cstrust.TRCAnnouncer{
+		TRCs: newTRCs,
+		Interfaces: func() []*ifstate.Interface {
+			return intfs.Filtered(func(*ifstate.Interface) bool { return true })
+		},
+		NextHopper: topo,
+		Neighbors:  trustgrpc.TRCAnnouncementSender{Dialer: dialer},
+		Daemons:    daemons,
+		Local:      trustgrpc.TRCAnnouncementSender{Dialer: libgrpc.SimpleDialer{}},
+	}
+	g.Go(func() error {
+		defer log.HandlePanic()
+		return trcAnnouncer.Run(errCtx)
+	})
+	trcWatcher := &cstrust.TRCWatcher{
+		DB:   trustDB,
+		Dirs: []string{filepath.Join(globalCfg.General.ConfigDir, "certs")},
+	}
+	g.Go(func() error {
+		defer log.HandlePanic()
+		if err := trcWatcher.Run(errCtx); err != nil {
+			log.Info("Failed to watch TRC directory, relying on periodic loading", "err", err)
+		}
+		return nil
+	})
+
 	hpCfg := cs.HiddenPathConfigurator{
 		LocalIA:           topo.IA(),
 		Verifier:          verifier,
@@ -588,7 +750,7 @@ func realMain(ctx context.Context) error {
 		IntraASTCPServer:  tcpServer,
 		InterASQUICServer: quicServer,
 	}
-	hpWriterCfg, err := hpCfg.Setup(globalCfg.PS.HiddenPathsCfg)
+	hiddenPaths, hpWriterCfg, err := hpCfg.Setup(globalCfg.PS.HiddenPathsCfg)
 	if err != nil {
 		return err
 	}
@@ -691,6 +853,40 @@ func realMain(ctx context.Context) error {
 	})
 	cleanup.Add(func() error { tcpServer.GracefulStop(); return nil })
 
+	var staticInfo atomic.Pointer[beaconing.StaticInfoCfg]
+	if cfg, err := beaconing.ParseStaticInfoCfg(globalCfg.General.StaticInfoConfig()); err == nil {
+		staticInfo.Store(cfg)
+	} else {
+		log.Info("No static info file found. Static info settings disabled.", "err", err)
+		if len(globalCfg.BS.RouterAPIs) > 0 {
+			// Measured link latencies are still included in the beacons.
+			staticInfo.Store(&beaconing.StaticInfoCfg{})
+		}
+	}
+	reloader := &cs.Reloader{
+		Policies:        globalCfg.BS.Policies,
+		Store:           beaconStore,
+		StaticInfoFile:  globalCfg.General.StaticInfoConfig(),
+		MeasuredLatency: len(globalCfg.BS.RouterAPIs) > 0,
+		StaticInfo:      &staticInfo,
+		HiddenPathsFile: globalCfg.PS.HiddenPathsCfg,
+		HiddenPaths:     hiddenPaths,
+	}
+	reloadC := app.SIGHUPChannel(errCtx)
+	g.Go(func() error {
+		defer log.HandlePanic()
+		for {
+			select {
+			case <-reloadC:
+				if _, err := reloader.Reload(errCtx); err != nil {
+					log.Error("Failed to reload configuration", "err", err)
+				}
+			case <-errCtx.Done():
+				return nil
+			}
+		}
+	})
+
 	if globalCfg.API.Addr != "" {
 		r := chi.NewRouter()
 		r.Use(cors.Handler(cors.Options{
@@ -704,10 +900,12 @@ func realMain(ctx context.Context) error {
 			},
 			CPPKIServer: cppkiapi.Server{
 				TrustDB: trustDB,
+				Expiry:  expiryChecker,
 			},
 			Beacons:  beaconDB,
 			CA:       chainBuilder,
 			Config:   service.NewConfigStatusPage(globalCfg).Handler,
+			Reloader: reloader,
 			Info:     service.NewInfoStatusPage().Handler,
 			LogLevel: service.NewLogLevelStatusPage().Handler,
 			Signer:   signer,
@@ -718,6 +916,11 @@ func realMain(ctx context.Context) error {
 				ISD:      topo.IA().ISD(),
 				CAHealth: caHealthCached,
 			},
+			ForwardingKey:   macGen,
+			PrincipalHeader: globalCfg.API.PrincipalHeader,
+		}
+		if hiddenPaths != nil {
+			server.HiddenPaths = hiddenPaths
 		}
 		log.Info("Exposing API", "addr", globalCfg.API.Addr)
 		s := http.Server{
@@ -744,11 +947,6 @@ func realMain(ctx context.Context) error {
 		return err
 	}
 
-	staticInfo, err := beaconing.ParseStaticInfoCfg(globalCfg.General.StaticInfoConfig())
-	if err != nil {
-		log.Info("No static info file found. Static info settings disabled.", "err", err)
-	}
-
 	var propagationFilter func(intf *ifstate.Interface) bool
 	if topo.Core() {
 		propagationFilter = func(intf *ifstate.Interface) bool {
@@ -804,9 +1002,9 @@ func realMain(ctx context.Context) error {
 		Inspector:   inspector,
 		Metrics:     metrics,
 		DRKeyEngine: drkeyEngine,
-		MACGen:      macGen,
+		MACGen:      macGen.New,
 		NextHopper:  topo,
-		StaticInfo:  func() *beaconing.StaticInfoCfg { return staticInfo },
+		StaticInfo:  staticInfo.Load,
 
 		OriginationInterval:       globalCfg.BS.OriginationInterval.Duration,
 		PropagationInterval:       globalCfg.BS.PropagationInterval.Duration,
@@ -815,6 +1013,9 @@ func realMain(ctx context.Context) error {
 		HiddenPathRegistrationCfg: hpWriterCfg,
 		AllowIsdLoop:              isdLoopAllowed,
 		EPIC:                      globalCfg.BS.EPIC,
+		RouterAPIs:                globalCfg.BS.RouterAPIs,
+		RouterQueryInterval:       globalCfg.BS.RouterQueryInterval.Duration,
+		Revoker:                   revIssuer,
 	})
 	if err != nil {
 		return serrors.Wrap("starting periodic tasks", err)
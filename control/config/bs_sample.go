@@ -26,6 +26,17 @@ registration_interval = "5s"
 
 # Add EPIC authenticators to the beacons. (default false)
 epic = false
+
+# The base URLs of the management APIs of the border routers of the AS, e.g.,
+# "http://192.0.2.1:30442/api/v1". The inter-AS link latencies measured by the
+# routers' BFD sessions are included in the beacons. Latencies set in the static
+# info configuration take precedence. Interfaces whose link is reported down by
+# the routers are revoked. (default [])
+router_apis = []
+
+# The interval between querying the border routers for the link states and the
+# measured link latencies. (default 10s)
+router_query_interval = "10s"
 `
 
 const policiesSample = `
@@ -17,16 +17,20 @@ package config
 
 import (
 	"io"
+	"net"
 	"strings"
 	"time"
 
+	"github.com/scionproto/scion/pkg/addr"
 	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/ctrl/path_mgmt"
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/pkg/private/util"
 	"github.com/scionproto/scion/private/config"
 	"github.com/scionproto/scion/private/env"
 	api "github.com/scionproto/scion/private/mgmtapi"
 	"github.com/scionproto/scion/private/mgmtapi/jwtauth"
+	"github.com/scionproto/scion/private/pkcs11"
 	"github.com/scionproto/scion/private/storage"
 	trustengine "github.com/scionproto/scion/private/trust/config"
 )
@@ -39,11 +43,20 @@ const (
 	DefaultPropagationInterval = 5 * time.Second
 	// DefaultRegistrationInterval is the default interval between registering segments.
 	DefaultRegistrationInterval = 5 * time.Second
+	// DefaultRouterQueryInterval is the default interval between querying the
+	// border routers for the link states and the measured link latencies.
+	DefaultRouterQueryInterval = 10 * time.Second
 	// DefaultQueryInterval is the default interval after which the segment
 	// cache expires.
 	DefaultQueryInterval = 5 * time.Minute
+	// DefaultRevocationTTL is the default validity period of the revocations
+	// issued by the control service.
+	DefaultRevocationTTL = path_mgmt.MinRevTTL
 	// DefaultMaxASValidity is the default validity period for renewed AS certificates.
 	DefaultMaxASValidity = 3 * 24 * time.Hour
+	// DefaultRenewalCheckInterval is the default interval between checking
+	// whether the AS certificate needs to be renewed.
+	DefaultRenewalCheckInterval = time.Minute
 )
 
 var _ config.Config = (*Config)(nil)
@@ -62,6 +75,8 @@ type Config struct {
 	BS          BSConfig           `toml:"beaconing,omitempty"`
 	PS          PSConfig           `toml:"path,omitempty"`
 	CA          CA                 `toml:"ca,omitempty"`
+	Renewal     RenewalConfig      `toml:"renewal,omitempty"`
+	Crypto      CryptoConfig       `toml:"crypto,omitempty"`
 	TrustEngine trustengine.Config `toml:"trustengine,omitempty"`
 	DRKey       DRKeyConfig        `toml:"drkey,omitempty"`
 }
@@ -81,6 +96,8 @@ func (cfg *Config) InitDefaults() {
 		&cfg.BS,
 		&cfg.PS,
 		&cfg.CA,
+		&cfg.Renewal,
+		&cfg.Crypto,
 		&cfg.TrustEngine,
 		&cfg.DRKey,
 	)
@@ -100,6 +117,8 @@ func (cfg *Config) Validate() error {
 		&cfg.BS,
 		&cfg.PS,
 		&cfg.CA,
+		&cfg.Renewal,
+		&cfg.Crypto,
 		&cfg.TrustEngine,
 		&cfg.DRKey,
 	)
@@ -138,6 +157,8 @@ func (cfg *Config) Sample(dst io.Writer, path config.Path, _ config.CtxMap) {
 		&cfg.BS,
 		&cfg.PS,
 		&cfg.CA,
+		&cfg.Renewal,
+		&cfg.Crypto,
 		&cfg.TrustEngine,
 		&cfg.DRKey,
 	)
@@ -157,6 +178,15 @@ type BSConfig struct {
 	Policies Policies `toml:"policies,omitempty"`
 	// EPIC specifies whether the EPIC authenticators should be added to the beacons.
 	EPIC bool `toml:"epic,omitempty"`
+	// RouterAPIs contains the base URLs of the management APIs of the border
+	// routers of the AS. The inter-AS link latencies measured by the routers
+	// are included in the beacons, unless they are set in the static info
+	// configuration. Interfaces whose link is reported down by the routers
+	// are revoked.
+	RouterAPIs []string `toml:"router_apis,omitempty"`
+	// RouterQueryInterval is the interval between querying the border routers
+	// for the link states and the measured link latencies.
+	RouterQueryInterval util.DurWrap `toml:"router_query_interval,omitempty"`
 }
 
 // InitDefaults the default values for the durations that are equal to zero.
@@ -174,6 +204,9 @@ func (cfg *BSConfig) Validate() error {
 	if cfg.RegistrationInterval.Duration == 0 {
 		initDurWrap(&cfg.RegistrationInterval, DefaultRegistrationInterval)
 	}
+	if cfg.RouterQueryInterval.Duration == 0 {
+		initDurWrap(&cfg.RouterQueryInterval, DefaultRouterQueryInterval)
+	}
 	return nil
 }
 
@@ -204,18 +237,36 @@ type PSConfig struct {
 	// If HiddenPathsCfg begins with http:// or https://, it will be fetched
 	// over the network from the specified URL instead.
 	HiddenPathsCfg string `toml:"hidden_paths_cfg,omitempty"`
+	// RevocationTTL is the validity period of the revocations that are issued
+	// for interfaces whose link is down.
+	RevocationTTL util.DurWrap `toml:"revocation_ttl,omitempty"`
+	// Daemons contains the addresses of the gRPC APIs of the daemons in the
+	// AS. Revocations and TRC announcements are pushed to them.
+	Daemons []string `toml:"daemons,omitempty"`
 }
 
 func (cfg *PSConfig) InitDefaults() {
 	if cfg.QueryInterval.Duration == 0 {
 		cfg.QueryInterval.Duration = DefaultQueryInterval
 	}
+	if cfg.RevocationTTL.Duration == 0 {
+		cfg.RevocationTTL.Duration = DefaultRevocationTTL
+	}
 }
 
 func (cfg *PSConfig) Validate() error {
 	if cfg.QueryInterval.Duration == 0 {
 		return serrors.New("query_interval must not be zero")
 	}
+	if cfg.RevocationTTL.Duration < path_mgmt.MinRevTTL {
+		return serrors.New("revocation_ttl must not be smaller than the minimum",
+			"revocation_ttl", cfg.RevocationTTL, "minimum", path_mgmt.MinRevTTL)
+	}
+	for _, daemon := range cfg.Daemons {
+		if _, _, err := net.SplitHostPort(daemon); err != nil {
+			return serrors.Wrap("invalid daemon address", err, "address", daemon)
+		}
+	}
 	return nil
 }
 
@@ -342,3 +393,86 @@ func (cfg *CAService) Sample(dst io.Writer, _ config.Path, _ config.CtxMap) {
 func (cfg *CAService) ConfigName() string {
 	return "service"
 }
+
+var _ config.Config = (*RenewalConfig)(nil)
+
+// RenewalConfig is the configuration for the automatic renewal of the AS
+// certificate.
+type RenewalConfig struct {
+	// CAs is the ordered list of CA ASes that are asked to renew the AS
+	// certificate. If it is empty, automatic renewal is disabled.
+	CAs []addr.IA `toml:"cas,omitempty"`
+	// LeadTime is the remaining validity of the AS certificate at which it is
+	// renewed. If it is zero, the AS certificate is renewed once a third of its
+	// validity period remains.
+	LeadTime util.DurWrap `toml:"lead_time,omitempty"`
+	// CheckInterval is the interval between checking whether the AS
+	// certificate needs to be renewed.
+	CheckInterval util.DurWrap `toml:"check_interval,omitempty"`
+}
+
+func (cfg *RenewalConfig) InitDefaults() {
+	if cfg.CheckInterval.Duration == 0 {
+		cfg.CheckInterval.Duration = DefaultRenewalCheckInterval
+	}
+}
+
+func (cfg *RenewalConfig) Validate() error {
+	if cfg.CheckInterval.Duration <= 0 {
+		return serrors.New("check_interval must be positive")
+	}
+	if cfg.LeadTime.Duration < 0 {
+		return serrors.New("lead_time must not be negative")
+	}
+	for _, ca := range cfg.CAs {
+		if ca.IsWildcard() {
+			return serrors.New("wildcard CA not allowed", "ca", ca)
+		}
+	}
+	return nil
+}
+
+func (cfg *RenewalConfig) Sample(dst io.Writer, _ config.Path, _ config.CtxMap) {
+	config.WriteString(dst, renewalSample)
+}
+
+func (cfg *RenewalConfig) ConfigName() string {
+	return "renewal"
+}
+
+var _ config.Config = (*CryptoConfig)(nil)
+
+// CryptoConfig is the configuration of the private key storage.
+type CryptoConfig struct {
+	config.NoDefaulter
+	// ASKeys is the PKCS#11 URI of the AS private keys. If it is empty, the
+	// keys are loaded from the crypto/as directory.
+	ASKeys string `toml:"as_keys,omitempty"`
+	// CAKeys is the PKCS#11 URI of the CA private keys. If it is empty, the
+	// keys are loaded from the crypto/ca directory.
+	CAKeys string `toml:"ca_keys,omitempty"`
+}
+
+func (cfg *CryptoConfig) Validate() error {
+	options := []struct{ name, uri string }{
+		{name: "as_keys", uri: cfg.ASKeys},
+		{name: "ca_keys", uri: cfg.CAKeys},
+	}
+	for _, o := range options {
+		if o.uri == "" {
+			continue
+		}
+		if _, err := pkcs11.ParseURI(o.uri); err != nil {
+			return serrors.Wrap("invalid PKCS#11 URI", err, "option", o.name)
+		}
+	}
+	return nil
+}
+
+func (cfg *CryptoConfig) Sample(dst io.Writer, _ config.Path, _ config.CtxMap) {
+	config.WriteString(dst, cryptoSample)
+}
+
+func (cfg *CryptoConfig) ConfigName() string {
+	return "crypto"
+}
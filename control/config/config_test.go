@@ -17,10 +17,12 @@ package config
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/pelletier/go-toml/v2"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/scionproto/scion/pkg/addr"
 	"github.com/scionproto/scion/pkg/log/logtest"
 	"github.com/scionproto/scion/private/env/envtest"
 	"github.com/scionproto/scion/private/mgmtapi/jwtauth"
@@ -46,6 +48,7 @@ func InitTestConfig(cfg *Config) {
 	InitTestBSConfig(&cfg.BS)
 	InitTestPSConfig(&cfg.PS)
 	InitTestCA(&cfg.CA)
+	InitTestRenewal(&cfg.Renewal)
 }
 
 func InitTestBSConfig(cfg *BSConfig) {
@@ -69,6 +72,8 @@ func CheckTestConfig(t *testing.T, cfg *Config, id string) {
 	CheckTestBSConfig(t, &cfg.BS)
 	CheckTestPSConfig(t, &cfg.PS, id)
 	CheckTestCA(t, &cfg.CA)
+	CheckTestRenewal(t, &cfg.Renewal)
+	CheckTestCrypto(t, &cfg.Crypto)
 }
 
 func CheckTestBSConfig(t *testing.T, cfg *BSConfig) {
@@ -76,6 +81,8 @@ func CheckTestBSConfig(t *testing.T, cfg *BSConfig) {
 	assert.Equal(t, DefaultPropagationInterval, cfg.PropagationInterval.Duration)
 	assert.Equal(t, DefaultRegistrationInterval, cfg.RegistrationInterval.Duration)
 	assert.False(t, cfg.EPIC)
+	assert.Empty(t, cfg.RouterAPIs)
+	assert.Equal(t, DefaultRouterQueryInterval, cfg.RouterQueryInterval.Duration)
 	CheckTestPolicies(t, &cfg.Policies)
 }
 
@@ -93,6 +100,8 @@ func InitTestPSConfig(cfg *PSConfig) {
 func CheckTestPSConfig(t *testing.T, cfg *PSConfig, id string) {
 	assert.Equal(t, DefaultQueryInterval, cfg.QueryInterval.Duration)
 	assert.Empty(t, cfg.HiddenPathsCfg)
+	assert.Equal(t, DefaultRevocationTTL, cfg.RevocationTTL.Duration)
+	assert.Empty(t, cfg.Daemons)
 }
 
 func InitTestCA(cfg *CA) {
@@ -104,6 +113,20 @@ func CheckTestCA(t *testing.T, cfg *CA) {
 	CheckTestService(t, &cfg.Service)
 }
 
+func InitTestRenewal(cfg *RenewalConfig) {
+}
+
+func CheckTestRenewal(t *testing.T, cfg *RenewalConfig) {
+	assert.Equal(t, []addr.IA{addr.MustParseIA("1-ff00:0:110")}, cfg.CAs)
+	assert.Equal(t, 24*time.Hour, cfg.LeadTime.Duration)
+	assert.Equal(t, DefaultRenewalCheckInterval, cfg.CheckInterval.Duration)
+}
+
+func CheckTestCrypto(t *testing.T, cfg *CryptoConfig) {
+	assert.Empty(t, cfg.ASKeys)
+	assert.Empty(t, cfg.CAKeys)
+}
+
 func CheckTestService(t *testing.T, cfg *CAService) {
 	assert.Empty(t, cfg.SharedSecret)
 	assert.Empty(t, cfg.Address)
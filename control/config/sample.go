@@ -23,6 +23,15 @@ query_interval = "5m"
 # paths functionality is not enabled. If the path starts with http:// or
 # https:// the configuration is fetched from the given URL. (default: "")
 hidden_paths_cfg = ""
+# The validity period of the revocations that are issued for interfaces whose
+# link is reported down by the border routers. The revocations are refreshed
+# for as long as the link is down. (default 10s)
+revocation_ttl = "10s"
+# The addresses of the gRPC APIs of the daemons in the AS, e.g.,
+# "192.0.2.2:30255". Revocations are pushed to them, so that they stop using
+# paths over revoked interfaces right away. New TRCs are announced to them, so
+# that they fetch the TRCs right away. (default [])
+daemons = []
 `
 
 const caSample = `
@@ -51,6 +60,37 @@ max_as_validity = "3d"
 mode = "in-process"
 `
 
+const renewalSample = `
+# The ordered list of CA ASes that are asked to renew the AS certificate. The
+# first CA that successfully renews the certificate is used. If the list is
+# empty, the AS certificate is not renewed automatically. (default [])
+cas = ["1-ff00:0:110"]
+
+# The remaining validity of the AS certificate at which it is renewed. If it is
+# not set, the certificate is renewed once a third of its validity period
+# remains. (default "")
+lead_time = "1d"
+
+# The interval between checking whether the AS certificate needs to be
+# renewed. (default 1m)
+check_interval = "1m"
+`
+
+const cryptoSample = `
+# The PKCS#11 URI (RFC 7512) of the AS private keys that are used for signing
+# control-plane messages and for TLS. The module-path query attribute is
+# required. If it is not set, the keys are loaded from the crypto/as directory.
+# For example:
+# "pkcs11:token=scion;object=cp-as?module-path=/usr/lib/softhsm/libsofthsm2.so&pin-source=/etc/scion/pin"
+# (default "")
+as_keys = ""
+
+# The PKCS#11 URI (RFC 7512) of the CA private keys that are used by the
+# in-process CA. If it is not set, the keys are loaded from the crypto/ca
+# directory. (default "")
+ca_keys = ""
+`
+
 const serviceSample = `
 # The path to the PEM-encoded shared secret that is used to create JWT tokens.
 shared_secret = ""
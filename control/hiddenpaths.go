@@ -15,6 +15,13 @@
 package control
 
 import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+
 	"google.golang.org/grpc"
 
 	beaconinggrpc "github.com/scionproto/scion/control/beaconing/grpc"
@@ -24,7 +31,9 @@ import (
 	hpgrpc "github.com/scionproto/scion/pkg/experimental/hiddenpath/grpc"
 	libgrpc "github.com/scionproto/scion/pkg/grpc"
 	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/serrors"
 	hspb "github.com/scionproto/scion/pkg/proto/hidden_segment"
+	seg "github.com/scionproto/scion/pkg/segment"
 	"github.com/scionproto/scion/private/pathdb"
 	infra "github.com/scionproto/scion/private/segment/verifier"
 )
@@ -43,71 +52,55 @@ type HiddenPathConfigurator struct {
 
 // Setup sets up the hidden paths servers using the configuration at the given
 // location. An empty location will not enable any hidden path behavior. It
-// returns the configuration for the hidden segment writer. The return value can
-// be nil if this AS isn't a writer.
-func (c HiddenPathConfigurator) Setup(location string) (*HiddenPathRegistrationCfg, error) {
+// returns a handle that can be used to update the hidden path groups at
+// runtime, and the configuration for the hidden segment writer. The handle is
+// nil if hidden paths are not enabled, the writer configuration is nil if this
+// AS isn't a writer.
+func (c HiddenPathConfigurator) Setup(
+	location string,
+) (*HiddenPaths, *HiddenPathRegistrationCfg, error) {
+
 	if location == "" {
-		return nil, nil
+		return nil, nil, nil
 	}
 	groups, regPolicy, err := hiddenpath.LoadConfiguration(location)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	roles := groups.Roles(c.LocalIA)
-	if roles.None() {
-		return nil, nil
+	hp := &HiddenPaths{
+		configurator: c,
+		location:     location,
+		roles:        groups.Roles(c.LocalIA),
+	}
+	hp.state.Store(c.newHiddenPathState(groups, regPolicy))
+	if hp.roles.None() {
+		return hp, nil, nil
 	}
 	log.Info("Starting hidden path forward server")
 	hspb.RegisterHiddenSegmentLookupServiceServer(c.IntraASTCPServer, &hpgrpc.SegmentServer{
-		Lookup: hiddenpath.ForwardServer{
-			Groups:    groups,
-			LocalAuth: c.localAuthServer(groups),
-			LocalIA:   c.LocalIA,
-			RPC: &hpgrpc.AuthoritativeRequester{
-				Dialer: c.Dialer,
-				Signer: c.Signer,
-			},
-			Resolver: hiddenpath.LookupResolver{
-				Router: segreq.NewRouter(c.FetcherConfig),
-				Discoverer: &hpgrpc.Discoverer{
-					Dialer: c.Dialer,
-				},
-			},
-			Verifier: hiddenpath.VerifierAdapter{
-				Verifier: c.Verifier,
-			},
-		},
+		Lookup: hiddenPathLookuper{hp: hp},
 	})
-	if roles.Registry {
+	if hp.roles.Registry {
 		log.Info("Starting hidden path authoritative and registration server")
 		hspb.RegisterAuthoritativeHiddenSegmentLookupServiceServer(c.InterASQUICServer,
 			&hpgrpc.AuthoritativeSegmentServer{
-				Lookup:   c.localAuthServer(groups),
+				Lookup:   hiddenPathLookuper{hp: hp, authoritative: true},
 				Verifier: c.Verifier,
 			},
 		)
 		hspb.RegisterHiddenSegmentRegistrationServiceServer(c.InterASQUICServer,
 			&hpgrpc.RegistrationServer{
-				Registry: hiddenpath.RegistryServer{
-					Groups: groups,
-					DB: &hiddenpath.Storer{
-						DB: c.PathDB,
-					},
-					Verifier: hiddenpath.VerifierAdapter{
-						Verifier: c.Verifier,
-					},
-					LocalIA: c.LocalIA,
-				},
+				Registry: hiddenPathRegistry{hp: hp},
 				Verifier: c.Verifier,
 			},
 		)
 	}
-	if !roles.Writer {
-		return nil, nil
+	if !hp.roles.Writer {
+		return hp, nil, nil
 	}
 	log.Info("Using hidden path beacon writer")
-	return &HiddenPathRegistrationCfg{
-		Policy: regPolicy,
+	return hp, &HiddenPathRegistrationCfg{
+		Policy: hp.RegistrationPolicy,
 		Router: segreq.NewRouter(c.FetcherConfig),
 		Discoverer: &hpgrpc.Discoverer{
 			Dialer: c.Dialer,
@@ -120,6 +113,46 @@ func (c HiddenPathConfigurator) Setup(location string) (*HiddenPathRegistrationC
 	}, nil
 }
 
+func (c HiddenPathConfigurator) newHiddenPathState(
+	groups hiddenpath.Groups,
+	regPolicy hiddenpath.RegistrationPolicy,
+) *hiddenPathState {
+
+	return &hiddenPathState{
+		groups: groups,
+		policy: regPolicy,
+		forward: hiddenpath.ForwardServer{
+			Groups:    groups,
+			LocalAuth: c.localAuthServer(groups),
+			LocalIA:   c.LocalIA,
+			RPC: &hpgrpc.AuthoritativeRequester{
+				Dialer: c.Dialer,
+				Signer: c.Signer,
+			},
+			Resolver: hiddenpath.LookupResolver{
+				Router: segreq.NewRouter(c.FetcherConfig),
+				Discoverer: &hpgrpc.Discoverer{
+					Dialer: c.Dialer,
+				},
+			},
+			Verifier: hiddenpath.VerifierAdapter{
+				Verifier: c.Verifier,
+			},
+		},
+		authoritative: c.localAuthServer(groups),
+		registry: hiddenpath.RegistryServer{
+			Groups: groups,
+			DB: &hiddenpath.Storer{
+				DB: c.PathDB,
+			},
+			Verifier: hiddenpath.VerifierAdapter{
+				Verifier: c.Verifier,
+			},
+			LocalIA: c.LocalIA,
+		},
+	}
+}
+
 func (c HiddenPathConfigurator) localAuthServer(groups hiddenpath.Groups) hiddenpath.Lookuper {
 	roles := groups.Roles(c.LocalIA)
 	if !roles.Registry {
@@ -133,3 +166,240 @@ func (c HiddenPathConfigurator) localAuthServer(groups hiddenpath.Groups) hidden
 		LocalIA: c.LocalIA,
 	}
 }
+
+// HiddenPaths gives access to the hidden path groups and the registration
+// policy that are in use by the hidden path servers and the hidden segment
+// writer. They can be replaced at runtime with Update, and individual groups
+// can be managed with CreateGroup, UpdateGroup and DeleteGroup.
+type HiddenPaths struct {
+	configurator HiddenPathConfigurator
+	// location is the location of the hidden path configuration. Changes of
+	// the groups are persisted to it.
+	location string
+	// roles are the roles of the local AS at setup. They determine which
+	// servers are running and can therefore not change at runtime.
+	roles hiddenpath.Roles
+	state atomic.Pointer[hiddenPathState]
+	// mu serializes the changes of the state.
+	mu sync.Mutex
+}
+
+type hiddenPathState struct {
+	groups        hiddenpath.Groups
+	policy        hiddenpath.RegistrationPolicy
+	forward       hiddenpath.Lookuper
+	authoritative hiddenpath.Lookuper
+	registry      hiddenpath.Registry
+}
+
+// Groups returns the hidden path groups that are currently in use.
+func (h *HiddenPaths) Groups() hiddenpath.Groups {
+	return h.state.Load().groups
+}
+
+// RegistrationPolicy returns the hidden path registration policy that is
+// currently in use.
+func (h *HiddenPaths) RegistrationPolicy() hiddenpath.RegistrationPolicy {
+	return h.state.Load().policy
+}
+
+// Load loads and validates the hidden path configuration at the given
+// location without putting it in use. It fails if the new configuration
+// changes the roles of the local AS in a way that requires a restart of the
+// control service.
+func (h *HiddenPaths) Load(
+	location string,
+) (hiddenpath.Groups, hiddenpath.RegistrationPolicy, error) {
+
+	groups, regPolicy, err := hiddenpath.LoadConfiguration(location)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := h.checkRoles(groups); err != nil {
+		return nil, nil, err
+	}
+	return groups, regPolicy, nil
+}
+
+// Update puts the given hidden path groups and registration policy in use.
+// The configuration should be obtained with Load.
+func (h *HiddenPaths) Update(
+	groups hiddenpath.Groups,
+	regPolicy hiddenpath.RegistrationPolicy,
+) {
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.state.Store(h.configurator.newHiddenPathState(groups, regPolicy))
+}
+
+// CreateGroup adds the hidden path group. The principal is the entity that
+// requested the change, it is recorded in the audit log.
+func (h *HiddenPaths) CreateGroup(
+	ctx context.Context,
+	group *hiddenpath.Group,
+	principal string,
+) error {
+
+	return h.modify(ctx, "create", group.ID, principal, func(groups hiddenpath.Groups) error {
+		if _, ok := groups[group.ID]; ok {
+			return serrors.JoinNoStack(hiddenpath.ErrGroupExists, nil, "group_id", group.ID)
+		}
+		groups[group.ID] = group
+		return nil
+	})
+}
+
+// UpdateGroup replaces the hidden path group with the same ID. The principal
+// is the entity that requested the change, it is recorded in the audit log.
+func (h *HiddenPaths) UpdateGroup(
+	ctx context.Context,
+	group *hiddenpath.Group,
+	principal string,
+) error {
+
+	return h.modify(ctx, "update", group.ID, principal, func(groups hiddenpath.Groups) error {
+		if _, ok := groups[group.ID]; !ok {
+			return serrors.JoinNoStack(hiddenpath.ErrGroupNotFound, nil, "group_id", group.ID)
+		}
+		groups[group.ID] = group
+		return nil
+	})
+}
+
+// DeleteGroup removes the hidden path group. Groups that are referred to by
+// the registration policy cannot be removed. The principal is the entity that
+// requested the change, it is recorded in the audit log.
+func (h *HiddenPaths) DeleteGroup(
+	ctx context.Context,
+	id hiddenpath.GroupID,
+	principal string,
+) error {
+
+	return h.modify(ctx, "delete", id, principal, func(groups hiddenpath.Groups) error {
+		if _, ok := groups[id]; !ok {
+			return serrors.JoinNoStack(hiddenpath.ErrGroupNotFound, nil, "group_id", id)
+		}
+		delete(groups, id)
+		return nil
+	})
+}
+
+// modify applies the change to a copy of the hidden path groups, persists the
+// resulting configuration and puts it in use. The running configuration is
+// left untouched if the change results in an invalid configuration.
+func (h *HiddenPaths) modify(
+	ctx context.Context,
+	action string,
+	id hiddenpath.GroupID,
+	principal string,
+	change func(hiddenpath.Groups) error,
+) error {
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	state := h.state.Load()
+	groups := make(hiddenpath.Groups, len(state.groups)+1)
+	for k, v := range state.groups {
+		groups[k] = v
+	}
+	if err := change(groups); err != nil {
+		return err
+	}
+	if err := groups.Validate(); err != nil {
+		return serrors.JoinNoStack(hiddenpath.ErrInvalidGroups, err)
+	}
+	if err := h.checkRoles(groups); err != nil {
+		return serrors.JoinNoStack(hiddenpath.ErrInvalidGroups, err)
+	}
+	policy, err := state.policy.WithGroups(groups)
+	if err != nil {
+		return serrors.JoinNoStack(hiddenpath.ErrInvalidGroups,
+			serrors.Wrap("applying groups to registration policy", err))
+	}
+	if err := h.persist(groups, policy); err != nil {
+		return serrors.Wrap("persisting hidden path configuration", err,
+			"location", h.location)
+	}
+	h.state.Store(h.configurator.newHiddenPathState(groups, policy))
+	log.FromCtx(ctx).Info("Hidden path group changed", "action", action,
+		"group_id", id, "principal", principal)
+	return nil
+}
+
+// persist writes the configuration to the location of the hidden path
+// configuration. The file is replaced atomically, so that a concurrent reload
+// never reads a partially written file.
+func (h *HiddenPaths) persist(
+	groups hiddenpath.Groups,
+	policy hiddenpath.RegistrationPolicy,
+) error {
+
+	if strings.HasPrefix(h.location, "http://") || strings.HasPrefix(h.location, "https://") {
+		return serrors.New("configuration fetched over HTTP cannot be modified")
+	}
+	raw, err := hiddenpath.MarshalConfiguration(groups, policy)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(h.location), filepath.Base(h.location)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if info, err := os.Stat(h.location); err == nil {
+		if err := os.Chmod(tmp.Name(), info.Mode().Perm()); err != nil {
+			return err
+		}
+	}
+	return os.Rename(tmp.Name(), h.location)
+}
+
+// checkRoles checks that the groups do not change the roles of the local AS
+// in a way that requires a restart of the control service.
+func (h *HiddenPaths) checkRoles(groups hiddenpath.Groups) error {
+	roles := groups.Roles(h.configurator.LocalIA)
+	if roles.None() != h.roles.None() || roles.Registry != h.roles.Registry ||
+		roles.Writer != h.roles.Writer {
+
+		return serrors.New("change of hidden path roles requires restart",
+			"current", h.roles, "new", roles)
+	}
+	return nil
+}
+
+// hiddenPathLookuper delegates the lookups to the current hidden path state.
+type hiddenPathLookuper struct {
+	hp            *HiddenPaths
+	authoritative bool
+}
+
+func (l hiddenPathLookuper) Segments(
+	ctx context.Context,
+	req hiddenpath.SegmentRequest,
+) ([]*seg.Meta, error) {
+
+	state := l.hp.state.Load()
+	if l.authoritative {
+		return state.authoritative.Segments(ctx, req)
+	}
+	return state.forward.Segments(ctx, req)
+}
+
+// hiddenPathRegistry delegates the registrations to the current hidden path
+// state.
+type hiddenPathRegistry struct {
+	hp *HiddenPaths
+}
+
+func (r hiddenPathRegistry) Register(ctx context.Context, reg hiddenpath.Registration) error {
+	return r.hp.state.Load().registry.Register(ctx, reg)
+}
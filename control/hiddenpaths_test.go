@@ -0,0 +1,122 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	cs "github.com/scionproto/scion/control"
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/experimental/hiddenpath"
+)
+
+func TestHiddenPathsModifyGroups(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "hp.yml")
+	require.NoError(t, os.WriteFile(file, []byte(`
+groups:
+  ff00:0:110-69b5:
+    owner: 1-ff00:0:110
+    writers: [1-ff00:0:112]
+    readers: [1-ff00:0:111]
+    registries: [1-ff00:0:113]
+registration_policy_per_interface:
+  2: [ff00:0:110-69b5, public]
+`), 0o644))
+
+	c := cs.HiddenPathConfigurator{
+		LocalIA:           addr.MustParseIA("1-ff00:0:111"),
+		IntraASTCPServer:  grpc.NewServer(),
+		InterASQUICServer: grpc.NewServer(),
+	}
+	hp, writerCfg, err := c.Setup(file)
+	require.NoError(t, err)
+	require.NotNil(t, hp)
+	require.Nil(t, writerCfg)
+
+	ctx := context.Background()
+	policyGroup := mustParseGroupID(t, "ff00:0:110-69b5")
+	newGroup := &hiddenpath.Group{
+		ID:         mustParseGroupID(t, "ff00:0:110-1"),
+		Owner:      addr.MustParseIA("1-ff00:0:110"),
+		Writers:    iaSet("1-ff00:0:112"),
+		Readers:    iaSet("1-ff00:0:111"),
+		Registries: iaSet("1-ff00:0:113"),
+	}
+
+	// Create a group, it is persisted and put in use.
+	require.NoError(t, hp.CreateGroup(ctx, newGroup, "admin"))
+	assert.Equal(t, newGroup, hp.Groups()[newGroup.ID])
+	groups, _, err := hiddenpath.LoadConfiguration(file)
+	require.NoError(t, err)
+	assert.Equal(t, hp.Groups(), groups)
+	err = hp.CreateGroup(ctx, newGroup, "admin")
+	assert.ErrorIs(t, err, hiddenpath.ErrGroupExists)
+
+	// Update a group that is referred to by the registration policy, the
+	// policy uses the updated group.
+	updated := &hiddenpath.Group{
+		ID:         policyGroup,
+		Owner:      addr.MustParseIA("1-ff00:0:110"),
+		Writers:    iaSet("1-ff00:0:112", "1-ff00:0:114"),
+		Readers:    iaSet("1-ff00:0:111"),
+		Registries: iaSet("1-ff00:0:113"),
+	}
+	require.NoError(t, hp.UpdateGroup(ctx, updated, "admin"))
+	assert.Equal(t, updated, hp.RegistrationPolicy()[2].Groups[policyGroup])
+	_, policy, err := hiddenpath.LoadConfiguration(file)
+	require.NoError(t, err)
+	assert.Equal(t, hp.RegistrationPolicy(), policy)
+
+	// Changes that require a restart are rejected.
+	writer := *updated
+	writer.Writers = iaSet("1-ff00:0:111")
+	err = hp.UpdateGroup(ctx, &writer, "admin")
+	assert.ErrorIs(t, err, hiddenpath.ErrInvalidGroups)
+	assert.Equal(t, updated, hp.Groups()[policyGroup])
+
+	// Groups that are referred to by the registration policy cannot be deleted.
+	err = hp.DeleteGroup(ctx, policyGroup, "admin")
+	assert.ErrorIs(t, err, hiddenpath.ErrInvalidGroups)
+
+	require.NoError(t, hp.DeleteGroup(ctx, newGroup.ID, "admin"))
+	assert.NotContains(t, hp.Groups(), newGroup.ID)
+	err = hp.DeleteGroup(ctx, newGroup.ID, "admin")
+	assert.ErrorIs(t, err, hiddenpath.ErrGroupNotFound)
+	groups, _, err = hiddenpath.LoadConfiguration(file)
+	require.NoError(t, err)
+	assert.Equal(t, hp.Groups(), groups)
+}
+
+func mustParseGroupID(t *testing.T, s string) hiddenpath.GroupID {
+	t.Helper()
+	id, err := hiddenpath.ParseGroupID(s)
+	require.NoError(t, err)
+	return id
+}
+
+func iaSet(ias ...string) map[addr.IA]struct{} {
+	set := make(map[addr.IA]struct{}, len(ias))
+	for _, ia := range ias {
+		set[addr.MustParseIA(ia)] = struct{}{}
+	}
+	return set
+}
@@ -141,12 +141,38 @@ func (intfs *Interfaces) Get(ifID uint16) *Interface {
 	return intfs.intfs[ifID]
 }
 
+// LinkState is the state of the inter-AS link of an interface, as reported by
+// the border router that owns the interface.
+type LinkState int
+
+const (
+	// LinkStateUnknown indicates that the link state has not been reported.
+	LinkStateUnknown LinkState = iota
+	// LinkStateUp indicates that the link is up.
+	LinkStateUp
+	// LinkStateDown indicates that the link is down.
+	LinkStateDown
+)
+
+func (s LinkState) String() string {
+	switch s {
+	case LinkStateUp:
+		return "up"
+	case LinkStateDown:
+		return "down"
+	default:
+		return "unknown"
+	}
+}
+
 // Interface keeps track of the interface state.
 type Interface struct {
 	mu            sync.RWMutex
 	topoInfo      InterfaceInfo
 	lastOriginate time.Time
 	lastPropagate time.Time
+	latency       time.Duration
+	linkState     LinkState
 	cfg           Config
 }
 
@@ -195,6 +221,36 @@ func (intf *Interface) LastPropagate() time.Time {
 	return intf.lastPropagate
 }
 
+// SetLatency sets the measured latency of the inter-AS link of this interface.
+// A latency of 0 indicates that the latency is not known.
+func (intf *Interface) SetLatency(latency time.Duration) {
+	intf.mu.Lock()
+	defer intf.mu.Unlock()
+	intf.latency = latency
+}
+
+// Latency returns the measured latency of the inter-AS link of this interface,
+// or 0 if it is not known.
+func (intf *Interface) Latency() time.Duration {
+	intf.mu.RLock()
+	defer intf.mu.RUnlock()
+	return intf.latency
+}
+
+// SetLinkState sets the state of the inter-AS link of this interface.
+func (intf *Interface) SetLinkState(state LinkState) {
+	intf.mu.Lock()
+	defer intf.mu.Unlock()
+	intf.linkState = state
+}
+
+// LinkState returns the state of the inter-AS link of this interface.
+func (intf *Interface) LinkState() LinkState {
+	intf.mu.RLock()
+	defer intf.mu.RUnlock()
+	return intf.linkState
+}
+
 func (intf *Interface) reset() {
 	intf.mu.Lock()
 	defer intf.mu.Unlock()
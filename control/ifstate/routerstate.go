@@ -0,0 +1,142 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ifstate
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/router/mgmtapi"
+)
+
+// Revoker revokes interfaces whose link is down.
+type Revoker interface {
+	// RevokeInterfaces revokes the interfaces with the given IDs. It is called
+	// periodically for as long as the links are down.
+	RevokeInterfaces(ctx context.Context, ifIDs []uint16) error
+}
+
+// RouterStateFetcher is a periodic task that queries the management API of the
+// border routers for the state of the inter-AS links. It records the link
+// state and half of the round-trip time measured by BFD as the link latency on
+// the interfaces.
+//
+// Interfaces that are not reported by any router in a run have their latency
+// and link state reset, so that stale information is not used. Interfaces
+// that are reported down are passed to the Revoker.
+type RouterStateFetcher struct {
+	// Interfaces is the set of interfaces on which the state is recorded.
+	Interfaces *Interfaces
+	// Routers contains the base URLs of the management APIs of the border
+	// routers, e.g., "http://192.0.2.1:30442/api/v1".
+	Routers []string
+	// Client is the HTTP client used to query the routers. If it is nil,
+	// http.DefaultClient is used.
+	Client *http.Client
+	// Revoker revokes the interfaces whose link is down. If it is nil, no
+	// interfaces are revoked.
+	Revoker Revoker
+}
+
+type routerState struct {
+	latency   time.Duration
+	linkState LinkState
+}
+
+// Name returns the task name.
+func (f *RouterStateFetcher) Name() string {
+	return "control_ifstate_router_state_fetcher"
+}
+
+// Run queries all routers and updates the interface states.
+func (f *RouterStateFetcher) Run(ctx context.Context) {
+	logger := log.FromCtx(ctx)
+	states := make(map[uint16]routerState)
+	for _, router := range f.Routers {
+		if err := f.fetch(ctx, router, states); err != nil {
+			logger.Info("Failed to fetch interface state from router",
+				"router", router, "err", err)
+		}
+	}
+	var down []uint16
+	for ifID, intf := range f.Interfaces.All() {
+		state := states[ifID]
+		intf.SetLatency(state.latency)
+		if prev := intf.LinkState(); prev != state.linkState {
+			logger.Debug("Interface link state changed", "interface", ifID,
+				"previous", prev, "current", state.linkState)
+		}
+		intf.SetLinkState(state.linkState)
+		if state.linkState == LinkStateDown {
+			down = append(down, ifID)
+		}
+	}
+	if f.Revoker == nil || len(down) == 0 {
+		return
+	}
+	slices.Sort(down)
+	if err := f.Revoker.RevokeInterfaces(ctx, down); err != nil {
+		logger.Info("Failed to revoke interfaces", "interfaces", down, "err", err)
+	}
+}
+
+func (f *RouterStateFetcher) fetch(
+	ctx context.Context,
+	router string,
+	states map[uint16]routerState,
+) error {
+
+	var client mgmtapi.HttpRequestDoer = http.DefaultClient
+	if f.Client != nil {
+		client = f.Client
+	}
+	c, err := mgmtapi.NewClientWithResponses(router, mgmtapi.WithHTTPClient(client))
+	if err != nil {
+		return err
+	}
+	rep, err := c.GetInterfacesWithResponse(ctx)
+	if err != nil {
+		return err
+	}
+	if rep.JSON200 == nil {
+		return serrors.New("unexpected response", "status", rep.Status())
+	}
+	if rep.JSON200.Interfaces == nil {
+		return nil
+	}
+	for _, intf := range *rep.JSON200.Interfaces {
+		var state routerState
+		switch intf.State {
+		case mgmtapi.UP:
+			state.linkState = LinkStateUp
+		case mgmtapi.DOWN:
+			state.linkState = LinkStateDown
+		}
+		if intf.Bfd.RoundTripTime != nil {
+			rtt, err := time.ParseDuration(*intf.Bfd.RoundTripTime)
+			if err != nil {
+				return serrors.Wrap("parsing round-trip time", err,
+					"interface", intf.InterfaceId) // nolint - name from published API.
+			}
+			state.latency = rtt / 2
+		}
+		states[uint16(intf.InterfaceId)] = state // nolint - name from published API.
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ifstate_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/scionproto/scion/control/ifstate"
+)
+
+func TestRouterStateFetcherRun(t *testing.T) {
+	router := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/interfaces", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"interfaces": [
+				{"interface_id": 1, "state": "UP", "bfd": {"round_trip_time": "3ms"}},
+				{"interface_id": 2, "state": "DOWN", "bfd": {}},
+				{"interface_id": 3, "state": "UP", "bfd": {"round_trip_time": "5ms"}}
+			]
+		}`))
+	}))
+	defer router.Close()
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	intfs := testInterfaces(t)
+	intfs.Get(2).SetLatency(time.Second)
+	intfs.Get(2).SetLinkState(ifstate.LinkStateUp)
+	var revoked []uint16
+	fetcher := &ifstate.RouterStateFetcher{
+		Interfaces: intfs,
+		Routers:    []string{router.URL, unreachable.URL},
+		Revoker: revokerFunc(func(_ context.Context, ifIDs []uint16) error {
+			revoked = append(revoked, ifIDs...)
+			return nil
+		}),
+	}
+	fetcher.Run(context.Background())
+
+	assert.Equal(t, 1500*time.Microsecond, intfs.Get(1).Latency())
+	assert.Equal(t, ifstate.LinkStateUp, intfs.Get(1).LinkState())
+	// Interface 2 has been reported without latency, the stale value is
+	// discarded.
+	assert.Zero(t, intfs.Get(2).Latency())
+	assert.Equal(t, ifstate.LinkStateDown, intfs.Get(2).LinkState())
+	assert.Equal(t, []uint16{2}, revoked)
+}
+
+type revokerFunc func(context.Context, []uint16) error
+
+func (f revokerFunc) RevokeInterfaces(ctx context.Context, ifIDs []uint16) error {
+	return f(ctx, ifIDs)
+}
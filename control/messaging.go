@@ -15,23 +15,69 @@
 package control
 
 import (
+	"bytes"
 	"hash"
 	"path/filepath"
+	"sync"
+	"sync/atomic"
 
+	"github.com/scionproto/scion/pkg/log"
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/pkg/scrypto"
 	"github.com/scionproto/scion/private/keyconf"
 )
 
-// MACGenFactory creates a MAC factory
-func MACGenFactory(configDir string) (func() hash.Hash, error) {
-	mk, err := keyconf.LoadMaster(filepath.Join(configDir, "keys"))
+// MACGen creates the MACs used to issue hop fields. The forwarding key is
+// derived from master0.key in the keys directory of the config directory. It
+// can be switched at runtime with Rollover.
+type MACGen struct {
+	configDir string
+
+	// mtx serializes rollovers.
+	mtx     sync.Mutex
+	key     []byte
+	factory atomic.Pointer[func() hash.Hash]
+}
+
+// NewMACGen creates a MAC generator that uses the forwarding key stored in the
+// config directory.
+func NewMACGen(configDir string) (*MACGen, error) {
+	g := &MACGen{configDir: configDir}
+	if _, err := g.Rollover(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// New creates a new MAC with the current forwarding key. It can be used as
+// MAC factory.
+func (g *MACGen) New() hash.Hash {
+	return (*g.factory.Load())()
+}
+
+// Rollover loads the master keys from the config directory and uses the
+// forwarding key derived from master0.key for all MACs that are created
+// afterwards. The returned value indicates whether the key changed. The
+// routers of the AS must already accept the new key.
+func (g *MACGen) Rollover() (bool, error) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	mk, err := keyconf.LoadMaster(filepath.Join(g.configDir, "keys"))
 	if err != nil {
-		return nil, serrors.Wrap("loading master key", err)
+		return false, serrors.Wrap("loading master key", err)
+	}
+	if bytes.Equal(mk.Key0, g.key) {
+		return false, nil
 	}
 	hfMacFactory, err := scrypto.HFMacFactory(mk.Key0)
 	if err != nil {
-		return nil, err
+		return false, err
+	}
+	if g.key != nil {
+		log.Info("Forwarding key rolled over")
 	}
-	return hfMacFactory, nil
+	g.key = mk.Key0
+	g.factory.Store(&hfMacFactory)
+	return true, nil
 }
@@ -0,0 +1,72 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control_test
+
+import (
+	"encoding/base64"
+	"hash"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cs "github.com/scionproto/scion/control"
+	"github.com/scionproto/scion/pkg/scrypto"
+	"github.com/scionproto/scion/private/keyconf"
+)
+
+func TestMACGenRollover(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "keys"), 0o755))
+	writeKey := func(t *testing.T, name, key string) {
+		enc := base64.StdEncoding.EncodeToString([]byte(key))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "keys", name), []byte(enc), 0o644))
+	}
+	sum := func(mac hash.Hash) []byte {
+		// Write must not return an error: https://godoc.org/hash#Hash
+		_, _ = mac.Write([]byte("hop field input"))
+		return mac.Sum(nil)
+	}
+	expected := func(t *testing.T, key string) []byte {
+		factory, err := scrypto.HFMacFactory([]byte(key))
+		require.NoError(t, err)
+		return sum(factory())
+	}
+	writeKey(t, keyconf.MasterKey0, "old_key_xxxxxxxx")
+	writeKey(t, keyconf.MasterKey1, "older_key_xxxxxx")
+
+	gen, err := cs.NewMACGen(dir)
+	require.NoError(t, err)
+	assert.Equal(t, expected(t, "old_key_xxxxxxxx"), sum(gen.New()))
+
+	changed, err := gen.Rollover()
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	writeKey(t, keyconf.MasterKey1, "old_key_xxxxxxxx")
+	writeKey(t, keyconf.MasterKey0, "new_key_xxxxxxxx")
+	changed, err = gen.Rollover()
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, expected(t, "new_key_xxxxxxxx"), sum(gen.New()))
+
+	// A failed rollover keeps the current key.
+	require.NoError(t, os.Remove(filepath.Join(dir, "keys", keyconf.MasterKey0)))
+	_, err = gen.Rollover()
+	assert.Error(t, err)
+	assert.Equal(t, expected(t, "new_key_xxxxxxxx"), sum(gen.New()))
+}
@@ -20,6 +20,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"net/http"
 	"sort"
@@ -30,6 +31,7 @@ import (
 	"github.com/scionproto/scion/control/beacon"
 	cstrust "github.com/scionproto/scion/control/trust"
 	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/experimental/hiddenpath"
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/pkg/scrypto/cppki"
 	seg "github.com/scionproto/scion/pkg/segment"
@@ -48,6 +50,28 @@ type BeaconStore interface {
 	DeleteBeacon(ctx context.Context, idPrefix string) error
 }
 
+// ConfigReloader reloads the runtime configuration files of the control
+// service and returns the configuration parts that changed.
+type ConfigReloader interface {
+	Reload(context.Context) ([]string, error)
+}
+
+// ForwardingKeyRoller switches the forwarding key that is used to issue hop
+// fields and reports whether the key changed.
+type ForwardingKeyRoller interface {
+	Rollover() (bool, error)
+}
+
+// HiddenPathGroups manages the hidden path groups of the control service. The
+// principal is the entity that requested a change, it is recorded in the
+// audit log.
+type HiddenPathGroups interface {
+	Groups() hiddenpath.Groups
+	CreateGroup(ctx context.Context, group *hiddenpath.Group, principal string) error
+	UpdateGroup(ctx context.Context, group *hiddenpath.Group, principal string) error
+	DeleteGroup(ctx context.Context, id hiddenpath.GroupID, principal string) error
+}
+
 type Healther interface {
 	GetSignerHealth(context.Context) SignerHealthData
 	GetTRCHealth(context.Context) TRCHealthData
@@ -85,12 +109,20 @@ type Server struct {
 	Beacons        BeaconStore
 	CA             renewal.ChainBuilder
 	Config         http.HandlerFunc
+	Reloader       ConfigReloader
+	ForwardingKey  ForwardingKeyRoller
+	HiddenPaths    HiddenPathGroups
 	Info           http.HandlerFunc
 	LogLevel       http.HandlerFunc
 	Signer         cstrust.RenewingSigner
 	Topology       http.HandlerFunc
 	TrustDB        storage.TrustDB
 	Healther       Healther
+	// PrincipalHeader is the HTTP header that carries the principal of the
+	// requests, as authenticated by a reverse proxy in front of the API. If
+	// set, changes requested without the header are rejected. If not set,
+	// changes are attributed to the unauthenticated remote address.
+	PrincipalHeader string
 
 	// nowProvider can be set during tests to control the current time.
 	nowProvider func() time.Time
@@ -609,11 +641,322 @@ func (s *Server) GetTrcBlob(w http.ResponseWriter, r *http.Request, isd int, bas
 	s.CPPKIServer.GetTrcBlob(w, r, isd, base, serial) // nolint - name from published API
 }
 
+// GetTrustHealth reports the expiration state of the trust material.
+func (s *Server) GetTrustHealth(w http.ResponseWriter, r *http.Request) {
+	s.CPPKIServer.GetTrustHealth(w, r)
+}
+
 // GetConfig is an indirection to the http handler.
 func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 	s.Config(w, r)
 }
 
+// ReloadConfig reloads the runtime configuration files and reports the
+// configuration parts that changed.
+func (s *Server) ReloadConfig(w http.ResponseWriter, r *http.Request) {
+	changed, err := s.Reloader.Reload(r.Context())
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to reload configuration",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	if changed == nil {
+		changed = []string{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(ConfigReload{Changed: changed}); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// RolloverForwardingKey switches the forwarding key that is used to issue hop
+// fields to the one stored in master0.key.
+func (s *Server) RolloverForwardingKey(w http.ResponseWriter, r *http.Request) {
+	changed, err := s.ForwardingKey.Rollover()
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to roll over forwarding key",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(ForwardingKeyRollover{Changed: changed}); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// GetHiddenPathGroups lists the hidden path groups.
+func (s *Server) GetHiddenPathGroups(w http.ResponseWriter, r *http.Request) {
+	rep := []HiddenPathGroup{}
+	if s.HiddenPaths != nil {
+		for _, group := range s.HiddenPaths.Groups() {
+			rep = append(rep, hiddenPathGroupToAPI(group))
+		}
+	}
+	sort.Slice(rep, func(i, j int) bool { return rep[i].GroupId < rep[j].GroupId })
+	writeHiddenPathResponse(w, http.StatusOK, rep)
+}
+
+// GetHiddenPathGroup gets the hidden path group with the given ID.
+func (s *Server) GetHiddenPathGroup(
+	w http.ResponseWriter,
+	r *http.Request,
+	groupId HiddenPathGroupID,
+) {
+
+	id, err := hiddenpath.ParseGroupID(groupId)
+	if err != nil {
+		hiddenPathError(w, serrors.JoinNoStack(hiddenpath.ErrInvalidGroups, err))
+		return
+	}
+	var group *hiddenpath.Group
+	if s.HiddenPaths != nil {
+		group = s.HiddenPaths.Groups()[id]
+	}
+	if group == nil {
+		hiddenPathError(w, serrors.JoinNoStack(hiddenpath.ErrGroupNotFound, nil, "group_id", id))
+		return
+	}
+	writeHiddenPathResponse(w, http.StatusOK, hiddenPathGroupToAPI(group))
+}
+
+// CreateHiddenPathGroup creates a new hidden path group.
+func (s *Server) CreateHiddenPathGroup(w http.ResponseWriter, r *http.Request) {
+	principal, ok := s.principal(w, r)
+	if !ok {
+		return
+	}
+	group, err := s.parseHiddenPathGroup(r, nil)
+	if err != nil {
+		hiddenPathError(w, err)
+		return
+	}
+	if err := s.HiddenPaths.CreateGroup(r.Context(), group, principal); err != nil {
+		hiddenPathError(w, err)
+		return
+	}
+	writeHiddenPathResponse(w, http.StatusCreated, hiddenPathGroupToAPI(group))
+}
+
+// UpdateHiddenPathGroup replaces the hidden path group with the given ID.
+func (s *Server) UpdateHiddenPathGroup(
+	w http.ResponseWriter,
+	r *http.Request,
+	groupId HiddenPathGroupID,
+) {
+
+	principal, ok := s.principal(w, r)
+	if !ok {
+		return
+	}
+	group, err := s.parseHiddenPathGroup(r, &groupId)
+	if err != nil {
+		hiddenPathError(w, err)
+		return
+	}
+	if err := s.HiddenPaths.UpdateGroup(r.Context(), group, principal); err != nil {
+		hiddenPathError(w, err)
+		return
+	}
+	writeHiddenPathResponse(w, http.StatusOK, hiddenPathGroupToAPI(group))
+}
+
+// DeleteHiddenPathGroup deletes the hidden path group with the given ID.
+func (s *Server) DeleteHiddenPathGroup(
+	w http.ResponseWriter,
+	r *http.Request,
+	groupId HiddenPathGroupID,
+) {
+
+	principal, ok := s.principal(w, r)
+	if !ok {
+		return
+	}
+	if s.HiddenPaths == nil {
+		hiddenPathError(w, errHiddenPathsDisabled)
+		return
+	}
+	id, err := hiddenpath.ParseGroupID(groupId)
+	if err != nil {
+		hiddenPathError(w, serrors.JoinNoStack(hiddenpath.ErrInvalidGroups, err))
+		return
+	}
+	if err := s.HiddenPaths.DeleteGroup(r.Context(), id, principal); err != nil {
+		hiddenPathError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+var errHiddenPathsDisabled = serrors.JoinNoStack(hiddenpath.ErrInvalidGroups,
+	serrors.New("hidden paths are not enabled"))
+
+// parseHiddenPathGroup parses the hidden path group in the request body. If
+// pathID is set, the group ID in the body must match it.
+func (s *Server) parseHiddenPathGroup(
+	r *http.Request,
+	pathID *HiddenPathGroupID,
+) (*hiddenpath.Group, error) {
+
+	if s.HiddenPaths == nil {
+		return nil, errHiddenPathsDisabled
+	}
+	var rep HiddenPathGroup
+	if err := json.NewDecoder(r.Body).Decode(&rep); err != nil {
+		return nil, serrors.JoinNoStack(hiddenpath.ErrInvalidGroups,
+			serrors.Wrap("decoding request body", err))
+	}
+	if pathID != nil && rep.GroupId != *pathID {
+		return nil, serrors.JoinNoStack(hiddenpath.ErrInvalidGroups,
+			serrors.New("group ID in body does not match path", "body", rep.GroupId,
+				"path", *pathID))
+	}
+	group, err := hiddenPathGroupFromAPI(rep)
+	if err != nil {
+		return nil, serrors.JoinNoStack(hiddenpath.ErrInvalidGroups, err)
+	}
+	return group, nil
+}
+
+func hiddenPathGroupFromAPI(rep HiddenPathGroup) (*hiddenpath.Group, error) {
+	id, err := hiddenpath.ParseGroupID(rep.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := addr.ParseIA(rep.Owner)
+	if err != nil {
+		return nil, serrors.Wrap("parsing owner", err)
+	}
+	parseSet := func(name string, raw []IsdAs) (map[addr.IA]struct{}, error) {
+		set := make(map[addr.IA]struct{}, len(raw))
+		for _, s := range raw {
+			ia, err := addr.ParseIA(s)
+			if err != nil {
+				return nil, serrors.Wrap("parsing "+name, err)
+			}
+			set[ia] = struct{}{}
+		}
+		return set, nil
+	}
+	group := &hiddenpath.Group{ID: id, Owner: owner}
+	if group.Writers, err = parseSet("writers", rep.Writers); err != nil {
+		return nil, err
+	}
+	if group.Readers, err = parseSet("readers", rep.Readers); err != nil {
+		return nil, err
+	}
+	if group.Registries, err = parseSet("registries", rep.Registries); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func hiddenPathGroupToAPI(group *hiddenpath.Group) HiddenPathGroup {
+	toList := func(set map[addr.IA]struct{}) []IsdAs {
+		list := make([]IsdAs, 0, len(set))
+		for ia := range set {
+			list = append(list, ia.String())
+		}
+		sort.Strings(list)
+		return list
+	}
+	return HiddenPathGroup{
+		GroupId:    group.ID.String(),
+		Owner:      group.Owner.String(),
+		Writers:    toList(group.Writers),
+		Readers:    toList(group.Readers),
+		Registries: toList(group.Registries),
+	}
+}
+
+func writeHiddenPathResponse(w http.ResponseWriter, status int, rep any) {
+	raw, err := json.MarshalIndent(rep, "", "    ")
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(append(raw, '\n'))
+}
+
+func hiddenPathError(w http.ResponseWriter, err error) {
+	p := Problem{
+		Detail: api.StringRef(err.Error()),
+		Status: http.StatusInternalServerError,
+		Title:  "unable to change hidden path groups",
+		Type:   api.StringRef(api.InternalError),
+	}
+	switch {
+	case errors.Is(err, hiddenpath.ErrGroupNotFound):
+		p.Status, p.Title, p.Type = http.StatusNotFound, "group not found",
+			api.StringRef(api.NotFound)
+	case errors.Is(err, hiddenpath.ErrGroupExists):
+		p.Status, p.Title, p.Type = http.StatusConflict, "group already exists",
+			api.StringRef(api.BadRequest)
+	case errors.Is(err, hiddenpath.ErrInvalidGroups):
+		p.Status, p.Title, p.Type = http.StatusBadRequest, "invalid hidden path groups",
+			api.StringRef(api.BadRequest)
+	}
+	ErrorResponse(w, p)
+}
+
+// principal returns the entity that requested a change. It is the value of
+// the principal header, which is set by the authenticating proxy in front of
+// the API. Changes are rejected if no principal header is configured, because
+// the requests cannot be authenticated then, and if the request does not
+// carry the header. If the request is rejected, the response is written and
+// false is returned.
+func (s *Server) principal(w http.ResponseWriter, r *http.Request) (string, bool) {
+	if s.PrincipalHeader == "" {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef("changes require the principal header to be configured"),
+			Status: http.StatusForbidden,
+			Title:  "changes disabled",
+			Type:   api.StringRef(api.Forbidden),
+		})
+		return "", false
+	}
+	if p := r.Header.Get(s.PrincipalHeader); p != "" {
+		return p, true
+	}
+	ErrorResponse(w, Problem{
+		Detail: api.StringRef("missing principal header " + s.PrincipalHeader),
+		Status: http.StatusUnauthorized,
+		Title:  "unauthenticated",
+		Type:   api.StringRef(api.Unauthorized),
+	})
+	return "", false
+}
+
 // GetInfo is an indirection to the http handler.
 func (s *Server) GetInfo(w http.ResponseWriter, r *http.Request) {
 	s.Info(w, r)
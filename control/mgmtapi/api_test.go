@@ -15,6 +15,7 @@
 package mgmtapi_test
 
 import (
+	"context"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/hex"
@@ -37,6 +38,7 @@ import (
 	cstrust "github.com/scionproto/scion/control/trust"
 	"github.com/scionproto/scion/control/trust/mock_trust"
 	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/experimental/hiddenpath"
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/pkg/private/xtest"
 	"github.com/scionproto/scion/pkg/scrypto/cppki"
@@ -57,7 +59,10 @@ func TestAPI(t *testing.T) {
 	beacons := createBeacons(t)
 	testCases := map[string]struct {
 		Handler            func(t *testing.T, ctrl *gomock.Controller) http.Handler
+		Method             string
 		RequestURL         string
+		Body               string
+		Header             http.Header
 		Status             int
 		IgnoreResponseBody bool
 		TimestampOffset    time.Duration
@@ -435,6 +440,156 @@ func TestAPI(t *testing.T) {
 			RequestURL: "/ca",
 			Status:     500,
 		},
+		"config reload": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				s := &api.Server{
+					Reloader: reloaderFunc(func(context.Context) ([]string, error) {
+						return []string{"static_info", "policies.propagation"}, nil
+					}),
+				}
+				return api.Handler(s)
+			},
+			Method:     http.MethodPost,
+			RequestURL: "/config/reload",
+			Status:     200,
+		},
+		"config reload unchanged": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				s := &api.Server{
+					Reloader: reloaderFunc(func(context.Context) ([]string, error) {
+						return nil, nil
+					}),
+				}
+				return api.Handler(s)
+			},
+			Method:     http.MethodPost,
+			RequestURL: "/config/reload",
+			Status:     200,
+		},
+		"config reload error": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				s := &api.Server{
+					Reloader: reloaderFunc(func(context.Context) ([]string, error) {
+						return nil, serrors.New("invalid policy")
+					}),
+				}
+				return api.Handler(s)
+			},
+			Method:     http.MethodPost,
+			RequestURL: "/config/reload",
+			Status:     500,
+		},
+		"forwarding key rollover": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				s := &api.Server{
+					ForwardingKey: rollerFunc(func() (bool, error) { return true, nil }),
+				}
+				return api.Handler(s)
+			},
+			Method:     http.MethodPost,
+			RequestURL: "/forwarding-key/rollover",
+			Status:     200,
+		},
+		"forwarding key rollover error": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				s := &api.Server{
+					ForwardingKey: rollerFunc(func() (bool, error) {
+						return false, serrors.New("loading master key")
+					}),
+				}
+				return api.Handler(s)
+			},
+			Method:     http.MethodPost,
+			RequestURL: "/forwarding-key/rollover",
+			Status:     500,
+		},
+		"hidden path groups": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(&api.Server{HiddenPaths: newHiddenPathGroups(t)})
+			},
+			RequestURL: "/hidden-paths/groups",
+			Status:     200,
+		},
+		"hidden path groups disabled": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(&api.Server{})
+			},
+			RequestURL: "/hidden-paths/groups",
+			Status:     200,
+		},
+		"hidden path group": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(&api.Server{HiddenPaths: newHiddenPathGroups(t)})
+			},
+			RequestURL: "/hidden-paths/groups/ff00:0:110-69b5",
+			Status:     200,
+		},
+		"hidden path group not found": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(&api.Server{HiddenPaths: newHiddenPathGroups(t)})
+			},
+			RequestURL: "/hidden-paths/groups/ff00:0:110-1",
+			Status:     404,
+		},
+		"hidden path group create": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(newHiddenPathServer(t))
+			},
+			Method:     http.MethodPost,
+			RequestURL: "/hidden-paths/groups",
+			Body: `{"group_id": "ff00:0:110-1", "owner": "1-ff00:0:110",
+				"writers": ["1-ff00:0:111"], "readers": [], "registries": ["1-ff00:0:110"]}`,
+			Header: http.Header{"X-Remote-User": {"alice"}},
+			Status: 201,
+		},
+		"hidden path group create exists": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(newHiddenPathServer(t))
+			},
+			Method:     http.MethodPost,
+			RequestURL: "/hidden-paths/groups",
+			Body: `{"group_id": "ff00:0:110-69b5", "owner": "1-ff00:0:110",
+				"writers": ["1-ff00:0:111"], "readers": [], "registries": ["1-ff00:0:110"]}`,
+			Header: http.Header{"X-Remote-User": {"alice"}},
+			Status: 409,
+		},
+		"hidden path group update id mismatch": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(newHiddenPathServer(t))
+			},
+			Method:     http.MethodPut,
+			RequestURL: "/hidden-paths/groups/ff00:0:110-69b5",
+			Body: `{"group_id": "ff00:0:110-1", "owner": "1-ff00:0:110",
+				"writers": ["1-ff00:0:111"], "readers": [], "registries": ["1-ff00:0:110"]}`,
+			Header: http.Header{"X-Remote-User": {"alice"}},
+			Status: 400,
+		},
+		"hidden path group delete": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(newHiddenPathServer(t))
+			},
+			Method:             http.MethodDelete,
+			RequestURL:         "/hidden-paths/groups/ff00:0:110-69b5",
+			Header:             http.Header{"X-Remote-User": {"alice"}},
+			Status:             204,
+			IgnoreResponseBody: true,
+		},
+		"hidden path group delete unauthenticated": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(newHiddenPathServer(t))
+			},
+			Method:     http.MethodDelete,
+			RequestURL: "/hidden-paths/groups/ff00:0:110-69b5",
+			Status:     401,
+		},
+		"hidden path group delete without principal header": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(&api.Server{HiddenPaths: newHiddenPathGroups(t)})
+			},
+			Method:     http.MethodDelete,
+			RequestURL: "/hidden-paths/groups/ff00:0:110-69b5",
+			Status:     403,
+		},
 		"health": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
 				h := mock_mgmtapi.NewMockHealther(ctrl)
@@ -886,8 +1041,15 @@ func TestAPI(t *testing.T) {
 			t.Parallel()
 			ctrl := gomock.NewController(t)
 
-			req, err := http.NewRequest("GET", tc.RequestURL, nil)
+			method := http.MethodGet
+			if tc.Method != "" {
+				method = tc.Method
+			}
+			req, err := http.NewRequest(method, tc.RequestURL, strings.NewReader(tc.Body))
 			require.NoError(t, err)
+			for k, v := range tc.Header {
+				req.Header[k] = v
+			}
 
 			rr := httptest.NewRecorder()
 			tc.Handler(t, ctrl).ServeHTTP(rr, req)
@@ -986,6 +1148,90 @@ func createBeacons(t *testing.T) []beacon.Beacon {
 	}
 }
 
+// hiddenPathGroups is a simple in-memory implementation of
+// api.HiddenPathGroups.
+type hiddenPathGroups struct {
+	groups hiddenpath.Groups
+}
+
+// newHiddenPathServer returns a server that allows changes of the hidden path
+// groups by the principal in the X-Remote-User header.
+func newHiddenPathServer(t *testing.T) *api.Server {
+	return &api.Server{
+		HiddenPaths:     newHiddenPathGroups(t),
+		PrincipalHeader: "X-Remote-User",
+	}
+}
+
+func newHiddenPathGroups(t *testing.T) *hiddenPathGroups {
+	id, err := hiddenpath.ParseGroupID("ff00:0:110-69b5")
+	require.NoError(t, err)
+	return &hiddenPathGroups{
+		groups: hiddenpath.Groups{
+			id: {
+				ID:    id,
+				Owner: addr.MustParseIA("1-ff00:0:110"),
+				Writers: map[addr.IA]struct{}{
+					addr.MustParseIA("1-ff00:0:111"): {},
+					addr.MustParseIA("1-ff00:0:112"): {},
+				},
+				Readers: map[addr.IA]struct{}{
+					addr.MustParseIA("1-ff00:0:114"): {},
+				},
+				Registries: map[addr.IA]struct{}{
+					addr.MustParseIA("1-ff00:0:110"): {},
+				},
+			},
+		},
+	}
+}
+
+func (h *hiddenPathGroups) Groups() hiddenpath.Groups {
+	return h.groups
+}
+
+func (h *hiddenPathGroups) CreateGroup(_ context.Context, group *hiddenpath.Group,
+	_ string) error {
+
+	if _, ok := h.groups[group.ID]; ok {
+		return hiddenpath.ErrGroupExists
+	}
+	h.groups[group.ID] = group
+	return nil
+}
+
+func (h *hiddenPathGroups) UpdateGroup(_ context.Context, group *hiddenpath.Group,
+	_ string) error {
+
+	if _, ok := h.groups[group.ID]; !ok {
+		return hiddenpath.ErrGroupNotFound
+	}
+	h.groups[group.ID] = group
+	return nil
+}
+
+func (h *hiddenPathGroups) DeleteGroup(_ context.Context, id hiddenpath.GroupID,
+	_ string) error {
+
+	if _, ok := h.groups[id]; !ok {
+		return hiddenpath.ErrGroupNotFound
+	}
+	delete(h.groups, id)
+	return nil
+}
+
+type reloaderFunc func(context.Context) ([]string, error)
+
+func (f reloaderFunc) Reload(ctx context.Context) ([]string, error) {
+	return f(ctx)
+}
+
+type rollerFunc func() (bool, error)
+
+func (f rollerFunc) Rollover() (bool, error) {
+	return f()
+}
+
 type queryMatcher struct {
 	query        *beacon.QueryParams
 	creationTime time.Time
@@ -116,9 +116,34 @@ type ClientInterface interface {
 	// GetConfig request
 	GetConfig(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ReloadConfig request
+	ReloadConfig(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RolloverForwardingKey request
+	RolloverForwardingKey(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetHealth request
 	GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetHiddenPathGroups request
+	GetHiddenPathGroups(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateHiddenPathGroupWithBody request with any body
+	CreateHiddenPathGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateHiddenPathGroup(ctx context.Context, body CreateHiddenPathGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteHiddenPathGroup request
+	DeleteHiddenPathGroup(ctx context.Context, groupId HiddenPathGroupID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHiddenPathGroup request
+	GetHiddenPathGroup(ctx context.Context, groupId HiddenPathGroupID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateHiddenPathGroupWithBody request with any body
+	UpdateHiddenPathGroupWithBody(ctx context.Context, groupId HiddenPathGroupID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateHiddenPathGroup(ctx context.Context, groupId HiddenPathGroupID, body UpdateHiddenPathGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetInfo request
 	GetInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -159,6 +184,9 @@ type ClientInterface interface {
 
 	// GetTrcBlob request
 	GetTrcBlob(ctx context.Context, isd int, base int, serial int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTrustHealth request
+	GetTrustHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) GetBeacons(ctx context.Context, params *GetBeaconsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -269,6 +297,30 @@ func (c *Client) GetConfig(ctx context.Context, reqEditors ...RequestEditorFn) (
 	return c.Client.Do(req)
 }
 
+func (c *Client) ReloadConfig(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReloadConfigRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RolloverForwardingKey(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRolloverForwardingKeyRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetHealthRequest(c.Server)
 	if err != nil {
@@ -281,6 +333,90 @@ func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetHiddenPathGroups(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHiddenPathGroupsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateHiddenPathGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateHiddenPathGroupRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateHiddenPathGroup(ctx context.Context, body CreateHiddenPathGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateHiddenPathGroupRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteHiddenPathGroup(ctx context.Context, groupId HiddenPathGroupID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteHiddenPathGroupRequest(c.Server, groupId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHiddenPathGroup(ctx context.Context, groupId HiddenPathGroupID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHiddenPathGroupRequest(c.Server, groupId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateHiddenPathGroupWithBody(ctx context.Context, groupId HiddenPathGroupID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateHiddenPathGroupRequestWithBody(c.Server, groupId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateHiddenPathGroup(ctx context.Context, groupId HiddenPathGroupID, body UpdateHiddenPathGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateHiddenPathGroupRequest(c.Server, groupId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetInfoRequest(c.Server)
 	if err != nil {
@@ -449,6 +585,18 @@ func (c *Client) GetTrcBlob(ctx context.Context, isd int, base int, serial int,
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetTrustHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTrustHealthRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewGetBeaconsRequest generates requests for GetBeacons
 func NewGetBeaconsRequest(server string, params *GetBeaconsParams) (*http.Request, error) {
 	var err error
@@ -899,8 +1047,8 @@ func NewGetConfigRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetHealthRequest generates requests for GetHealth
-func NewGetHealthRequest(server string) (*http.Request, error) {
+// NewReloadConfigRequest generates requests for ReloadConfig
+func NewReloadConfigRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -908,7 +1056,7 @@ func NewGetHealthRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/health")
+	operationPath := fmt.Sprintf("/config/reload")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -918,7 +1066,7 @@ func NewGetHealthRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -926,8 +1074,8 @@ func NewGetHealthRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetInfoRequest generates requests for GetInfo
-func NewGetInfoRequest(server string) (*http.Request, error) {
+// NewRolloverForwardingKeyRequest generates requests for RolloverForwardingKey
+func NewRolloverForwardingKeyRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -935,7 +1083,7 @@ func NewGetInfoRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/info")
+	operationPath := fmt.Sprintf("/forwarding-key/rollover")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -945,7 +1093,7 @@ func NewGetInfoRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -953,8 +1101,8 @@ func NewGetInfoRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetLogLevelRequest generates requests for GetLogLevel
-func NewGetLogLevelRequest(server string) (*http.Request, error) {
+// NewGetHealthRequest generates requests for GetHealth
+func NewGetHealthRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -962,7 +1110,7 @@ func NewGetLogLevelRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/log/level")
+	operationPath := fmt.Sprintf("/health")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -980,19 +1128,8 @@ func NewGetLogLevelRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewSetLogLevelRequest calls the generic SetLogLevel builder with application/json body
-func NewSetLogLevelRequest(server string, body SetLogLevelJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewSetLogLevelRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewSetLogLevelRequestWithBody generates requests for SetLogLevel with any type of body
-func NewSetLogLevelRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetHiddenPathGroupsRequest generates requests for GetHiddenPathGroups
+func NewGetHiddenPathGroupsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1000,7 +1137,7 @@ func NewSetLogLevelRequestWithBody(server string, contentType string, body io.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/log/level")
+	operationPath := fmt.Sprintf("/hidden-paths/groups")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1010,18 +1147,27 @@ func NewSetLogLevelRequestWithBody(server string, contentType string, body io.Re
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetSegmentsRequest generates requests for GetSegments
-func NewGetSegmentsRequest(server string, params *GetSegmentsParams) (*http.Request, error) {
+// NewCreateHiddenPathGroupRequest calls the generic CreateHiddenPathGroup builder with application/json body
+func NewCreateHiddenPathGroupRequest(server string, body CreateHiddenPathGroupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateHiddenPathGroupRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateHiddenPathGroupRequestWithBody generates requests for CreateHiddenPathGroup with any type of body
+func NewCreateHiddenPathGroupRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1029,7 +1175,7 @@ func NewGetSegmentsRequest(server string, params *GetSegmentsParams) (*http.Requ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/segments")
+	operationPath := fmt.Sprintf("/hidden-paths/groups")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1039,59 +1185,23 @@ func NewGetSegmentsRequest(server string, params *GetSegmentsParams) (*http.Requ
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.StartIsdAs != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_isd_as", runtime.ParamLocationQuery, *params.StartIsdAs); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.EndIsdAs != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_isd_as", runtime.ParamLocationQuery, *params.EndIsdAs); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewDeleteSegmentRequest generates requests for DeleteSegment
-func NewDeleteSegmentRequest(server string, segmentId SegmentID) (*http.Request, error) {
+// NewDeleteHiddenPathGroupRequest generates requests for DeleteHiddenPathGroup
+func NewDeleteHiddenPathGroupRequest(server string, groupId HiddenPathGroupID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "segment-id", runtime.ParamLocationPath, segmentId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "group-id", runtime.ParamLocationPath, groupId)
 	if err != nil {
 		return nil, err
 	}
@@ -1101,7 +1211,7 @@ func NewDeleteSegmentRequest(server string, segmentId SegmentID) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/segments/%s", pathParam0)
+	operationPath := fmt.Sprintf("/hidden-paths/groups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1119,13 +1229,13 @@ func NewDeleteSegmentRequest(server string, segmentId SegmentID) (*http.Request,
 	return req, nil
 }
 
-// NewGetSegmentRequest generates requests for GetSegment
-func NewGetSegmentRequest(server string, segmentId SegmentID) (*http.Request, error) {
+// NewGetHiddenPathGroupRequest generates requests for GetHiddenPathGroup
+func NewGetHiddenPathGroupRequest(server string, groupId HiddenPathGroupID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "segment-id", runtime.ParamLocationPath, segmentId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "group-id", runtime.ParamLocationPath, groupId)
 	if err != nil {
 		return nil, err
 	}
@@ -1135,7 +1245,7 @@ func NewGetSegmentRequest(server string, segmentId SegmentID) (*http.Request, er
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/segments/%s", pathParam0)
+	operationPath := fmt.Sprintf("/hidden-paths/groups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1153,13 +1263,24 @@ func NewGetSegmentRequest(server string, segmentId SegmentID) (*http.Request, er
 	return req, nil
 }
 
-// NewGetSegmentBlobRequest generates requests for GetSegmentBlob
-func NewGetSegmentBlobRequest(server string, segmentId SegmentID) (*http.Request, error) {
+// NewUpdateHiddenPathGroupRequest calls the generic UpdateHiddenPathGroup builder with application/json body
+func NewUpdateHiddenPathGroupRequest(server string, groupId HiddenPathGroupID, body UpdateHiddenPathGroupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateHiddenPathGroupRequestWithBody(server, groupId, "application/json", bodyReader)
+}
+
+// NewUpdateHiddenPathGroupRequestWithBody generates requests for UpdateHiddenPathGroup with any type of body
+func NewUpdateHiddenPathGroupRequestWithBody(server string, groupId HiddenPathGroupID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "segment-id", runtime.ParamLocationPath, segmentId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "group-id", runtime.ParamLocationPath, groupId)
 	if err != nil {
 		return nil, err
 	}
@@ -1169,7 +1290,7 @@ func NewGetSegmentBlobRequest(server string, segmentId SegmentID) (*http.Request
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/segments/%s/blob", pathParam0)
+	operationPath := fmt.Sprintf("/hidden-paths/groups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1179,16 +1300,18 @@ func NewGetSegmentBlobRequest(server string, segmentId SegmentID) (*http.Request
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetSignerRequest generates requests for GetSigner
-func NewGetSignerRequest(server string) (*http.Request, error) {
+// NewGetInfoRequest generates requests for GetInfo
+func NewGetInfoRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1196,7 +1319,7 @@ func NewGetSignerRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/signer")
+	operationPath := fmt.Sprintf("/info")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1214,8 +1337,8 @@ func NewGetSignerRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetSignerChainRequest generates requests for GetSignerChain
-func NewGetSignerChainRequest(server string) (*http.Request, error) {
+// NewGetLogLevelRequest generates requests for GetLogLevel
+func NewGetLogLevelRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1223,7 +1346,7 @@ func NewGetSignerChainRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/signer/blob")
+	operationPath := fmt.Sprintf("/log/level")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1241,8 +1364,19 @@ func NewGetSignerChainRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetTopologyRequest generates requests for GetTopology
-func NewGetTopologyRequest(server string) (*http.Request, error) {
+// NewSetLogLevelRequest calls the generic SetLogLevel builder with application/json body
+func NewSetLogLevelRequest(server string, body SetLogLevelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetLogLevelRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSetLogLevelRequestWithBody generates requests for SetLogLevel with any type of body
+func NewSetLogLevelRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1250,7 +1384,7 @@ func NewGetTopologyRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/topology")
+	operationPath := fmt.Sprintf("/log/level")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1260,16 +1394,18 @@ func NewGetTopologyRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetTrcsRequest generates requests for GetTrcs
-func NewGetTrcsRequest(server string, params *GetTrcsParams) (*http.Request, error) {
+// NewGetSegmentsRequest generates requests for GetSegments
+func NewGetSegmentsRequest(server string, params *GetSegmentsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1277,7 +1413,255 @@ func NewGetTrcsRequest(server string, params *GetTrcsParams) (*http.Request, err
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/trcs")
+	operationPath := fmt.Sprintf("/segments")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartIsdAs != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_isd_as", runtime.ParamLocationQuery, *params.StartIsdAs); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndIsdAs != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_isd_as", runtime.ParamLocationQuery, *params.EndIsdAs); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteSegmentRequest generates requests for DeleteSegment
+func NewDeleteSegmentRequest(server string, segmentId SegmentID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "segment-id", runtime.ParamLocationPath, segmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/segments/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSegmentRequest generates requests for GetSegment
+func NewGetSegmentRequest(server string, segmentId SegmentID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "segment-id", runtime.ParamLocationPath, segmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/segments/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSegmentBlobRequest generates requests for GetSegmentBlob
+func NewGetSegmentBlobRequest(server string, segmentId SegmentID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "segment-id", runtime.ParamLocationPath, segmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/segments/%s/blob", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSignerRequest generates requests for GetSigner
+func NewGetSignerRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/signer")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSignerChainRequest generates requests for GetSignerChain
+func NewGetSignerChainRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/signer/blob")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTopologyRequest generates requests for GetTopology
+func NewGetTopologyRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/topology")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTrcsRequest generates requests for GetTrcs
+func NewGetTrcsRequest(server string, params *GetTrcsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trcs")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1429,6 +1813,33 @@ func NewGetTrcBlobRequest(server string, isd int, base int, serial int) (*http.R
 	return req, nil
 }
 
+// NewGetTrustHealthRequest generates requests for GetTrustHealth
+func NewGetTrustHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trust/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -1499,9 +1910,34 @@ type ClientWithResponsesInterface interface {
 	// GetConfigWithResponse request
 	GetConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigResponse, error)
 
+	// ReloadConfigWithResponse request
+	ReloadConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReloadConfigResponse, error)
+
+	// RolloverForwardingKeyWithResponse request
+	RolloverForwardingKeyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RolloverForwardingKeyResponse, error)
+
 	// GetHealthWithResponse request
 	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
 
+	// GetHiddenPathGroupsWithResponse request
+	GetHiddenPathGroupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHiddenPathGroupsResponse, error)
+
+	// CreateHiddenPathGroupWithBodyWithResponse request with any body
+	CreateHiddenPathGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateHiddenPathGroupResponse, error)
+
+	CreateHiddenPathGroupWithResponse(ctx context.Context, body CreateHiddenPathGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateHiddenPathGroupResponse, error)
+
+	// DeleteHiddenPathGroupWithResponse request
+	DeleteHiddenPathGroupWithResponse(ctx context.Context, groupId HiddenPathGroupID, reqEditors ...RequestEditorFn) (*DeleteHiddenPathGroupResponse, error)
+
+	// GetHiddenPathGroupWithResponse request
+	GetHiddenPathGroupWithResponse(ctx context.Context, groupId HiddenPathGroupID, reqEditors ...RequestEditorFn) (*GetHiddenPathGroupResponse, error)
+
+	// UpdateHiddenPathGroupWithBodyWithResponse request with any body
+	UpdateHiddenPathGroupWithBodyWithResponse(ctx context.Context, groupId HiddenPathGroupID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateHiddenPathGroupResponse, error)
+
+	UpdateHiddenPathGroupWithResponse(ctx context.Context, groupId HiddenPathGroupID, body UpdateHiddenPathGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateHiddenPathGroupResponse, error)
+
 	// GetInfoWithResponse request
 	GetInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetInfoResponse, error)
 
@@ -1542,6 +1978,9 @@ type ClientWithResponsesInterface interface {
 
 	// GetTrcBlobWithResponse request
 	GetTrcBlobWithResponse(ctx context.Context, isd int, base int, serial int, reqEditors ...RequestEditorFn) (*GetTrcBlobResponse, error)
+
+	// GetTrustHealthWithResponse request
+	GetTrustHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTrustHealthResponse, error)
 }
 
 type GetBeaconsResponse struct {
@@ -1750,6 +2189,52 @@ func (r GetConfigResponse) StatusCode() int {
 	return 0
 }
 
+type ReloadConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ConfigReload
+	JSON500      *Internal
+}
+
+// Status returns HTTPResponse.Status
+func (r ReloadConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReloadConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RolloverForwardingKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ForwardingKeyRollover
+	JSON500      *Internal
+}
+
+// Status returns HTTPResponse.Status
+func (r RolloverForwardingKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RolloverForwardingKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetHealthResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1758,7 +2243,133 @@ type GetHealthResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetHealthResponse) Status() string {
+func (r GetHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHiddenPathGroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]HiddenPathGroup
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHiddenPathGroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHiddenPathGroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateHiddenPathGroupResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *HiddenPathGroup
+	ApplicationproblemJSON400 *HiddenPathProblem
+	ApplicationproblemJSON401 *HiddenPathProblem
+	ApplicationproblemJSON403 *HiddenPathProblem
+	ApplicationproblemJSON409 *HiddenPathProblem
+	ApplicationproblemJSON500 *HiddenPathProblem
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateHiddenPathGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateHiddenPathGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteHiddenPathGroupResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *HiddenPathProblem
+	ApplicationproblemJSON401 *HiddenPathProblem
+	ApplicationproblemJSON403 *HiddenPathProblem
+	ApplicationproblemJSON404 *HiddenPathProblem
+	ApplicationproblemJSON500 *HiddenPathProblem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteHiddenPathGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteHiddenPathGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHiddenPathGroupResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *HiddenPathGroup
+	ApplicationproblemJSON400 *HiddenPathProblem
+	ApplicationproblemJSON404 *HiddenPathProblem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHiddenPathGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHiddenPathGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateHiddenPathGroupResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *HiddenPathGroup
+	ApplicationproblemJSON400 *HiddenPathProblem
+	ApplicationproblemJSON401 *HiddenPathProblem
+	ApplicationproblemJSON403 *HiddenPathProblem
+	ApplicationproblemJSON404 *HiddenPathProblem
+	ApplicationproblemJSON500 *HiddenPathProblem
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateHiddenPathGroupResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1766,7 +2377,7 @@ func (r GetHealthResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetHealthResponse) StatusCode() int {
+func (r UpdateHiddenPathGroupResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -2068,6 +2679,29 @@ func (r GetTrcBlobResponse) StatusCode() int {
 	return 0
 }
 
+type GetTrustHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TrustHealth
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTrustHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTrustHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 // GetBeaconsWithResponse request returning *GetBeaconsResponse
 func (c *ClientWithResponses) GetBeaconsWithResponse(ctx context.Context, params *GetBeaconsParams, reqEditors ...RequestEditorFn) (*GetBeaconsResponse, error) {
 	rsp, err := c.GetBeacons(ctx, params, reqEditors...)
@@ -2149,6 +2783,24 @@ func (c *ClientWithResponses) GetConfigWithResponse(ctx context.Context, reqEdit
 	return ParseGetConfigResponse(rsp)
 }
 
+// ReloadConfigWithResponse request returning *ReloadConfigResponse
+func (c *ClientWithResponses) ReloadConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReloadConfigResponse, error) {
+	rsp, err := c.ReloadConfig(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReloadConfigResponse(rsp)
+}
+
+// RolloverForwardingKeyWithResponse request returning *RolloverForwardingKeyResponse
+func (c *ClientWithResponses) RolloverForwardingKeyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RolloverForwardingKeyResponse, error) {
+	rsp, err := c.RolloverForwardingKey(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRolloverForwardingKeyResponse(rsp)
+}
+
 // GetHealthWithResponse request returning *GetHealthResponse
 func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
 	rsp, err := c.GetHealth(ctx, reqEditors...)
@@ -2158,6 +2810,67 @@ func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEdit
 	return ParseGetHealthResponse(rsp)
 }
 
+// GetHiddenPathGroupsWithResponse request returning *GetHiddenPathGroupsResponse
+func (c *ClientWithResponses) GetHiddenPathGroupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHiddenPathGroupsResponse, error) {
+	rsp, err := c.GetHiddenPathGroups(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHiddenPathGroupsResponse(rsp)
+}
+
+// CreateHiddenPathGroupWithBodyWithResponse request with arbitrary body returning *CreateHiddenPathGroupResponse
+func (c *ClientWithResponses) CreateHiddenPathGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateHiddenPathGroupResponse, error) {
+	rsp, err := c.CreateHiddenPathGroupWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateHiddenPathGroupResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateHiddenPathGroupWithResponse(ctx context.Context, body CreateHiddenPathGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateHiddenPathGroupResponse, error) {
+	rsp, err := c.CreateHiddenPathGroup(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateHiddenPathGroupResponse(rsp)
+}
+
+// DeleteHiddenPathGroupWithResponse request returning *DeleteHiddenPathGroupResponse
+func (c *ClientWithResponses) DeleteHiddenPathGroupWithResponse(ctx context.Context, groupId HiddenPathGroupID, reqEditors ...RequestEditorFn) (*DeleteHiddenPathGroupResponse, error) {
+	rsp, err := c.DeleteHiddenPathGroup(ctx, groupId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteHiddenPathGroupResponse(rsp)
+}
+
+// GetHiddenPathGroupWithResponse request returning *GetHiddenPathGroupResponse
+func (c *ClientWithResponses) GetHiddenPathGroupWithResponse(ctx context.Context, groupId HiddenPathGroupID, reqEditors ...RequestEditorFn) (*GetHiddenPathGroupResponse, error) {
+	rsp, err := c.GetHiddenPathGroup(ctx, groupId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHiddenPathGroupResponse(rsp)
+}
+
+// UpdateHiddenPathGroupWithBodyWithResponse request with arbitrary body returning *UpdateHiddenPathGroupResponse
+func (c *ClientWithResponses) UpdateHiddenPathGroupWithBodyWithResponse(ctx context.Context, groupId HiddenPathGroupID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateHiddenPathGroupResponse, error) {
+	rsp, err := c.UpdateHiddenPathGroupWithBody(ctx, groupId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateHiddenPathGroupResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateHiddenPathGroupWithResponse(ctx context.Context, groupId HiddenPathGroupID, body UpdateHiddenPathGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateHiddenPathGroupResponse, error) {
+	rsp, err := c.UpdateHiddenPathGroup(ctx, groupId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateHiddenPathGroupResponse(rsp)
+}
+
 // GetInfoWithResponse request returning *GetInfoResponse
 func (c *ClientWithResponses) GetInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetInfoResponse, error) {
 	rsp, err := c.GetInfo(ctx, reqEditors...)
@@ -2253,179 +2966,405 @@ func (c *ClientWithResponses) GetTopologyWithResponse(ctx context.Context, reqEd
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetTopologyResponse(rsp)
+	return ParseGetTopologyResponse(rsp)
+}
+
+// GetTrcsWithResponse request returning *GetTrcsResponse
+func (c *ClientWithResponses) GetTrcsWithResponse(ctx context.Context, params *GetTrcsParams, reqEditors ...RequestEditorFn) (*GetTrcsResponse, error) {
+	rsp, err := c.GetTrcs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTrcsResponse(rsp)
+}
+
+// GetTrcWithResponse request returning *GetTrcResponse
+func (c *ClientWithResponses) GetTrcWithResponse(ctx context.Context, isd int, base int, serial int, reqEditors ...RequestEditorFn) (*GetTrcResponse, error) {
+	rsp, err := c.GetTrc(ctx, isd, base, serial, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTrcResponse(rsp)
+}
+
+// GetTrcBlobWithResponse request returning *GetTrcBlobResponse
+func (c *ClientWithResponses) GetTrcBlobWithResponse(ctx context.Context, isd int, base int, serial int, reqEditors ...RequestEditorFn) (*GetTrcBlobResponse, error) {
+	rsp, err := c.GetTrcBlob(ctx, isd, base, serial, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTrcBlobResponse(rsp)
+}
+
+// GetTrustHealthWithResponse request returning *GetTrustHealthResponse
+func (c *ClientWithResponses) GetTrustHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTrustHealthResponse, error) {
+	rsp, err := c.GetTrustHealth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTrustHealthResponse(rsp)
+}
+
+// ParseGetBeaconsResponse parses an HTTP response from a GetBeaconsWithResponse call
+func ParseGetBeaconsResponse(rsp *http.Response) (*GetBeaconsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBeaconsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Beacons *[]Beacon `json:"beacons,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteBeaconResponse parses an HTTP response from a DeleteBeaconWithResponse call
+func ParseDeleteBeaconResponse(rsp *http.Response) (*DeleteBeaconResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteBeaconResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Internal
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBeaconResponse parses an HTTP response from a GetBeaconWithResponse call
+func ParseGetBeaconResponse(rsp *http.Response) (*GetBeaconResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBeaconResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BeaconGetResponseJson
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBeaconBlobResponse parses an HTTP response from a GetBeaconBlobWithResponse call
+func ParseGetBeaconBlobResponse(rsp *http.Response) (*GetBeaconBlobResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBeaconBlobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCaResponse parses an HTTP response from a GetCaWithResponse call
+func ParseGetCaResponse(rsp *http.Response) (*GetCaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CA
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetTrcsWithResponse request returning *GetTrcsResponse
-func (c *ClientWithResponses) GetTrcsWithResponse(ctx context.Context, params *GetTrcsParams, reqEditors ...RequestEditorFn) (*GetTrcsResponse, error) {
-	rsp, err := c.GetTrcs(ctx, params, reqEditors...)
+// ParseGetCertificatesResponse parses an HTTP response from a GetCertificatesWithResponse call
+func ParseGetCertificatesResponse(rsp *http.Response) (*GetCertificatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetTrcsResponse(rsp)
-}
 
-// GetTrcWithResponse request returning *GetTrcResponse
-func (c *ClientWithResponses) GetTrcWithResponse(ctx context.Context, isd int, base int, serial int, reqEditors ...RequestEditorFn) (*GetTrcResponse, error) {
-	rsp, err := c.GetTrc(ctx, isd, base, serial, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetCertificatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetTrcResponse(rsp)
-}
 
-// GetTrcBlobWithResponse request returning *GetTrcBlobResponse
-func (c *ClientWithResponses) GetTrcBlobWithResponse(ctx context.Context, isd int, base int, serial int, reqEditors ...RequestEditorFn) (*GetTrcBlobResponse, error) {
-	rsp, err := c.GetTrcBlob(ctx, isd, base, serial, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ChainBrief
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
 	}
-	return ParseGetTrcBlobResponse(rsp)
+
+	return response, nil
 }
 
-// ParseGetBeaconsResponse parses an HTTP response from a GetBeaconsWithResponse call
-func ParseGetBeaconsResponse(rsp *http.Response) (*GetBeaconsResponse, error) {
+// ParseGetCertificateResponse parses an HTTP response from a GetCertificateWithResponse call
+func ParseGetCertificateResponse(rsp *http.Response) (*GetCertificateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetBeaconsResponse{
+	response := &GetCertificateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Beacons *[]Beacon `json:"beacons,omitempty"`
-		}
+		var dest Chain
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+		var dest Problem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.ApplicationproblemJSON400 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeleteBeaconResponse parses an HTTP response from a DeleteBeaconWithResponse call
-func ParseDeleteBeaconResponse(rsp *http.Response) (*DeleteBeaconResponse, error) {
+// ParseGetCertificateBlobResponse parses an HTTP response from a GetCertificateBlobWithResponse call
+func ParseGetCertificateBlobResponse(rsp *http.Response) (*GetCertificateBlobResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteBeaconResponse{
+	response := &GetCertificateBlobResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+		var dest Problem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.ApplicationproblemJSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Internal
+	}
+
+	return response, nil
+}
+
+// ParseGetConfigResponse parses an HTTP response from a GetConfigWithResponse call
+func ParseGetConfigResponse(rsp *http.Response) (*GetConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON400 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetBeaconResponse parses an HTTP response from a GetBeaconWithResponse call
-func ParseGetBeaconResponse(rsp *http.Response) (*GetBeaconResponse, error) {
+// ParseReloadConfigResponse parses an HTTP response from a ReloadConfigWithResponse call
+func ParseReloadConfigResponse(rsp *http.Response) (*ReloadConfigResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetBeaconResponse{
+	response := &ReloadConfigResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BeaconGetResponseJson
+		var dest ConfigReload
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Internal
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetBeaconBlobResponse parses an HTTP response from a GetBeaconBlobWithResponse call
-func ParseGetBeaconBlobResponse(rsp *http.Response) (*GetBeaconBlobResponse, error) {
+// ParseRolloverForwardingKeyResponse parses an HTTP response from a RolloverForwardingKeyWithResponse call
+func ParseRolloverForwardingKeyResponse(rsp *http.Response) (*RolloverForwardingKeyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetBeaconBlobResponse{
+	response := &RolloverForwardingKeyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ForwardingKeyRollover
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Internal
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetCaResponse parses an HTTP response from a GetCaWithResponse call
-func ParseGetCaResponse(rsp *http.Response) (*GetCaResponse, error) {
+// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
+func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCaResponse{
+	response := &GetHealthResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CA
+		var dest HealthResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2443,151 +3382,242 @@ func ParseGetCaResponse(rsp *http.Response) (*GetCaResponse, error) {
 	return response, nil
 }
 
-// ParseGetCertificatesResponse parses an HTTP response from a GetCertificatesWithResponse call
-func ParseGetCertificatesResponse(rsp *http.Response) (*GetCertificatesResponse, error) {
+// ParseGetHiddenPathGroupsResponse parses an HTTP response from a GetHiddenPathGroupsWithResponse call
+func ParseGetHiddenPathGroupsResponse(rsp *http.Response) (*GetHiddenPathGroupsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCertificatesResponse{
+	response := &GetHiddenPathGroupsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []ChainBrief
+		var dest []HiddenPathGroup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Problem
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.ApplicationproblemJSON400 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseGetCertificateResponse parses an HTTP response from a GetCertificateWithResponse call
-func ParseGetCertificateResponse(rsp *http.Response) (*GetCertificateResponse, error) {
+// ParseCreateHiddenPathGroupResponse parses an HTTP response from a CreateHiddenPathGroupWithResponse call
+func ParseCreateHiddenPathGroupResponse(rsp *http.Response) (*CreateHiddenPathGroupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCertificateResponse{
+	response := &CreateHiddenPathGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Chain
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest HiddenPathGroup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Problem
+		var dest HiddenPathProblem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.ApplicationproblemJSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HiddenPathProblem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HiddenPathProblem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest HiddenPathProblem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HiddenPathProblem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetCertificateBlobResponse parses an HTTP response from a GetCertificateBlobWithResponse call
-func ParseGetCertificateBlobResponse(rsp *http.Response) (*GetCertificateBlobResponse, error) {
+// ParseDeleteHiddenPathGroupResponse parses an HTTP response from a DeleteHiddenPathGroupWithResponse call
+func ParseDeleteHiddenPathGroupResponse(rsp *http.Response) (*DeleteHiddenPathGroupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCertificateBlobResponse{
+	response := &DeleteHiddenPathGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Problem
+		var dest HiddenPathProblem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.ApplicationproblemJSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HiddenPathProblem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HiddenPathProblem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HiddenPathProblem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HiddenPathProblem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetConfigResponse parses an HTTP response from a GetConfigWithResponse call
-func ParseGetConfigResponse(rsp *http.Response) (*GetConfigResponse, error) {
+// ParseGetHiddenPathGroupResponse parses an HTTP response from a GetHiddenPathGroupWithResponse call
+func ParseGetHiddenPathGroupResponse(rsp *http.Response) (*GetHiddenPathGroupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetConfigResponse{
+	response := &GetHiddenPathGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HiddenPathGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+		var dest HiddenPathProblem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HiddenPathProblem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
-func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
+// ParseUpdateHiddenPathGroupResponse parses an HTTP response from a UpdateHiddenPathGroupWithResponse call
+func ParseUpdateHiddenPathGroupResponse(rsp *http.Response) (*UpdateHiddenPathGroupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetHealthResponse{
+	response := &UpdateHiddenPathGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest HealthResponse
+		var dest HiddenPathGroup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+		var dest HiddenPathProblem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HiddenPathProblem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HiddenPathProblem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HiddenPathProblem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HiddenPathProblem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
 
 	}
 
@@ -2994,3 +4024,36 @@ func ParseGetTrcBlobResponse(rsp *http.Response) (*GetTrcBlobResponse, error) {
 
 	return response, nil
 }
+
+// ParseGetTrustHealthResponse parses an HTTP response from a GetTrustHealthWithResponse call
+func ParseGetTrustHealthResponse(rsp *http.Response) (*GetTrustHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTrustHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TrustHealth
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
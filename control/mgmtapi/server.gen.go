@@ -40,9 +40,30 @@ type ServerInterface interface {
 	// Prints the TOML configuration file.
 	// (GET /config)
 	GetConfig(w http.ResponseWriter, r *http.Request)
+	// Reload the runtime configuration files.
+	// (POST /config/reload)
+	ReloadConfig(w http.ResponseWriter, r *http.Request)
+	// Roll over the forwarding key.
+	// (POST /forwarding-key/rollover)
+	RolloverForwardingKey(w http.ResponseWriter, r *http.Request)
 	// Indicate the service health.
 	// (GET /health)
 	GetHealth(w http.ResponseWriter, r *http.Request)
+	// List the hidden path groups.
+	// (GET /hidden-paths/groups)
+	GetHiddenPathGroups(w http.ResponseWriter, r *http.Request)
+	// Create a hidden path group.
+	// (POST /hidden-paths/groups)
+	CreateHiddenPathGroup(w http.ResponseWriter, r *http.Request)
+	// Delete a hidden path group.
+	// (DELETE /hidden-paths/groups/{group-id})
+	DeleteHiddenPathGroup(w http.ResponseWriter, r *http.Request, groupId HiddenPathGroupID)
+	// Get a hidden path group.
+	// (GET /hidden-paths/groups/{group-id})
+	GetHiddenPathGroup(w http.ResponseWriter, r *http.Request, groupId HiddenPathGroupID)
+	// Update a hidden path group.
+	// (PUT /hidden-paths/groups/{group-id})
+	UpdateHiddenPathGroup(w http.ResponseWriter, r *http.Request, groupId HiddenPathGroupID)
 	// Basic information page about the control service process.
 	// (GET /info)
 	GetInfo(w http.ResponseWriter, r *http.Request)
@@ -82,6 +103,9 @@ type ServerInterface interface {
 	// Get the TRC blob
 	// (GET /trcs/isd{isd}-b{base}-s{serial}/blob)
 	GetTrcBlob(w http.ResponseWriter, r *http.Request, isd int, base int, serial int)
+	// Get the health of the trust material
+	// (GET /trust/health)
+	GetTrustHealth(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -142,12 +166,54 @@ func (_ Unimplemented) GetConfig(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Reload the runtime configuration files.
+// (POST /config/reload)
+func (_ Unimplemented) ReloadConfig(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Roll over the forwarding key.
+// (POST /forwarding-key/rollover)
+func (_ Unimplemented) RolloverForwardingKey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Indicate the service health.
 // (GET /health)
 func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List the hidden path groups.
+// (GET /hidden-paths/groups)
+func (_ Unimplemented) GetHiddenPathGroups(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a hidden path group.
+// (POST /hidden-paths/groups)
+func (_ Unimplemented) CreateHiddenPathGroup(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a hidden path group.
+// (DELETE /hidden-paths/groups/{group-id})
+func (_ Unimplemented) DeleteHiddenPathGroup(w http.ResponseWriter, r *http.Request, groupId HiddenPathGroupID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a hidden path group.
+// (GET /hidden-paths/groups/{group-id})
+func (_ Unimplemented) GetHiddenPathGroup(w http.ResponseWriter, r *http.Request, groupId HiddenPathGroupID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a hidden path group.
+// (PUT /hidden-paths/groups/{group-id})
+func (_ Unimplemented) UpdateHiddenPathGroup(w http.ResponseWriter, r *http.Request, groupId HiddenPathGroupID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Basic information page about the control service process.
 // (GET /info)
 func (_ Unimplemented) GetInfo(w http.ResponseWriter, r *http.Request) {
@@ -226,6 +292,12 @@ func (_ Unimplemented) GetTrcBlob(w http.ResponseWriter, r *http.Request, isd in
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get the health of the trust material
+// (GET /trust/health)
+func (_ Unimplemented) GetTrustHealth(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -506,6 +578,34 @@ func (siw *ServerInterfaceWrapper) GetConfig(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
+// ReloadConfig operation middleware
+func (siw *ServerInterfaceWrapper) ReloadConfig(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReloadConfig(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RolloverForwardingKey operation middleware
+func (siw *ServerInterfaceWrapper) RolloverForwardingKey(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RolloverForwardingKey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetHealth operation middleware
 func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
 
@@ -520,6 +620,109 @@ func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
+// GetHiddenPathGroups operation middleware
+func (siw *ServerInterfaceWrapper) GetHiddenPathGroups(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHiddenPathGroups(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateHiddenPathGroup operation middleware
+func (siw *ServerInterfaceWrapper) CreateHiddenPathGroup(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateHiddenPathGroup(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteHiddenPathGroup operation middleware
+func (siw *ServerInterfaceWrapper) DeleteHiddenPathGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "group-id" -------------
+	var groupId HiddenPathGroupID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "group-id", chi.URLParam(r, "group-id"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group-id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteHiddenPathGroup(w, r, groupId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHiddenPathGroup operation middleware
+func (siw *ServerInterfaceWrapper) GetHiddenPathGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "group-id" -------------
+	var groupId HiddenPathGroupID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "group-id", chi.URLParam(r, "group-id"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group-id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHiddenPathGroup(w, r, groupId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateHiddenPathGroup operation middleware
+func (siw *ServerInterfaceWrapper) UpdateHiddenPathGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "group-id" -------------
+	var groupId HiddenPathGroupID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "group-id", chi.URLParam(r, "group-id"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group-id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateHiddenPathGroup(w, r, groupId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetInfo operation middleware
 func (siw *ServerInterfaceWrapper) GetInfo(w http.ResponseWriter, r *http.Request) {
 
@@ -835,6 +1038,20 @@ func (siw *ServerInterfaceWrapper) GetTrcBlob(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
+// GetTrustHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetTrustHealth(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTrustHealth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -975,9 +1192,30 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/config", wrapper.GetConfig)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/config/reload", wrapper.ReloadConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/forwarding-key/rollover", wrapper.RolloverForwardingKey)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/health", wrapper.GetHealth)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/hidden-paths/groups", wrapper.GetHiddenPathGroups)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/hidden-paths/groups", wrapper.CreateHiddenPathGroup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/hidden-paths/groups/{group-id}", wrapper.DeleteHiddenPathGroup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/hidden-paths/groups/{group-id}", wrapper.GetHiddenPathGroup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/hidden-paths/groups/{group-id}", wrapper.UpdateHiddenPathGroup)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/info", wrapper.GetInfo)
 	})
@@ -1017,6 +1255,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/trcs/isd{isd}-b{base}-s{serial}/blob", wrapper.GetTrcBlob)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trust/health", wrapper.GetTrustHealth)
+	})
 
 	return r
 }
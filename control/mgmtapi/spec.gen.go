@@ -18,85 +18,114 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x8bXPbNrbwX8Fw90M7K8myY29rzTwfFNlp9WyTeGx1d6ZNrgORRyIaCGAB0Laur/77",
-	"HbyQAklQouwkze5tJh8sCjw472840GMU81XGGTAlo9FjJEBmnEkwH17i5Bp+z0Eq/SnmTAEzf+IsoyTG",
-	"inB29JvkTD+TcQorrP/6q4BFNIr+crQFfWS/lUc3CrMEi+RSCC6izWbTixKQsSCZBhaN9J5IuE03vWjK",
-	"FAiG6ZdDoNgR3YC4A4GKhT23geUM4Njuiil9u4hGv+7ZFZYrjfqm9xhlgmcgFLE8JmwpQMpbordd4Bj0",
-	"wzpGZgkqlyC+QCoFNDdYDKJepNYZRKNIr1iC0IzLJV7aHXbhZen42a7VNGrWEwFJNPq1ANEL4Pi+3JLP",
-	"f4NYRRv9hCiqH91Mpm/foAyrtC8t3SjmTCqRx5oih7ZG0m7/A6hrp3b/38myyqN5ye39tDSocC83MS62",
-	"N9Rr4MDylaE7uxWwJFIJo2BRL0r4Pas/i7mA+jONNl7aTx5DxpTye0iQ3Q8ZvnpSk0oQtqwhZJVDweoQ",
-	"GWoYxaY/Eam0omC3+dzbXHq7YyHwOupFOSO/5zC1OyqRw6YXTcZNYcQg1O0dpiQhar0Pt38W6za9KOOU",
-	"xHvfuLKrtLnlVlD7DDov5eneuP0I61uSdHzxH7CeXjS0pti8AbSko1fjREjBJpptC+2ooMnIhEhF2DIn",
-	"MoXkluGVWdPQCSKTW7xXCaYyGcs6DzBdcqPYD3iVGaW4nFzcjEOa9xzW9aLD1aHG7gAvSso98AHyGqh7",
-	"duexH/kuNSSpFJOA5yFS5iD2keWLubviVt5qVT+HQQtVsUa7E20vBdGoNAjcK2vzthVzN27UVbHz+mdr",
-	"kTHPBus8wB4XDT9Q/CReTi+qVrXAZy/w8BRHvWjBxQqraBSl8NB35rVLdNMEmH5k8GxY5SSF+GPAc2CF",
-	"94sN4o8XeqHJcBQmtJlZjJOE6D8xRYRZ1IlNKLbEhfAqnFUV2hu8MqlJCpiqFMUagyosIwgkyZKBQPgO",
-	"E4rnFEI7CMAuFajucW2eowUXFj5aYEJzAftxlgqrXHZID/WqumY5j+Rg9KwEPG360ZI8KUgO6E0hDp0z",
-	"lmy/8uSqY+4W4isBoMlcoe1qpLc1tOvsr87mxp4WqUAE12/IJm+LjMEHbDKFTmmI1dVNLa94LuNLjjuk",
-	"/TQzX62wWHsY28UIs8RDvoUtRcbZZE9asm0Xvo65dXzdyz6aIO5IXIqrZmdN7HgW8NJ+cVCq+elJKPE/",
-	"KF+oO9Ai4lYzfUfJFdY8dhl9yrMQ+hZuxTse9xeL4XA0HB0fD3X6hJUusKJR9F/v3iV/63/zK+4vhv3z",
-	"94/HvdPN6NvHk0310bf/o9f91XOj05uL/vhmj+/8iS9/gjugTW7S4nFN/flySdgS2a97ZTmQwDxfGp4s",
-	"dLoBph5877sb900NhRpvLdhQlnhVJsZ1O8WE3VKyAEVWVdFH352kw9VQ7t21BiO4veBzCqtAmGmLGijN",
-	"V5ghATjR/hvBQ0YxMzqNZAaxDnFIcaRSIhGP41wIYNuyNbMbIpVihYhEKdBskVP9BuUmNvqrtDUvyR0g",
-	"nBg74gyl/F4vzgSPAZIB+pcgSgFDhKFLtqREpuatEj/tMYEtCQMQsodymWNK14hxhWROFCRmBeMMKYhT",
-	"RmJMtS/5CCmnCQjrUfRqjR4l/w1JNdxMOGNga1vFjZOeYwlIczxBPFch9SRMKsxC5f4Y/Xw9RQIWYLlm",
-	"2VToujTMKbncyt0egsFygOZrEz/YEmG0ENjabglMIC6QzOd9XaxbiXniWWcwQK/xGs0B5RKSmoAE58pu",
-	"SmT5EmEWP56LGFDMk1pkPnILj+KSZ31jUX9R/COwvjalvhZc33Cvb7lXZlW5IP2SM7ujfJWpsxTQj7PZ",
-	"VREjNGZoCQwE1vKfrw3aXJAlYUjazo8NtLtUuELb2fBFL1rhB7LSfuPs/LwXrQizn46Hw5Cvdg6tqQEy",
-	"5UIrZxnhmoL5o5W+iGs/s52JnH2gKVzgnGoZ4jnP1WhOMfsY9brovu1M0HXdCHx+IM70Aqt9plH4oDy+",
-	"3ZEEEjS+mg7Q2yzjTpl9S7LeizB0/WrS/+774Xc9RIx3YkBUCgIJiPlqBSyx7851SVkgahiu+ZVxwpT+",
-	"Glsf2S/FkfA418Zn92FcoCXlcyMSS1+Z11XE3M14DjCRtvzKqmIoPhS9y0Z8gIeMuNbX6HGLQIIVGOsN",
-	"qUPKs+6dLZ0LBRLKDv0Ji7KtWimW6jbPNFpJd0T1c6nwKuv6SqgW3QLp+dyq4eS4EuyglvnWnrrUUdxS",
-	"5QNLbg/sIx3KZGBLmzTXkirzvLBER0xFq49DjlEqLNTts1LZJKqB6flsKDFutASezPtGV2B+epacniZ7",
-	"uwLu/T357I2pmpuyxfI2rrYZD2hVVU24Kjq7IdouQWRlXed87boX2uXNrieoaLBU3dXJ8OSkPzzuD09n",
-	"w/PR2fnoxYtffGbstj8Rd2hEzq4nVv/Mcna7FDiG2wwE4UkgCbie2EQGS6RELpXNYYjUft+8iuyrPUOZ",
-	"1liKFUhliIwxY1y9Y0U2VAEyeOepxpxzCrh5FFFxATW5lRSHafH7f5wpwSnSOTcUzRSvrAyqaOXUq+kf",
-	"isdVfpnVaAXSnC3s83hlYRTa3SVlRU2VYSmtESSwFDgxXnCBCdUPK7XVdmWt1+ISudKzmGwkeKpys+1D",
-	"1ru7zy6Vg+T63fGKS/j+HL08R6fnaHKCTl7p/+cTdHGBhhfoZIzOvkPjc3Rxib6/NF+doVcv0PAcHQ/R",
-	"xbFvODLDMST9qjOpUz27ngScRa5SLojOQu7gFssDjpnKyFAPx+Yg7NOAqqhf6Cyku0P4NM1k7+RhS2Yv",
-	"xMYq8p65atexJ4DMridPbs87gpvINwJbN0SsytbOX7GEW5av5jb+7A7dRCYdulQSBME0BPRFc3nT9KJe",
-	"Bak6vBr7Q4HVI5pnnPLlem9ntv7iPz0VqzKMcXWLF6pG2fMCooY5hwUX0AB6/ESg9Sb3doeeR4LHzIJi",
-	"Fyab3NxsXJ+sWdNeTcsKx6ZYRRxzhWTUjHBFiTm+mmpbBCEtrOFgODjWPOEZMJyRaBS9GAwHJ7a7mBoR",
-	"HNnzbvP3ElRLt3uLjVtuK04sAH1k/J4VVWLsMCrCDJqlgATInCqpEwNdDi4IVSC2zQSTfKLxTQ+RxgCH",
-	"Ti/MSXxtlAO9XCNXKfcQphTlzCQN5fm9NLgJULlgkGg8iERzSPEd4aLAJE4xW0KC7olKDfQPmNIPZtMP",
-	"xqPdYvUBZVjgFSgQpk2u1dekD9MkGkU/gHrp+Kd5Wiw0cy61LNFQ6TqyfFGgaTmEk8QQrvEiLKZ5Auie",
-	"0CTGIpHom+G3aM5VWurF9ObCIDm+8VpU1Zyy1kwmGoXfcxDaQ9tTqXrS320sqAzydfpe2xZOOUZh5ycK",
-	"sRWC2JL9ltF1U5mKt3XOTKl51QFyLQuqtfGeUKrlV4rXJ73bXMr7ME/KUZ5u3KiPBe2fSCJVZE/CaDQH",
-	"iXyMyt7Z38/OXpx53bNhKCQ0kvui1kZYofuUxGlDOkYUxgAGaLpAOZNgXIDrGpken0LaYWp/qesCneg7",
-	"IzMNphRLhBmCxQJihcjCWNb/W2Aq4UOj+DnuHx/3T85mxyejk+HobDg4O/mlRWcLq6zwo5sLb8rG2llB",
-	"s4AlFgnV4uILv5ozx2QC7AcNfdCCHKa0glfZyjN0h6qeOk7/SsH00BRHArQfB9clFgpxkYBA32AZAzON",
-	"6nnpAr9tw0hDfyZKY6UEmecK9H6Fulh/rrVEo2ZFbzQmB/TB9ysfbI9SFvHB+T+/sW4dxIIIaQ7LqtpR",
-	"qQSDTowLFaaw3jsqSqoKSL/xVPOHtdd3zfaVSva+Vx0MPRkODxrIDI3zHTrg1qwXNsH0I3ymvcIqTrV2",
-	"VaL9QAM9tcSEMCiJPvJGYc0oqO3Mt6YRWgR4Kf35Q/1akZQcPbrWUp8kGytdCipwEnBhnjfgbyP7ktwB",
-	"KxtV04tmKLcgHA/3BPPZtkeHphfV3KRs3mnXqR8TluXKGQeR9shC23iKGcIemKKRrgknicmQMMoELMiD",
-	"8UE6IJbi8T21ZUrhf603XuTUpAvmO/8FXQ4kiBtXTgSi7jBXb2+tm9hDg+MTNF8rKBBwJOJY5Zj6fDT9",
-	"HHjIKE+gdCvGUnWK6RlqKcjIz6ZtydBxJNlvpEq1Nh5CEuMqAqZ32lQTN1jqGIZkHscg5SKndP00Fe9F",
-	"Z11eKaezqzbRorUho+iFk/MfbGD261Uzy7o9+PQB78hf/yCNn+fK6nR5VOVrW3VDeMCxomvEWbFxr0hK",
-	"TG5vUCGylhV+hYo5/GRD+uG58IB7rzjFymjNsx17oYKVLWr9k64u/mhO+by1Eg3upN/QxcHV5WsELOY6",
-	"Ndqh5y/1Bg1d/7dTk4d+Bqv+gtBak6Ov/728/GH6Bl2NZz+im8sfXl++mZnH75hhnOXDYDB4x8zjyzcX",
-	"obXRHiUykvo8yjO3MgpqTYw99WjIeIKjz2htk3HQtMoggt4W+DyfMdOtjSIzCGDYNBkPPMbEWfaRFHzZ",
-	"HpF0aOW4Eo6udUAnd9Cc721p8LxjOzo8oQaPTfgH6FUudGWz4gJ675h24XpxhqXUSQ4WisQ5xcINBhBb",
-	"aG0rVIP0Fsd3zCFZFqoISxt2BmiMXDlT4FPONZiySgcHnUu9Yz7PerX6z2ZHtn2nP99h4o7uTMLT1Dyf",
-	"/w3/Eqzxn9x4+eSFcZditlEpPjeudRyWLUfym2VNaxHT1GbPIFsQdCMjfzvMJRQzgcH7cVYxxe5yKIDr",
-	"fgs/ejRLi6poZ7Rsju7rugC7isjN6e/X6halrgbJAqsnh8jyEsVnTZvsJZaAzBr3Dr46vWmV6mFa0y3R",
-	"aqqOybDsmb5OuHSFKG0K9iSlCmdjX5NidUi0JpfXs+mr6WQ8u3S50/jGV6RqqtVcvRPUZHwIqKiDStcz",
-	"t69cr+vZYEW5OVuQ5c6E0K7YK3IFD+ooo+5uWyPqlcHyC2V/V4IwZSvi2dvXPyFLaO4a0lobK3kgX63K",
-	"BHl7KyNo2lcCpC6pvYsx1ckQhClny23jDB4gzhUkzdsuDWa7qx6f0XHXrqSE5LHjFsknSMoTUo51y8pO",
-	"vjyKuy1GHsUpb5uGTu2diH8v/XyJJYl95qIML8ErVGpVgr2CIGWr1lK+PCqvm7Sxqryp8hk1rNzji/FS",
-	"ez5au1LT4FEvyvIAU25qTDHwX/Jk/UX4UVwE8vffRubNf5SUbrpISWuy6xN1nqfwB2jbpioOn6bALEFg",
-	"JwRqI8VoymQGsXKN2oTckcRr6UuXyOlCHdmLTZCgOwL3QZd/U1B74PRDaMD5y88szECsCMMU7UDqpEDq",
-	"pBWpyrj0YSh9kSK6MvN+QBldOwusaOrg662oA9h6xuoe1az16QeNlQH4g48bizsjTzp98bf+vKeNVSfV",
-	"+cyx+tr/6ZPH4G0Jw8KvwZDKY8wvh0Hrj0e1n4763Ata9DMPSSv2tCPa/QecHx34W2DFr3K1nQlV9Lql",
-	"5vq6+gz7by91jxeHnFpWdmztpu3Svj9PMK+wSou7YE8/x6xI4qvuibXh26qk5Q24tkra3ZH7nC7D7vCl",
-	"O2YkeGw6vkF+G7S4o6/55Hcr+vammLvH1XbSarn71Aa66ZVX7b6lTW45OHG9/T9b1p9u2OCgHrPybr20",
-	"mVN5M+YzGlS5xx/RhHYUlOP84xtU8GV3N1qJuEMnxF0etX5uZu6KXnOu0MRve9vOBOA4NfcfDr5/0jKd",
-	"MEBvM3uTia7tVZLZ9aTsrjjHbG4kSAXYzAKYCXcPb84g3BCfaeq7hermcECll1DW+YHfxmj8jJQNy9oR",
-	"Rl/36X55n++ApoTbdk7BCOpTTiZreG1eQMTyiMjkkchk058/6lp205eP9jrdpmPy16baLRFgJuJOh6NW",
-	"Wdozup1XDEsNqcLUBHYDetwZpmVWN6ih242fs8SZXU9CWje7nnzCEUm9yZP065AKo03JiiqjSD5Mj8UU",
-	"G63a1/l4/k8NfGIiNrueuDzol9/G929/G//99ezyflrLmraroqCKfuL8qIQY0FV7Jfmu0IVc0GgUpUpl",
-	"o6Ojx5RLtRk9ZlyojbkULoh21PbXArlUtfs5PMbUPDY/eixqX78Ynp6daJt8X6LR+N2FOxBrZTqUAqj5",
-	"gSrFw93qehUcNU8DdkGbXF39Y4pWWBkF8sBZxjSBTUwWhMZXUwQP5a+BWGAuOfGxcklTACmWmJFI6ePk",
-	"Hd5vf90hANUdRG/eb/43AAD//y7RBnW/XgAA",
+	"H4sIAAAAAAAC/+w9/XPbNpb/Coa7P2xnKVl27Lb2zP2g2k6i26bx2O7uzNY5ByKfJDQUwAVA27qc/veb",
+	"B4AUSIL68EeSvWunM7FJEHh4X3if8OcoEfNccOBaRSefIwkqF1yB+eUnml7CvwpQGn9LBNfAzY80zzOW",
+	"UM0E3/tdCY7PVDKDOcWf/ixhEp1Ef9pbTb1n36q9K015SmV6LqWQ0XK5jKMUVCJZjpNFJ7gmkW7RZRy9",
+	"ZWkK/ILq2YUU4wzmayDJ7Yi/7gZROW8AFgdkHI24Bslp9uXQUK5IrkDegSTlwNgtYOkDNLGr0ix7P4lO",
+	"ftuwKkznCPoy/hzlUuQgNbOUZnwqQalbhstOaAL4sAmRGUKqIURMiJ4BGRso+lEc6UUO0UmEI6ZgEFco",
+	"OrUrrIPL7uNXOxb3iAzAJKTRyW/lFHEAxg/VkmL8OyQ6WuITpjN8dHU6ev8Lyame9ZTdN0kEV1oWCe7I",
+	"gY1A2uXfgL50zP+fjpZ1HI0rbG/eS2sX7uM2xHHk7R4nB17Mzb7zWwlTprQ0DBbFUSruefNZIiQ0nyHY",
+	"dGp/8xAyzDJxDymx6xGDV49qSkvGpw2ALHNomO9Cw2i5WvRnpjQyCnWLj73Flbc6lZIuojgqOPtXASO7",
+	"opYFLOPodNgmRgJS397RjKVMLzbB9vdy3DKOcpGxZOMXF3YUilthCbVJoIuKnu6L20+wuGXplh/+DRaj",
+	"sxbXlIu3Jq32ETcwEWKwU0TbBBUVtBGZMqUZnxZMzSC95XRuxrR4gqn0lm5kgpFKh6qJA5pNBX4ID3Se",
+	"G6Y4Pz27GoY47ymoi6Pd2aGB7gAuqp170we21wLdkzsP/cRXqSFKzSgLaB6mVAFy07Z8Mm/PuLWvOtnP",
+	"QdCxqwTB3mpvP0kGk8AGN9LafG3JvB02mqy49fgnc5ERzxbqvIk9LBp8kORRuByd1aVqQo9e0cEhjeJo",
+	"IuSc6ugkmsFDz4nXOtKNUuD4CORqtZVUns4g+RTQHFTTzWSD5NMZDjQWjqYsa1sWwzRl+CPNCOMWdGYN",
+	"itXmQnCVyqo+2y90bkyTGdBMz0iCENTnMoQgik05SELvKMvoOIPQChKoMwXqa1ya52QipJ2fTCjLCgmb",
+	"YVaa6kJtYR7iqCZnOY3k5ogtBTxuemu3fFpuOcA3JTnQZqzQfuHRFc/c1YyvJQBuc05Wowkua/aO1l8T",
+	"ze01BZ+w6SVkgqaBc3xG+RTSNoqvZ4D22oRNC2vYkJxKrYieUU3cV+Se6ZmBQprp++RCKMXGGZA7mhWg",
+	"CJVAEF0suUXWiok5NhmovmckeU9b5pT3rsi73rQMM0J5SmbGdblFC1TVGOO3yAOpPMobMCFZK7urxUU1",
+	"o6nJJSVKQ6bA+UPO5ALZq2ZrGokwIObMKQvzo52lITmeJjHT2R3jlqxLIAulyZxqkIxmQQPztZD3VKaM",
+	"T/8Gi0uRZeLOKvQteeMfM9AzsPw3qeYin2BRckafjDRhikxopoCwCZlTpUEO+jhGaZZlyFyaMq7MLPjY",
+	"cBZThGYSaLogDM3Uukg74bD7GQuRAeU74d9KaGirkHxS7Z2W5rMvZYaZtrLJreJu8csTtVClfhzQvs9V",
+	"zOdULjyI7WAjDyvgO9BSul9t9MwqtK2D1yG3Ca/72AcT5B1LKt3VOHTa0FUxiDdSFHkbvCk+3sJgbUxk",
+	"zRlxz3ewZpA1QaqwvhxegVOQqPdKl0sLgl85fUQqfbQVC1ULN1nIqTsG62ChmtzPWGJ19L1kGqQi9kuQ",
+	"zwyQm35nzLwAMA0GrNijJPYK2BVBaxj1z/QVXMRMtAWHjs66sEB4MR+DLEM3BhwjnPYofyDAE5FCSva/",
+	"742ZJqqYTNhDTBTkVFKNDvyCUJJSNavbOpPJYHAyONnfH/S+Px4fRWs2QNhaa/OtCEhYLS5VrXp4EIo5",
+	"7eSqNm330tmrB5ncVhDBpAwmzUSQFHZeH8pov7fCThRHOdUaJNLkv25u0r/2/vIb7U0GveMPn/fjw+XJ",
+	"d58PlvVH3/0Pjvuzh9PR1VlveLXBbP9ZTH+GO8ja2MzKx43DRkyneI7a13FlHaQwLqYGJ8ZcAROKrNkF",
+	"7k0DhAZu7bShU/Giism0DADGbzM2Ac3mddJHPxzMBvOB2rhqY47g8qvIcsPD6XJYyKyYU27UKroOBB7y",
+	"jHJnCOWQoHeF2kXPmCIiSQopga8ipi5QXVkcM8jySZHhF5kwbpk/CsVzyu6A0NScWoKTmbjHwbkUCaCx",
+	"8w/JtAaOJss5n2ZMzcxXFXxorAOfMg4gVUwKVdAsWxAuUMIZijWO4IITDcmMs4RmeHJ/gpnIUDuZ2XA0",
+	"gpex/4a0Lv2ngnOwYVUtjH8wpgoIYjwlotAh9mRcacpDkeYh+fVyRCRMwGLNoqnkdWuwVVjuxG5MoD/t",
+	"G32VGvOQkomkVnZXGogISVQx7hn1pIU/AUGQ++QdXZAxoCmYNggkhdB2Uaaqjxi38IlCJujBpA2nsMxS",
+	"7CUVznpGov6kxSfgPRSlHhKuZ7DXs9irHPpCsl6FmfUOZlv/v72+vigtMoSMTIFDpdbNgSDZlHGibNLB",
+	"+njrWLi2t6PBqzia0wc2R71xdHwcR3PG7W/7g0FIVzuF1uYANRMSmbOyJ9uE+dpMX1qRv/K1MQT7AHc4",
+	"oUWGNKRjUeiTcUb5pyjehvdtUDxbNIXAxwcRPFuU3GdyVA/aw9sdw1N9eDHqk/d5Lhwz+5JktRfj5PL1",
+	"ae+HHwc/xIQZ7cSBGW9LQiLmc+Cp/XYMJIUSUINwxFcuGNf4mlod2avIkYqkQOGz63AhyTQTY0MSu78q",
+	"pFAj83bCs4OIdHkzlhVD50OZNmudD1C5v/hbBUBKNRjpDbHDTOTbJ1XQFgqYuluExi3I1sPIqNK3RY5g",
+	"pdsDis+VpvN8209CYdDVJLGPrQZMDivB5F1lb20IiboddwSYgae3O6YwdkUy8Kl1URtGlXleSqLbTI2r",
+	"90OKUWkq9e2TTNk0akwT+2ioIG5Fox+N+1ZAenx4lB4ephsD0u77DfbslQnYtmlL1W1Sz3DtkCWpi3Cd",
+	"dHZBshpC2NyqzvHCBc5R5V1fnpIytl9XVweDg4PeYL83OLweHJ8cHZ+8evVPHxnr5U8mW4QUri9PR2fV",
+	"cH47lTSB2xwkE6Gg6uWpNWSocnE6Y8MwhXrffErsp3HlFGZUg9JmkwnlXOgbPobAJP0bHm0MjdVUQINu",
+	"1Y7De/FTT4JrKTKCNjeUcXwviBNk0VrBRVs/lI8DBSBkDsqktTdpvMoxCq3ujLLSp8qpUlYIUphKmhot",
+	"iFkEfFjzrVYjG2F+Z8hVmsVYI8F469UqBdZMLD7ZVQ5u10/M1lTCj8fkp2NyeExOD8jBa/z/+JScnZHB",
+	"GTkYkqMfyPCYnJ2TH8/NqyPy+hUZHJP9ATnb9wVH5TSBtFdXJs1dX1+eBpRFoWdCMrRC7uCWqh0qHDoj",
+	"TyZp8DxT1dgvlIbfXiE8Tx7TS3qvthmH0FgH3hNXVB0bDpDry9NHZ4bdhtvAtw627QAZnbWhQG/21obO",
+	"avy83xF/2iJKpUx6JDTpq/bwtuhFcQ2o5nwN9IcOVm/TIheZmC42JgVbH6L2X5vSgPSW6sA5xObWha4n",
+	"i8g9VQQtQ+I+bh2n+48+TtdqeOCJKLgGiTnFGcvAAoBRgzaUQRVbvtxaBRjcvSu/enKOJo7uqeS3Y5gI",
+	"CaGE9ZwyjvspBZqMIRP3ZXi+TgamTKkcSxEhN7zMCtojfvcQXD1hZFmiDq+Hv3Y62x1wdRijLnZ85xEi",
+	"pErqeHnrhbxdyYbJBY7OymU9EyXGYNHqzfXlaRMjPx6Pjw+Pk4ODycHkOEnTQXpAj36gxyn8CAeT46PJ",
+	"q8Hx/iDdD3EQF/qWTnRDGTzNhrRRqPa2XyOL4x7qkifQDiETKeZmqwbjt+m44YODTvZUgjWviVzkWuzh",
+	"gXZ1tt8bXmGc+3Zwu78/6Ocw7wpPbbTR/Sx1vYKnUZJqw+BtSpW0tBS7qpEsirtD82uCNzX1ZeItIbbs",
+	"k/fcvDLuQUwSeltjIC0TtKxvOJq4vn3b5CTz/UbJMm9rxUZpVFHdZ6kS8R+CuftW1j4kXX/37Im6YL0E",
+	"5+KcK2X2LGdAA3feCj6mPAyVO3Y+URspy6VLirQDmBejKpxl/enSaXFRw6jtzrg3GKRDwwuksnMN+oP+",
+	"vskU58BpzqKT6FV/0D+wqaSZIcGeras1P09BdxQSrKBxw1cp0U9c3PMyJJg4iEqfglybEhtVZFqhF4ix",
+	"vwnL7HnpIscm0kCGVzFhrUJx9CVNxW+jZJz8tCAuLBpjUpYU3JWdVAAibBJ0ITnmOa4xGD2GGb1jQpaQ",
+	"tIqBPtIs+2gW/WhOu1uqP2L1EJ2DBmmyu8i+hvdHaXSCGOuVCIyj1UhTUN+ICZhtrhSP+8yiiKap2TkC",
+	"xniSFSmQe5alCZWpIn8ZfEfGQs8qxkDFhFAOr7yExFr9xBCEfxUg0R635W/NEM92/QeVS9fc3zsbsK9S",
+	"5IZslZNZUmK17fcYdW5xU/k1RkiyzHzqJnIB6gzZ8R7LcMYefetFUtsUwH8I46TqGdgOG83+g82tD6wO",
+	"7EEYjHbHgg9RlSn5/ujo1ZGXKxmEHICQCW0iq6siiyZ1DCmMBPTJaEIKrsDoAJcjMBkdjdk6kxpFq69Q",
+	"lZSZdMKMKkI5gckEEo1lVCha/2GKqj4GbPP9/d7B0fX+wcnB4ORo0D86+GcHz5ZiWcPHdjq8TRsrZ+We",
+	"JUypTDMkl5j4sTtTgiTB/oKz9zuAo1lWg6tK3Jh9h2JcnfVpgkhARQ4uJyg1ETIFSf5CVQLcpCXHlQ78",
+	"rgsinP2JIA21lmxcaMD1SnaxCp1KC5olvSudJB99vfLRZqRUeUA4/eenUa2CmDCpTGlEnTtqcb+gEhNS",
+	"h3fYzBSUAbTalH6aoaEPG5+vayKqmOxDXO+DOxgMdur8CvUN7dpJE6wqCtgf4XrBOdXJDLmrdtz3cdLD",
+	"waALgmrTe17n39JY4CYP22lHIAnoVPmNTvhZaZXsfXaJhB5Ll5a6GeiAYX1mnrfmXx3tWAfBq7TE6Kx9",
+	"ltup3XG+6TS/XqVkPMfOLepeGN2JjxnPC+2kgymboTZFqJQT6k1T5k1XLjShJJcwYQ9GCeGJWNHHV9UW",
+	"9FIBW3WMZSFoL5h3/gcY/UmJMLqcSZK52h1c3oo3szni/QMyXmgoAXBbpIkuaOYBXfofeSZSqPSKEVU0",
+	"Mj1JrSgZ+fa0jRBt2fzo582UXhgVoZjRFQHZO2zziWthcwgjqkgSUGpSZNnicTweR0fbfFL1gdaFooNt",
+	"Q1IRh83zN/Zk9sOTSCq6qnPxJ15nwX4llh8X2jJ1VZrgs1t9QXigic4WRPBy4bg0S5hyT3C5ul34DXLm",
+	"4Nn6gcMtqAEFX1OLtcLlJ6v2kgdrSzTi5dsq+b1xJsadzmhwJfwC3YOL83c2JIcRxzWM3jNLtLj9345R",
+	"Hno5zHsTljUiHT3876fzN6NfyMXw+i25On/z7vyXa/P4hhvUWa7p9/s33Dw+/+UsNDbawEYGkS/DPo5G",
+	"Qb5JqMcgbSonNHpBgTsdBqWrOkjI+xKgp2NmtBJTYmq/DJ5Oh30PM0mef2IlYlYRwy0COs6PyxZ4qGOZ",
+	"aKubsCPMc8PXxHlCYR5r9ffJ60KiezMXEuIbjlocB+dUKTR0qNQsKTIqXS0Ys95WvRfAg/GGOyArb5VQ",
+	"ZU+ePhkS59OU8FSlbFq48wHtqRvu4yxuOIHWQrJBPPwdq/VstYYxegKs5xOgpWGCnv6jwy/P7h5v49K2",
+	"/MWnnm1btiNVHcBt56bTlWmzsyeRX+omkBG3nCnXO0UBWDeL+N5nM7T0jdaemK0FjHNAnV/k2oK3YOsO",
+	"rq6fkyVYjz4lq6btF7WdzCohorX6nL85xukk625ss5211eadMvNJlbG60E9U1g57HFd1mGTfEmttYW2d",
+	"nl9ej16PTofX586AGl75rFS3t9qj1051OtxlqmgLpm6ab984ZzdNwhp7m37v9VahHbKR5hoe9F6eues0",
+	"WidfdWB+IRPwQjKurWd8/f7dz43OdmTHmjEo5vOVmWyG7slVA71QOlThge/LVJxmifEO6wvFnq+PwaKy",
+	"8XzVAdjs1VN9MswyA6BnoNmOEZM7JZSX7Rlz9NkxXuAapzGI0HjLLM9YOGTBDRR1XDBFMphoUnAtimRW",
+	"hcMwFWE2R+dQJiSoIspF0a9Gb97+euF18CAR2+6jxeLWXPSEQ8m/9SB0NtU2bcHCSMcTI1EeF8iCG7u7",
+	"zWqqk9dWzfS9T7DYk35rfpDrfi5Xs/31WDujTAFJmUf2lk6ZhEQLuTDspu6ZTqqWq0YXfwqS3blSFL91",
+	"33ooDbcFmc3yBod787k5vbIM8xBkwiBLFYZkTfIH2XiiwazmsrZSFAj6HUjbWeN9Radow+na5Aj8J4Bc",
+	"EZokkJugrPeJXcXLRucS7pgolPkYaZJVNw74FdeGwdGHgtTKnAWrSr0Or8i8UBp9IIu6lQgGUvYh1nfE",
+	"7NWJ/JJCEL7nISANr+vkR1AhJTj66RIhsszMFOCzTjlY3TcQtKguJCjg2r//pF6FTWgm+HTFA/AASYFc",
+	"0brHoX3CubVfkCyN2xZCh+CaCxKeIRySsqqHUtVW8glSXttgCWIOJtOlqfbsybQ5MtI+zVaBkFXUxJ5W",
+	"ZRlLMyQSJtEKmp4D5kv4z817KHZwogMHe5cPGxrqUcW8xZcmmRE+FU6NDiTU6MzWfFbrmh+NxQBSMaVX",
+	"DYn+B+3Dy3YZVlYGYfM5pIxqyMrTwZQD2fwBpkkhxcIFpsm9KLK0fN1cSIpsVeZiGj5NWQ6mOe6puxvG",
+	"eSoY5ZJg0sx9dI741NlGpmTCHgp2UaO3L0YuGWg2Up4MmBFkPGE5zZD3U5Cx6141IqPMqDJUaAe4Wii3",
+	"J6pIwbEMH7g24pS2edWeRW12dV4XKP2TSBfPp1ea/LlcNt27ZUtO9l92+Ua5b5MXy+N6e7XWvpDWfLn/",
+	"6C9fPfrL40d+efTIfdb0RSXjbfnuUhcdinzvs/l3y9qA0ILkTUO7m2oV6fqUF21h96/qshdpuuY229Xs",
+	"0vDftGy7OoewbG/Kn7floNz0v6McHH5NOVjDlt3HZmd4YwtyDr6qwnwygxw+HeUYW9oN37vniEuV9OgA",
+	"ZfCur0CmOC+CwZw8owms7miKy9uzYuIujHIapbwyylSLcAIP9grbP6yur6eZbbHht211fV0lQiyK/jht",
+	"dlZ9vxrE7Wx1lU0qneeOGfDvFlT/iSqW+MEJktMpeCUWzUChFxYOhn0yMd2r7kbrxFUmpj076gXFqrq8",
+	"7YthE0/VrHEDXAtL1XlVR4tqoeX59dw6jJQ31/3srf9l1N+Xp9PVNnRCbnZlblv3hPk3vnR1hu3eEYbH",
+	"K9gmp8YdOGTEVQ6JBYHxlN2x1CtKVi4JPRemNFpThjHhOwb34aBctd0dO7hCV/J8+b6ra5Bzxq390wXU",
+	"QQnUQSdQtQt+dgPpi5QA1W5p2iF+2ehnqLFq/9utBwpA60mre9QQ18c3S/jrPKJlogTnUQXk/tov2zFR",
+	"V1Nb903UP/t/3T0RvODLoPBbkKTKUv5yEHT+qa3uDg8fe0GRfmKjR02e1p13/wcq4Hf802lu353NETXG",
+	"7khdfltFUptv3Nv+xNil86K2Ymcx4Fr2+6ML4xf01h0k5PG9GDVafNMlfV3wdrJpdW9jp0vthryk1rAr",
+	"fOmCPxZs/RheEb+Ms7xaGrHgxy169oJDd/1gV7eIxd1jS4Dxs4bodxX62oV6ZX3yH0W3z9cztVOVrPau",
+	"a+uUqGrQC8pUdW/c1yijdTvw6+PKPa+vp9Uy2SIk4q49tbrOXCxGLoXQpFa3aUMUQJOZuctl58t0Opqs",
+	"8IpuewdftrD34lxfnlZhFqecze0qSgM1LU0mCeLBLTh0lJeZ7W93Xrd7nKI45PAHrnVv3WVnz2ZUhtG3",
+	"3aRUXUW5Q3TCLYu3nCOlnvOaBZyvSw/IRO0xlX5mKl32xp/Rp1321Gd7E+RySxuwi7e7TgEtk606PCy3",
+	"dNt1a6/HXMbBOXGH2026v/WcqrzwbItZQzdzvqSrgzfYBtjO3GL3bGcPLvIoBtvF0+jistLbKC0QE2wx",
+	"Tkc3+23fZfQHDz7SGru+PHXG0D9/H96//334/bvr8/tRw3RajYqCTPrMRlI1Yxe3Fkpvqtwu54LQHy5s",
+	"30fbqEyICetDP77hzuBot3vGrrXbf7Vqr8Ed+FPecHPav0Yj4g7kwv0hj+ZtjHH9qk5jZpgNgL0t94an",
+	"oE3+oPzjOObCVWtp2J8xnlleNF7WSFQTurluuBKC24IIdxO5q+HA1gQ7KO2Wy0J9idp1/8rhUKnBmitj",
+	"n1FnzjbcTNtkT5zEBBetqipkFp1EM63zk729zzOh9PLkcy6kXpobtyVDU8KgblbVV1fXYSHjmMem/Fo2",
+	"Xr8aHB4dIGY+VGC0rjxGVtMmli4ho64GKJhYaQZromW8y2ynFxd/G9XuTXbTWcS0Jzs1hrqp3oGH6k8t",
+	"2Mn8zpZyGjM8BBRPzeUDyofJI9zq6vzArDPHXe27ESmnUzABD0f5cK18Oc+qCmP5Yfm/AwASAVC6aYMA",
+	"AA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -15,6 +15,13 @@ const (
 	UpRegistration   BeaconUsage = "up_registration"
 )
 
+// Defines values for ExpiryState.
+const (
+	Expired  ExpiryState = "expired"
+	Expiring ExpiryState = "expiring"
+	Valid    ExpiryState = "valid"
+)
+
 // Defines values for LogLevelLevel.
 const (
 	Debug LogLevelLevel = "debug"
@@ -114,6 +121,21 @@ type Check struct {
 // CheckData defines model for CheckData.
 type CheckData map[string]interface{}
 
+// ConfigReload defines model for ConfigReload.
+type ConfigReload struct {
+	// Changed The configuration parts that changed with the reload. Possible values are static_info, policies.propagation, policies.core_registration, policies.up_registration, policies.down_registration and hidden_paths.
+	Changed []string `json:"changed"`
+}
+
+// ExpiryState defines model for ExpiryState.
+type ExpiryState string
+
+// ForwardingKeyRollover defines model for ForwardingKeyRollover.
+type ForwardingKeyRollover struct {
+	// Changed Whether the forwarding key changed. It is false if master0.key still contains the key that is already in use.
+	Changed bool `json:"changed"`
+}
+
 // Health defines model for Health.
 type Health struct {
 	// Checks List of health checks.
@@ -126,6 +148,25 @@ type HealthResponse struct {
 	Health Health `json:"health"`
 }
 
+// HiddenPathGroup defines model for HiddenPathGroup.
+type HiddenPathGroup struct {
+	// GroupId The AS number of the owner and the hex encoded 16-bit suffix, separated by a dash.
+	GroupId HiddenPathGroupID `json:"group_id"`
+	Owner   IsdAs             `json:"owner"`
+
+	// Readers The ASes that are allowed to read hidden paths.
+	Readers []IsdAs `json:"readers"`
+
+	// Registries The ASes at which the writers register hidden paths.
+	Registries []IsdAs `json:"registries"`
+
+	// Writers The ASes that are allowed to register hidden paths.
+	Writers []IsdAs `json:"writers"`
+}
+
+// HiddenPathGroupID The AS number of the owner and the hex encoded 16-bit suffix, separated by a dash.
+type HiddenPathGroupID = string
+
 // Hop defines model for Hop.
 type Hop struct {
 	Interface int   `json:"interface"`
@@ -243,6 +284,39 @@ type TRCID struct {
 // Topology defines model for Topology.
 type Topology map[string]interface{}
 
+// TrustHealth defines model for TrustHealth.
+type TrustHealth struct {
+	// CheckedAt Time the trust material was last checked.
+	CheckedAt time.Time `json:"checked_at"`
+
+	// Error Error encountered while checking the trust material.
+	Error    *string         `json:"error,omitempty"`
+	Material []TrustMaterial `json:"material"`
+	Status   Status          `json:"status"`
+
+	// WarnBefore Remaining validity below which trust material is considered
+	// expiring.
+	WarnBefore string `json:"warn_before"`
+}
+
+// TrustMaterial defines model for TrustMaterial.
+type TrustMaterial struct {
+	// Id Hex encoded subject key ID of the certificate, or ID of the TRC.
+	Id       string    `json:"id"`
+	NotAfter time.Time `json:"not_after"`
+
+	// Source File the material was loaded from, or trust_db.
+	Source string      `json:"source"`
+	State  ExpiryState `json:"state"`
+
+	// Subject ISD-AS of the certificate subject, or ISD of the TRC.
+	Subject string `json:"subject"`
+
+	// Type Type of the trust material. One of chain, ca_certificate, trc and
+	// trc_grace_period.
+	Type string `json:"type"`
+}
+
 // Validity defines model for Validity.
 type Validity struct {
 	NotAfter  time.Time `json:"not_after"`
@@ -252,6 +326,9 @@ type Validity struct {
 // BadRequest defines model for BadRequest.
 type BadRequest = StandardError
 
+// HiddenPathProblem defines model for HiddenPathProblem.
+type HiddenPathProblem = Problem
+
 // Internal defines model for Internal.
 type Internal = StandardError
 
@@ -304,5 +381,11 @@ type GetTrcsParams struct {
 	All *bool  `form:"all,omitempty" json:"all,omitempty"`
 }
 
+// CreateHiddenPathGroupJSONRequestBody defines body for CreateHiddenPathGroup for application/json ContentType.
+type CreateHiddenPathGroupJSONRequestBody = HiddenPathGroup
+
+// UpdateHiddenPathGroupJSONRequestBody defines body for UpdateHiddenPathGroup for application/json ContentType.
+type UpdateHiddenPathGroupJSONRequestBody = HiddenPathGroup
+
 // SetLogLevelJSONRequestBody defines body for SetLogLevel for application/json ContentType.
 type SetLogLevelJSONRequestBody = LogLevel
@@ -0,0 +1,204 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/scionproto/scion/control/beacon"
+	"github.com/scionproto/scion/control/beaconing"
+	"github.com/scionproto/scion/control/config"
+	"github.com/scionproto/scion/pkg/experimental/hiddenpath"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/serrors"
+)
+
+// Names of the configuration parts that are reported as changed by the
+// Reloader.
+const (
+	ReloadedStaticInfo       = "static_info"
+	ReloadedPropagation      = "policies.propagation"
+	ReloadedCoreRegistration = "policies.core_registration"
+	ReloadedUpRegistration   = "policies.up_registration"
+	ReloadedDownRegistration = "policies.down_registration"
+	ReloadedHiddenPaths      = "hidden_paths"
+)
+
+// nonCorePolicyStore is a beacon store of a non-core AS whose policies can be
+// replaced at runtime.
+type nonCorePolicyStore interface {
+	Policies() beacon.Policies
+	SetPolicies(beacon.Policies) error
+}
+
+// corePolicyStore is a beacon store of a core AS whose policies can be
+// replaced at runtime.
+type corePolicyStore interface {
+	Policies() beacon.CorePolicies
+	SetPolicies(beacon.CorePolicies) error
+}
+
+// Reloader reloads the parts of the control service configuration that can be
+// changed without restarting the service: the static info configuration, the
+// beaconing policies and the hidden path groups.
+//
+// All files are loaded and validated before any of them is put in use. If any
+// of them is invalid, the running configuration is left untouched.
+type Reloader struct {
+	// Policies contains the locations of the beaconing policy files.
+	Policies config.Policies
+	// Store is the beacon store in which the beaconing policies are replaced.
+	// It must either be a *beacon.Store or a *beacon.CoreStore.
+	Store Store
+	// StaticInfoFile is the location of the static info configuration file.
+	StaticInfoFile string
+	// MeasuredLatency indicates that the link latencies are measured. In that
+	// case, the static info is included in the beacons even if there is no
+	// static info configuration file.
+	MeasuredLatency bool
+	// StaticInfo holds the static info configuration that is in use.
+	StaticInfo *atomic.Pointer[beaconing.StaticInfoCfg]
+	// HiddenPathsFile is the location of the hidden path groups configuration.
+	HiddenPathsFile string
+	// HiddenPaths holds the hidden path groups that are in use. It is nil if
+	// hidden paths are not enabled.
+	HiddenPaths *HiddenPaths
+
+	mu sync.Mutex
+}
+
+// Reload loads the configuration files and puts them in use if all of them
+// are valid. It returns the names of the configuration parts that changed.
+func (r *Reloader) Reload(ctx context.Context) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	staticInfo, err := r.loadStaticInfo()
+	if err != nil {
+		return nil, err
+	}
+	var hpGroups hiddenpath.Groups
+	var hpPolicy hiddenpath.RegistrationPolicy
+	if r.HiddenPaths != nil {
+		hpGroups, hpPolicy, err = r.HiddenPaths.Load(r.HiddenPathsFile)
+		if err != nil {
+			return nil, serrors.Wrap("loading hidden path configuration", err)
+		}
+	}
+
+	var changed []string
+	var swapPolicies func() error
+	switch store := r.Store.(type) {
+	case corePolicyStore:
+		policies, err := LoadCorePolicies(r.Policies)
+		if err != nil {
+			return nil, err
+		}
+		current := store.Policies()
+		policies.InitDefaults()
+		if err := policies.Validate(); err != nil {
+			return nil, serrors.Wrap("validating beaconing policies", err)
+		}
+		if err := checkIsdLoop(current.Prop, policies.Prop); err != nil {
+			return nil, err
+		}
+		changed = appendIfChanged(changed, ReloadedPropagation, current.Prop, policies.Prop)
+		changed = appendIfChanged(changed, ReloadedCoreRegistration,
+			current.CoreReg, policies.CoreReg)
+		swapPolicies = func() error { return store.SetPolicies(policies) }
+	case nonCorePolicyStore:
+		policies, err := LoadNonCorePolicies(r.Policies)
+		if err != nil {
+			return nil, err
+		}
+		current := store.Policies()
+		policies.InitDefaults()
+		if err := policies.Validate(); err != nil {
+			return nil, serrors.Wrap("validating beaconing policies", err)
+		}
+		if err := checkIsdLoop(current.Prop, policies.Prop); err != nil {
+			return nil, err
+		}
+		changed = appendIfChanged(changed, ReloadedPropagation, current.Prop, policies.Prop)
+		changed = appendIfChanged(changed, ReloadedUpRegistration,
+			current.UpReg, policies.UpReg)
+		changed = appendIfChanged(changed, ReloadedDownRegistration,
+			current.DownReg, policies.DownReg)
+		swapPolicies = func() error { return store.SetPolicies(policies) }
+	default:
+		return nil, serrors.New("beacon store does not support policy updates",
+			"type", reflect.TypeOf(r.Store))
+	}
+	changed = appendIfChanged(changed, ReloadedStaticInfo, r.StaticInfo.Load(), staticInfo)
+	if r.HiddenPaths != nil {
+		changed = appendIfChanged(changed, ReloadedHiddenPaths,
+			hpConfig{r.HiddenPaths.Groups(), r.HiddenPaths.RegistrationPolicy()},
+			hpConfig{hpGroups, hpPolicy},
+		)
+	}
+
+	// All configuration parts are valid, put them in use.
+	if err := swapPolicies(); err != nil {
+		return nil, serrors.Wrap("updating beaconing policies", err)
+	}
+	r.StaticInfo.Store(staticInfo)
+	if r.HiddenPaths != nil {
+		r.HiddenPaths.Update(hpGroups, hpPolicy)
+	}
+	log.FromCtx(ctx).Info("Reloaded configuration", "changed", changed)
+	return changed, nil
+}
+
+func (r *Reloader) loadStaticInfo() (*beaconing.StaticInfoCfg, error) {
+	staticInfo, err := beaconing.ParseStaticInfoCfg(r.StaticInfoFile)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		if r.MeasuredLatency {
+			return &beaconing.StaticInfoCfg{}, nil
+		}
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	return staticInfo, nil
+}
+
+// checkIsdLoop checks that the ISD loop setting of the propagation policy is
+// not changed. The setting is also used by the propagator and can therefore
+// not be changed at runtime.
+func checkIsdLoop(current, reloaded beacon.Policy) error {
+	if *current.Filter.AllowIsdLoop != *reloaded.Filter.AllowIsdLoop {
+		return serrors.New("change of AllowIsdLoop in propagation policy requires restart",
+			"current", *current.Filter.AllowIsdLoop, "new", *reloaded.Filter.AllowIsdLoop)
+	}
+	return nil
+}
+
+type hpConfig struct {
+	groups hiddenpath.Groups
+	policy hiddenpath.RegistrationPolicy
+}
+
+func appendIfChanged(changed []string, name string, current, reloaded any) []string {
+	if reflect.DeepEqual(current, reloaded) {
+		return changed
+	}
+	return append(changed, name)
+}
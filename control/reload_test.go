@@ -0,0 +1,87 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cs "github.com/scionproto/scion/control"
+	"github.com/scionproto/scion/control/beacon"
+	"github.com/scionproto/scion/control/beacon/mock_beacon"
+	"github.com/scionproto/scion/control/beaconing"
+	"github.com/scionproto/scion/control/config"
+)
+
+func TestReloaderReload(t *testing.T) {
+	dir := t.TempDir()
+	propFile := filepath.Join(dir, "prop.yml")
+	staticInfoFile := filepath.Join(dir, "staticInfoConfig.json")
+	write := func(t *testing.T, file, content string) {
+		require.NoError(t, os.WriteFile(file, []byte(content), 0o644))
+	}
+	write(t, propFile, "BestSetSize: 5\n")
+
+	ctrl := gomock.NewController(t)
+	policies, err := cs.LoadNonCorePolicies(config.Policies{Propagation: propFile})
+	require.NoError(t, err)
+	store, err := beacon.NewBeaconStore(policies, mock_beacon.NewMockDB(ctrl))
+	require.NoError(t, err)
+
+	var staticInfo atomic.Pointer[beaconing.StaticInfoCfg]
+	r := &cs.Reloader{
+		Policies:       config.Policies{Propagation: propFile},
+		Store:          store,
+		StaticInfoFile: staticInfoFile,
+		StaticInfo:     &staticInfo,
+	}
+
+	// Nothing changed.
+	changed, err := r.Reload(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, changed)
+
+	// Policy and static info changed.
+	write(t, propFile, "BestSetSize: 10\n")
+	write(t, staticInfoFile, `{"Note": "reloaded"}`)
+	changed, err = r.Reload(context.Background())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{cs.ReloadedPropagation, cs.ReloadedStaticInfo}, changed)
+	assert.Equal(t, 10, store.Policies().Prop.BestSetSize)
+	require.NotNil(t, staticInfo.Load())
+	assert.Equal(t, "reloaded", staticInfo.Load().Note)
+
+	// Invalid static info leaves the running configuration untouched.
+	write(t, propFile, "BestSetSize: 20\n")
+	write(t, staticInfoFile, `{"Note": `)
+	_, err = r.Reload(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, 10, store.Policies().Prop.BestSetSize)
+	assert.Equal(t, "reloaded", staticInfo.Load().Note)
+
+	// Changing the ISD loop setting requires a restart.
+	write(t, staticInfoFile, `{"Note": "reloaded"}`)
+	write(t, propFile, "Filter:\n  AllowIsdLoop: false\n")
+	_, err = r.Reload(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, 10, store.Policies().Prop.BestSetSize)
+}
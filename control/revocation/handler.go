@@ -0,0 +1,62 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revocation
+
+import (
+	"context"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/ctrl/path_mgmt"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	cryptopb "github.com/scionproto/scion/pkg/proto/crypto"
+	"github.com/scionproto/scion/private/revcache"
+)
+
+// Handler handles the verified revocations that are received from the control
+// services of neighboring ASes. Revocations that are new to the revocation
+// cache are propagated further. Revocations that are already known are
+// dropped, which prevents propagation loops.
+type Handler struct {
+	// RevCache is the revocation cache of the control service.
+	RevCache revcache.RevCache
+	// Propagator propagates the new revocations.
+	Propagator Propagator
+}
+
+// HandleRevocation inserts the revocation into the revocation cache and
+// propagates it in the background if it is new.
+func (h Handler) HandleRevocation(
+	ctx context.Context,
+	rev *path_mgmt.RevInfo,
+	signed *cryptopb.SignedMessage,
+	sender addr.IA,
+) error {
+
+	inserted, err := h.RevCache.Insert(ctx, rev)
+	if err != nil {
+		return serrors.Wrap("inserting revocation", err)
+	}
+	if !inserted {
+		return nil
+	}
+	log.FromCtx(ctx).Debug("Received revocation", "revocation", rev, "sender", sender)
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer log.HandlePanic()
+		h.Propagator.Propagate(ctx, signed, sender)
+	}()
+	return nil
+}
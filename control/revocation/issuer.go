@@ -0,0 +1,123 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package revocation issues signed revocations for the interfaces of the local
+// AS whose link is down, and propagates signed revocations to the daemons of
+// the local AS and to the control services of the downstream ASes.
+package revocation
+
+import (
+	"context"
+	"time"
+
+	"github.com/scionproto/scion/control/ifstate"
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/ctrl/path_mgmt"
+	"github.com/scionproto/scion/pkg/private/ctrl/path_mgmt/proto"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/private/util"
+	"github.com/scionproto/scion/pkg/segment/iface"
+	"github.com/scionproto/scion/private/revcache"
+	revgrpc "github.com/scionproto/scion/private/revcache/grpc"
+	"github.com/scionproto/scion/private/topology"
+)
+
+// DefaultTTL is the default validity period of the issued revocations.
+const DefaultTTL = path_mgmt.MinRevTTL
+
+// Issuer issues signed revocations for the interfaces of the local AS. It
+// implements the ifstate.Revoker interface.
+//
+// A revocation is only issued if there is no active revocation for the
+// interface that is valid for more than half of the TTL. This way, the
+// revocation is refreshed before it expires for as long as the link is down.
+type Issuer struct {
+	// IA is the ISD-AS of the local AS.
+	IA addr.IA
+	// Interfaces contains the interfaces of the local AS.
+	Interfaces *ifstate.Interfaces
+	// RevCache is the revocation cache of the control service. The issued
+	// revocations are inserted into it.
+	RevCache revcache.RevCache
+	// Signer signs the revocations.
+	Signer revgrpc.Signer
+	// Propagator propagates the signed revocations.
+	Propagator Propagator
+	// TTL is the validity period of the issued revocations. If it is zero,
+	// DefaultTTL is used.
+	TTL time.Duration
+}
+
+// RevokeInterfaces issues revocations for the given interfaces.
+func (i *Issuer) RevokeInterfaces(ctx context.Context, ifIDs []uint16) error {
+	var errs serrors.List
+	for _, ifID := range ifIDs {
+		if err := i.revoke(ctx, ifID); err != nil {
+			errs = append(errs, serrors.Wrap("revoking interface", err, "interface", ifID))
+		}
+	}
+	return errs.ToError()
+}
+
+func (i *Issuer) revoke(ctx context.Context, ifID uint16) error {
+	intf := i.Interfaces.Get(ifID)
+	if intf == nil {
+		return serrors.New("unknown interface")
+	}
+	ttl := i.TTL
+	if ttl == 0 {
+		ttl = DefaultTTL
+	}
+	now := time.Now()
+	existing, err := i.RevCache.Get(ctx, revcache.NewKey(i.IA, iface.ID(ifID)))
+	if err != nil {
+		return serrors.Wrap("reading revocation cache", err)
+	}
+	if existing != nil && existing.RelativeTTL(now) > ttl/2 {
+		return nil
+	}
+	rev := &path_mgmt.RevInfo{
+		IfID:         iface.ID(ifID),
+		RawIsdas:     i.IA,
+		LinkType:     linkType(intf.TopoInfo().LinkType),
+		RawTimestamp: util.TimeToSecs(now),
+		RawTTL:       uint32(ttl / time.Second),
+	}
+	signed, err := revgrpc.SignRevocation(ctx, i.Signer, rev)
+	if err != nil {
+		return err
+	}
+	if _, err := i.RevCache.Insert(ctx, rev); err != nil {
+		return serrors.Wrap("inserting revocation", err)
+	}
+	log.FromCtx(ctx).Info("Issued revocation", "revocation", rev)
+	i.Propagator.Propagate(ctx, signed, 0)
+	return nil
+}
+
+func linkType(t topology.LinkType) proto.LinkType {
+	switch t {
+	case topology.Core:
+		return proto.LinkType_core
+	case topology.Parent:
+		return proto.LinkType_parent
+	case topology.Child:
+		return proto.LinkType_child
+	case topology.Peer:
+		return proto.LinkType_peer
+	default:
+		return proto.LinkType_unset
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revocation
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/scionproto/scion/control/ifstate"
+	"github.com/scionproto/scion/control/onehop"
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/log"
+	cryptopb "github.com/scionproto/scion/pkg/proto/crypto"
+)
+
+// DefaultSendTimeout is the default timeout for sending a revocation to a
+// single recipient.
+const DefaultSendTimeout = 2 * time.Second
+
+// Propagator propagates signed revocations.
+type Propagator interface {
+	// Propagate sends the signed revocation to all recipients, except for the
+	// control service of the sender AS. The sender is 0 for revocations that
+	// originate in the local AS.
+	Propagate(ctx context.Context, signed *cryptopb.SignedMessage, sender addr.IA)
+}
+
+// Sender sends signed revocations.
+type Sender interface {
+	// SendRevocation sends the signed revocation to the remote.
+	SendRevocation(ctx context.Context, signed *cryptopb.SignedMessage, remote net.Addr) error
+}
+
+// DefaultPropagator propagates signed revocations to the daemons of the local
+// AS and to the control services of the neighboring ASes. The revocations are
+// forwarded unchanged, i.e., they keep the signature of the AS that issued
+// them.
+type DefaultPropagator struct {
+	// Interfaces returns the interfaces over which the revocations are
+	// propagated to the control services of the neighboring ASes.
+	Interfaces func() []*ifstate.Interface
+	// NextHopper returns the underlay next hop for an interface.
+	NextHopper interface {
+		UnderlayNextHop(uint16) *net.UDPAddr
+	}
+	// Neighbors sends the revocations to the control services of the
+	// neighboring ASes.
+	Neighbors Sender
+	// Daemons contains the addresses of the daemons of the local AS.
+	Daemons []net.Addr
+	// Local sends the revocations to the daemons of the local AS.
+	Local Sender
+	// SendTimeout is the timeout for sending a revocation to a single
+	// recipient. If it is zero, DefaultSendTimeout is used.
+	SendTimeout time.Duration
+}
+
+// Propagate sends the signed revocation to all recipients concurrently and
+// waits until all of them have been sent. Failures are logged.
+func (p *DefaultPropagator) Propagate(
+	ctx context.Context,
+	signed *cryptopb.SignedMessage,
+	sender addr.IA,
+) {
+
+	var wg sync.WaitGroup
+	send := func(s Sender, remote net.Addr) {
+		wg.Add(1)
+		go func() {
+			defer log.HandlePanic()
+			defer wg.Done()
+			p.send(ctx, s, signed, remote)
+		}()
+	}
+	for _, daemon := range p.Daemons {
+		send(p.Local, daemon)
+	}
+	if p.Interfaces != nil {
+		for _, intf := range p.Interfaces() {
+			if intf.LinkState() == ifstate.LinkStateDown {
+				continue
+			}
+			topoInfo := intf.TopoInfo()
+			if topoInfo.IA == sender {
+				continue
+			}
+			send(p.Neighbors, &onehop.Addr{
+				IA:      topoInfo.IA,
+				Egress:  topoInfo.ID,
+				SVC:     addr.SvcCS,
+				NextHop: p.NextHopper.UnderlayNextHop(topoInfo.ID),
+			})
+		}
+	}
+	wg.Wait()
+}
+
+func (p *DefaultPropagator) send(
+	ctx context.Context,
+	s Sender,
+	signed *cryptopb.SignedMessage,
+	remote net.Addr,
+) {
+
+	timeout := p.SendTimeout
+	if timeout == 0 {
+		timeout = DefaultSendTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if err := s.SendRevocation(ctx, signed, remote); err != nil {
+		log.FromCtx(ctx).Info("Failed to send revocation", "remote", remote, "err", err)
+	}
+}
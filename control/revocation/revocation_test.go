@@ -0,0 +1,228 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revocation_test
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/control/ifstate"
+	"github.com/scionproto/scion/control/onehop"
+	"github.com/scionproto/scion/control/revocation"
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/ctrl/path_mgmt"
+	"github.com/scionproto/scion/pkg/private/ctrl/path_mgmt/proto"
+	"github.com/scionproto/scion/pkg/private/util"
+	cryptopb "github.com/scionproto/scion/pkg/proto/crypto"
+	"github.com/scionproto/scion/private/revcache"
+	"github.com/scionproto/scion/private/revcache/memrevcache"
+	"github.com/scionproto/scion/private/topology"
+)
+
+var (
+	localIA = addr.MustParseIA("1-ff00:0:110")
+	childIA = addr.MustParseIA("1-ff00:0:111")
+	coreIA  = addr.MustParseIA("1-ff00:0:120")
+)
+
+func TestIssuerRevokeInterfaces(t *testing.T) {
+	intfs := testInterfaces()
+	revCache := memrevcache.New()
+	prop := &recordingPropagator{}
+	issuer := &revocation.Issuer{
+		IA:         localIA,
+		Interfaces: intfs,
+		RevCache:   revCache,
+		Signer:     fakeSigner{},
+		Propagator: prop,
+		TTL:        20 * time.Second,
+	}
+
+	err := issuer.RevokeInterfaces(context.Background(), []uint16{1})
+	require.NoError(t, err)
+	rev, err := revCache.Get(context.Background(), revcache.NewKey(localIA, 1))
+	require.NoError(t, err)
+	require.NotNil(t, rev)
+	assert.Equal(t, proto.LinkType_child, rev.LinkType)
+	assert.Equal(t, 20*time.Second, rev.TTL())
+	assert.Len(t, prop.propagated(), 1)
+
+	// The revocation is still valid for more than half of the TTL, it is not
+	// issued again.
+	err = issuer.RevokeInterfaces(context.Background(), []uint16{1})
+	require.NoError(t, err)
+	assert.Len(t, prop.propagated(), 1)
+
+	// A revocation that is about to expire is refreshed.
+	_, err = revCache.Insert(context.Background(), &path_mgmt.RevInfo{
+		IfID:         2,
+		RawIsdas:     localIA,
+		LinkType:     proto.LinkType_core,
+		RawTimestamp: util.TimeToSecs(time.Now().Add(-15 * time.Second)),
+		RawTTL:       20,
+	})
+	require.NoError(t, err)
+	err = issuer.RevokeInterfaces(context.Background(), []uint16{2})
+	require.NoError(t, err)
+	assert.Len(t, prop.propagated(), 2)
+
+	// Unknown interfaces are reported.
+	err = issuer.RevokeInterfaces(context.Background(), []uint16{42})
+	assert.Error(t, err)
+}
+
+func TestDefaultPropagatorPropagate(t *testing.T) {
+	intfs := testInterfaces()
+	intfs.Get(2).SetLinkState(ifstate.LinkStateDown)
+	daemon := &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 30255}
+	neighbors, local := &recordingSender{}, &recordingSender{}
+	p := &revocation.DefaultPropagator{
+		Interfaces: func() []*ifstate.Interface {
+			return intfs.Filtered(func(*ifstate.Interface) bool { return true })
+		},
+		NextHopper: nextHopper{},
+		Neighbors:  neighbors,
+		Daemons:    []net.Addr{daemon},
+		Local:      local,
+	}
+
+	// Interface 2 is down, nothing is sent over it.
+	p.Propagate(context.Background(), &cryptopb.SignedMessage{}, 0)
+	assert.Equal(t, []net.Addr{daemon}, local.remotes())
+	assert.Equal(t, []net.Addr{&onehop.Addr{
+		IA:      childIA,
+		Egress:  1,
+		SVC:     addr.SvcCS,
+		NextHop: nextHopper{}.UnderlayNextHop(1),
+	}}, neighbors.remotes())
+
+	// Revocations are not sent back to the sender.
+	intfs.Get(2).SetLinkState(ifstate.LinkStateUp)
+	neighbors.reset()
+	p.Propagate(context.Background(), &cryptopb.SignedMessage{}, childIA)
+	require.Len(t, neighbors.remotes(), 1)
+	assert.Equal(t, coreIA, neighbors.remotes()[0].(*onehop.Addr).IA)
+}
+
+func TestHandlerHandleRevocation(t *testing.T) {
+	revCache := memrevcache.New()
+	prop := &recordingPropagator{done: make(chan struct{}, 2)}
+	h := revocation.Handler{
+		RevCache:   revCache,
+		Propagator: prop,
+	}
+	rev := &path_mgmt.RevInfo{
+		IfID:         5,
+		RawIsdas:     coreIA,
+		LinkType:     proto.LinkType_core,
+		RawTimestamp: util.TimeToSecs(time.Now()),
+		RawTTL:       10,
+	}
+	require.NoError(t, h.HandleRevocation(context.Background(), rev,
+		&cryptopb.SignedMessage{}, coreIA))
+	<-prop.done
+	// The same revocation is not propagated twice.
+	require.NoError(t, h.HandleRevocation(context.Background(), rev,
+		&cryptopb.SignedMessage{}, childIA))
+	assert.Equal(t, []addr.IA{coreIA}, prop.senders())
+}
+
+func testInterfaces() *ifstate.Interfaces {
+	return ifstate.NewInterfaces(map[uint16]ifstate.InterfaceInfo{
+		1: {ID: 1, IA: childIA, LinkType: topology.Child},
+		2: {ID: 2, IA: coreIA, LinkType: topology.Core},
+	}, ifstate.Config{})
+}
+
+type fakeSigner struct{}
+
+func (fakeSigner) Sign(_ context.Context, msg []byte,
+	_ ...[]byte) (*cryptopb.SignedMessage, error) {
+
+	return &cryptopb.SignedMessage{HeaderAndBody: msg}, nil
+}
+
+type nextHopper struct{}
+
+func (nextHopper) UnderlayNextHop(ifID uint16) *net.UDPAddr {
+	return net.UDPAddrFromAddrPort(netip.AddrPortFrom(netip.MustParseAddr("10.0.0.1"), ifID))
+}
+
+type recordingPropagator struct {
+	mu   sync.Mutex
+	msgs []*cryptopb.SignedMessage
+	from []addr.IA
+	done chan struct{}
+}
+
+func (p *recordingPropagator) Propagate(_ context.Context, signed *cryptopb.SignedMessage,
+	sender addr.IA) {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.msgs = append(p.msgs, signed)
+	p.from = append(p.from, sender)
+	if p.done != nil {
+		p.done <- struct{}{}
+	}
+}
+
+func (p *recordingPropagator) propagated() []*cryptopb.SignedMessage {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]*cryptopb.SignedMessage(nil), p.msgs...)
+}
+
+func (p *recordingPropagator) senders() []addr.IA {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]addr.IA(nil), p.from...)
+}
+
+type recordingSender struct {
+	mu   sync.Mutex
+	sent []net.Addr
+}
+
+func (s *recordingSender) SendRevocation(_ context.Context, _ *cryptopb.SignedMessage,
+	remote net.Addr) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, remote)
+	return nil
+}
+
+func (s *recordingSender) remotes() []net.Addr {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r := append([]net.Addr(nil), s.sent...)
+	sort.Slice(r, func(i, j int) bool { return r[i].String() < r[j].String() })
+	return r
+}
+
+func (s *recordingSender) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = nil
+}
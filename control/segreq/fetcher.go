@@ -93,7 +93,9 @@ func NewFetcher(cfg FetcherConfig) *segfetcher.Fetcher {
 			DstProvider: d,
 			MaxRetries:  20,
 		},
-		Metrics: segfetcher.NewFetcherMetrics("control"),
+		NegativeCacheTTL: segfetcher.DefaultNegativeCacheTTL,
+		Metrics:          segfetcher.NewFetcherMetrics("control"),
+		RequestMetrics:   segfetcher.NewMetrics("control"),
 	}
 
 	d.router = newRouter(cfg, fetcher)
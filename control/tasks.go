@@ -76,6 +76,15 @@ type TasksConfig struct {
 	AllowIsdLoop bool
 
 	EPIC bool
+
+	// RouterAPIs contains the base URLs of the management APIs of the border
+	// routers that are queried for the link states and the measured link
+	// latencies. If it is empty, the routers are not queried.
+	RouterAPIs          []string
+	RouterQueryInterval time.Duration
+	// Revoker revokes the interfaces whose link is reported down by the
+	// border routers. If it is nil, no interfaces are revoked.
+	Revoker ifstate.Revoker
 }
 
 // Originator starts a periodic beacon origination task. For non-core ASes, no
@@ -157,21 +166,23 @@ func (t *TasksConfig) segmentWriter(segType seg.Type,
 		}
 
 	case t.HiddenPathRegistrationCfg != nil:
-		writer = &hiddenpath.BeaconWriter{
-			InternalErrors: metrics.CounterWith(internalErr, "seg_type", segType.String()),
-			Registered:     registered,
-			Intfs:          t.AllInterfaces,
-			Extender: t.extender("registrar", t.IA, t.MTU, func() uint8 {
-				return t.BeaconStore.MaxExpTime(policyType)
-			}),
-			RPC: t.HiddenPathRegistrationCfg.RPC,
-			Pather: addrutil.Pather{
-				NextHopper: t.NextHopper,
-			},
-			RegistrationPolicy: t.HiddenPathRegistrationCfg.Policy,
-			AddressResolver: hiddenpath.RegistrationResolver{
-				Router:     t.HiddenPathRegistrationCfg.Router,
-				Discoverer: t.HiddenPathRegistrationCfg.Discoverer,
+		writer = &hiddenPathWriter{
+			Policy: t.HiddenPathRegistrationCfg.Policy,
+			Writer: hiddenpath.BeaconWriter{
+				InternalErrors: metrics.CounterWith(internalErr, "seg_type", segType.String()),
+				Registered:     registered,
+				Intfs:          t.AllInterfaces,
+				Extender: t.extender("registrar", t.IA, t.MTU, func() uint8 {
+					return t.BeaconStore.MaxExpTime(policyType)
+				}),
+				RPC: t.HiddenPathRegistrationCfg.RPC,
+				Pather: addrutil.Pather{
+					NextHopper: t.NextHopper,
+				},
+				AddressResolver: hiddenpath.RegistrationResolver{
+					Router:     t.HiddenPathRegistrationCfg.Router,
+					Discoverer: t.HiddenPathRegistrationCfg.Discoverer,
+				},
 			},
 		}
 	default:
@@ -262,12 +273,32 @@ func (t *TasksConfig) DRKeyPrefetcher() *periodic.Runner {
 	)
 }
 
+// RouterStateFetcher starts a periodic task that queries the border routers for
+// the link states and the measured link latencies. If no router API is
+// configured, no periodic runner is started.
+func (t *TasksConfig) RouterStateFetcher() *periodic.Runner {
+	if len(t.RouterAPIs) == 0 {
+		return nil
+	}
+	//nolint:staticcheck // SA1019: fix later (https://github.com/scionproto/scion/issues/4776).
+	return periodic.Start(
+		&ifstate.RouterStateFetcher{
+			Interfaces: t.AllInterfaces,
+			Routers:    t.RouterAPIs,
+			Revoker:    t.Revoker,
+		},
+		t.RouterQueryInterval,
+		t.RouterQueryInterval,
+	)
+}
+
 // Tasks keeps track of the running tasks.
 type Tasks struct {
-	Originator      *periodic.Runner
-	Propagator      *periodic.Runner
-	Registrars      []*periodic.Runner
-	DRKeyPrefetcher *periodic.Runner
+	Originator         *periodic.Runner
+	Propagator         *periodic.Runner
+	Registrars         []*periodic.Runner
+	DRKeyPrefetcher    *periodic.Runner
+	RouterStateFetcher *periodic.Runner
 
 	PathCleaner   *periodic.Runner
 	DRKeyCleaners []*periodic.Runner
@@ -293,8 +324,9 @@ func StartTasks(cfg TasksConfig) (*Tasks, error) {
 			10*time.Second,
 			10*time.Second,
 		),
-		DRKeyPrefetcher: cfg.DRKeyPrefetcher(),
-		DRKeyCleaners:   cfg.DRKeyCleaners(),
+		DRKeyPrefetcher:    cfg.DRKeyPrefetcher(),
+		DRKeyCleaners:      cfg.DRKeyCleaners(),
+		RouterStateFetcher: cfg.RouterStateFetcher(),
 	}, nil
 
 }
@@ -309,6 +341,7 @@ func (t *Tasks) Kill() {
 		t.Propagator,
 		t.PathCleaner,
 		t.DRKeyPrefetcher,
+		t.RouterStateFetcher,
 	})
 	killRunners(t.Registrars)
 	killRunners(t.DRKeyCleaners)
@@ -318,6 +351,7 @@ func (t *Tasks) Kill() {
 	t.Registrars = nil
 	t.DRKeyPrefetcher = nil
 	t.DRKeyCleaners = nil
+	t.RouterStateFetcher = nil
 }
 
 func killRunners(runners []*periodic.Runner) {
@@ -329,12 +363,31 @@ func killRunners(runners []*periodic.Runner) {
 // HiddenPathRegistrationCfg contains the required options to configure hidden
 // paths down segment registration.
 type HiddenPathRegistrationCfg struct {
-	Policy     hiddenpath.RegistrationPolicy
+	// Policy returns the registration policy that is currently in use.
+	Policy     func() hiddenpath.RegistrationPolicy
 	Router     snet.Router
 	Discoverer hiddenpath.Discoverer
 	RPC        hiddenpath.Register
 }
 
+// hiddenPathWriter writes the beacons with the hidden path beacon writer,
+// using the registration policy that is in use at the time of the call.
+type hiddenPathWriter struct {
+	Policy func() hiddenpath.RegistrationPolicy
+	Writer hiddenpath.BeaconWriter
+}
+
+func (w *hiddenPathWriter) Write(
+	ctx context.Context,
+	segments []beacon.Beacon,
+	peers []uint16,
+) (beaconing.WriteStats, error) {
+
+	writer := w.Writer
+	writer.RegistrationPolicy = w.Policy()
+	return writer.Write(ctx, segments, peers)
+}
+
 // Store is the interface to interact with the beacon store.
 type Store interface {
 	// PreFilter indicates whether the beacon will be filtered on insert by
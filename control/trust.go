@@ -27,6 +27,7 @@ import (
 	"github.com/scionproto/scion/pkg/log"
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/private/ca/renewal"
+	"github.com/scionproto/scion/private/pkcs11"
 	"github.com/scionproto/scion/private/trust"
 )
 
@@ -46,6 +47,18 @@ func LoadTrustMaterial(ctx context.Context, configDir string, db trust.DB) error
 		}
 		logger.Info("Ignoring non-TRC", "file", f, "reason", r)
 	}
+	loaded, err = trust.LoadCRLs(ctx, certsDir, db)
+	if err != nil {
+		return serrors.Wrap("loading CRLs from disk", err)
+	}
+	logger.Info("CRLs loaded", "files", loaded.Loaded)
+	for f, r := range loaded.Ignored {
+		if errors.Is(r, trust.ErrAlreadyExists) {
+			logger.Debug("Ignoring existing CRL", "file", f)
+			continue
+		}
+		logger.Info("Ignoring CRL", "file", f, "reason", r)
+	}
 	localCertsDir := filepath.Join(configDir, "crypto/as")
 	loaded, err = trust.LoadChains(ctx, localCertsDir, db)
 	if err != nil {
@@ -66,21 +79,40 @@ func LoadTrustMaterial(ctx context.Context, configDir string, db trust.DB) error
 	return nil
 }
 
+// NewKeyRing creates the key ring that provides the private keys. If the
+// PKCS#11 URI is set, the keys are stored on the PKCS#11 token. Otherwise, they
+// are loaded from the directory.
+func NewKeyRing(pkcs11URI, dir string) (trust.KeyRing, error) {
+	if pkcs11URI == "" {
+		return cstrust.LoadingRing{Dir: dir}, nil
+	}
+	return pkcs11.NewKeyRing(pkcs11URI)
+}
+
 func NewTLSCertificateLoader(
 	ia addr.IA,
 	extKeyUsage x509.ExtKeyUsage,
 	db trust.DB,
 	cfgDir string,
+	keyRing trust.KeyRing,
 ) cstrust.TLSCertificateLoader {
 	return cstrust.TLSCertificateLoader{
-		SignerGen: newCachingSignerGen(ia, extKeyUsage, db, cfgDir),
+		SignerGen: newCachingSignerGen(ia, extKeyUsage, db, cfgDir, keyRing),
 	}
 }
 
-// NewSigner creates a renewing signer backed by a certificate chain.
-func NewSigner(ctx context.Context, ia addr.IA, db trust.DB, cfgDir string) cstrust.RenewingSigner {
+// NewSigner creates a renewing signer backed by a certificate chain. The
+// private keys are provided by the key ring.
+func NewSigner(
+	ctx context.Context,
+	ia addr.IA,
+	db trust.DB,
+	cfgDir string,
+	keyRing trust.KeyRing,
+) cstrust.RenewingSigner {
+
 	signer := cstrust.RenewingSigner{
-		SignerGen: newCachingSignerGen(ia, x509.ExtKeyUsageAny, db, cfgDir),
+		SignerGen: newCachingSignerGen(ia, x509.ExtKeyUsageAny, db, cfgDir, keyRing),
 	}
 	if _, err := signer.SignerGen.Generate(ctx); err != nil {
 		log.Debug("Initial signer generation failed", "err", err)
@@ -96,6 +128,7 @@ func newCachingSignerGen(
 	extKeyUsage x509.ExtKeyUsage,
 	db trust.DB,
 	cfgDir string,
+	keyRing trust.KeyRing,
 ) *cstrust.CachingSignerGen {
 	gen := trust.SignerGen{
 		IA: ia,
@@ -104,9 +137,7 @@ func newCachingSignerGen(
 			TRCDirs: []string{filepath.Join(cfgDir, "certs")},
 			DB:      db,
 		},
-		KeyRing: cstrust.LoadingRing{
-			Dir: filepath.Join(cfgDir, "crypto/as"),
-		},
+		KeyRing:     keyRing,
 		ExtKeyUsage: extKeyUsage,
 	}
 	return &cstrust.CachingSignerGen{
@@ -121,6 +152,9 @@ type ChainBuilderConfig struct {
 	MaxValidity time.Duration
 	ConfigDir   string
 	Metrics     renewal.Metrics
+	// KeyRing provides the CA private keys. If it is nil, the keys are loaded
+	// from the crypto/ca directory.
+	KeyRing trust.KeyRing
 
 	// ForceECDSAWithSHA512 forces the CA policy to use ECDSAWithSHA512 as the
 	// signature algorithm for signing the issued certificate. This field
@@ -133,6 +167,10 @@ type ChainBuilderConfig struct {
 
 // NewChainBuilder creates a renewing chain builder.
 func NewChainBuilder(cfg ChainBuilderConfig) renewal.ChainBuilder {
+	keyRing := cfg.KeyRing
+	if keyRing == nil {
+		keyRing = cstrust.LoadingRing{Dir: filepath.Join(cfg.ConfigDir, "crypto/ca")}
+	}
 	return renewal.ChainBuilder{
 		PolicyGen: &renewal.CachingPolicyGen{
 			PolicyGen: renewal.LoadingPolicyGen{
@@ -142,9 +180,7 @@ func NewChainBuilder(cfg ChainBuilderConfig) renewal.ChainBuilder {
 					DB:  cfg.DB,
 					Dir: filepath.Join(cfg.ConfigDir, "crypto/ca"),
 				},
-				KeyRing: cstrust.LoadingRing{
-					Dir: filepath.Join(cfg.ConfigDir, "crypto/ca"),
-				},
+				KeyRing:              keyRing,
 				ForceECDSAWithSHA512: cfg.ForceECDSAWithSHA512,
 				CASigners:            cfg.Metrics.CASigners,
 			},
@@ -0,0 +1,104 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trust
+
+import (
+	"context"
+	"errors"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	truststorage "github.com/scionproto/scion/private/storage/trust"
+	"github.com/scionproto/scion/private/trust"
+)
+
+// CRLFetcher fetches the certificate revocation lists of an ISD and inserts
+// the verified ones into the trust database.
+type CRLFetcher interface {
+	// UpdateCRLs fetches the CRLs of the ISD and returns the number of CRLs
+	// that were inserted.
+	UpdateCRLs(ctx context.Context, isd addr.ISD, opts ...trust.Option) (int, error)
+}
+
+// TRCLister lists the TRCs in the trust database.
+type TRCLister interface {
+	// SignedTRCs returns the TRCs matching the query.
+	SignedTRCs(ctx context.Context, query truststorage.TRCsQuery) (cppki.SignedTRCs, error)
+}
+
+// CRLUpdater keeps the certificate revocation lists in the trust database up
+// to date. On every run, the CRLs in the certs directory are loaded, and the
+// CRLs of all ISDs with a TRC in the trust database are fetched from their
+// core ASes.
+type CRLUpdater struct {
+	// IA is the local ISD-AS.
+	IA addr.IA
+	// Core indicates whether the local AS is a core AS. Core ASes do not fetch
+	// the CRLs of the local ISD, they are distributed out of band to the certs
+	// directory.
+	Core bool
+	// Dir is the directory the CRL files are loaded from.
+	Dir string
+	// DB is the trust database the CRLs are inserted into.
+	DB trust.DB
+	// TRCs lists the ISDs the CRLs are fetched for.
+	TRCs TRCLister
+	// Fetcher fetches the CRLs from the core ASes.
+	Fetcher CRLFetcher
+}
+
+// Name returns the task name.
+func (u *CRLUpdater) Name() string {
+	return "control_trust_crl_updater"
+}
+
+// Run loads and fetches the CRLs.
+func (u *CRLUpdater) Run(ctx context.Context) {
+	logger := log.FromCtx(ctx)
+	loaded, err := trust.LoadCRLs(ctx, u.Dir, u.DB)
+	if err != nil {
+		logger.Info("Failed to load CRLs from disk", "err", err)
+	}
+	for _, f := range loaded.Loaded {
+		logger.Info("CRL loaded", "file", f)
+	}
+	for f, r := range loaded.Ignored {
+		if errors.Is(r, trust.ErrAlreadyExists) {
+			continue
+		}
+		logger.Info("Ignoring CRL", "file", f, "reason", r)
+	}
+
+	trcs, err := u.TRCs.SignedTRCs(ctx, truststorage.TRCsQuery{Latest: true})
+	if err != nil {
+		logger.Info("Failed to list TRCs", "err", err)
+		return
+	}
+	for _, trc := range trcs {
+		isd := trc.TRC.ID.ISD
+		if u.Core && isd == u.IA.ISD() {
+			continue
+		}
+		inserted, err := u.Fetcher.UpdateCRLs(ctx, isd)
+		if err != nil {
+			logger.Info("Failed to update CRLs", "isd", isd, "err", err)
+			continue
+		}
+		if inserted > 0 {
+			logger.Info("CRLs updated", "isd", isd, "inserted", inserted)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trust_test
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cstrust "github.com/scionproto/scion/control/trust"
+	"github.com/scionproto/scion/control/trust/mock_trust"
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/xtest"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	"github.com/scionproto/scion/private/storage/trust/sqlite"
+	"github.com/scionproto/scion/private/trust"
+)
+
+func TestCRLUpdaterRun(t *testing.T) {
+	cryptoDir := genCrypto(t)
+	signedTRC := xtest.LoadTRC(t, filepath.Join(cryptoDir, "trcs/ISD1-B1-S1.trc"))
+	caDir := filepath.Join(cryptoDir, "ISD1/ASff00_0_110/crypto/ca")
+	caCert := xtest.LoadChain(t, filepath.Join(caDir, "ISD1-ASff00_0_110.ca.crt"))[0]
+	crl, err := cppki.CAPolicy{
+		Certificate: caCert,
+		Signer:      xtest.LoadSigner(t, filepath.Join(caDir, "cp-ca.key")),
+	}.CreateCRL(big.NewInt(1), []x509.RevocationListEntry{
+		{SerialNumber: big.NewInt(42), RevocationTime: time.Now()},
+	}, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	certsDir := t.TempDir()
+	raw := pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crl.Raw})
+	raw = append(raw, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caCert.Raw})...)
+	require.NoError(t, os.WriteFile(filepath.Join(certsDir, "ISD1.crl"), raw, 0o644))
+
+	db, err := sqlite.New("file::memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	_, err = db.InsertTRC(context.Background(), signedTRC)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	trcs := mock_trust.NewMockTRCLister(ctrl)
+	trcs.EXPECT().SignedTRCs(gomock.Any(), gomock.Any()).Return(cppki.SignedTRCs{
+		signedTRC,
+		{TRC: cppki.TRC{ID: cppki.TRCID{ISD: 2, Base: 1, Serial: 1}}},
+	}, nil)
+	fetcher := mock_trust.NewMockCRLFetcher(ctrl)
+	// The core AS does not fetch the CRLs of the local ISD.
+	fetcher.EXPECT().UpdateCRLs(gomock.Any(), addr.ISD(2)).Return(1, nil)
+
+	updater := &cstrust.CRLUpdater{
+		IA:      caIA,
+		Core:    true,
+		Dir:     certsDir,
+		DB:      db,
+		TRCs:    trcs,
+		Fetcher: fetcher,
+	}
+	updater.Run(context.Background())
+
+	crls, err := db.CRLs(context.Background(), trust.CRLQuery{ISD: 1})
+	require.NoError(t, err)
+	require.Len(t, crls, 1)
+	assert.Equal(t, crl.Raw, crls[0].RevocationList.Raw)
+	assert.Equal(t, caCert.Raw, crls[0].CA.Raw)
+}
@@ -26,18 +26,28 @@ import (
 	"github.com/scionproto/scion/pkg/log"
 	"github.com/scionproto/scion/pkg/metrics"
 	"github.com/scionproto/scion/pkg/private/prom"
+	"github.com/scionproto/scion/pkg/private/serrors"
 	cppb "github.com/scionproto/scion/pkg/proto/control_plane"
 	"github.com/scionproto/scion/pkg/scrypto/cppki"
 	"github.com/scionproto/scion/private/tracing"
 	"github.com/scionproto/scion/private/trust"
 )
 
+// CRLProvider provides the certificate revocation lists that are known locally.
+type CRLProvider interface {
+	// CRLs returns the CRLs that match the query.
+	CRLs(ctx context.Context, query trust.CRLQuery) ([]trust.CRL, error)
+}
+
 // MaterialServer servers trust material for gRPC requests.
 type MaterialServer struct {
 	// Provider provides the trust material.
 	Provider trust.Provider
 	// IA is the local ISD-AS.
 	IA addr.IA
+	// CRLProvider provides the certificate revocation lists. If it is nil, CRL
+	// requests are answered with an empty list.
+	CRLProvider CRLProvider
 
 	// Requests aggregates all the incoming requests received by the handler. If
 	// it is not initialized, nothing is reported.
@@ -110,6 +120,47 @@ func (s MaterialServer) TRC(ctx context.Context, req *cppb.TRCRequest) (*cppb.TR
 	return trcToResponse(trc), nil
 }
 
+func (s MaterialServer) CRLs(ctx context.Context,
+	req *cppb.CRLsRequest) (*cppb.CRLsResponse, error) {
+
+	labels := requestLabels{
+		ReqType: trustmetrics.CRLReq,
+		Client:  "unknown",
+	}
+	peer, ok := peer.FromContext(ctx)
+	if ok {
+		labels.Client = trustmetrics.PeerToLabel(peer.Addr, s.IA)
+	}
+	span := opentracing.SpanFromContext(ctx)
+	logger := log.FromCtx(ctx)
+
+	if req.Isd > uint32(addr.MaxISD) {
+		err := serrors.New("requested ISD not in range", "max", addr.MaxISD, "isd", req.Isd)
+		logger.Debug("Invalid CRL request", "peer", peer.Addr, "err", err)
+		s.updateMetric(span, labels.WithResult(trustmetrics.ErrParse), err)
+		return nil, err
+	}
+	isd := addr.ISD(req.Isd)
+	if span != nil {
+		span.SetTag("query.isd", isd)
+	}
+	logger.Debug("Received CRL request", "isd", isd, "peer", peer.Addr)
+
+	if s.CRLProvider == nil {
+		s.updateMetric(span, labels.WithResult(trustmetrics.Success), nil)
+		return &cppb.CRLsResponse{}, nil
+	}
+	crls, err := s.CRLProvider.CRLs(ctx, trust.CRLQuery{ISD: isd})
+	if err != nil {
+		logger.Info("Unable to retrieve CRLs", "isd", isd, "err", err)
+		s.updateMetric(span, labels.WithResult(trustmetrics.ErrInternal), err)
+		return nil, err
+	}
+	logger.Debug("Replied with CRLs", "count", len(crls))
+	s.updateMetric(span, labels.WithResult(trustmetrics.Success), nil)
+	return crlsToResponse(crls), nil
+}
+
 func (s MaterialServer) updateMetric(span opentracing.Span, l requestLabels, err error) {
 	if s.Requests != nil {
 		s.Requests.With(l.Expand()...).Add(1)
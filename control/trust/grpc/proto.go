@@ -97,3 +97,16 @@ func trcToResponse(trc cppki.SignedTRC) *cppb.TRCResponse {
 		Trc: trc.Raw, // nolint - name from protobuf
 	}
 }
+
+func crlsToResponse(crls []trust.CRL) *cppb.CRLsResponse {
+	rep := &cppb.CRLsResponse{
+		Crls: make([]*cppb.CRL, 0, len(crls)),
+	}
+	for _, crl := range crls {
+		rep.Crls = append(rep.Crls, &cppb.CRL{
+			Crl:    crl.RevocationList.Raw,
+			CaCert: crl.CA.Raw,
+		})
+	}
+	return rep
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"crypto/x509"
+	"net"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/grpc"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	cppb "github.com/scionproto/scion/pkg/proto/control_plane"
+	"github.com/scionproto/scion/pkg/snet"
+	"github.com/scionproto/scion/private/ca/renewal"
+)
+
+// ChainRenewalRequester requests renewed certificate chains from the control
+// service of a CA AS using gRPC.
+type ChainRenewalRequester struct {
+	// IA is the local ISD-AS.
+	IA addr.IA
+	// Dialer dials a new gRPC connection.
+	Dialer grpc.Dialer
+	// Router resolves the paths to the remote CA ASes.
+	Router snet.Router
+}
+
+// RequestChain sends the renewal request to the control service of the CA AS
+// and returns the renewed certificate chain.
+func (r ChainRenewalRequester) RequestChain(
+	ctx context.Context,
+	req *cppb.ChainRenewalRequest,
+	ca addr.IA,
+) ([]*x509.Certificate, error) {
+
+	remote, err := r.remote(ctx, ca)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := r.Dialer.Dial(ctx, remote)
+	if err != nil {
+		return nil, serrors.Wrap("dialing", err, "remote", remote)
+	}
+	defer conn.Close()
+	client := cppb.NewChainRenewalServiceClient(conn)
+	rep, err := client.ChainRenewal(ctx, req, grpc.RetryProfile...)
+	if err != nil {
+		return nil, serrors.Wrap("requesting certificate chain", err, "remote", remote)
+	}
+	chain, err := renewal.ExtractChainFromResponse(rep)
+	if err != nil {
+		return nil, serrors.Wrap("extracting certificate chain from response", err)
+	}
+	return chain, nil
+}
+
+func (r ChainRenewalRequester) remote(ctx context.Context, ca addr.IA) (net.Addr, error) {
+	if ca == r.IA {
+		return &snet.SVCAddr{IA: ca, SVC: addr.SvcCS}, nil
+	}
+	path, err := r.Router.Route(ctx, ca)
+	if err != nil || path == nil {
+		return nil, serrors.Wrap("unable to find path to CA", err, "ca", ca)
+	}
+	return &snet.SVCAddr{
+		IA:      path.Destination(),
+		Path:    path.Dataplane(),
+		NextHop: path.UnderlayNextHop(),
+		SVC:     addr.SvcCS,
+	}, nil
+}
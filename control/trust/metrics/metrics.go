@@ -35,6 +35,7 @@ const (
 const (
 	TRCReq   = "trc_request"
 	ChainReq = "chain_request"
+	CRLReq   = "crl_request"
 )
 
 // Result types
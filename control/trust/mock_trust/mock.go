@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/scionproto/scion/control/trust (interfaces: SignerGen)
+// Source: github.com/scionproto/scion/control/trust (interfaces: CRLFetcher,SignerGen,TRCLister)
 
 // Package mock_trust is a generated GoMock package.
 package mock_trust
@@ -9,9 +9,55 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
-	trust "github.com/scionproto/scion/private/trust"
+	addr "github.com/scionproto/scion/pkg/addr"
+	cppki "github.com/scionproto/scion/pkg/scrypto/cppki"
+	trust "github.com/scionproto/scion/private/storage/trust"
+	trust0 "github.com/scionproto/scion/private/trust"
 )
 
+// MockCRLFetcher is a mock of CRLFetcher interface.
+type MockCRLFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockCRLFetcherMockRecorder
+}
+
+// MockCRLFetcherMockRecorder is the mock recorder for MockCRLFetcher.
+type MockCRLFetcherMockRecorder struct {
+	mock *MockCRLFetcher
+}
+
+// NewMockCRLFetcher creates a new mock instance.
+func NewMockCRLFetcher(ctrl *gomock.Controller) *MockCRLFetcher {
+	mock := &MockCRLFetcher{ctrl: ctrl}
+	mock.recorder = &MockCRLFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCRLFetcher) EXPECT() *MockCRLFetcherMockRecorder {
+	return m.recorder
+}
+
+// UpdateCRLs mocks base method.
+func (m *MockCRLFetcher) UpdateCRLs(arg0 context.Context, arg1 addr.ISD, arg2 ...trust0.Option) (int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateCRLs", varargs...)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCRLs indicates an expected call of UpdateCRLs.
+func (mr *MockCRLFetcherMockRecorder) UpdateCRLs(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCRLs", reflect.TypeOf((*MockCRLFetcher)(nil).UpdateCRLs), varargs...)
+}
+
 // MockSignerGen is a mock of SignerGen interface.
 type MockSignerGen struct {
 	ctrl     *gomock.Controller
@@ -36,10 +82,10 @@ func (m *MockSignerGen) EXPECT() *MockSignerGenMockRecorder {
 }
 
 // Generate mocks base method.
-func (m *MockSignerGen) Generate(arg0 context.Context) ([]trust.Signer, error) {
+func (m *MockSignerGen) Generate(arg0 context.Context) ([]trust0.Signer, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Generate", arg0)
-	ret0, _ := ret[0].([]trust.Signer)
+	ret0, _ := ret[0].([]trust0.Signer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -49,3 +95,41 @@ func (mr *MockSignerGenMockRecorder) Generate(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockSignerGen)(nil).Generate), arg0)
 }
+
+// MockTRCLister is a mock of TRCLister interface.
+type MockTRCLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockTRCListerMockRecorder
+}
+
+// MockTRCListerMockRecorder is the mock recorder for MockTRCLister.
+type MockTRCListerMockRecorder struct {
+	mock *MockTRCLister
+}
+
+// NewMockTRCLister creates a new mock instance.
+func NewMockTRCLister(ctrl *gomock.Controller) *MockTRCLister {
+	mock := &MockTRCLister{ctrl: ctrl}
+	mock.recorder = &MockTRCListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTRCLister) EXPECT() *MockTRCListerMockRecorder {
+	return m.recorder
+}
+
+// SignedTRCs mocks base method.
+func (m *MockTRCLister) SignedTRCs(arg0 context.Context, arg1 trust.TRCsQuery) (cppki.SignedTRCs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignedTRCs", arg0, arg1)
+	ret0, _ := ret[0].(cppki.SignedTRCs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignedTRCs indicates an expected call of SignedTRCs.
+func (mr *MockTRCListerMockRecorder) SignedTRCs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignedTRCs", reflect.TypeOf((*MockTRCLister)(nil).SignedTRCs), arg0, arg1)
+}
@@ -0,0 +1,315 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trust
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	cppb "github.com/scionproto/scion/pkg/proto/control_plane"
+	"github.com/scionproto/scion/pkg/scrypto"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	"github.com/scionproto/scion/private/ca/renewal"
+	"github.com/scionproto/scion/private/trust"
+)
+
+// renewedPrefix is the file name prefix of the key and certificate chain files
+// that are written by the ChainRenewer.
+const renewedPrefix = "renewed-"
+
+// ChainRequester requests renewed certificate chains from a CA.
+type ChainRequester interface {
+	// RequestChain sends the renewal request to the control service of the CA
+	// AS and returns the renewed certificate chain. The chain is not verified.
+	RequestChain(ctx context.Context, req *cppb.ChainRenewalRequest,
+		ca addr.IA) ([]*x509.Certificate, error)
+}
+
+// KeyGenerator generates fresh private keys.
+type KeyGenerator interface {
+	// GenerateKey generates a fresh private key of the same type as the
+	// provided public key. The key is stored by the generator.
+	GenerateKey(ctx context.Context, pub crypto.PublicKey) (crypto.Signer, error)
+}
+
+// ChainRenewer renews the AS certificate before it expires. The fresh private
+// key and the renewed certificate chain are written to the crypto directory of
+// the AS, where they are picked up by the signer generator. Thus, the control
+// service switches to the renewed certificate chain without restart.
+type ChainRenewer struct {
+	// IA is the local ISD-AS.
+	IA addr.IA
+	// Dir is the directory the AS certificates and private keys are loaded
+	// from.
+	Dir string
+	// SignerGen generates the signers of the currently active certificate
+	// chains.
+	SignerGen SignerGen
+	// TRCs provides the TRCs that the renewed certificate chain is verified
+	// against.
+	TRCs renewal.TRCFetcher
+	// Requester requests the renewed certificate chains.
+	Requester ChainRequester
+	// CAs is the ordered list of CA ASes that are asked to renew the AS
+	// certificate.
+	CAs []addr.IA
+	// LeadTime is the remaining validity of the AS certificate at which it is
+	// renewed. If it is zero, the AS certificate is renewed once a third of its
+	// validity period remains.
+	LeadTime time.Duration
+	// KeyGenerator generates the fresh private keys, e.g., on a PKCS#11 token.
+	// If it is nil, the fresh private keys are generated in memory and written
+	// to Dir.
+	KeyGenerator KeyGenerator
+}
+
+// Name returns the task name.
+func (r *ChainRenewer) Name() string {
+	return "control_trust_chain_renewer"
+}
+
+// Run renews the AS certificate if it is about to expire.
+func (r *ChainRenewer) Run(ctx context.Context) {
+	if err := r.Renew(ctx); err != nil {
+		log.FromCtx(ctx).Info("Failed to renew AS certificate", "err", err)
+	}
+}
+
+// Renew renews the AS certificate if the remaining validity of the active
+// certificate chain is less than the lead time. The CAs are tried in order
+// until one of them successfully renews the certificate.
+func (r *ChainRenewer) Renew(ctx context.Context) error {
+	now := time.Now()
+	signers, err := r.SignerGen.Generate(ctx)
+	if err != nil {
+		return serrors.Wrap("generating signers", err)
+	}
+	signer, err := trust.LastExpiring(signers, cppki.Validity{NotBefore: now, NotAfter: now})
+	if err != nil {
+		return serrors.Wrap("selecting active signer", err)
+	}
+	if !r.shouldRenew(signer.ChainValidity, now) {
+		return nil
+	}
+	logger := log.FromCtx(ctx)
+	logger.Info("Renewing AS certificate", "not_after", signer.ChainValidity.NotAfter)
+
+	var key crypto.Signer
+	if r.KeyGenerator != nil {
+		key, err = r.KeyGenerator.GenerateKey(ctx, signer.PrivateKey.Public())
+	} else {
+		key, err = generateKey(signer.PrivateKey.Public())
+	}
+	if err != nil {
+		return serrors.Wrap("creating fresh private key", err)
+	}
+	subject := signer.Subject
+	subject.ExtraNames = subject.Names
+	csr, err := x509.CreateCertificateRequest(rand.Reader,
+		&x509.CertificateRequest{Subject: subject}, key)
+	if err != nil {
+		return serrors.Wrap("creating CSR", err)
+	}
+	req, err := renewal.NewChainRenewalRequest(ctx, csr, signer)
+	if err != nil {
+		return serrors.Wrap("creating renewal request", err)
+	}
+
+	var errs serrors.List
+	for _, ca := range r.CAs {
+		chain, err := r.Requester.RequestChain(ctx, req, ca)
+		if err == nil {
+			err = r.verify(ctx, chain, key.Public())
+		}
+		if err != nil {
+			errs = append(errs, serrors.Wrap("renewing with CA", err, "ca", ca))
+			continue
+		}
+		file, err := r.write(key, chain)
+		if err != nil {
+			return serrors.Wrap("writing renewed certificate chain", err)
+		}
+		logger.Info("Renewed AS certificate", "ca", ca, "file", file,
+			"not_after", chain[0].NotAfter)
+		r.cleanup(ctx, now)
+		return nil
+	}
+	if len(errs) == 0 {
+		return serrors.New("no CA configured")
+	}
+	return errs.ToError()
+}
+
+func (r *ChainRenewer) shouldRenew(validity cppki.Validity, now time.Time) bool {
+	leadTime := r.LeadTime
+	if leadTime == 0 {
+		leadTime = validity.NotAfter.Sub(validity.NotBefore) / 3
+	}
+	return validity.NotAfter.Sub(now) < leadTime
+}
+
+// verify checks that the renewed certificate chain is issued for the local AS
+// and the fresh private key, and that it is verifiable with the active TRCs of
+// the local ISD.
+func (r *ChainRenewer) verify(
+	ctx context.Context,
+	chain []*x509.Certificate,
+	pub crypto.PublicKey,
+) error {
+
+	if len(chain) == 0 {
+		return serrors.New("empty certificate chain")
+	}
+	ia, err := cppki.ExtractIA(chain[0].Subject)
+	if err != nil {
+		return serrors.Wrap("extracting ISD-AS from certificate", err)
+	}
+	if ia != r.IA {
+		return serrors.New("certificate issued for wrong ISD-AS", "isd_as", ia)
+	}
+	if eq, ok := pub.(interface{ Equal(crypto.PublicKey) bool }); !ok ||
+		!eq.Equal(chain[0].PublicKey) {
+
+		return serrors.New("certificate issued for wrong public key")
+	}
+	trc, err := r.TRCs.SignedTRC(ctx, cppki.TRCID{
+		ISD:    r.IA.ISD(),
+		Serial: scrypto.LatestVer,
+		Base:   scrypto.LatestVer,
+	})
+	if err != nil {
+		return serrors.Wrap("loading TRC", err)
+	}
+	if trc.IsZero() {
+		return serrors.New("TRC not found", "isd", r.IA.ISD())
+	}
+	trcs := []*cppki.TRC{&trc.TRC}
+	if trc.TRC.InGracePeriod(time.Now()) {
+		graceID := trc.TRC.ID
+		graceID.Serial--
+		grace, err := r.TRCs.SignedTRC(ctx, graceID)
+		if err == nil && !grace.IsZero() {
+			trcs = append(trcs, &grace.TRC)
+		}
+	}
+	if err := cppki.VerifyChain(chain, cppki.VerifyOptions{TRC: trcs}); err != nil {
+		return serrors.Wrap("verifying certificate chain", err)
+	}
+	return nil
+}
+
+// write writes the private key and the certificate chain to the crypto
+// directory. The key is written first, such that the chain is only picked up
+// once the matching key is available. If the key is stored by the key
+// generator, only the certificate chain is written.
+func (r *ChainRenewer) write(key crypto.Signer, chain []*x509.Certificate) (string, error) {
+	var rawChain bytes.Buffer
+	for _, c := range chain {
+		if err := pem.Encode(&rawChain, &pem.Block{Type: "CERTIFICATE", Bytes: c.Raw}); err != nil {
+			return "", serrors.Wrap("encoding certificate chain", err)
+		}
+	}
+	stem := filepath.Join(r.Dir,
+		renewedPrefix+chain[0].NotBefore.UTC().Format("20060102T150405Z"))
+	if r.KeyGenerator == nil {
+		rawKey, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			return "", serrors.Wrap("encoding private key", err)
+		}
+		pemKey := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: rawKey})
+		if err := writeFileAtomic(stem+".key", pemKey, 0o600); err != nil {
+			return "", err
+		}
+	}
+	if err := writeFileAtomic(stem+".pem", rawChain.Bytes(), 0o644); err != nil {
+		return "", err
+	}
+	return stem + ".pem", nil
+}
+
+// cleanup removes the previously renewed certificate chains that have expired,
+// together with their private keys.
+func (r *ChainRenewer) cleanup(ctx context.Context, now time.Time) {
+	files, err := filepath.Glob(filepath.Join(r.Dir, renewedPrefix+"*.pem"))
+	if err != nil {
+		return
+	}
+	for _, file := range files {
+		chain, err := cppki.ReadPEMCerts(file)
+		if err != nil || len(chain) == 0 || !chain[0].NotAfter.Before(now) {
+			continue
+		}
+		keyFile := strings.TrimSuffix(file, ".pem") + ".key"
+		for _, f := range []string{file, keyFile} {
+			if err := os.Remove(f); err != nil && !os.IsNotExist(err) {
+				log.FromCtx(ctx).Info("Failed to remove expired file", "file", f, "err", err)
+			}
+		}
+	}
+}
+
+// generateKey generates a fresh private key of the same type as the provided
+// public key.
+func generateKey(pub crypto.PublicKey) (crypto.Signer, error) {
+	switch pub := pub.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.GenerateKey(pub.Curve, rand.Reader)
+	case ed25519.PublicKey:
+		if !scrypto.Ed25519Enabled() {
+			return nil, serrors.New("ed25519 support not enabled")
+		}
+		_, priv, err := ed25519.GenerateKey(rand.Reader)
+		return priv, err
+	default:
+		return nil, serrors.New("unsupported key type", "type", fmt.Sprintf("%T", pub))
+	}
+}
+
+func writeFileAtomic(name string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+".*")
+	if err != nil {
+		return serrors.Wrap("creating temporary file", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return serrors.Wrap("writing temporary file", err, "file", tmp.Name())
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return serrors.Wrap("setting file mode", err, "file", tmp.Name())
+	}
+	if err := tmp.Close(); err != nil {
+		return serrors.Wrap("closing temporary file", err, "file", tmp.Name())
+	}
+	if err := os.Rename(tmp.Name(), name); err != nil {
+		return serrors.Wrap("renaming temporary file", err, "file", name)
+	}
+	return nil
+}
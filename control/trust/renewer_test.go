@@ -0,0 +1,183 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trust_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cstrust "github.com/scionproto/scion/control/trust"
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/private/xtest"
+	cppb "github.com/scionproto/scion/pkg/proto/control_plane"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	"github.com/scionproto/scion/pkg/scrypto/signed"
+	"github.com/scionproto/scion/private/ca/renewal"
+	"github.com/scionproto/scion/private/trust"
+)
+
+var (
+	caIA  = addr.MustParseIA("1-ff00:0:110")
+	asIA  = addr.MustParseIA("1-ff00:0:111")
+	badCA = addr.MustParseIA("1-ff00:0:112")
+)
+
+func TestChainRenewerRenew(t *testing.T) {
+	cryptoDir := genCrypto(t)
+	signedTRC := xtest.LoadTRC(t, filepath.Join(cryptoDir, "trcs/ISD1-B1-S1.trc"))
+	trc := signedTRC.TRC
+	trcs := staticTRCs{trc: signedTRC}
+	caDir := filepath.Join(cryptoDir, "ISD1/ASff00_0_110/crypto/ca")
+	caCert := xtest.LoadChain(t, filepath.Join(caDir, "ISD1-ASff00_0_110.ca.crt"))[0]
+	caKey := xtest.LoadSigner(t, filepath.Join(caDir, "cp-ca.key"))
+	ca := &issuingCA{
+		verifier: renewal.RequestVerifier{TRCFetcher: trcs},
+		policy: cppki.CAPolicy{
+			Validity:    24 * time.Hour,
+			Certificate: caCert,
+			Signer:      caKey,
+		},
+	}
+
+	testCases := map[string]struct {
+		LeadTime time.Duration
+		CAs      []addr.IA
+		Renewed  bool
+		Assert   assert.ErrorAssertionFunc
+	}{
+		"not about to expire": {
+			LeadTime: time.Hour,
+			CAs:      []addr.IA{caIA},
+			Assert:   assert.NoError,
+		},
+		"renewed": {
+			LeadTime: 2 * 365 * 24 * time.Hour,
+			CAs:      []addr.IA{caIA},
+			Renewed:  true,
+			Assert:   assert.NoError,
+		},
+		"fallback CA": {
+			LeadTime: 2 * 365 * 24 * time.Hour,
+			CAs:      []addr.IA{badCA, caIA},
+			Renewed:  true,
+			Assert:   assert.NoError,
+		},
+		"all CAs fail": {
+			LeadTime: 2 * 365 * 24 * time.Hour,
+			CAs:      []addr.IA{badCA},
+			Assert:   assert.Error,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			asDir := filepath.Join(cryptoDir, "ISD1/ASff00_0_111/crypto/as")
+			chain := xtest.LoadChain(t, filepath.Join(asDir, "ISD1-ASff00_0_111.pem"))
+			key := xtest.LoadSigner(t, filepath.Join(asDir, "cp-as.key"))
+
+			r := &cstrust.ChainRenewer{
+				IA:        asIA,
+				Dir:       dir,
+				SignerGen: staticSignerGen{signer: newSigner(t, key, chain, trc.ID)},
+				TRCs:      trcs,
+				Requester: ca,
+				CAs:       tc.CAs,
+				LeadTime:  tc.LeadTime,
+			}
+			tc.Assert(t, r.Renew(context.Background()))
+
+			chains, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+			require.NoError(t, err)
+			if !tc.Renewed {
+				assert.Empty(t, chains)
+				return
+			}
+			require.Len(t, chains, 1)
+			renewed := xtest.LoadChain(t, chains[0])
+			assert.NoError(t, cppki.VerifyChain(renewed,
+				cppki.VerifyOptions{TRC: []*cppki.TRC{&trc}}))
+			keys, err := cstrust.LoadingRing{Dir: dir}.PrivateKeys(context.Background())
+			require.NoError(t, err)
+			require.Len(t, keys, 1)
+			assert.Equal(t, renewed[0].PublicKey, keys[0].Public())
+			assert.NotEqual(t, chain[0].PublicKey, keys[0].Public())
+		})
+	}
+}
+
+func newSigner(t *testing.T, key crypto.Signer, chain []*x509.Certificate,
+	trcID cppki.TRCID) trust.Signer {
+
+	algo, err := signed.SelectSignatureAlgorithm(key.Public())
+	require.NoError(t, err)
+	return trust.Signer{
+		PrivateKey:   key,
+		Algorithm:    algo,
+		IA:           asIA,
+		TRCID:        trcID,
+		SubjectKeyID: chain[0].SubjectKeyId,
+		Expiration:   chain[0].NotAfter,
+		ChainValidity: cppki.Validity{
+			NotBefore: chain[0].NotBefore,
+			NotAfter:  chain[0].NotAfter,
+		},
+		Subject: chain[0].Subject,
+		Chain:   chain,
+	}
+}
+
+type staticSignerGen struct {
+	signer trust.Signer
+}
+
+func (g staticSignerGen) Generate(context.Context) ([]trust.Signer, error) {
+	return []trust.Signer{g.signer}, nil
+}
+
+type staticTRCs struct {
+	trc cppki.SignedTRC
+}
+
+func (s staticTRCs) SignedTRC(context.Context, cppki.TRCID) (cppki.SignedTRC, error) {
+	return s.trc, nil
+}
+
+// issuingCA issues certificate chains for the renewal requests that are sent
+// to caIA, and fails for all other CAs.
+type issuingCA struct {
+	verifier renewal.RequestVerifier
+	policy   cppki.CAPolicy
+}
+
+func (c *issuingCA) RequestChain(ctx context.Context, req *cppb.ChainRenewalRequest,
+	ca addr.IA) ([]*x509.Certificate, error) {
+
+	if ca != caIA {
+		return nil, serrors.New("CA not reachable", "ca", ca)
+	}
+	csr, err := c.verifier.VerifyCMSSignedRenewalRequest(ctx, req.CmsSignedRequest)
+	if err != nil {
+		return nil, err
+	}
+	return c.policy.CreateChain(csr)
+}
@@ -0,0 +1,160 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trust
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/scionproto/scion/control/ifstate"
+	"github.com/scionproto/scion/control/onehop"
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	"github.com/scionproto/scion/private/storage"
+)
+
+// DefaultAnnouncementTimeout is the default timeout for announcing a TRC to a
+// single recipient.
+const DefaultAnnouncementTimeout = 2 * time.Second
+
+// TRCAnnouncementSender sends TRC announcements.
+type TRCAnnouncementSender interface {
+	// SendTRCAnnouncement announces the TRC to the remote.
+	SendTRCAnnouncement(ctx context.Context, id cppki.TRCID, remote net.Addr) error
+}
+
+// TRCAnnouncer announces new TRCs to the daemons of the local AS and to the
+// control services of the neighboring ASes. The recipients fetch the announced
+// TRC from the announcing control service and verify it against its
+// predecessor. Recipients that insert the TRC announce it further, which
+// floods the TRC through the network. Recipients that already know the TRC do
+// not announce it again.
+type TRCAnnouncer struct {
+	// TRCs are the IDs of the TRCs to announce. See WrapAnnouncingDB.
+	TRCs <-chan cppki.TRCID
+	// Interfaces returns the interfaces over which the TRCs are announced to
+	// the control services of the neighboring ASes.
+	Interfaces func() []*ifstate.Interface
+	// NextHopper returns the underlay next hop for an interface.
+	NextHopper interface {
+		UnderlayNextHop(uint16) *net.UDPAddr
+	}
+	// Neighbors sends the announcements to the control services of the
+	// neighboring ASes.
+	Neighbors TRCAnnouncementSender
+	// Daemons contains the addresses of the daemons of the local AS.
+	Daemons []net.Addr
+	// Local sends the announcements to the daemons of the local AS.
+	Local TRCAnnouncementSender
+	// SendTimeout is the timeout for announcing a TRC to a single recipient.
+	// If it is zero, DefaultAnnouncementTimeout is used.
+	SendTimeout time.Duration
+}
+
+// Run announces the TRCs received on the channel until the context is
+// canceled.
+func (a *TRCAnnouncer) Run(ctx context.Context) error {
+	for {
+		select {
+		case id := <-a.TRCs:
+			log.FromCtx(ctx).Info("Announcing new TRC", "id", id)
+			a.Announce(ctx, id)
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Announce sends the TRC announcement to all recipients concurrently and waits
+// until all of them have been sent. Failures are logged.
+func (a *TRCAnnouncer) Announce(ctx context.Context, id cppki.TRCID) {
+	var wg sync.WaitGroup
+	send := func(s TRCAnnouncementSender, remote net.Addr) {
+		wg.Add(1)
+		go func() {
+			defer log.HandlePanic()
+			defer wg.Done()
+			a.send(ctx, s, id, remote)
+		}()
+	}
+	for _, daemon := range a.Daemons {
+		send(a.Local, daemon)
+	}
+	if a.Interfaces != nil {
+		for _, intf := range a.Interfaces() {
+			if intf.LinkState() == ifstate.LinkStateDown {
+				continue
+			}
+			topoInfo := intf.TopoInfo()
+			send(a.Neighbors, &onehop.Addr{
+				IA:      topoInfo.IA,
+				Egress:  topoInfo.ID,
+				SVC:     addr.SvcCS,
+				NextHop: a.NextHopper.UnderlayNextHop(topoInfo.ID),
+			})
+		}
+	}
+	wg.Wait()
+}
+
+func (a *TRCAnnouncer) send(
+	ctx context.Context,
+	s TRCAnnouncementSender,
+	id cppki.TRCID,
+	remote net.Addr,
+) {
+
+	timeout := a.SendTimeout
+	if timeout == 0 {
+		timeout = DefaultAnnouncementTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if err := s.SendTRCAnnouncement(ctx, id, remote); err != nil {
+		log.FromCtx(ctx).Info("Failed to announce TRC", "id", id, "remote", remote, "err", err)
+	}
+}
+
+type announcingDB struct {
+	storage.TrustDB
+	trcs chan<- cppki.TRCID
+}
+
+// WrapAnnouncingDB wraps the given trust database into one that sends the IDs
+// of newly inserted TRCs on the channel. This includes the TRCs that are
+// loaded from disk and the TRCs that are fetched from remotes. If the channel
+// is full, the ID is dropped.
+func WrapAnnouncingDB(db storage.TrustDB, trcs chan<- cppki.TRCID) storage.TrustDB {
+	return &announcingDB{
+		TrustDB: db,
+		trcs:    trcs,
+	}
+}
+
+func (db *announcingDB) InsertTRC(ctx context.Context, trc cppki.SignedTRC) (bool, error) {
+	inserted, err := db.TrustDB.InsertTRC(ctx, trc)
+	if err != nil || !inserted {
+		return inserted, err
+	}
+	select {
+	case db.trcs <- trc.TRC.ID:
+	default:
+		log.FromCtx(ctx).Info("Dropping TRC announcement, queue is full", "id", trc.TRC.ID)
+	}
+	return inserted, err
+}
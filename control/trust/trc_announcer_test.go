@@ -0,0 +1,146 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trust_test
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/control/ifstate"
+	"github.com/scionproto/scion/control/onehop"
+	cstrust "github.com/scionproto/scion/control/trust"
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/xtest"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	"github.com/scionproto/scion/private/storage/trust/sqlite"
+	"github.com/scionproto/scion/private/topology"
+)
+
+func TestTRCAnnouncerAnnounce(t *testing.T) {
+	childIA := addr.MustParseIA("1-ff00:0:111")
+	coreIA := addr.MustParseIA("1-ff00:0:120")
+	intfs := ifstate.NewInterfaces(map[uint16]ifstate.InterfaceInfo{
+		1: {ID: 1, IA: childIA, LinkType: topology.Child},
+		2: {ID: 2, IA: coreIA, LinkType: topology.Core},
+	}, ifstate.Config{})
+	intfs.Get(2).SetLinkState(ifstate.LinkStateDown)
+	daemon := &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 30255}
+	neighbors, local := &recordingAnnouncementSender{}, &recordingAnnouncementSender{}
+	a := &cstrust.TRCAnnouncer{
+		Interfaces: func() []*ifstate.Interface {
+			return intfs.Filtered(func(*ifstate.Interface) bool { return true })
+		},
+		NextHopper: nextHopper{},
+		Neighbors:  neighbors,
+		Daemons:    []net.Addr{daemon},
+		Local:      local,
+	}
+
+	id := cppki.TRCID{ISD: 1, Base: 1, Serial: 2}
+	a.Announce(context.Background(), id)
+	assert.Equal(t, []net.Addr{daemon}, local.remotes())
+	// Interface 2 is down, nothing is sent over it.
+	assert.Equal(t, []net.Addr{&onehop.Addr{
+		IA:      childIA,
+		Egress:  1,
+		SVC:     addr.SvcCS,
+		NextHop: nextHopper{}.UnderlayNextHop(1),
+	}}, neighbors.remotes())
+	assert.Equal(t, []cppki.TRCID{id, id}, append(local.trcs(), neighbors.trcs()...))
+}
+
+func TestTRCAnnouncerRun(t *testing.T) {
+	dir := genCrypto(t)
+	trc := xtest.LoadTRC(t, filepath.Join(dir, "trcs/ISD1-B1-S1.trc"))
+
+	db, err := sqlite.New("file::memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	newTRCs := make(chan cppki.TRCID, 1)
+	announcingDB := cstrust.WrapAnnouncingDB(db, newTRCs)
+
+	local := &recordingAnnouncementSender{}
+	daemon := &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 30255}
+	a := &cstrust.TRCAnnouncer{
+		TRCs:    newTRCs,
+		Daemons: []net.Addr{daemon},
+		Local:   local,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.NoError(t, a.Run(ctx))
+	}()
+
+	inserted, err := announcingDB.InsertTRC(context.Background(), trc)
+	require.NoError(t, err)
+	require.True(t, inserted)
+	// Known TRCs are not announced again.
+	inserted, err = announcingDB.InsertTRC(context.Background(), trc)
+	require.NoError(t, err)
+	require.False(t, inserted)
+
+	require.Eventually(t, func() bool { return len(local.trcs()) > 0 },
+		time.Second, 10*time.Millisecond)
+	cancel()
+	<-done
+	assert.Equal(t, []cppki.TRCID{trc.TRC.ID}, local.trcs())
+}
+
+type nextHopper struct{}
+
+func (nextHopper) UnderlayNextHop(ifID uint16) *net.UDPAddr {
+	return net.UDPAddrFromAddrPort(netip.AddrPortFrom(netip.MustParseAddr("10.0.0.1"), ifID))
+}
+
+type recordingAnnouncementSender struct {
+	mu   sync.Mutex
+	sent []net.Addr
+	ids  []cppki.TRCID
+}
+
+func (s *recordingAnnouncementSender) SendTRCAnnouncement(_ context.Context,
+	id cppki.TRCID, remote net.Addr) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, remote)
+	s.ids = append(s.ids, id)
+	return nil
+}
+
+func (s *recordingAnnouncementSender) remotes() []net.Addr {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r := append([]net.Addr(nil), s.sent...)
+	sort.Slice(r, func(i, j int) bool { return r[i].String() < r[j].String() })
+	return r
+}
+
+func (s *recordingAnnouncementSender) trcs() []cppki.TRCID {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]cppki.TRCID(nil), s.ids...)
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trust
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/private/trust"
+)
+
+// DefaultTRCWatcherDelay is the default delay between the last filesystem
+// event and loading the TRCs.
+const DefaultTRCWatcherDelay = 500 * time.Millisecond
+
+// TRCWatcher watches directories for new TRC files and loads them into the
+// database as soon as they are written.
+type TRCWatcher struct {
+	// DB is the database the TRCs are loaded into.
+	DB trust.DB
+	// Dirs are the directories that are watched.
+	Dirs []string
+	// Delay is the delay between the last filesystem event and loading the
+	// TRCs. It avoids loading partially written files. If it is zero,
+	// DefaultTRCWatcherDelay is used.
+	Delay time.Duration
+}
+
+// Run watches the directories until the context is canceled. The TRCs that are
+// present when the watcher starts are loaded immediately.
+func (w *TRCWatcher) Run(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return serrors.Wrap("creating filesystem watcher", err)
+	}
+	defer watcher.Close()
+
+	loaders := make([]*trust.TRCLoader, 0, len(w.Dirs))
+	for _, dir := range w.Dirs {
+		if err := watcher.Add(dir); err != nil {
+			return serrors.Wrap("watching directory", err, "dir", dir)
+		}
+		loaders = append(loaders, &trust.TRCLoader{Dir: dir, DB: w.DB})
+	}
+	delay := w.Delay
+	if delay == 0 {
+		delay = DefaultTRCWatcherDelay
+	}
+
+	logger := log.FromCtx(ctx)
+	load := func() {
+		for _, loader := range loaders {
+			res, err := loader.Load(ctx)
+			if err != nil {
+				logger.Info("Failed to load TRCs", "dir", loader.Dir, "err", err)
+			}
+			if len(res.Loaded) > 0 {
+				logger.Info("TRCs loaded", "files", res.Loaded)
+			}
+			for f, reason := range res.Ignored {
+				if !errors.Is(reason, trust.ErrAlreadyExists) {
+					logger.Info("Ignoring TRC", "file", f, "reason", reason)
+				}
+			}
+		}
+	}
+	load()
+
+	timer := time.NewTimer(delay)
+	timer.Stop()
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if filepath.Ext(event.Name) != ".trc" ||
+				!event.Has(fsnotify.Create) && !event.Has(fsnotify.Write) {
+				continue
+			}
+			timer.Reset(delay)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			logger.Info("Filesystem watcher error", "err", err)
+		case <-timer.C:
+			load()
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trust_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cstrust "github.com/scionproto/scion/control/trust"
+	"github.com/scionproto/scion/pkg/private/xtest"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	"github.com/scionproto/scion/private/storage/trust/sqlite"
+)
+
+func TestTRCWatcherRun(t *testing.T) {
+	dir := genCrypto(t)
+	raw, err := os.ReadFile(filepath.Join(dir, "trcs/ISD1-B1-S1.trc"))
+	require.NoError(t, err)
+	trc := xtest.LoadTRC(t, filepath.Join(dir, "trcs/ISD1-B1-S1.trc"))
+
+	db, err := sqlite.New("file::memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	certsDir := t.TempDir()
+	w := &cstrust.TRCWatcher{
+		DB:    db,
+		Dirs:  []string{certsDir},
+		Delay: 10 * time.Millisecond,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.NoError(t, w.Run(ctx))
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	err = os.WriteFile(filepath.Join(certsDir, "ISD1-B1-S1.trc"), raw, 0o644)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		loaded, err := db.SignedTRC(context.Background(), trc.TRC.ID)
+		require.NoError(t, err)
+		return !loaded.IsZero()
+	}, 2*time.Second, 10*time.Millisecond)
+	loaded, err := db.SignedTRC(context.Background(), cppki.TRCID{ISD: 1, Base: 1, Serial: 1})
+	require.NoError(t, err)
+	assert.Equal(t, trc.Raw, loaded.Raw)
+}
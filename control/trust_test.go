@@ -35,11 +35,15 @@ func TestNewSigner(t *testing.T) {
 	db, err := sqlite.New("file::memory:")
 	require.NoError(t, err)
 
+	cfgDir := filepath.Join(dir, "/ISD1/ASff00_0_110")
+	keyRing, err := cs.NewKeyRing("", filepath.Join(cfgDir, "crypto/as"))
+	require.NoError(t, err)
 	signer := cs.NewSigner(
 		context.Background(),
 		addr.MustParseIA("1-ff00:0:110"),
 		db,
-		filepath.Join(dir, "/ISD1/ASff00_0_110"),
+		cfgDir,
+		keyRing,
 	)
 
 	_, err = signer.Sign(context.Background(), []byte("message"))
@@ -31,13 +31,13 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/resolver"
 
 	"github.com/scionproto/scion/daemon"
 	"github.com/scionproto/scion/daemon/config"
 	sd_drkey "github.com/scionproto/scion/daemon/drkey"
 	sd_grpc "github.com/scionproto/scion/daemon/drkey/grpc"
 	"github.com/scionproto/scion/daemon/fetcher"
+	"github.com/scionproto/scion/daemon/internal/servers"
 	api "github.com/scionproto/scion/daemon/mgmtapi"
 	"github.com/scionproto/scion/pkg/addr"
 	"github.com/scionproto/scion/pkg/experimental/hiddenpath"
@@ -47,8 +47,10 @@ import (
 	"github.com/scionproto/scion/pkg/metrics"
 	"github.com/scionproto/scion/pkg/private/prom"
 	"github.com/scionproto/scion/pkg/private/serrors"
+	cppb "github.com/scionproto/scion/pkg/proto/control_plane"
 	cryptopb "github.com/scionproto/scion/pkg/proto/crypto"
 	sdpb "github.com/scionproto/scion/pkg/proto/daemon"
+	"github.com/scionproto/scion/pkg/scrypto"
 	"github.com/scionproto/scion/pkg/scrypto/cppki"
 	"github.com/scionproto/scion/pkg/scrypto/signed"
 	"github.com/scionproto/scion/private/app"
@@ -58,6 +60,7 @@ import (
 	"github.com/scionproto/scion/private/pathdb"
 	"github.com/scionproto/scion/private/periodic"
 	"github.com/scionproto/scion/private/revcache"
+	revgrpc "github.com/scionproto/scion/private/revcache/grpc"
 	"github.com/scionproto/scion/private/segment/segfetcher"
 	segfetchergrpc "github.com/scionproto/scion/private/segment/segfetcher/grpc"
 	infra "github.com/scionproto/scion/private/segment/verifier"
@@ -69,6 +72,8 @@ import (
 	"github.com/scionproto/scion/private/topology"
 	"github.com/scionproto/scion/private/trust"
 	"github.com/scionproto/scion/private/trust/compat"
+	"github.com/scionproto/scion/private/trust/expiry"
+	trustgrpc "github.com/scionproto/scion/private/trust/grpc"
 	trustmetrics "github.com/scionproto/scion/private/trust/metrics"
 )
 
@@ -86,6 +91,7 @@ func main() {
 }
 
 func realMain(ctx context.Context) error {
+	scrypto.EnableEd25519(globalCfg.Features.ExperimentalEd25519)
 	topo, err := topology.NewLoader(topology.LoaderCfg{
 		File:      globalCfg.General.Topology(),
 		Reload:    app.SIGHUPChannel(ctx),
@@ -126,19 +132,7 @@ func realMain(ctx context.Context) error {
 		10*time.Second, 10*time.Second)
 	defer rcCleaner.Stop()
 
-	dialer := &libgrpc.TCPDialer{
-		SvcResolver: func(dst addr.SVC) []resolver.Address {
-			if base := dst.Base(); base != addr.SvcCS {
-				panic("unsupported address type, possible implementation error: " +
-					base.String())
-			}
-			targets := []resolver.Address{}
-			for _, entry := range topo.ControlServiceAddresses() {
-				targets = append(targets, resolver.Address{Addr: entry.String()})
-			}
-			return targets
-		},
-	}
+	dialer := daemon.ControlServiceDialer(topo)
 
 	trustDB, err := storage.NewTrustStorage(globalCfg.TrustDB)
 	if err != nil {
@@ -186,6 +180,17 @@ func realMain(ctx context.Context) error {
 	}, 10*time.Second, 10*time.Second)
 	defer trcLoaderTask.Stop()
 
+	expiryChecker := &expiry.Checker{
+		IA:           topo.IA(),
+		DB:           trustDB,
+		WarnBefore:   globalCfg.TrustEngine.Expiry.WarnBefore.Duration,
+		TimeToExpiry: trustmetrics.TimeToExpirySeconds,
+	}
+	//nolint:staticcheck // SA1019: fix later (https://github.com/scionproto/scion/issues/4776).
+	expiryTask := periodic.Start(expiryChecker, time.Minute, 30*time.Second)
+	defer expiryTask.Stop()
+	expiryTask.TriggerRun()
+
 	var drkeyClientEngine *sd_drkey.ClientEngine
 	if globalCfg.DRKeyLevel2DB.Connection != "" {
 		backend, err := storage.NewDRKeyLevel2Storage(globalCfg.DRKeyLevel2DB)
@@ -268,9 +273,11 @@ func realMain(ctx context.Context) error {
 
 	server := grpc.NewServer(
 		libgrpc.UnaryServerInterceptor(),
+		libgrpc.StreamServerInterceptor(),
 		libgrpc.DefaultMaxConcurrentStreams(),
 	)
-	sdpb.RegisterDaemonServiceServer(server, daemon.NewServer(
+	pathNotifier := &servers.PathNotifier{}
+	sdServer := daemon.NewServer(
 		daemon.ServerConfig{
 			IA:       topo.IA(),
 			MTU:      topo.MTU(),
@@ -287,13 +294,25 @@ func realMain(ctx context.Context) error {
 					Verifier:   createVerifier(),
 					RevCache:   revCache,
 					Cfg:        globalCfg.SD,
+					OnStore:    pathNotifier.Notify,
 				},
 			),
-			Engine:      engine,
-			RevCache:    revCache,
-			DRKeyClient: drkeyClientEngine,
+			Engine:       engine,
+			RevCache:     revCache,
+			DRKeyClient:  drkeyClientEngine,
+			PathNotifier: pathNotifier,
 		},
-	))
+	)
+	sdpb.RegisterDaemonServiceServer(server, sdServer)
+	// Revocations pushed by the control service of the AS.
+	cppb.RegisterRevocationServiceServer(server, revgrpc.RevocationServer{
+		Verifier: createVerifier(),
+		Handler:  sdServer,
+	})
+	// TRC announcements pushed by the control service of the AS.
+	cppb.RegisterTRCAnnouncementServiceServer(server, trustgrpc.TRCAnnouncementServer{
+		Notifier: engine,
+	})
 
 	promgrpc.Register(server)
 
@@ -320,7 +339,9 @@ func realMain(ctx context.Context) error {
 			},
 			CPPKIServer: cppkiapi.Server{
 				TrustDB: trustDB,
+				Expiry:  expiryChecker,
 			},
+			RevCache: revCache,
 			Config:   service.NewConfigStatusPage(globalCfg).Handler,
 			Info:     service.NewInfoStatusPage().Handler,
 			LogLevel: service.NewLogLevelStatusPage().Handler,
@@ -25,6 +25,7 @@ import (
 
 	opentracing "github.com/opentracing/opentracing-go"
 	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/resolver"
 
 	"github.com/scionproto/scion/daemon/drkey"
 	"github.com/scionproto/scion/daemon/fetcher"
@@ -107,6 +108,26 @@ func TrustEngine(
 	}, nil
 }
 
+// ControlServiceDialer returns a dialer that connects to the control services of the AS over TCP.
+func ControlServiceDialer(
+	topo interface{ ControlServiceAddresses() []*net.UDPAddr },
+) *libgrpc.TCPDialer {
+
+	return &libgrpc.TCPDialer{
+		SvcResolver: func(dst addr.SVC) []resolver.Address {
+			if base := dst.Base(); base != addr.SvcCS {
+				panic("unsupported address type, possible implementation error: " +
+					base.String())
+			}
+			targets := []resolver.Address{}
+			for _, entry := range topo.ControlServiceAddresses() {
+				targets = append(targets, resolver.Address{Addr: entry.String()})
+			}
+			return targets
+		},
+	}
+}
+
 // ServerConfig is the configuration for the daemon API server.
 type ServerConfig struct {
 	IA          addr.IA
@@ -116,6 +137,9 @@ type ServerConfig struct {
 	Engine      trust.Engine
 	Topology    servers.Topology
 	DRKeyClient *drkey.ClientEngine
+	// PathNotifier wakes up the path watches. It should also be notified by the fetcher when it
+	// stores segments, see fetcher.FetcherConfig.OnStore.
+	PathNotifier *servers.PathNotifier
 }
 
 // NewServer constructs a daemon API server.
@@ -126,11 +150,12 @@ func NewServer(cfg ServerConfig) *servers.DaemonServer {
 		// TODO(JordiSubira): This will be changed in the future to fetch
 		// the information from the CS instead of feeding the configuration
 		// file into.
-		Topology:    cfg.Topology,
-		Fetcher:     cfg.Fetcher,
-		ASInspector: cfg.Engine.Inspector,
-		RevCache:    cfg.RevCache,
-		DRKeyClient: cfg.DRKeyClient,
+		Topology:     cfg.Topology,
+		Fetcher:      cfg.Fetcher,
+		ASInspector:  cfg.Engine.Inspector,
+		RevCache:     cfg.RevCache,
+		DRKeyClient:  cfg.DRKeyClient,
+		PathNotifier: cfg.PathNotifier,
 		Metrics: servers.Metrics{
 			PathsRequests: servers.RequestMetrics{
 				Requests: metrics.NewPromCounterFrom(prometheus.CounterOpts{
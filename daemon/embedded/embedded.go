@@ -0,0 +1,290 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package embedded runs the SCION daemon in-process, for end hosts without a daemon process,
+// e.g., containers and mobile clients. The embedded daemon keeps the path segments and the trust
+// material in memory, and talks to the control service of the AS directly.
+//
+// Applications switch between a daemon process and the embedded daemon by the configuration of
+// the Service: with Daemon set, Connect connects to the daemon process at that address, and
+// otherwise it starts the embedded daemon.
+//
+//	conn, err := embedded.Service{
+//		Daemon:    cfg.Daemon, // e.g., daemon.DefaultAPIAddress, or empty to embed
+//		Discovery: "192.0.2.1:31000",
+//		ConfigDir: "/etc/scion",
+//	}.Connect(ctx)
+package embedded
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	sd "github.com/scionproto/scion/daemon"
+	"github.com/scionproto/scion/daemon/config"
+	"github.com/scionproto/scion/daemon/fetcher"
+	"github.com/scionproto/scion/daemon/internal/servers"
+	"github.com/scionproto/scion/pkg/daemon"
+	libgrpc "github.com/scionproto/scion/pkg/grpc"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/private/util"
+	sdpb "github.com/scionproto/scion/pkg/proto/daemon"
+	"github.com/scionproto/scion/private/discovery"
+	"github.com/scionproto/scion/private/periodic"
+	"github.com/scionproto/scion/private/revcache"
+	segfetchergrpc "github.com/scionproto/scion/private/segment/segfetcher/grpc"
+	"github.com/scionproto/scion/private/storage"
+	"github.com/scionproto/scion/private/topology"
+	"github.com/scionproto/scion/private/trust"
+	"github.com/scionproto/scion/private/trust/compat"
+)
+
+const (
+	// DefaultTopologyRefreshInterval is the default interval at which the topology is fetched
+	// again from the discovery service.
+	DefaultTopologyRefreshInterval = 5 * time.Minute
+
+	// inMemoryDB is the connection of the in-memory databases. Each database has a single
+	// connection, and thus its own memory.
+	inMemoryDB = "file::memory:"
+	// fetchTimeout bounds a topology fetch.
+	fetchTimeout = 10 * time.Second
+)
+
+// Service runs an embedded SCION daemon. Connect starts the daemon and returns a connector to it,
+// like daemon.Service does for a daemon process. Closing the connector stops the daemon.
+type Service struct {
+	// Daemon is the address of a daemon process. If it is set, Connect connects to the daemon
+	// process, like daemon.Service, and no embedded daemon is started. The other fields, except
+	// Metrics, are ignored then.
+	Daemon string
+	// Discovery is the TCP address of the discovery service of the AS, e.g., that of a control
+	// service. The topology of the AS is fetched from it, and fetched again periodically.
+	Discovery string
+	// Topology is the topology file of the AS. It is used instead of the discovery service if it
+	// is set.
+	Topology string
+	// ConfigDir is the directory with the certs subdirectory that contains the TRCs of the ISD.
+	// The TRCs are the only files the embedded daemon needs.
+	ConfigDir string
+	// TopologyRefreshInterval is the interval at which the topology is fetched again from the
+	// discovery service. If zero, DefaultTopologyRefreshInterval is used.
+	TopologyRefreshInterval time.Duration
+	// QueryInterval is the interval after which segments for a destination are fetched again.
+	// If zero, the default of the daemon is used.
+	QueryInterval time.Duration
+	// Metrics are the metric counters that are incremented when using the connector.
+	Metrics daemon.Metrics
+}
+
+// Connect starts the embedded daemon, or connects to the daemon process if Daemon is set. The
+// context bounds the startup only, e.g., the initial topology fetch.
+func (s Service) Connect(ctx context.Context) (daemon.Connector, error) {
+	if s.Daemon != "" {
+		return daemon.Service{Address: s.Daemon, Metrics: s.Metrics}.Connect(ctx)
+	}
+	runCtx, cancelF := context.WithCancel(context.Background())
+	var cleanup []func()
+	stop := func() {
+		cancelF()
+		for i := len(cleanup) - 1; i >= 0; i-- {
+			cleanup[i]()
+		}
+	}
+	conn, err := s.start(ctx, runCtx, &cleanup)
+	if err != nil {
+		stop()
+		return nil, err
+	}
+	return connector{Connector: conn, stop: stop}, nil
+}
+
+func (s Service) start(
+	ctx context.Context,
+	runCtx context.Context,
+	cleanup *[]func(),
+) (daemon.Connector, error) {
+
+	topo, err := s.loadTopology(ctx, runCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	revCache := storage.NewRevocationStorage()
+	*cleanup = append(*cleanup, func() { revCache.Close() })
+	//nolint:staticcheck // SA1019: fix later (https://github.com/scionproto/scion/issues/4776).
+	rcCleaner := periodic.Start(revcache.NewCleaner(revCache, "sd_revocation"),
+		10*time.Second, 10*time.Second)
+	*cleanup = append(*cleanup, rcCleaner.Stop)
+
+	pathDB, err := storage.NewPathStorage(storage.DBConfig{Connection: inMemoryDB})
+	if err != nil {
+		return nil, serrors.Wrap("initializing path storage", err)
+	}
+	*cleanup = append(*cleanup, func() { pathDB.Close() })
+
+	trustDB, err := storage.NewTrustStorage(storage.DBConfig{Connection: inMemoryDB})
+	if err != nil {
+		return nil, serrors.Wrap("initializing trust database", err)
+	}
+	*cleanup = append(*cleanup, func() { trustDB.Close() })
+
+	dialer := sd.ControlServiceDialer(topo)
+	engine, err := sd.TrustEngine(ctx, s.ConfigDir, topo.IA(), trustDB, dialer)
+	if err != nil {
+		return nil, serrors.Wrap("creating trust engine", err)
+	}
+
+	sdCfg := config.SDConfig{QueryInterval: util.DurWrap{Duration: s.QueryInterval}}
+	sdCfg.InitDefaults()
+	pathNotifier := &servers.PathNotifier{}
+	// The server is built without metrics, so that several embedded daemons can run in the same
+	// process.
+	sdServer := &servers.DaemonServer{
+		IA:       topo.IA(),
+		MTU:      topo.MTU(),
+		Topology: topo,
+		Fetcher: fetcher.NewFetcher(fetcher.FetcherConfig{
+			IA:         topo.IA(),
+			MTU:        topo.MTU(),
+			Core:       topo.Core(),
+			NextHopper: topo,
+			RPC:        &segfetchergrpc.Requester{Dialer: dialer},
+			PathDB:     pathDB,
+			Inspector:  engine,
+			Verifier:   compat.Verifier{Verifier: trust.Verifier{Engine: engine}},
+			RevCache:   revCache,
+			Cfg:        sdCfg,
+			OnStore:    pathNotifier.Notify,
+		}),
+		ASInspector:  engine.Inspector,
+		RevCache:     revCache,
+		PathNotifier: pathNotifier,
+	}
+
+	// The connector talks to the server over an in-memory connection, so that it behaves
+	// exactly like the connector to a daemon process.
+	server := grpc.NewServer(
+		libgrpc.UnaryServerInterceptor(),
+		libgrpc.StreamServerInterceptor(),
+	)
+	sdpb.RegisterDaemonServiceServer(server, sdServer)
+	listener := bufconn.Listen(1 << 20)
+	go func() {
+		defer log.HandlePanic()
+		if err := server.Serve(listener); err != nil {
+			log.Info("Embedded daemon stopped serving", "err", err)
+		}
+	}()
+	*cleanup = append(*cleanup, server.Stop)
+
+	conn, err := daemon.Service{
+		Address: "passthrough:///embedded",
+		Metrics: s.Metrics,
+		Dialer: func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		},
+	}.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	*cleanup = append(*cleanup, func() { conn.Close() })
+	return conn, nil
+}
+
+// loadTopology loads the topology from the topology file, or fetches it from the discovery
+// service. A fetched topology is refreshed until runCtx is canceled.
+func (s Service) loadTopology(ctx, runCtx context.Context) (*topology.Loader, error) {
+	if s.Topology != "" {
+		loader, err := topology.NewLoader(topology.LoaderCfg{
+			File:      s.Topology,
+			Validator: &topology.DefaultValidator{},
+		})
+		if err != nil {
+			return nil, serrors.Wrap("loading topology", err)
+		}
+		return loader, nil
+	}
+	if s.Discovery == "" {
+		return nil, serrors.New("neither topology file nor discovery service configured")
+	}
+	discoveryAddr, err := net.ResolveTCPAddr("tcp", s.Discovery)
+	if err != nil {
+		return nil, serrors.Wrap("resolving discovery service address", err,
+			"addr", s.Discovery)
+	}
+	topoFetcher := discovery.TopologyFetcher{
+		Dialer:  libgrpc.SimpleDialer{},
+		Address: discoveryAddr,
+	}
+	// The first fetch is bounded by ctx, the refreshes by runCtx.
+	fetchCtx := ctx
+	reload := make(chan struct{})
+	loader, err := topology.NewLoader(topology.LoaderCfg{
+		Source: func() ([]byte, error) {
+			ctx, cancelF := context.WithTimeout(fetchCtx, fetchTimeout)
+			defer cancelF()
+			return topoFetcher.Fetch(ctx)
+		},
+		Reload:    reload,
+		Validator: &topology.DefaultValidator{},
+	})
+	if err != nil {
+		return nil, serrors.Wrap("fetching topology", err, "discovery", s.Discovery)
+	}
+	fetchCtx = runCtx
+
+	interval := s.TopologyRefreshInterval
+	if interval == 0 {
+		interval = DefaultTopologyRefreshInterval
+	}
+	go func() {
+		defer log.HandlePanic()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				select {
+				case reload <- struct{}{}:
+				case <-runCtx.Done():
+					return
+				}
+			case <-runCtx.Done():
+				return
+			}
+		}
+	}()
+	go func() {
+		defer log.HandlePanic()
+		loader.Run(runCtx)
+	}()
+	return loader, nil
+}
+
+// connector is the connector to an embedded daemon. Close also stops the daemon.
+type connector struct {
+	daemon.Connector
+	stop func()
+}
+
+func (c connector) Close() error {
+	c.stop()
+	return nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embedded_test
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/scionproto/scion/daemon/embedded"
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/daemon"
+	sdpb "github.com/scionproto/scion/pkg/proto/daemon"
+	dpb "github.com/scionproto/scion/pkg/proto/discovery"
+	"github.com/scionproto/scion/private/discovery"
+)
+
+const testTopology = `{
+  "isd_as": "1-ff00:0:110",
+  "mtu": 1472,
+  "dispatched_ports": "31000-32767",
+  "control_service": {
+    "cs1-ff00:0:110-1": {"addr": "127.0.0.11:31002"}
+  },
+  "border_routers": {
+    "br1-ff00:0:110-1": {
+      "internal_addr": "127.0.0.9:31004",
+      "interfaces": {
+        "1": {
+          "underlay": {"local": "127.0.0.4:50000", "remote": "127.0.0.5:50000"},
+          "isd_as": "1-ff00:0:111",
+          "link_to": "child",
+          "mtu": 1280
+        }
+      }
+    }
+  }
+}`
+
+type testTopologyInformation struct {
+	discovery.TopologyInformation
+}
+
+func (testTopologyInformation) TopologyJSON() ([]byte, error) {
+	return []byte(testTopology), nil
+}
+
+type testDaemonServer struct {
+	sdpb.UnimplementedDaemonServiceServer
+}
+
+func (*testDaemonServer) PortRange(
+	context.Context, *emptypb.Empty) (*sdpb.PortRangeResponse, error) {
+
+	return &sdpb.PortRangeResponse{DispatchedPortStart: 40000, DispatchedPortEnd: 40010}, nil
+}
+
+func TestConnect(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	dpb.RegisterDiscoveryServiceServer(server, discovery.Topology{
+		Information:   testTopologyInformation{},
+		ServeTopology: true,
+	})
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	configDir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(configDir, "certs"), 0755))
+
+	ctx, cancelF := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelF()
+
+	t.Run("discovery", func(t *testing.T) {
+		conn, err := embedded.Service{
+			Discovery: listener.Addr().String(),
+			ConfigDir: configDir,
+		}.Connect(ctx)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		start, end, err := conn.PortRange(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, uint16(31000), start)
+		assert.Equal(t, uint16(32767), end)
+
+		intfs, err := conn.Interfaces(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[uint16]netip.AddrPort{
+			1: netip.MustParseAddrPort("127.0.0.9:31004"),
+		}, intfs)
+
+		// Paths within the AS need no segments.
+		ia := addr.MustParseIA("1-ff00:0:110")
+		paths, err := conn.Paths(ctx, ia, ia, daemon.PathReqFlags{})
+		require.NoError(t, err)
+		assert.Len(t, paths, 1)
+	})
+	t.Run("topology file", func(t *testing.T) {
+		topoFile := filepath.Join(t.TempDir(), "topology.json")
+		require.NoError(t, os.WriteFile(topoFile, []byte(testTopology), 0644))
+		conn, err := embedded.Service{
+			Topology:  topoFile,
+			ConfigDir: configDir,
+		}.Connect(ctx)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		start, end, err := conn.PortRange(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, uint16(31000), start)
+		assert.Equal(t, uint16(32767), end)
+	})
+	t.Run("daemon process", func(t *testing.T) {
+		daemonListener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		daemonServer := grpc.NewServer()
+		sdpb.RegisterDaemonServiceServer(daemonServer, &testDaemonServer{})
+		go func() { _ = daemonServer.Serve(daemonListener) }()
+		defer daemonServer.Stop()
+
+		// Without a topology or discovery service, only the daemon process can answer.
+		conn, err := embedded.Service{Daemon: daemonListener.Addr().String()}.Connect(ctx)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		start, end, err := conn.PortRange(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, uint16(40000), start)
+		assert.Equal(t, uint16(40010), end)
+	})
+	t.Run("no discovery service", func(t *testing.T) {
+		_, err := embedded.Service{ConfigDir: configDir}.Connect(ctx)
+		assert.Error(t, err)
+	})
+	t.Run("missing TRC directory", func(t *testing.T) {
+		_, err := embedded.Service{
+			Discovery: listener.Addr().String(),
+			ConfigDir: t.TempDir(),
+		}.Connect(ctx)
+		assert.Error(t, err)
+	})
+}
@@ -23,7 +23,9 @@ import (
 
 	"github.com/scionproto/scion/daemon/config"
 	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/ctrl/path_mgmt"
 	"github.com/scionproto/scion/pkg/private/serrors"
+	seg "github.com/scionproto/scion/pkg/segment"
 	"github.com/scionproto/scion/pkg/snet"
 	"github.com/scionproto/scion/private/pathdb"
 	"github.com/scionproto/scion/private/revcache"
@@ -65,9 +67,20 @@ type FetcherConfig struct {
 	Verifier infra.Verifier
 	RevCache revcache.RevCache
 	Cfg      config.SDConfig
+
+	// OnStore is called after new or updated segments, or revocations, are stored. It may be
+	// nil.
+	OnStore func()
 }
 
 func NewFetcher(cfg FetcherConfig) Fetcher {
+	var storage seghandler.Storage = &seghandler.DefaultStorage{
+		PathDB:   cfg.PathDB,
+		RevCache: cfg.RevCache,
+	}
+	if cfg.OnStore != nil {
+		storage = notifyingStorage{Storage: storage, notify: cfg.OnStore}
+	}
 	return &fetcher{
 		pather: segfetcher.Pather{
 			IA:         cfg.IA,
@@ -84,16 +97,15 @@ func NewFetcher(cfg FetcherConfig) Fetcher {
 				),
 				ReplyHandler: &seghandler.Handler{
 					Verifier: &seghandler.DefaultVerifier{Verifier: cfg.Verifier},
-					Storage: &seghandler.DefaultStorage{
-						PathDB:   cfg.PathDB,
-						RevCache: cfg.RevCache,
-					},
+					Storage:  storage,
 				},
 				Requester: &segfetcher.DefaultRequester{
 					RPC:         cfg.RPC,
 					DstProvider: &dstProvider{},
 				},
-				Metrics: segfetcher.NewFetcherMetrics("sd"),
+				NegativeCacheTTL: segfetcher.DefaultNegativeCacheTTL,
+				Metrics:          segfetcher.NewFetcherMetrics("sd"),
+				RequestMetrics:   segfetcher.NewMetrics("sd"),
 			},
 			Splitter: &segfetcher.MultiSegmentSplitter{
 				LocalIA:   cfg.IA,
@@ -122,6 +134,32 @@ func (f *fetcher) GetPaths(ctx context.Context, src, dst addr.IA,
 	return f.pather.GetPaths(ctx, dst, refresh)
 }
 
+// notifyingStorage calls notify whenever segments or revocations are stored.
+type notifyingStorage struct {
+	seghandler.Storage
+	notify func()
+}
+
+func (s notifyingStorage) StoreSegs(
+	ctx context.Context,
+	segs []*seg.Meta,
+) (seghandler.SegStats, error) {
+
+	stats, err := s.Storage.StoreSegs(ctx, segs)
+	if stats.Total() > 0 {
+		s.notify()
+	}
+	return stats, err
+}
+
+func (s notifyingStorage) StoreRevs(ctx context.Context, revs []*path_mgmt.RevInfo) error {
+	err := s.Storage.StoreRevs(ctx, revs)
+	if err == nil && len(revs) > 0 {
+		s.notify()
+	}
+	return err
+}
+
 type dstProvider struct {
 }
 
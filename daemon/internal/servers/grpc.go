@@ -36,6 +36,7 @@ import (
 	"github.com/scionproto/scion/pkg/private/prom"
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/pkg/private/util"
+	cryptopb "github.com/scionproto/scion/pkg/proto/crypto"
 	"github.com/scionproto/scion/pkg/proto/daemon"
 	"github.com/scionproto/scion/pkg/segment/iface"
 	"github.com/scionproto/scion/pkg/snet"
@@ -62,10 +63,15 @@ type DaemonServer struct {
 	ASInspector trust.Inspector
 	DRKeyClient *drkey_daemon.ClientEngine
 
+	// PathNotifier wakes up the path watches. Notify is called when revocations are
+	// inserted; the fetcher must call it when it stores segments.
+	PathNotifier *PathNotifier
+
 	Metrics Metrics
 
 	foregroundPathDedupe singleflight.Group
 	backgroundPathDedupe singleflight.Group
+	watchPathDedupe      singleflight.Group
 }
 
 // Paths serves the paths request.
@@ -350,9 +356,42 @@ func (s *DaemonServer) notifyInterfaceDown(ctx context.Context,
 			result: prom.ErrDB,
 		}
 	}
+	s.PathNotifier.Notify()
 	return &daemon.NotifyInterfaceDownResponse{}, nil
 }
 
+// HandleRevocation inserts a verified revocation that was pushed by a control
+// service into the revocation cache. Paths over the revoked interface are no
+// longer returned from then on.
+func (s *DaemonServer) HandleRevocation(ctx context.Context,
+	rev *path_mgmt.RevInfo,
+	_ *cryptopb.SignedMessage,
+	_ addr.IA,
+) error {
+	start := time.Now()
+	err := s.handleRevocation(ctx, rev)
+	s.Metrics.InterfaceDownNotifications.inc(
+		ifDownLabels{Result: errToMetricResult(err), Src: "control_service"},
+		time.Since(start).Seconds(),
+	)
+	return unwrapMetricsError(err)
+}
+
+func (s *DaemonServer) handleRevocation(ctx context.Context, rev *path_mgmt.RevInfo) error {
+	inserted, err := s.RevCache.Insert(ctx, rev)
+	if err != nil {
+		return metricsError{
+			err:    serrors.Wrap("inserting revocation", err),
+			result: prom.ErrDB,
+		}
+	}
+	if inserted {
+		log.FromCtx(ctx).Info("Received revocation", "revocation", rev)
+		s.PathNotifier.Notify()
+	}
+	return nil
+}
+
 // PortRange returns the port range for the dispatched ports.
 func (s *DaemonServer) PortRange(
 	_ context.Context,
@@ -0,0 +1,186 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servers
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/proto/daemon"
+	"github.com/scionproto/scion/pkg/snet"
+	"github.com/scionproto/scion/private/path/pathpol"
+)
+
+// PathWatchRefreshInterval is the interval at which path watches look up their paths, even if
+// they were not notified of a change. Looking up the paths fetches segments if the cached ones
+// are outdated.
+const PathWatchRefreshInterval = time.Minute
+
+// PathNotifier wakes up the path watches when something happened that may change their paths,
+// e.g., segments were stored or an interface was revoked. The zero value is ready to use, and a
+// nil PathNotifier never notifies.
+type PathNotifier struct {
+	mtx sync.Mutex
+	ch  chan struct{}
+}
+
+// Notify wakes up all path watches.
+func (n *PathNotifier) Notify() {
+	if n == nil {
+		return
+	}
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	if n.ch != nil {
+		close(n.ch)
+		n.ch = nil
+	}
+}
+
+// changed returns a channel that is closed by the next notification.
+func (n *PathNotifier) changed() <-chan struct{} {
+	if n == nil {
+		return nil
+	}
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	if n.ch == nil {
+		n.ch = make(chan struct{})
+	}
+	return n.ch
+}
+
+// WatchPaths serves the path watch request. It sends the current paths, and then the changes to
+// them, until the client cancels the request.
+func (s *DaemonServer) WatchPaths(
+	req *daemon.WatchPathsRequest,
+	stream daemon.DaemonService_WatchPathsServer,
+) error {
+	ctx := stream.Context()
+	srcIA, dstIA := addr.IA(req.SourceIsdAs), addr.IA(req.DestinationIsdAs)
+	var policy *pathpol.Policy
+	if len(req.Policy) > 0 {
+		policy = &pathpol.Policy{}
+		if err := json.Unmarshal(req.Policy, policy); err != nil {
+			return serrors.Wrap("parsing path policy", err)
+		}
+	}
+	logger := log.FromCtx(ctx)
+	current := make(map[snet.PathFingerprint]snet.Path)
+	for {
+		// Get the channel before looking up the paths, so that no change is missed.
+		changed := s.PathNotifier.changed()
+		now := time.Now()
+		paths, err := s.watchedPaths(ctx, srcIA, dstIA, policy)
+		if err != nil {
+			// Keep the paths we have, minus the expired ones, until the lookup succeeds again.
+			logger.Debug("Fetching watched paths", "err", err, "src", srcIA, "dst", dstIA)
+			paths = slices.Collect(maps.Values(current))
+		}
+		added, removed := diffPaths(current, paths, now)
+		for _, p := range added {
+			if err := stream.Send(&daemon.WatchPathsResponse{
+				Type: daemon.PathEventType_PATH_EVENT_TYPE_ADDED,
+				Path: pathToPB(p),
+			}); err != nil {
+				return err
+			}
+		}
+		for _, p := range removed {
+			if err := stream.Send(&daemon.WatchPathsResponse{
+				Type: daemon.PathEventType_PATH_EVENT_TYPE_REMOVED,
+				Path: pathToPB(p),
+			}); err != nil {
+				return err
+			}
+		}
+
+		wait := PathWatchRefreshInterval
+		for _, p := range current {
+			wait = min(wait, time.Until(p.Metadata().Expiry))
+		}
+		timer := time.NewTimer(max(wait, 0))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-changed:
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+}
+
+func (s *DaemonServer) watchedPaths(
+	ctx context.Context,
+	src, dst addr.IA,
+	policy *pathpol.Policy,
+) ([]snet.Path, error) {
+
+	ctx, cancelF := context.WithTimeout(ctx, 10*time.Second)
+	defer cancelF()
+	paths, err := s.fetchPaths(ctx, &s.watchPathDedupe, src, dst, false)
+	if err != nil {
+		return nil, err
+	}
+	return policy.Filter(paths), nil
+}
+
+// diffPaths updates current to the paths in next that have not expired. It returns the paths
+// that were added, or that replace a path with the same fingerprint but a different expiration,
+// and the paths that were removed.
+func diffPaths(
+	current map[snet.PathFingerprint]snet.Path,
+	next []snet.Path,
+	now time.Time,
+) (added, removed []snet.Path) {
+
+	nextSet := make(map[snet.PathFingerprint]snet.Path, len(next))
+	for _, p := range next {
+		if !p.Metadata().Expiry.After(now) {
+			continue
+		}
+		fp := snet.Fingerprint(p)
+		if _, ok := nextSet[fp]; ok {
+			continue
+		}
+		nextSet[fp] = p
+		if old, ok := current[fp]; !ok || !old.Metadata().Expiry.Equal(p.Metadata().Expiry) {
+			added = append(added, p)
+		}
+	}
+	fps := make([]snet.PathFingerprint, 0, len(current))
+	for fp := range current {
+		if _, ok := nextSet[fp]; !ok {
+			fps = append(fps, fp)
+		}
+	}
+	slices.Sort(fps)
+	for _, fp := range fps {
+		removed = append(removed, current[fp])
+		delete(current, fp)
+	}
+	for fp, p := range nextSet {
+		current[fp] = p
+	}
+	return added, removed
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/segment/iface"
+	"github.com/scionproto/scion/pkg/snet"
+	snetpath "github.com/scionproto/scion/pkg/snet/path"
+)
+
+func testPath(expiry time.Time, ifIDs ...uint16) snet.Path {
+	ia := addr.MustParseIA("1-ff00:0:110")
+	var intfs []snet.PathInterface
+	for _, id := range ifIDs {
+		intfs = append(intfs, snet.PathInterface{IA: ia, ID: iface.ID(id)})
+	}
+	return snetpath.Path{Meta: snet.PathMetadata{Interfaces: intfs, Expiry: expiry}}
+}
+
+func TestDiffPaths(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Hour)
+	p1, p2, p3 := testPath(later, 1, 2), testPath(later, 3, 4), testPath(later, 5, 6)
+	current := make(map[snet.PathFingerprint]snet.Path)
+
+	added, removed := diffPaths(current, []snet.Path{p1, p2, p1}, now)
+	assert.Equal(t, []snet.Path{p1, p2}, added)
+	assert.Empty(t, removed)
+	assert.Len(t, current, 2)
+
+	// Nothing changed.
+	added, removed = diffPaths(current, []snet.Path{p2, p1}, now)
+	assert.Empty(t, added)
+	assert.Empty(t, removed)
+
+	// p1 is refreshed, p2 is gone, p3 is new.
+	p1Refreshed := testPath(later.Add(time.Hour), 1, 2)
+	added, removed = diffPaths(current, []snet.Path{p1Refreshed, p3}, now)
+	assert.Equal(t, []snet.Path{p1Refreshed, p3}, added)
+	assert.Equal(t, []snet.Path{p2}, removed)
+	assert.Len(t, current, 2)
+
+	// Expired paths are removed, even if they are still looked up.
+	added, removed = diffPaths(current, []snet.Path{p1Refreshed, p3}, later)
+	assert.Empty(t, added)
+	assert.Equal(t, []snet.Path{p3}, removed)
+	assert.Equal(t, map[snet.PathFingerprint]snet.Path{
+		snet.Fingerprint(p1): p1Refreshed,
+	}, current)
+}
+
+func TestPathNotifier(t *testing.T) {
+	var n PathNotifier
+	ch := n.changed()
+	assert.Equal(t, ch, n.changed())
+	select {
+	case <-ch:
+		t.Fatal("notified before Notify")
+	default:
+	}
+	n.Notify()
+	<-ch
+	assert.NotEqual(t, ch, n.changed())
+
+	var nilNotifier *PathNotifier
+	nilNotifier.Notify()
+	assert.Nil(t, nilNotifier.changed())
+}
@@ -15,16 +15,25 @@
 package mgmtapi
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
+	"sort"
 
+	"github.com/scionproto/scion/pkg/private/ctrl/path_mgmt"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	api "github.com/scionproto/scion/private/mgmtapi"
 	cppkiapi "github.com/scionproto/scion/private/mgmtapi/cppki/api"
 	segapi "github.com/scionproto/scion/private/mgmtapi/segments/api"
+	"github.com/scionproto/scion/private/pathdb/query"
+	"github.com/scionproto/scion/private/revcache"
 )
 
 // Server implements the SCION Daemon Service API.
 type Server struct {
 	SegmentsServer segapi.Server
 	CPPKIServer    cppkiapi.Server
+	RevCache       revcache.RevCache
 	Config         http.HandlerFunc
 	Info           http.HandlerFunc
 	LogLevel       http.HandlerFunc
@@ -124,3 +133,100 @@ func (s *Server) GetTrc(w http.ResponseWriter, r *http.Request, isd int, base in
 func (s *Server) GetTrcBlob(w http.ResponseWriter, r *http.Request, isd int, base int, serial int) {
 	s.CPPKIServer.GetTrcBlob(w, r, isd, base, serial) // nolint - name from published API
 }
+
+// GetTrustHealth reports the expiration state of the trust material.
+func (s *Server) GetTrustHealth(w http.ResponseWriter, r *http.Request) {
+	s.CPPKIServer.GetTrustHealth(w, r)
+}
+
+// GetRevocations lists the active revocations and the path segments that are
+// affected by them.
+func (s *Server) GetRevocations(w http.ResponseWriter, r *http.Request) {
+	revs, err := s.activeRevocations(r.Context())
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "error getting revocations",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	rep := make([]Revocation, 0, len(revs))
+	for _, rev := range revs {
+		res, err := s.SegmentsServer.Segments.Get(r.Context(), &query.Params{
+			Intfs: []*query.IntfSpec{{IA: rev.IA(), IfID: rev.IfID}},
+		})
+		if err != nil {
+			ErrorResponse(w, Problem{
+				Detail: api.StringRef(serrors.Wrap("getting affected segments", err,
+					"revocation", rev).Error()),
+				Status: http.StatusInternalServerError,
+				Title:  "error getting segments",
+				Type:   api.StringRef(api.InternalError),
+			})
+			return
+		}
+		affected := make([]SegmentID, 0, len(res))
+		for _, segRes := range res {
+			affected = append(affected, segapi.SegID(segRes.Seg))
+		}
+		sort.Strings(affected)
+		rep = append(rep, Revocation{
+			IsdAs:            rev.IA().String(),
+			InterfaceId:      int(rev.IfID),
+			LinkType:         RevocationLinkType(rev.LinkType.String()),
+			Timestamp:        rev.Timestamp().UTC(),
+			Expiration:       rev.Expiration().UTC(),
+			AffectedSegments: affected,
+		})
+	}
+	sort.Slice(rep, func(i, j int) bool {
+		if rep[i].IsdAs != rep[j].IsdAs {
+			return rep[i].IsdAs < rep[j].IsdAs
+		}
+		return rep[i].InterfaceId < rep[j].InterfaceId
+	})
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+func (s *Server) activeRevocations(ctx context.Context) ([]*path_mgmt.RevInfo, error) {
+	results, err := s.RevCache.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The channel must be drained completely, even in case of an error.
+	var revs []*path_mgmt.RevInfo
+	var errs serrors.List
+	for res := range results {
+		if res.Err != nil {
+			errs = append(errs, res.Err)
+			continue
+		}
+		if res.Rev.Active() == nil {
+			revs = append(revs, res.Rev)
+		}
+	}
+	return revs, errs.ToError()
+}
+
+// ErrorResponse creates an detailed error response.
+func ErrorResponse(w http.ResponseWriter, p Problem) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(p.Status)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	// no point in catching error here, there is nothing we can do about it anymore.
+	_ = enc.Encode(p)
+}
@@ -112,6 +112,9 @@ type ClientInterface interface {
 
 	SetLogLevel(ctx context.Context, body SetLogLevelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetRevocations request
+	GetRevocations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetSegments request
 	GetSegments(ctx context.Context, params *GetSegmentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -132,6 +135,9 @@ type ClientInterface interface {
 
 	// GetTrcBlob request
 	GetTrcBlob(ctx context.Context, isd int, base int, serial int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTrustHealth request
+	GetTrustHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) GetCertificates(ctx context.Context, params *GetCertificatesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -230,6 +236,18 @@ func (c *Client) SetLogLevel(ctx context.Context, body SetLogLevelJSONRequestBod
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetRevocations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRevocationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetSegments(ctx context.Context, params *GetSegmentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetSegmentsRequest(c.Server, params)
 	if err != nil {
@@ -314,6 +332,18 @@ func (c *Client) GetTrcBlob(ctx context.Context, isd int, base int, serial int,
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetTrustHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTrustHealthRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewGetCertificatesRequest generates requests for GetCertificates
 func NewGetCertificatesRequest(server string, params *GetCertificatesParams) (*http.Request, error) {
 	var err error
@@ -584,6 +614,33 @@ func NewSetLogLevelRequestWithBody(server string, contentType string, body io.Re
 	return req, nil
 }
 
+// NewGetRevocationsRequest generates requests for GetRevocations
+func NewGetRevocationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/revocations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetSegmentsRequest generates requests for GetSegments
 func NewGetSegmentsRequest(server string, params *GetSegmentsParams) (*http.Request, error) {
 	var err error
@@ -912,6 +969,33 @@ func NewGetTrcBlobRequest(server string, isd int, base int, serial int) (*http.R
 	return req, nil
 }
 
+// NewGetTrustHealthRequest generates requests for GetTrustHealth
+func NewGetTrustHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trust/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -978,6 +1062,9 @@ type ClientWithResponsesInterface interface {
 
 	SetLogLevelWithResponse(ctx context.Context, body SetLogLevelJSONRequestBody, reqEditors ...RequestEditorFn) (*SetLogLevelResponse, error)
 
+	// GetRevocationsWithResponse request
+	GetRevocationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRevocationsResponse, error)
+
 	// GetSegmentsWithResponse request
 	GetSegmentsWithResponse(ctx context.Context, params *GetSegmentsParams, reqEditors ...RequestEditorFn) (*GetSegmentsResponse, error)
 
@@ -998,6 +1085,9 @@ type ClientWithResponsesInterface interface {
 
 	// GetTrcBlobWithResponse request
 	GetTrcBlobWithResponse(ctx context.Context, isd int, base int, serial int, reqEditors ...RequestEditorFn) (*GetTrcBlobResponse, error)
+
+	// GetTrustHealthWithResponse request
+	GetTrustHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTrustHealthResponse, error)
 }
 
 type GetCertificatesResponse struct {
@@ -1158,6 +1248,29 @@ func (r SetLogLevelResponse) StatusCode() int {
 	return 0
 }
 
+type GetRevocationsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Revocation
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRevocationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRevocationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetSegmentsResponse struct {
 	Body                      []byte
 	HTTPResponse              *http.Response
@@ -1317,6 +1430,29 @@ func (r GetTrcBlobResponse) StatusCode() int {
 	return 0
 }
 
+type GetTrustHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TrustHealth
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTrustHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTrustHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 // GetCertificatesWithResponse request returning *GetCertificatesResponse
 func (c *ClientWithResponses) GetCertificatesWithResponse(ctx context.Context, params *GetCertificatesParams, reqEditors ...RequestEditorFn) (*GetCertificatesResponse, error) {
 	rsp, err := c.GetCertificates(ctx, params, reqEditors...)
@@ -1388,6 +1524,15 @@ func (c *ClientWithResponses) SetLogLevelWithResponse(ctx context.Context, body
 	return ParseSetLogLevelResponse(rsp)
 }
 
+// GetRevocationsWithResponse request returning *GetRevocationsResponse
+func (c *ClientWithResponses) GetRevocationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRevocationsResponse, error) {
+	rsp, err := c.GetRevocations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRevocationsResponse(rsp)
+}
+
 // GetSegmentsWithResponse request returning *GetSegmentsResponse
 func (c *ClientWithResponses) GetSegmentsWithResponse(ctx context.Context, params *GetSegmentsParams, reqEditors ...RequestEditorFn) (*GetSegmentsResponse, error) {
 	rsp, err := c.GetSegments(ctx, params, reqEditors...)
@@ -1451,6 +1596,15 @@ func (c *ClientWithResponses) GetTrcBlobWithResponse(ctx context.Context, isd in
 	return ParseGetTrcBlobResponse(rsp)
 }
 
+// GetTrustHealthWithResponse request returning *GetTrustHealthResponse
+func (c *ClientWithResponses) GetTrustHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTrustHealthResponse, error) {
+	rsp, err := c.GetTrustHealth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTrustHealthResponse(rsp)
+}
+
 // ParseGetCertificatesResponse parses an HTTP response from a GetCertificatesWithResponse call
 func ParseGetCertificatesResponse(rsp *http.Response) (*GetCertificatesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1661,6 +1815,39 @@ func ParseSetLogLevelResponse(rsp *http.Response) (*SetLogLevelResponse, error)
 	return response, nil
 }
 
+// ParseGetRevocationsResponse parses an HTTP response from a GetRevocationsWithResponse call
+func ParseGetRevocationsResponse(rsp *http.Response) (*GetRevocationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRevocationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Revocation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetSegmentsResponse parses an HTTP response from a GetSegmentsWithResponse call
 func ParseGetSegmentsResponse(rsp *http.Response) (*GetSegmentsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1877,3 +2064,36 @@ func ParseGetTrcBlobResponse(rsp *http.Response) (*GetTrcBlobResponse, error) {
 
 	return response, nil
 }
+
+// ParseGetTrustHealthResponse parses an HTTP response from a GetTrustHealthWithResponse call
+func ParseGetTrustHealthResponse(rsp *http.Response) (*GetTrustHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTrustHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TrustHealth
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
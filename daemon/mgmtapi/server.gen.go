@@ -34,6 +34,9 @@ type ServerInterface interface {
 	// Set logging level
 	// (PUT /log/level)
 	SetLogLevel(w http.ResponseWriter, r *http.Request)
+	// List the active revocations
+	// (GET /revocations)
+	GetRevocations(w http.ResponseWriter, r *http.Request)
 	// List the SCION path segments
 	// (GET /segments)
 	GetSegments(w http.ResponseWriter, r *http.Request, params GetSegmentsParams)
@@ -55,6 +58,9 @@ type ServerInterface interface {
 	// Get the TRC blob
 	// (GET /trcs/isd{isd}-b{base}-s{serial}/blob)
 	GetTrcBlob(w http.ResponseWriter, r *http.Request, isd int, base int, serial int)
+	// Get the health of the trust material
+	// (GET /trust/health)
+	GetTrustHealth(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -103,6 +109,12 @@ func (_ Unimplemented) SetLogLevel(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List the active revocations
+// (GET /revocations)
+func (_ Unimplemented) GetRevocations(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List the SCION path segments
 // (GET /segments)
 func (_ Unimplemented) GetSegments(w http.ResponseWriter, r *http.Request, params GetSegmentsParams) {
@@ -145,6 +157,12 @@ func (_ Unimplemented) GetTrcBlob(w http.ResponseWriter, r *http.Request, isd in
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get the health of the trust material
+// (GET /trust/health)
+func (_ Unimplemented) GetTrustHealth(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -303,6 +321,20 @@ func (siw *ServerInterfaceWrapper) SetLogLevel(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
+// GetRevocations operation middleware
+func (siw *ServerInterfaceWrapper) GetRevocations(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRevocations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetSegments operation middleware
 func (siw *ServerInterfaceWrapper) GetSegments(w http.ResponseWriter, r *http.Request) {
 
@@ -534,6 +566,20 @@ func (siw *ServerInterfaceWrapper) GetTrcBlob(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
+// GetTrustHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetTrustHealth(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTrustHealth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -668,6 +714,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/log/level", wrapper.SetLogLevel)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/revocations", wrapper.GetRevocations)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/segments", wrapper.GetSegments)
 	})
@@ -689,6 +738,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/trcs/isd{isd}-b{base}-s{serial}/blob", wrapper.GetTrcBlob)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trust/health", wrapper.GetTrustHealth)
+	})
 
 	return r
 }
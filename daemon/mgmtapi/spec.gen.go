@@ -18,59 +18,74 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xaWXPjNhL+K11MHjYV6vKxifWmkTUTVeZwWUq2KrHXBZEtETMkwACgbK1X/30LAEnx",
-	"kkV5JrPO1k7Ng0UCjcaHrxt98NHxeBRzhkxJZ/joCJQxZxLNj1fEv8Y/EpRK//I4U8jMnySOQ+oRRTnr",
-	"fZSc6WfSCzAi+q9vBS6dofNNbye6Z9/K3kwR5hPhT4Tgwtlut67jo/QEjbUwZ6jXBJEuqt+mE7XcMQpF",
-	"l3pd1D9jwWP9xOrqU6koWyVUBujfMRKZMWoTozN0pBKUrZyt61Dp31lpT2k5lf5I6uEyWXxET919ws0d",
-	"CVdcT8QHEsWhFjsZX85GjltfpTiN+gcxsaN/xs30Us9ek5D6VG0Ozfs1G6dx0phRgb4z/L0Ji3znBfEN",
-	"26upfus6iiqz2wL8UDyzfP/czNQ7GAeEsvoZUSkTFIe2VTzmHZZHzargkYlwMw327MrTarfa2ytBtSq1",
-	"DR48azPbHnM7NKpUbD3+s1lE/R0ddtAVBBdQNHiA9ywsp5dlq1qS81PSPyOO6yy5iIhyhk6AD53UvJ46",
-	"uqmPTD8yetas8iceNxwZUyiWxMOSEmcn+Xw9YIXiaOdRRTMzv92CBfyuiApA4ipCpiDgcRNYVm4JqkFn",
-	"uez3h/3hYNB3XCcmSqHQXvSfNzf+952//U46y37n4vZx4J5th989nmzLj777tx73bQHT6eyyM5odAPIt",
-	"X73FNYZ1NMPscdmpv+WrFWUrsK9dB1kSGUeFi2RlMFlq34PmUrh1CztM31RUqGBrxd42YHYl+CLEqOG6",
-	"QEVog6YjCJKIMBBIfLIIEfAhDgkzVx3IGD1NOFAcVEAlcM9LhEDmIfAlqAAhtguCCogCKiHAMF4moZ4R",
-	"csPU4ijCfFjRNQLx11QLYRDwez04FtxD9LvwD0GVQgaUwYStQioDMyvXb8kFIFtRhiikC4lMSBhugHEF",
-	"MqEKfTOCcQYKvYBRj4QgFfmEAQ99FNJI06O1eiH9F/pdp3gAY84Yemb7ioNPFFkQiaBohD7wRDXxgzKp",
-	"CLMmVYX3l+spCFyiRc3ClJFNGnBylPei6wJ2V11YbID4vuYVgaUg1nhyYQK4AJksOrG2LXNihePZxNiF",
-	"d2QDC4REol85IMG5sotSmU+izOrHE+EheNzHMlS9dGDPyzHrGEp/o/gnZB3N5Y4+uI5Br2PRy31cImgn",
-	"R6YxqlBEJbIO6jxA+Gk+vwI7wGgGK2QoiD7/xcaozQVdUQYSxRqFIcXTFC7t7bx/6joReaCRNtzziwvX",
-	"iSizvwb9fpOzTD1KnQEy4EKTM4qI2NTsxhzMf5v0MxTGHn9hZE1oqNdsOhD7QO9wSZJQnyFZ8EQNFyFh",
-	"nxy3DfcTRv9IMNxUjaCIB3CmB1j2mSj8QRVwW1MffRhdTbvwIY55SuaiJVnvRRlcvx53fvix/4ML1Hgn",
-	"hlQFKECgx6MImW/nLnSAlylqANd4xZwypV8T6yM7+XH43Eu08dl1GBewCvnCHIndX0q3yjG3M54jTKQa",
-	"9ll7yajYdD/M7JVbvx/wIaaC2JN73CngE4XGepvoEPDYhhQKo4NRgg5Gcgo5RAiyMa7zcLZgVbYxZEik",
-	"uktirZbfXlH9XCoSxW2nNEWGOyFuEa2KTikqhVBnNp5+eA9xMeA5ECWmO94TcyPz747M6o4FGdlKBQ1R",
-	"jXmeWWK6mRKrB02OUSoi1N1nxZImMi+KcYsw5BrXAvRnY1+L0Rdn5/7ZmX8wRk/nHwgoy1WB+hFnj8v4",
-	"m9EQoZRkdZi0eXBZ32Mx/y5t88cLeHUBZxcwPoGT1/r/xRguL6F/CScjOP8BRhdwOYEfJ+bVObw+hf4F",
-	"DPpwOSgiI2Piod8pA1TFYH49ru+cJCrggmrPusY7ktZlWjmYnO1VF+Nx8aVElc6jqdpy0NDm1+MvVPQw",
-	"RlGobey26TbBWFa+YCnz6/Eho5hfj59dAEg3XFe+ZqztFLGULWuhI/Q7lkQLW1542h1R6bdIfSUKSsIm",
-	"oaf14fXU13FLSlXlVeBvcha7Tf9aYEp534yrO7JUFQWdk/7JSac/6PTP5v2L4fnF8PT0t6J5PnlXapkL",
-	"XHKBNaGDZwqtwFNYwS1soYBJtmOIUVDu10HZbtMcuuYjs0h2dDXNgzB7C1wSjCyrShezfazHa3NCIa2c",
-	"frffHWg8eIyMxNQZOqfdfvfEVh0CA3+vUP8xD1aoGm5NKpWNZE3eocINEE/bZb18JG2MTATCJ8bvWRrX",
-	"3jAdBAsemmSGetgFnQIJlEmowCNMB7BLGioUNv2xRY0uvE6EDncjLtC9YZyhGRwTKYFATISiXhISkUa6",
-	"OuCmEQJRcB9QL7BK73S8YamSWj/jeIBIoCxOVBdGsOA8RMIyffJAXXEQqBLBgIThDSti5oLAFRF+iFKm",
-	"YQUV6aHr3zoXMUTo3uiD09Q3QdfUd4bOG1TjIv76YASJUKGQzvD3R4dq9P9IUGjvaAvku6pUu+p9Ho40",
-	"SzMg3BFVktfOIpoFkjAsyUqnpdA62+2tW+5YnPT7R7UqWl1/hYpv7Q6sNzAMv3lDMdTcoGdPKpjmQN8f",
-	"11PJilwNykyZJWapo2Iz75Ip1nV1HUVWmjiOF8efqHOrp5YsvPdohnaov91r7G9wzwLGGRFT/GKQloEP",
-	"s3oPqbUH2pEm08opulklEmzL8rxG/9n0OrhK05nVytovjjd7T/U41vQWIV88gzrIPK49KZFwNXkHi41C",
-	"CVrW80j1Smvxoon10Ikx6ixpWIlBOvrfq8mb6XsYT67n09fT8Wg+MU9v2GhWJFK3271h5s3k/WXD6CdF",
-	"jUfHiHJaUNoc11+H11bdPeTmbElXBRrXuWZHHDxyhQ+qF4dp67R26+WXZW1Xs8TzUMplEsKHbPECuE1Y",
-	"5ar0Ck3+MhpXgjJlq5HzD+/egt1oYsXr+MpU8HJIeKTDSYtJFovuQ2Rquzp/LTxeEUk90FvTAY3GICYr",
-	"BFPyzUuzhajU9nCk3ItSyFe9vGG2D6q81/YnXkX5Gl8NS21pYaUpWMPIdeKkAZRZBRQj/xX3N18Fj6yV",
-	"WVx/dxNs/6dOadbmlDST02Jii6SvXoHck+Q15XayKbkzYxURyjQpkPkwmlVrsjBlMkbPqkCZT9fUT0i4",
-	"U8EGDjoxBNsZRh/WFO+7TbHDLNttLWioHIrRKu2o82Vjhbjawm/KgiqV3qNTtUq3EEVEGQnhCaVOMqVO",
-	"9ipVqjcfp9JXSdpKTYMj0raIKC/QhG9gavflZnAN2haMNX1UsdbeY/pXlsL5GKJq6Nlemud71oF7qmxt",
-	"xMbd2ePpZd14rKCs6XbAfOY7A4bpZd7wLCzdhekyNek4UbAmYYJApe0wo9COhQEpCMn6nh5nkvrGgxCI",
-	"BS7pg/EeJAx3BCg7KWJcg1bf1y6JSi0nkahdrvYe5l192oJI9IGztJaTeVOtii0qURthDU5MHpMpk26W",
-	"eKrgpiDLZvAhDrmPznBJQoluU66yO9lnZyulHphUG+MZJDUuosGGzxqKj03tJgPhSzAk1zn/2hooFNrz",
-	"zuw3GNk3sEWDftLUGi3afTp9LjzVrCK7z2vq8p+67erW+iJZ+OXCrWzfTdFWndeFtKD7YvPaw+3f9vdF",
-	"u+JNw4p7qzdPsa+5RvOXY2CLQs7VaP4TzCZv3k3ez9OCigHxiqgga6aXKzANM5xWnH3RNZh9+u4jqRJe",
-	"i/QjJAqlSoXPRSIVXHOuYFysbdh0AIkX6OB9T3pyfAuqCx+MPiQMN64JNebX4zylSdFAHyiTColp+JgP",
-	"vwp6c4ay0Uzmevft7KPeASoF8Hlw3fBFX6X7n9mC9nzOy27h5B37IzKBdNlFiOagup+fT+c01PL2lBM1",
-	"j3tU+o9U+tvO4lEHkNuOfLQN821Lj7uP2nuq4XPhtaqAW7Lsd6NPfkSQM6QsU2+wndBBa5kWrHZSm75f",
-	"+DPjivn1uIl18+tx98vU1VKCPY9fx1zr+0iWXe3ZTW8SG3PD72Vf6x7M/xn4zLhifj1Og4PfPo7uP3wc",
-	"/f3dfHI/rcQSu1FOI0WrMcPn03Rva8V+dLTOuJCI0Bk6gVLxsNd7DLhU2+FjzIXa9khMe+uB+fpLUO2v",
-	"DWJ6SPnjbPOxt3m8dR09tfz6dDA4P9GmeZtrU+X/2BQ9zcc0+GA/tV5sUmtIAwFzP2cNQ1sjrdfgJmsU",
-	"G2WqDAJD85W+4s0Vp2oke6S08dXVz1OIiDJ8LOpmcN7ebv8TAAD//yDXdgQCOgAA",
+	"H4sIAAAAAAAC/+xcX3PbOJL/KijuPtzWUn9jX2K9KbIzUW0ycdnavaod51QQ0RIxJgEOANrR+fTdrwDw",
+	"DyhCEu1kspmrnZoHiwQaje5fN7obzTwFEU8zzoApGUyeAgEy40yC+fEWkxv4LQep9K+IMwXM/ImzLKER",
+	"VpSzwa+SM/1MRjGkWP/1ZwHrYBL8aVCTHti3cnCrMCNYkCshuAh2u10YEJCRoJkmFkz0mkgUi+q3xURN",
+	"dwZC0bVeF/TPTPBMP7G8EioVZZucyhjIkuHUjFHbDIJJIJWgbBPswoBKssTyFJdzSaZSD5f56leI1PIe",
+	"tkucbLieCF9wmiWa7NXs8nYahO1V3GmUnJSJHf032M4v9ewHnFBC1fbUvH+U47SctMyoABJMfvHJotq5",
+	"Q96zvRbrn8NAUWV264gfuTqr9s/NTL2DWYwpa+uISpmDOLUtV821LJ81a08eJYmw5ODAriLNdqe9vRUU",
+	"1p4NntS1mW3V3E0a+1DsPP6rUURJELZF5xB2pGjkgaIXyXJ+2bSqNT5/hYdnOAiDNRcpVsEkiOFLrzCv",
+	"Y6qbE2D6EYh6tdoqr75kVGxvVeE/gOWp3qfZTxAGoF/bFcyfYNBf81WOK5c35IwPRFLTRHyNlMilQilW",
+	"IChO+j4u3vPMAxymQKxxBA1RnI2r+XrABsSzXdi+TksnUC/oaPEaqxhJ2KTAFIp55lOZpdtQ2Ki3Xg+H",
+	"k+FkNBoGYZBhpUCwYBL8990d+WvvP37BvfWwd/H5aRSe7SZ/eRrvmo/+8r963J8d0c5vL3vT2xPq/MA3",
+	"H+ABkrY0k/Jx82j5wDcbyjbIvg4rABBY5RsjkzXXj83R1FB98WaPhT3ZWrKfPTK7FnyVQOo5tEBh6uF0",
+	"iuI8xQwJwASvEkDwJUswK8CWQaRhjxRHKqYS8SjKhQAWWQjGgDK7IFIxVohKFEOSrfNEz0i4sRd3FGYE",
+	"begDIEweqCbCUMwf9eBM8AiA9NF/CaoUMEQZumKbhMrYzKr4W3OBgG0oAxAyRLnMcZJsEeMKyZwqIGYE",
+	"4wwpiGJGI5xoo7mHmCcEhDTU9GjNXkL/B0g/cBUw44xBZLavOCJY4RWWgBRNgSCeKx8+KJMKswh84v37",
+	"zRwJWIOVmhVTCTZphFNJ+aB0QwT9TR+ttggTonGF0VpgazwVMYG4QDJf9TJtW4q7BJBmuY8+4i1aAcol",
+	"kD0FCc6VXZTKahJllj+eiwhQxAk0RTUoBg6iSmY9A+k/KX4PrKex3NOK6xnp9az0Kk+bC9qrJOONbRRW",
+	"uWwLdREDer9YXCM7wHCGNsBAYK3/1dawzQXdUIYkiAcQBhTHIdzY2/nwVRik+AtNteGeX1yEQUqZ/TUa",
+	"Dn3OsvAobQTImAsNzjTFYtuyG6OYfzXob0EYe/w7ww+YJnpNn0LsA73DNc4TrUO84rmarBLM7oOwC/Zz",
+	"Rn/LIdnuG4ErD8RZsi3RZ3KBL8qR2wMlQND0et5Hn7KMF2B2Lcl6L8rQzbtZ7/Wb4esQUeOdGFAVg0AC",
+	"Ip6mwIiduwJEoGTUCFzLK+OUKf0aWx/Zq9RBeJRr47PrMC7QJuEroxK7vwJue2ruZjzPMJH94NPaSwlF",
+	"3/lwAw/ciqd9ROD1GiIFZFmcywcsb34pK2U4p7gsFWYe1n5TK10J/ABCFq4GHvg9EFTFBVouVEF6MtC4",
+	"tSvZmLbYGhYCb4NdEUhVO2uyfW00qfmjKSCs0GNMo7jipsCLoQCy7yqAYAXGe/ndfrGDIvNqLjq/LMXk",
+	"3XGFhNFXR15hkFB2v6ytsxGKUHZfWNUxbooAJeICTGAlgKkgDKKYJkQ/ABMZ5UyCagYs5Yi2s6ApSIXT",
+	"7KX6eMQSmVSAdFXJyRB0acLqWloulw0IhR5rcMLXeUnQ4dcXwhaIbdtaE63d4BbzzMztZCs69vdYSYcS",
+	"gWtkCZZqmWeaLdKd0YbmX6I3ckwxDZ4KqTiquZ3NP/3c8EynUsNixwcSbWBk+UxrfK6QgW1U7LFc87w0",
+	"22Izp12HVFio5VelbiTYIxO6Yqg4bmXlL5Z9KzFfnZ2TszNyMjEv5p/I35qlwLaKy8dN+ZvRKAUp8eY0",
+	"aKtcrr3HKowtvWyGpbT7ILARmBggrzFN9MOGd61Hljt+DzjR8jVEa3CY+M1bBWjU/BpSfnOB3l6gsws0",
+	"G6PxO/3/xQxdXqLhJRpP0flrNL1Al1fozZV5dY7evULDCzQaosuRqxiZ4QhIr6mffS4WNzNPxJGrmAuq",
+	"sKIPsMQSuvu3ytj2PZw+wb4RqQYcfBXek3a+uJl9o0KrsUmnnlpvM/SJscm8Y6iLm9kpm1zczF5cdCw2",
+	"3Ga+5Su6MTK/bHOh48oly9MViAaeDwVSHQpd0hTRfERftYe3o4wgbDC1T29P/D5f5Wxa1/Wskbe3HsUQ",
+	"3QNZYuWJzWlqA+xmZdCEUfrMRMXkZhoyHo5HveGoNzxbDC8m5xeTV6/+2TkAPuo4gUU8ZwoEEB3fJWAZ",
+	"0OWLNpdez1W+7GzJRnYfy1kei64rCidujvSoXRg8YsGWK1hz4YmsbyDFlOn9lHaJVpDwxzKabaqB6joF",
+	"k5RogdyxsgTcv2MNfbwex8N0KLvneg4kmvw68vvcOj6Kc6PJ40E4fnQU4fMITbm8hy9G+Tq5Lor66B62",
+	"qE6InOp9qKtW9ZvFzWxfIm8uVhdnF9F4vB6vLyJChmSMz1/jCwJvYLy+OF+/Gl6MhmTkQxDjaonXas+m",
+	"NejHLwa9LYe1t/1OQ1zvoWl5XB/vaC14arZqJL4kq71iAKhoICN9yRmJbab4QJ9Lt5ej3vRWV7yXw+Vo",
+	"NOxnkB6qk8EpULtXEs07nr201RbE25oqdWk1dttQWRAeLtIfqSI13JeTou75BvSJmVfm5iVEEV42AKRE",
+	"pOs1d0yJaLkROs3LQFBO9pFk5p+0rCIxdK6jSFBp3YVUKfjP3oua1hWNz7r+4YQFTcP6PZCradbO7Juc",
+	"AXuyc1ZwJeVIqNwxsipqC2W3K65HWggpi5TT63lVX7MZxyWG1IYQjSTQPtbjdewEQlo6w/6wP9Ly4Bkw",
+	"nNFgErzqD/tje6EUG/EPHISZBxtQvtqKVNZMTElZJVuEIx2Ete8npa2EYQHonvFHVpQs75iubwqeVCE8",
+	"WpgqiMwThSLMdG1yTRN7jK62yJpnH73LhYpBpFxAeMc4AzNYpwsIowwLRaM8wQJlh0stDo93rGBS82dO",
+	"M4QloizLVR9N0YrzBDAr+alqsIojASoXDOEkuWOuzEIkYIMFSUCWWQoVhdL1b11mNkCwRqqhb+xmToKJ",
+	"FnavoQBTkcIpKBAymPzyFFAt/t9yEDoWti0YdbmnW39Ilfv6qRkp2FO1ptfNJPwEcZI0aBXTCtkGu93n",
+	"sNkTMx4On9UM0ylEcnoKWvFRu0XGAJx7rttNdHR2lMGivv3X53XtlBeYHmbmzCKz0bNjb1UattjmNQwU",
+	"3khT38yyexp81lMbJj54MkN7lOwOWvtPcGAB442wudhkqGg06ADrA6jWPqhGTclW4DpaJXLoCvOqDeSr",
+	"8XVyFZ/SWp0TPxxwDqr1ebAZrBK+egF2ykgZS3R99RGttgok0rReiKqe4eOHhtaXXgZpb02TvTikp/97",
+	"e/XT/Gc0u7pZzN/NZ9PFlXl6x6a3LpT6/f4dM2+ufr70jD5KajZ9DqmgA6iNwv44yEYFRrzw5mxNNw6Q",
+	"PWizQ07qXN/bDrKkaNBrnXzVgdna1m0eRSCl7iP5VK7uSNcnrIqVgdNK2hTHtaBM2dvmxaePH5DdRm7J",
+	"6yAL+q5MeKpjSiuUMiA9KBIz4I8mkLdY0ghRZqMaLYQMbwCZO/3q7t2JTW2TjpQHxZTwzaDqiDooq4Rv",
+	"enbU73ggVS1b302a2tqSvb6vlpTCIMs9YpEtsZgV3nKy/S4SKfvVPjjr1+fB7v+Vnm676Emjub7a7ZAB",
+	"Us+NsJPztRLEolmiHowiHMVVBYSY1DVEim/AdKw8UhV/w54L2Ue6BdPhcJXTRJk6lZ4hoV5Dv2VcFame",
+	"TfschUuUM0WTI90UbRfgivZ7ZD1O28szsp5CVQ6zfT39/Huf8QoEwwmC4kMGb9bT5tWBdf20gLbb4XMc",
+	"1+0r3QOVDF8BQ/oqGGaswkKZJitgBNXlxvKSG82ZzCAqTYvQB0pynJTvZREbp1wAsp2tQNADhUc/3Krt",
+	"tsLiPYdj2KoroL479/3ypi/V37s7f3Y9Yq8yCiKlGgBHmBqXTI0PMtW4wX8eS9+lMtFow3iGlaZYRbF2",
+	"5h6o9n/cMoWHW8dii0d75jp4Kv4q6xQEElCeYvqleX5gnfossbll+Xh+2bYeu0BpQKfsZ1GbsHOn467d",
+	"R/N1YdRZrnSpMQdEpe2RBV3TxQxhh0jZuVnfnen6poA1/WL8B06SGgFNN4WNc9D8E+2UqNR0cgk6ntD+",
+	"w7xrT9NHKNGd6bZkWYYKmhVbO6U2hxiNTbJeMlNsFkfKcVSoTNl1Vz0nEEzWOJEQ+tLxWrUvTsgbbUVS",
+	"bY1rkNT4CI8Rn3lq7L4OHiPCH8GS/nVn763tIr/yHMFHbc1r0uHxGpHz1EQh9QcCbfpHz7u2uf6QMPx2",
+	"yUSxsDeXaAPbSXz7P2zt5nRLXfcTo1uJ0rPiwRrlUfgdqET+4TDYoVx5PV28R7dXP328+nlRlA2NGHWO",
+	"VXCyV2f0zAg6ofaHrjQe4vcQTJWIOuQgCVYgVUHcNKSgG84VmrkFPJsTAI5i05zgz1Gef9mqvzHR5PXH",
+	"HaGJNhY3syqvKaRhMmupAJurTfP1isM3Z4fSYLP9bgbSvusMQl+E7fkuqdUDZY1BO7/gx76srDoRn5O0",
+	"22X1ZzpaU/2vLxhVONT0DlTNtSYHVJInKsmut3rSQeSuJ59sI+Cuo9M9hO1D1z5KRJ1ueixaDjvSo92R",
+	"u9BLU++wG9FRZ5qybJTpQNXXmPl7xha6gdkDO9P99E1qxwXCXgaw5xzth1BWHu/laW+yG3PKH4Zf99vG",
+	"f2PwhbHF4mZWBAj//HX6+OnX6X9+XFw9zvfiiXpU4AXpftzw9UA9doloOt8GcdXFfBSV4PvXDdp9zMVT",
+	"8wUrmt6GiPahH94x/XB662n7CM342dR9Zb+ELXfgkrxj5rR/p4OIBxDb+pu5Bhths8XThBlFqdt0Wd8x",
+	"AsoU7Mqvu02jro007N+ISlR+94HoukmwoHXHJNf1f81t8WGIHkoVirEsBpHDdplL1Suk/3u6RKdV3eMa",
+	"j7Uaf0OfGZ/oaN6Hp233fyhdVS6SYBLESmWTweAp5lLtJk8ZF2o3wBkdPIzMdxeC6ojCSFAPaX4EbfBj",
+	"Huv7PS72Xr8ajc7HWkCfK272TWHG06JTUevWfNK82hbuuohVZb/2UcU1VbtUfKWRq0wtTEBivoZX3F8Y",
+	"3U+2nkltdn39t3mjfb/kzcj5ecS8l2cOzfppsPu8+78BAEVYmpFJSgAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
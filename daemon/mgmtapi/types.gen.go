@@ -7,6 +7,13 @@ import (
 	"time"
 )
 
+// Defines values for ExpiryState.
+const (
+	Expired  ExpiryState = "expired"
+	Expiring ExpiryState = "expiring"
+	Valid    ExpiryState = "valid"
+)
+
 // Defines values for LogLevelLevel.
 const (
 	Debug LogLevelLevel = "debug"
@@ -14,6 +21,22 @@ const (
 	Info  LogLevelLevel = "info"
 )
 
+// Defines values for RevocationLinkType.
+const (
+	Child  RevocationLinkType = "child"
+	Core   RevocationLinkType = "core"
+	Parent RevocationLinkType = "parent"
+	Peer   RevocationLinkType = "peer"
+	Unset  RevocationLinkType = "unset"
+)
+
+// Defines values for Status.
+const (
+	Degraded Status = "degraded"
+	Failing  Status = "failing"
+	Passing  Status = "passing"
+)
+
 // Certificate defines model for Certificate.
 type Certificate struct {
 	DistinguishedName string       `json:"distinguished_name"`
@@ -40,6 +63,9 @@ type ChainBrief struct {
 // ChainID defines model for ChainID.
 type ChainID = string
 
+// ExpiryState defines model for ExpiryState.
+type ExpiryState string
+
 // Hop defines model for Hop.
 type Hop struct {
 	Interface int   `json:"interface"`
@@ -76,6 +102,28 @@ type Problem struct {
 	Type *string `json:"type,omitempty"`
 }
 
+// Revocation defines model for Revocation.
+type Revocation struct {
+	// AffectedSegments The IDs of the path segments in the path database that traverse the revoked interface.
+	AffectedSegments []SegmentID `json:"affected_segments"`
+
+	// Expiration Point in time at which the revocation expires.
+	Expiration time.Time `json:"expiration"`
+
+	// InterfaceId ID of the revoked interface.
+	InterfaceId int   `json:"interface_id"`
+	IsdAs       IsdAs `json:"isd_as"`
+
+	// LinkType Link type of the revoked interface.
+	LinkType RevocationLinkType `json:"link_type"`
+
+	// Timestamp Point in time at which the revocation was issued.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// RevocationLinkType Link type of the revoked interface.
+type RevocationLinkType string
+
 // Segment defines model for Segment.
 type Segment struct {
 	Expiration  time.Time `json:"expiration"`
@@ -104,6 +152,9 @@ type StandardError struct {
 	Error string `json:"error"`
 }
 
+// Status defines model for Status.
+type Status string
+
 // SubjectKeyID defines model for SubjectKeyID.
 type SubjectKeyID = string
 
@@ -128,6 +179,39 @@ type TRCID struct {
 	SerialNumber int `json:"serial_number"`
 }
 
+// TrustHealth defines model for TrustHealth.
+type TrustHealth struct {
+	// CheckedAt Time the trust material was last checked.
+	CheckedAt time.Time `json:"checked_at"`
+
+	// Error Error encountered while checking the trust material.
+	Error    *string         `json:"error,omitempty"`
+	Material []TrustMaterial `json:"material"`
+	Status   Status          `json:"status"`
+
+	// WarnBefore Remaining validity below which trust material is considered
+	// expiring.
+	WarnBefore string `json:"warn_before"`
+}
+
+// TrustMaterial defines model for TrustMaterial.
+type TrustMaterial struct {
+	// Id Hex encoded subject key ID of the certificate, or ID of the TRC.
+	Id       string    `json:"id"`
+	NotAfter time.Time `json:"not_after"`
+
+	// Source File the material was loaded from, or trust_db.
+	Source string      `json:"source"`
+	State  ExpiryState `json:"state"`
+
+	// Subject ISD-AS of the certificate subject, or ISD of the TRC.
+	Subject string `json:"subject"`
+
+	// Type Type of the trust material. One of chain, ca_certificate, trc and
+	// trc_grace_period.
+	Type string `json:"type"`
+}
+
 // Validity defines model for Validity.
 type Validity struct {
 	NotAfter  time.Time `json:"not_after"`
@@ -27,6 +27,7 @@ import (
 	"github.com/scionproto/scion/pkg/private/ctrl/path_mgmt"
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/pkg/snet"
+	"github.com/scionproto/scion/private/path/pathpol"
 )
 
 // Errors for SCION Daemon API requests
@@ -71,6 +72,10 @@ type Connector interface {
 	Interfaces(ctx context.Context) (map[uint16]netip.AddrPort, error)
 	// Paths requests from the daemon a set of end to end paths between the source and destination.
 	Paths(ctx context.Context, dst, src addr.IA, f PathReqFlags) ([]snet.Path, error)
+	// WatchPaths watches the set of end to end paths between the source and destination that
+	// match the policy; a nil policy matches all paths. The watch first receives the current
+	// paths as added, and then the changes to them, until the context is canceled.
+	WatchPaths(ctx context.Context, dst, src addr.IA, policy *pathpol.Policy) (PathWatch, error)
 	// ASInfo requests from the daemon information about AS ia, the zero IA can be
 	// used to detect the local IA.
 	ASInfo(ctx context.Context, ia addr.IA) (ASInfo, error)
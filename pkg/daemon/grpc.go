@@ -16,6 +16,7 @@ package daemon
 
 import (
 	"context"
+	"encoding/json"
 	"net"
 	"net/netip"
 	"time"
@@ -35,6 +36,7 @@ import (
 	"github.com/scionproto/scion/pkg/segment/iface"
 	"github.com/scionproto/scion/pkg/snet"
 	"github.com/scionproto/scion/pkg/snet/path"
+	"github.com/scionproto/scion/private/path/pathpol"
 	"github.com/scionproto/scion/private/topology"
 )
 
@@ -45,14 +47,21 @@ type Service struct {
 	// Metrics are the metric counters that should be incremented when using the
 	// connector.
 	Metrics Metrics
+	// Dialer, if set, is used to connect to the daemon instead of the network, e.g., to connect
+	// to an in-process daemon.
+	Dialer func(ctx context.Context, address string) (net.Conn, error)
 }
 
 func (s Service) Connect(ctx context.Context) (Connector, error) {
-	conn, err := grpc.NewClient(s.Address,
+	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		libgrpc.UnaryClientInterceptor(),
 		libgrpc.StreamClientInterceptor(),
-	)
+	}
+	if s.Dialer != nil {
+		opts = append(opts, grpc.WithContextDialer(s.Dialer))
+	}
+	conn, err := grpc.NewClient(s.Address, opts...)
 	if err != nil {
 		s.Metrics.incConnects(err)
 		return nil, serrors.Wrap("creating client", err)
@@ -123,6 +132,60 @@ func (c grpcConn) Paths(ctx context.Context, dst, src addr.IA,
 	return paths, err
 }
 
+func (c grpcConn) WatchPaths(ctx context.Context, dst, src addr.IA,
+	policy *pathpol.Policy) (PathWatch, error) {
+
+	req := &sdpb.WatchPathsRequest{
+		SourceIsdAs:      uint64(src),
+		DestinationIsdAs: uint64(dst),
+	}
+	if policy != nil {
+		raw, err := json.Marshal(policy)
+		if err != nil {
+			return nil, serrors.Wrap("encoding path policy", err)
+		}
+		req.Policy = raw
+	}
+	client := sdpb.NewDaemonServiceClient(c.conn)
+	stream, err := client.WatchPaths(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return grpcPathWatch{stream: stream, dst: dst}, nil
+}
+
+type grpcPathWatch struct {
+	stream sdpb.DaemonService_WatchPathsClient
+	dst    addr.IA
+}
+
+func (w grpcPathWatch) Recv() (PathEvent, error) {
+	for {
+		rep, err := w.stream.Recv()
+		if err != nil {
+			return PathEvent{}, err
+		}
+		var typ PathEventType
+		switch rep.Type {
+		case sdpb.PathEventType_PATH_EVENT_TYPE_ADDED:
+			typ = PathAdded
+		case sdpb.PathEventType_PATH_EVENT_TYPE_REMOVED:
+			typ = PathRemoved
+		default:
+			// Events of types added in the future are skipped.
+			continue
+		}
+		if rep.Path == nil {
+			return PathEvent{}, serrors.New("path event without path", "type", rep.Type)
+		}
+		p, err := convertPath(rep.Path, w.dst)
+		if err != nil {
+			return PathEvent{}, err
+		}
+		return PathEvent{Type: typ, Path: p}, nil
+	}
+}
+
 func (c grpcConn) ASInfo(ctx context.Context, ia addr.IA) (ASInfo, error) {
 	client := sdpb.NewDaemonServiceClient(c.conn)
 	response, err := client.AS(ctx, &sdpb.ASRequest{IsdAs: uint64(ia)})
@@ -0,0 +1,110 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/scionproto/scion/pkg/snet"
+)
+
+// PathEventType is the type of a change to a watched set of paths.
+type PathEventType int
+
+const (
+	// PathAdded means that the path was added to the set. A path that is added again, e.g.,
+	// with a later expiration, replaces the path with the same fingerprint.
+	PathAdded PathEventType = iota + 1
+	// PathRemoved means that the path was removed from the set, e.g., because it expired or
+	// one of its interfaces was revoked.
+	PathRemoved
+)
+
+func (t PathEventType) String() string {
+	switch t {
+	case PathAdded:
+		return "added"
+	case PathRemoved:
+		return "removed"
+	default:
+		return "unknown"
+	}
+}
+
+// PathEvent is a change to a watched set of paths.
+type PathEvent struct {
+	Type PathEventType
+	Path snet.Path
+}
+
+// PathWatch is the stream of changes to a watched set of paths, see Connector.WatchPaths.
+type PathWatch interface {
+	// Recv blocks until the next change. It returns an error once the watch has ended, e.g.,
+	// because its context was canceled or the connection to the daemon was lost.
+	Recv() (PathEvent, error)
+}
+
+// PathSet is a set of paths kept up to date by the events of a path watch. Paths are identified
+// by their fingerprint. The zero value is an empty set, ready to use. It is safe for concurrent
+// use.
+type PathSet struct {
+	mtx   sync.RWMutex
+	paths map[snet.PathFingerprint]snet.Path
+}
+
+// Run applies the events of the watch to the set until the watch ends, and returns the error that
+// ended it. The set keeps the last known paths; it is up to the caller to start a new watch.
+func (s *PathSet) Run(w PathWatch) error {
+	for {
+		e, err := w.Recv()
+		if err != nil {
+			return err
+		}
+		s.Update(e)
+	}
+}
+
+// Update applies the event to the set.
+func (s *PathSet) Update(e PathEvent) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	fp := snet.Fingerprint(e.Path)
+	switch e.Type {
+	case PathAdded:
+		if s.paths == nil {
+			s.paths = make(map[snet.PathFingerprint]snet.Path)
+		}
+		s.paths[fp] = e.Path
+	case PathRemoved:
+		delete(s.paths, fp)
+	}
+}
+
+// Paths returns the paths in the set, ordered by fingerprint.
+func (s *PathSet) Paths() []snet.Path {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	fps := make([]snet.PathFingerprint, 0, len(s.paths))
+	for fp := range s.paths {
+		fps = append(fps, fp)
+	}
+	slices.Sort(fps)
+	paths := make([]snet.Path, 0, len(fps))
+	for _, fp := range fps {
+		paths = append(paths, s.paths[fp])
+	}
+	return paths
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/daemon"
+	"github.com/scionproto/scion/pkg/segment/iface"
+	"github.com/scionproto/scion/pkg/snet"
+	snetpath "github.com/scionproto/scion/pkg/snet/path"
+)
+
+type testPathWatch []daemon.PathEvent
+
+func (w *testPathWatch) Recv() (daemon.PathEvent, error) {
+	if len(*w) == 0 {
+		return daemon.PathEvent{}, io.EOF
+	}
+	e := (*w)[0]
+	*w = (*w)[1:]
+	return e, nil
+}
+
+func testPath(ifIDs ...uint16) snet.Path {
+	ia := addr.MustParseIA("1-ff00:0:110")
+	var intfs []snet.PathInterface
+	for _, id := range ifIDs {
+		intfs = append(intfs, snet.PathInterface{IA: ia, ID: iface.ID(id)})
+	}
+	return snetpath.Path{Meta: snet.PathMetadata{Interfaces: intfs}}
+}
+
+func TestPathSet(t *testing.T) {
+	p1, p2, p3 := testPath(1, 2), testPath(3, 4), testPath(5, 6)
+	w := testPathWatch{
+		{Type: daemon.PathAdded, Path: p1},
+		{Type: daemon.PathAdded, Path: p2},
+		{Type: daemon.PathAdded, Path: p3},
+		{Type: daemon.PathRemoved, Path: p2},
+		// Adding a path again replaces it.
+		{Type: daemon.PathAdded, Path: p1},
+		// Removing an unknown path is a no-op.
+		{Type: daemon.PathRemoved, Path: testPath(7, 8)},
+	}
+	var s daemon.PathSet
+	assert.Empty(t, s.Paths())
+	assert.ErrorIs(t, s.Run(&w), io.EOF)
+
+	paths := s.Paths()
+	assert.Len(t, paths, 2)
+	assert.ElementsMatch(t,
+		[]snet.PathFingerprint{snet.Fingerprint(p1), snet.Fingerprint(p3)},
+		[]snet.PathFingerprint{snet.Fingerprint(paths[0]), snet.Fingerprint(paths[1])},
+	)
+	assert.Less(t, snet.Fingerprint(paths[0]), snet.Fingerprint(paths[1]))
+}
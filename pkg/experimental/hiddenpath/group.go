@@ -27,6 +27,17 @@ import (
 	"github.com/scionproto/scion/private/config"
 )
 
+// Errors for the management of hidden path groups.
+var (
+	// ErrGroupNotFound indicates that the hidden path group does not exist.
+	ErrGroupNotFound = serrors.New("hidden path group not found")
+	// ErrGroupExists indicates that the hidden path group already exists.
+	ErrGroupExists = serrors.New("hidden path group already exists")
+	// ErrInvalidGroups indicates that a change of the hidden path groups
+	// results in an invalid configuration.
+	ErrInvalidGroups = serrors.New("invalid hidden path groups")
+)
+
 // GroupID is unique 64bit identification of the group.
 type GroupID struct {
 	OwnerAS addr.AS
@@ -47,13 +47,35 @@ func (p RegistrationPolicy) Validate() error {
 	return nil
 }
 
+// WithGroups returns a copy of the registration policy that refers to the
+// given groups instead of the ones it currently refers to. This is used to
+// apply changes of the group membership to the policy. It fails if the policy
+// refers to a group that is not part of the given groups.
+func (p RegistrationPolicy) WithGroups(groups Groups) (RegistrationPolicy, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return parsePolicies(groups, p.rawPolicies())
+}
+
 // MarshalYAML implements the yaml marshaller interface.
 func (p RegistrationPolicy) MarshalYAML() (any, error) {
 	collectedGroups := make(Groups)
-	policies := make(map[uint64][]string, len(p))
-	for ifID, ip := range p {
+	for _, ip := range p {
 		for id, group := range ip.Groups {
 			collectedGroups[id] = group
+		}
+	}
+	return &registrationPolicyInfo{
+		Groups:   marshalGroups(collectedGroups),
+		Policies: p.rawPolicies(),
+	}, nil
+}
+
+func (p RegistrationPolicy) rawPolicies() map[uint64][]string {
+	policies := make(map[uint64][]string, len(p))
+	for ifID, ip := range p {
+		for id := range ip.Groups {
 			policies[ifID] = append(policies[ifID], id.String())
 		}
 		if ip.Public {
@@ -61,10 +83,7 @@ func (p RegistrationPolicy) MarshalYAML() (any, error) {
 		}
 		sort.Strings(policies[ifID])
 	}
-	return &registrationPolicyInfo{
-		Groups:   marshalGroups(collectedGroups),
-		Policies: policies,
-	}, nil
+	return policies
 }
 
 // UnmarshalYAML implements YAML unmarshaling for the registration policy type.
@@ -120,6 +139,19 @@ func LoadConfiguration(location string) (Groups, RegistrationPolicy, error) {
 	return groups, pol, nil
 }
 
+// MarshalConfiguration encodes the hidden path groups and the registration
+// policy in the format that is read by LoadConfiguration. The policy must only
+// refer to the given groups.
+func MarshalConfiguration(groups Groups, policy RegistrationPolicy) ([]byte, error) {
+	if _, err := policy.WithGroups(groups); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(&registrationPolicyInfo{
+		Groups:   marshalGroups(groups),
+		Policies: policy.rawPolicies(),
+	})
+}
+
 type registrationPolicyInfo struct {
 	Groups   map[string]*groupInfo `yaml:"groups,omitempty"`
 	Policies map[uint64][]string   `yaml:"registration_policy_per_interface,omitempty"`
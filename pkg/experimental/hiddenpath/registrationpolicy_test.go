@@ -16,6 +16,7 @@ package hiddenpath_test
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -98,6 +99,46 @@ func TestRegistrationPolicyUnmarshalYAML(t *testing.T) {
 	}
 }
 
+func TestRegistrationPolicyWithGroups(t *testing.T) {
+	groups, policy, err := hiddenpath.LoadConfiguration("testdata/registrationpolicy.yml")
+	require.NoError(t, err)
+
+	id := mustParseGroupID(t, "ff00:0:110-69b5")
+	updated := make(hiddenpath.Groups)
+	for k, v := range groups {
+		updated[k] = v
+	}
+	updated[id] = &hiddenpath.Group{
+		ID:         id,
+		Owner:      addr.MustParseIA("1-ff00:0:110"),
+		Writers:    map[addr.IA]struct{}{addr.MustParseIA("1-ff00:0:112"): {}},
+		Registries: map[addr.IA]struct{}{addr.MustParseIA("1-ff00:0:113"): {}},
+	}
+	got, err := policy.WithGroups(updated)
+	require.NoError(t, err)
+	assert.Equal(t, updated[id], got[2].Groups[id])
+	assert.Equal(t, groups[id], policy[2].Groups[id], "original policy modified")
+
+	// Groups that are referred to by the policy cannot be removed.
+	delete(updated, id)
+	_, err = policy.WithGroups(updated)
+	assert.Error(t, err)
+}
+
+func TestMarshalConfiguration(t *testing.T) {
+	groups, policy, err := hiddenpath.LoadConfiguration("testdata/registrationpolicy.yml")
+	require.NoError(t, err)
+	raw, err := hiddenpath.MarshalConfiguration(groups, policy)
+	require.NoError(t, err)
+
+	file := filepath.Join(t.TempDir(), "hp.yml")
+	require.NoError(t, os.WriteFile(file, raw, 0o666))
+	gotGroups, gotPolicy, err := hiddenpath.LoadConfiguration(file)
+	require.NoError(t, err)
+	assert.Equal(t, groups, gotGroups)
+	assert.Equal(t, policy, gotPolicy)
+}
+
 func mustParseGroupID(t *testing.T, s string) hiddenpath.GroupID {
 	t.Helper()
 
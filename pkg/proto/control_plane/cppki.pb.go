@@ -294,6 +294,242 @@ func (x *TRCResponse) GetTrc() []byte {
 	return nil
 }
 
+type TRCAnnouncementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Isd           uint32                 `protobuf:"varint,1,opt,name=isd,proto3" json:"isd,omitempty"`
+	Base          uint64                 `protobuf:"varint,2,opt,name=base,proto3" json:"base,omitempty"`
+	Serial        uint64                 `protobuf:"varint,3,opt,name=serial,proto3" json:"serial,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TRCAnnouncementRequest) Reset() {
+	*x = TRCAnnouncementRequest{}
+	mi := &file_proto_control_plane_v1_cppki_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TRCAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TRCAnnouncementRequest) ProtoMessage() {}
+
+func (x *TRCAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_control_plane_v1_cppki_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TRCAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*TRCAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_proto_control_plane_v1_cppki_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TRCAnnouncementRequest) GetIsd() uint32 {
+	if x != nil {
+		return x.Isd
+	}
+	return 0
+}
+
+func (x *TRCAnnouncementRequest) GetBase() uint64 {
+	if x != nil {
+		return x.Base
+	}
+	return 0
+}
+
+func (x *TRCAnnouncementRequest) GetSerial() uint64 {
+	if x != nil {
+		return x.Serial
+	}
+	return 0
+}
+
+type TRCAnnouncementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TRCAnnouncementResponse) Reset() {
+	*x = TRCAnnouncementResponse{}
+	mi := &file_proto_control_plane_v1_cppki_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TRCAnnouncementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TRCAnnouncementResponse) ProtoMessage() {}
+
+func (x *TRCAnnouncementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_control_plane_v1_cppki_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TRCAnnouncementResponse.ProtoReflect.Descriptor instead.
+func (*TRCAnnouncementResponse) Descriptor() ([]byte, []int) {
+	return file_proto_control_plane_v1_cppki_proto_rawDescGZIP(), []int{6}
+}
+
+type CRLsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Isd           uint32                 `protobuf:"varint,1,opt,name=isd,proto3" json:"isd,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CRLsRequest) Reset() {
+	*x = CRLsRequest{}
+	mi := &file_proto_control_plane_v1_cppki_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CRLsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CRLsRequest) ProtoMessage() {}
+
+func (x *CRLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_control_plane_v1_cppki_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CRLsRequest.ProtoReflect.Descriptor instead.
+func (*CRLsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_control_plane_v1_cppki_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CRLsRequest) GetIsd() uint32 {
+	if x != nil {
+		return x.Isd
+	}
+	return 0
+}
+
+type CRLsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Crls          []*CRL                 `protobuf:"bytes,1,rep,name=crls,proto3" json:"crls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CRLsResponse) Reset() {
+	*x = CRLsResponse{}
+	mi := &file_proto_control_plane_v1_cppki_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CRLsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CRLsResponse) ProtoMessage() {}
+
+func (x *CRLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_control_plane_v1_cppki_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CRLsResponse.ProtoReflect.Descriptor instead.
+func (*CRLsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_control_plane_v1_cppki_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CRLsResponse) GetCrls() []*CRL {
+	if x != nil {
+		return x.Crls
+	}
+	return nil
+}
+
+type CRL struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Crl           []byte                 `protobuf:"bytes,1,opt,name=crl,proto3" json:"crl,omitempty"`
+	CaCert        []byte                 `protobuf:"bytes,2,opt,name=ca_cert,json=caCert,proto3" json:"ca_cert,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CRL) Reset() {
+	*x = CRL{}
+	mi := &file_proto_control_plane_v1_cppki_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CRL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CRL) ProtoMessage() {}
+
+func (x *CRL) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_control_plane_v1_cppki_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CRL.ProtoReflect.Descriptor instead.
+func (*CRL) Descriptor() ([]byte, []int) {
+	return file_proto_control_plane_v1_cppki_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CRL) GetCrl() []byte {
+	if x != nil {
+		return x.Crl
+	}
+	return nil
+}
+
+func (x *CRL) GetCaCert() []byte {
+	if x != nil {
+		return x.CaCert
+	}
+	return nil
+}
+
 type VerificationKeyID struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	IsdAs         uint64                 `protobuf:"varint,1,opt,name=isd_as,json=isdAs,proto3" json:"isd_as,omitempty"`
@@ -306,7 +542,7 @@ type VerificationKeyID struct {
 
 func (x *VerificationKeyID) Reset() {
 	*x = VerificationKeyID{}
-	mi := &file_proto_control_plane_v1_cppki_proto_msgTypes[5]
+	mi := &file_proto_control_plane_v1_cppki_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -318,7 +554,7 @@ func (x *VerificationKeyID) String() string {
 func (*VerificationKeyID) ProtoMessage() {}
 
 func (x *VerificationKeyID) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_control_plane_v1_cppki_proto_msgTypes[5]
+	mi := &file_proto_control_plane_v1_cppki_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -331,7 +567,7 @@ func (x *VerificationKeyID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerificationKeyID.ProtoReflect.Descriptor instead.
 func (*VerificationKeyID) Descriptor() ([]byte, []int) {
-	return file_proto_control_plane_v1_cppki_proto_rawDescGZIP(), []int{5}
+	return file_proto_control_plane_v1_cppki_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *VerificationKeyID) GetIsdAs() uint64 {
@@ -383,16 +619,31 @@ const file_proto_control_plane_v1_cppki_proto_rawDesc = "" +
 	"\x04base\x18\x02 \x01(\x04R\x04base\x12\x16\n" +
 	"\x06serial\x18\x03 \x01(\x04R\x06serial\"\x1f\n" +
 	"\vTRCResponse\x12\x10\n" +
-	"\x03trc\x18\x01 \x01(\fR\x03trc\"\x8a\x01\n" +
+	"\x03trc\x18\x01 \x01(\fR\x03trc\"V\n" +
+	"\x16TRCAnnouncementRequest\x12\x10\n" +
+	"\x03isd\x18\x01 \x01(\rR\x03isd\x12\x12\n" +
+	"\x04base\x18\x02 \x01(\x04R\x04base\x12\x16\n" +
+	"\x06serial\x18\x03 \x01(\x04R\x06serial\"\x19\n" +
+	"\x17TRCAnnouncementResponse\"\x1f\n" +
+	"\vCRLsRequest\x12\x10\n" +
+	"\x03isd\x18\x01 \x01(\rR\x03isd\"?\n" +
+	"\fCRLsResponse\x12/\n" +
+	"\x04crls\x18\x01 \x03(\v2\x1b.proto.control_plane.v1.CRLR\x04crls\"0\n" +
+	"\x03CRL\x12\x10\n" +
+	"\x03crl\x18\x01 \x01(\fR\x03crl\x12\x17\n" +
+	"\aca_cert\x18\x02 \x01(\fR\x06caCert\"\x8a\x01\n" +
 	"\x11VerificationKeyID\x12\x15\n" +
 	"\x06isd_as\x18\x01 \x01(\x04R\x05isdAs\x12$\n" +
 	"\x0esubject_key_id\x18\x02 \x01(\fR\fsubjectKeyId\x12\x19\n" +
 	"\btrc_base\x18\x03 \x01(\x04R\atrcBase\x12\x1d\n" +
 	"\n" +
-	"trc_serial\x18\x04 \x01(\x04R\ttrcSerial2\xc3\x01\n" +
+	"trc_serial\x18\x04 \x01(\x04R\ttrcSerial2\x98\x02\n" +
 	"\x14TrustMaterialService\x12Y\n" +
 	"\x06Chains\x12%.proto.control_plane.v1.ChainsRequest\x1a&.proto.control_plane.v1.ChainsResponse\"\x00\x12P\n" +
-	"\x03TRC\x12\".proto.control_plane.v1.TRCRequest\x1a#.proto.control_plane.v1.TRCResponse\"\x00B5Z3github.com/scionproto/scion/pkg/proto/control_planeb\x06proto3"
+	"\x03TRC\x12\".proto.control_plane.v1.TRCRequest\x1a#.proto.control_plane.v1.TRCResponse\"\x00\x12S\n" +
+	"\x04CRLs\x12#.proto.control_plane.v1.CRLsRequest\x1a$.proto.control_plane.v1.CRLsResponse\"\x002\x8e\x01\n" +
+	"\x16TRCAnnouncementService\x12t\n" +
+	"\x0fTRCAnnouncement\x12..proto.control_plane.v1.TRCAnnouncementRequest\x1a/.proto.control_plane.v1.TRCAnnouncementResponse\"\x00B5Z3github.com/scionproto/scion/pkg/proto/control_planeb\x06proto3"
 
 var (
 	file_proto_control_plane_v1_cppki_proto_rawDescOnce sync.Once
@@ -406,29 +657,39 @@ func file_proto_control_plane_v1_cppki_proto_rawDescGZIP() []byte {
 	return file_proto_control_plane_v1_cppki_proto_rawDescData
 }
 
-var file_proto_control_plane_v1_cppki_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_control_plane_v1_cppki_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_proto_control_plane_v1_cppki_proto_goTypes = []any{
-	(*ChainsRequest)(nil),         // 0: proto.control_plane.v1.ChainsRequest
-	(*ChainsResponse)(nil),        // 1: proto.control_plane.v1.ChainsResponse
-	(*Chain)(nil),                 // 2: proto.control_plane.v1.Chain
-	(*TRCRequest)(nil),            // 3: proto.control_plane.v1.TRCRequest
-	(*TRCResponse)(nil),           // 4: proto.control_plane.v1.TRCResponse
-	(*VerificationKeyID)(nil),     // 5: proto.control_plane.v1.VerificationKeyID
-	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*ChainsRequest)(nil),           // 0: proto.control_plane.v1.ChainsRequest
+	(*ChainsResponse)(nil),          // 1: proto.control_plane.v1.ChainsResponse
+	(*Chain)(nil),                   // 2: proto.control_plane.v1.Chain
+	(*TRCRequest)(nil),              // 3: proto.control_plane.v1.TRCRequest
+	(*TRCResponse)(nil),             // 4: proto.control_plane.v1.TRCResponse
+	(*TRCAnnouncementRequest)(nil),  // 5: proto.control_plane.v1.TRCAnnouncementRequest
+	(*TRCAnnouncementResponse)(nil), // 6: proto.control_plane.v1.TRCAnnouncementResponse
+	(*CRLsRequest)(nil),             // 7: proto.control_plane.v1.CRLsRequest
+	(*CRLsResponse)(nil),            // 8: proto.control_plane.v1.CRLsResponse
+	(*CRL)(nil),                     // 9: proto.control_plane.v1.CRL
+	(*VerificationKeyID)(nil),       // 10: proto.control_plane.v1.VerificationKeyID
+	(*timestamppb.Timestamp)(nil),   // 11: google.protobuf.Timestamp
 }
 var file_proto_control_plane_v1_cppki_proto_depIdxs = []int32{
-	6, // 0: proto.control_plane.v1.ChainsRequest.at_least_valid_until:type_name -> google.protobuf.Timestamp
-	6, // 1: proto.control_plane.v1.ChainsRequest.at_least_valid_since:type_name -> google.protobuf.Timestamp
-	2, // 2: proto.control_plane.v1.ChainsResponse.chains:type_name -> proto.control_plane.v1.Chain
-	0, // 3: proto.control_plane.v1.TrustMaterialService.Chains:input_type -> proto.control_plane.v1.ChainsRequest
-	3, // 4: proto.control_plane.v1.TrustMaterialService.TRC:input_type -> proto.control_plane.v1.TRCRequest
-	1, // 5: proto.control_plane.v1.TrustMaterialService.Chains:output_type -> proto.control_plane.v1.ChainsResponse
-	4, // 6: proto.control_plane.v1.TrustMaterialService.TRC:output_type -> proto.control_plane.v1.TRCResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	11, // 0: proto.control_plane.v1.ChainsRequest.at_least_valid_until:type_name -> google.protobuf.Timestamp
+	11, // 1: proto.control_plane.v1.ChainsRequest.at_least_valid_since:type_name -> google.protobuf.Timestamp
+	2,  // 2: proto.control_plane.v1.ChainsResponse.chains:type_name -> proto.control_plane.v1.Chain
+	9,  // 3: proto.control_plane.v1.CRLsResponse.crls:type_name -> proto.control_plane.v1.CRL
+	0,  // 4: proto.control_plane.v1.TrustMaterialService.Chains:input_type -> proto.control_plane.v1.ChainsRequest
+	3,  // 5: proto.control_plane.v1.TrustMaterialService.TRC:input_type -> proto.control_plane.v1.TRCRequest
+	7,  // 6: proto.control_plane.v1.TrustMaterialService.CRLs:input_type -> proto.control_plane.v1.CRLsRequest
+	5,  // 7: proto.control_plane.v1.TRCAnnouncementService.TRCAnnouncement:input_type -> proto.control_plane.v1.TRCAnnouncementRequest
+	1,  // 8: proto.control_plane.v1.TrustMaterialService.Chains:output_type -> proto.control_plane.v1.ChainsResponse
+	4,  // 9: proto.control_plane.v1.TrustMaterialService.TRC:output_type -> proto.control_plane.v1.TRCResponse
+	8,  // 10: proto.control_plane.v1.TrustMaterialService.CRLs:output_type -> proto.control_plane.v1.CRLsResponse
+	6,  // 11: proto.control_plane.v1.TRCAnnouncementService.TRCAnnouncement:output_type -> proto.control_plane.v1.TRCAnnouncementResponse
+	8,  // [8:12] is the sub-list for method output_type
+	4,  // [4:8] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_proto_control_plane_v1_cppki_proto_init() }
@@ -442,9 +703,9 @@ func file_proto_control_plane_v1_cppki_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_control_plane_v1_cppki_proto_rawDesc), len(file_proto_control_plane_v1_cppki_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   11,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_proto_control_plane_v1_cppki_proto_goTypes,
 		DependencyIndexes: file_proto_control_plane_v1_cppki_proto_depIdxs,
@@ -469,6 +730,7 @@ const _ = grpc.SupportPackageIsVersion6
 type TrustMaterialServiceClient interface {
 	Chains(ctx context.Context, in *ChainsRequest, opts ...grpc.CallOption) (*ChainsResponse, error)
 	TRC(ctx context.Context, in *TRCRequest, opts ...grpc.CallOption) (*TRCResponse, error)
+	CRLs(ctx context.Context, in *CRLsRequest, opts ...grpc.CallOption) (*CRLsResponse, error)
 }
 
 type trustMaterialServiceClient struct {
@@ -497,10 +759,20 @@ func (c *trustMaterialServiceClient) TRC(ctx context.Context, in *TRCRequest, op
 	return out, nil
 }
 
+func (c *trustMaterialServiceClient) CRLs(ctx context.Context, in *CRLsRequest, opts ...grpc.CallOption) (*CRLsResponse, error) {
+	out := new(CRLsResponse)
+	err := c.cc.Invoke(ctx, "/proto.control_plane.v1.TrustMaterialService/CRLs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TrustMaterialServiceServer is the server API for TrustMaterialService service.
 type TrustMaterialServiceServer interface {
 	Chains(context.Context, *ChainsRequest) (*ChainsResponse, error)
 	TRC(context.Context, *TRCRequest) (*TRCResponse, error)
+	CRLs(context.Context, *CRLsRequest) (*CRLsResponse, error)
 }
 
 // UnimplementedTrustMaterialServiceServer can be embedded to have forward compatible implementations.
@@ -513,6 +785,9 @@ func (*UnimplementedTrustMaterialServiceServer) Chains(context.Context, *ChainsR
 func (*UnimplementedTrustMaterialServiceServer) TRC(context.Context, *TRCRequest) (*TRCResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TRC not implemented")
 }
+func (*UnimplementedTrustMaterialServiceServer) CRLs(context.Context, *CRLsRequest) (*CRLsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CRLs not implemented")
+}
 
 func RegisterTrustMaterialServiceServer(s *grpc.Server, srv TrustMaterialServiceServer) {
 	s.RegisterService(&_TrustMaterialService_serviceDesc, srv)
@@ -554,6 +829,24 @@ func _TrustMaterialService_TRC_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TrustMaterialService_CRLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CRLsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrustMaterialServiceServer).CRLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.control_plane.v1.TrustMaterialService/CRLs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrustMaterialServiceServer).CRLs(ctx, req.(*CRLsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TrustMaterialService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "proto.control_plane.v1.TrustMaterialService",
 	HandlerType: (*TrustMaterialServiceServer)(nil),
@@ -566,6 +859,82 @@ var _TrustMaterialService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TRC",
 			Handler:    _TrustMaterialService_TRC_Handler,
 		},
+		{
+			MethodName: "CRLs",
+			Handler:    _TrustMaterialService_CRLs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/control_plane/v1/cppki.proto",
+}
+
+// TRCAnnouncementServiceClient is the client API for TRCAnnouncementService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TRCAnnouncementServiceClient interface {
+	TRCAnnouncement(ctx context.Context, in *TRCAnnouncementRequest, opts ...grpc.CallOption) (*TRCAnnouncementResponse, error)
+}
+
+type tRCAnnouncementServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTRCAnnouncementServiceClient(cc grpc.ClientConnInterface) TRCAnnouncementServiceClient {
+	return &tRCAnnouncementServiceClient{cc}
+}
+
+func (c *tRCAnnouncementServiceClient) TRCAnnouncement(ctx context.Context, in *TRCAnnouncementRequest, opts ...grpc.CallOption) (*TRCAnnouncementResponse, error) {
+	out := new(TRCAnnouncementResponse)
+	err := c.cc.Invoke(ctx, "/proto.control_plane.v1.TRCAnnouncementService/TRCAnnouncement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TRCAnnouncementServiceServer is the server API for TRCAnnouncementService service.
+type TRCAnnouncementServiceServer interface {
+	TRCAnnouncement(context.Context, *TRCAnnouncementRequest) (*TRCAnnouncementResponse, error)
+}
+
+// UnimplementedTRCAnnouncementServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedTRCAnnouncementServiceServer struct {
+}
+
+func (*UnimplementedTRCAnnouncementServiceServer) TRCAnnouncement(context.Context, *TRCAnnouncementRequest) (*TRCAnnouncementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TRCAnnouncement not implemented")
+}
+
+func RegisterTRCAnnouncementServiceServer(s *grpc.Server, srv TRCAnnouncementServiceServer) {
+	s.RegisterService(&_TRCAnnouncementService_serviceDesc, srv)
+}
+
+func _TRCAnnouncementService_TRCAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TRCAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TRCAnnouncementServiceServer).TRCAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.control_plane.v1.TRCAnnouncementService/TRCAnnouncement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TRCAnnouncementServiceServer).TRCAnnouncement(ctx, req.(*TRCAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _TRCAnnouncementService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.control_plane.v1.TRCAnnouncementService",
+	HandlerType: (*TRCAnnouncementServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TRCAnnouncement",
+			Handler:    _TRCAnnouncementService_TRCAnnouncement_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/control_plane/v1/cppki.proto",
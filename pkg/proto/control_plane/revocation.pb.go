@@ -0,0 +1,406 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.30.1
+// source: proto/control_plane/v1/revocation.proto
+
+package control_plane
+
+import (
+	context "context"
+	crypto "github.com/scionproto/scion/pkg/proto/crypto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RevocationBody_LinkType int32
+
+const (
+	RevocationBody_LINK_TYPE_UNSPECIFIED RevocationBody_LinkType = 0
+	RevocationBody_LINK_TYPE_CORE        RevocationBody_LinkType = 1
+	RevocationBody_LINK_TYPE_PARENT      RevocationBody_LinkType = 2
+	RevocationBody_LINK_TYPE_CHILD       RevocationBody_LinkType = 3
+	RevocationBody_LINK_TYPE_PEER        RevocationBody_LinkType = 4
+)
+
+// Enum value maps for RevocationBody_LinkType.
+var (
+	RevocationBody_LinkType_name = map[int32]string{
+		0: "LINK_TYPE_UNSPECIFIED",
+		1: "LINK_TYPE_CORE",
+		2: "LINK_TYPE_PARENT",
+		3: "LINK_TYPE_CHILD",
+		4: "LINK_TYPE_PEER",
+	}
+	RevocationBody_LinkType_value = map[string]int32{
+		"LINK_TYPE_UNSPECIFIED": 0,
+		"LINK_TYPE_CORE":        1,
+		"LINK_TYPE_PARENT":      2,
+		"LINK_TYPE_CHILD":       3,
+		"LINK_TYPE_PEER":        4,
+	}
+)
+
+func (x RevocationBody_LinkType) Enum() *RevocationBody_LinkType {
+	p := new(RevocationBody_LinkType)
+	*p = x
+	return p
+}
+
+func (x RevocationBody_LinkType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RevocationBody_LinkType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_control_plane_v1_revocation_proto_enumTypes[0].Descriptor()
+}
+
+func (RevocationBody_LinkType) Type() protoreflect.EnumType {
+	return &file_proto_control_plane_v1_revocation_proto_enumTypes[0]
+}
+
+func (x RevocationBody_LinkType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RevocationBody_LinkType.Descriptor instead.
+func (RevocationBody_LinkType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_control_plane_v1_revocation_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type RevocationRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SignedRevocation *crypto.SignedMessage  `protobuf:"bytes,1,opt,name=signed_revocation,json=signedRevocation,proto3" json:"signed_revocation,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RevocationRequest) Reset() {
+	*x = RevocationRequest{}
+	mi := &file_proto_control_plane_v1_revocation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevocationRequest) ProtoMessage() {}
+
+func (x *RevocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_control_plane_v1_revocation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevocationRequest.ProtoReflect.Descriptor instead.
+func (*RevocationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_control_plane_v1_revocation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RevocationRequest) GetSignedRevocation() *crypto.SignedMessage {
+	if x != nil {
+		return x.SignedRevocation
+	}
+	return nil
+}
+
+type RevocationBody struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	IsdAs         uint64                  `protobuf:"varint,1,opt,name=isd_as,json=isdAs,proto3" json:"isd_as,omitempty"`
+	InterfaceId   uint64                  `protobuf:"varint,2,opt,name=interface_id,json=interfaceId,proto3" json:"interface_id,omitempty"`
+	LinkType      RevocationBody_LinkType `protobuf:"varint,3,opt,name=link_type,json=linkType,proto3,enum=proto.control_plane.v1.RevocationBody_LinkType" json:"link_type,omitempty"`
+	Timestamp     *timestamppb.Timestamp  `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Ttl           *durationpb.Duration    `protobuf:"bytes,5,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevocationBody) Reset() {
+	*x = RevocationBody{}
+	mi := &file_proto_control_plane_v1_revocation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevocationBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevocationBody) ProtoMessage() {}
+
+func (x *RevocationBody) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_control_plane_v1_revocation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevocationBody.ProtoReflect.Descriptor instead.
+func (*RevocationBody) Descriptor() ([]byte, []int) {
+	return file_proto_control_plane_v1_revocation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RevocationBody) GetIsdAs() uint64 {
+	if x != nil {
+		return x.IsdAs
+	}
+	return 0
+}
+
+func (x *RevocationBody) GetInterfaceId() uint64 {
+	if x != nil {
+		return x.InterfaceId
+	}
+	return 0
+}
+
+func (x *RevocationBody) GetLinkType() RevocationBody_LinkType {
+	if x != nil {
+		return x.LinkType
+	}
+	return RevocationBody_LINK_TYPE_UNSPECIFIED
+}
+
+func (x *RevocationBody) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *RevocationBody) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type RevocationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevocationResponse) Reset() {
+	*x = RevocationResponse{}
+	mi := &file_proto_control_plane_v1_revocation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevocationResponse) ProtoMessage() {}
+
+func (x *RevocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_control_plane_v1_revocation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevocationResponse.ProtoReflect.Descriptor instead.
+func (*RevocationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_control_plane_v1_revocation_proto_rawDescGZIP(), []int{2}
+}
+
+var File_proto_control_plane_v1_revocation_proto protoreflect.FileDescriptor
+
+const file_proto_control_plane_v1_revocation_proto_rawDesc = "" +
+	"\n" +
+	"'proto/control_plane/v1/revocation.proto\x12\x16proto.control_plane.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cproto/crypto/v1/signed.proto\"`\n" +
+	"\x11RevocationRequest\x12K\n" +
+	"\x11signed_revocation\x18\x01 \x01(\v2\x1e.proto.crypto.v1.SignedMessageR\x10signedRevocation\"\xf9\x02\n" +
+	"\x0eRevocationBody\x12\x15\n" +
+	"\x06isd_as\x18\x01 \x01(\x04R\x05isdAs\x12!\n" +
+	"\finterface_id\x18\x02 \x01(\x04R\vinterfaceId\x12L\n" +
+	"\tlink_type\x18\x03 \x01(\x0e2/.proto.control_plane.v1.RevocationBody.LinkTypeR\blinkType\x128\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12+\n" +
+	"\x03ttl\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\"x\n" +
+	"\bLinkType\x12\x19\n" +
+	"\x15LINK_TYPE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eLINK_TYPE_CORE\x10\x01\x12\x14\n" +
+	"\x10LINK_TYPE_PARENT\x10\x02\x12\x13\n" +
+	"\x0fLINK_TYPE_CHILD\x10\x03\x12\x12\n" +
+	"\x0eLINK_TYPE_PEER\x10\x04\"\x14\n" +
+	"\x12RevocationResponse2z\n" +
+	"\x11RevocationService\x12e\n" +
+	"\n" +
+	"Revocation\x12).proto.control_plane.v1.RevocationRequest\x1a*.proto.control_plane.v1.RevocationResponse\"\x00B5Z3github.com/scionproto/scion/pkg/proto/control_planeb\x06proto3"
+
+var (
+	file_proto_control_plane_v1_revocation_proto_rawDescOnce sync.Once
+	file_proto_control_plane_v1_revocation_proto_rawDescData []byte
+)
+
+func file_proto_control_plane_v1_revocation_proto_rawDescGZIP() []byte {
+	file_proto_control_plane_v1_revocation_proto_rawDescOnce.Do(func() {
+		file_proto_control_plane_v1_revocation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_control_plane_v1_revocation_proto_rawDesc), len(file_proto_control_plane_v1_revocation_proto_rawDesc)))
+	})
+	return file_proto_control_plane_v1_revocation_proto_rawDescData
+}
+
+var file_proto_control_plane_v1_revocation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_control_plane_v1_revocation_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_proto_control_plane_v1_revocation_proto_goTypes = []any{
+	(RevocationBody_LinkType)(0),  // 0: proto.control_plane.v1.RevocationBody.LinkType
+	(*RevocationRequest)(nil),     // 1: proto.control_plane.v1.RevocationRequest
+	(*RevocationBody)(nil),        // 2: proto.control_plane.v1.RevocationBody
+	(*RevocationResponse)(nil),    // 3: proto.control_plane.v1.RevocationResponse
+	(*crypto.SignedMessage)(nil),  // 4: proto.crypto.v1.SignedMessage
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 6: google.protobuf.Duration
+}
+var file_proto_control_plane_v1_revocation_proto_depIdxs = []int32{
+	4, // 0: proto.control_plane.v1.RevocationRequest.signed_revocation:type_name -> proto.crypto.v1.SignedMessage
+	0, // 1: proto.control_plane.v1.RevocationBody.link_type:type_name -> proto.control_plane.v1.RevocationBody.LinkType
+	5, // 2: proto.control_plane.v1.RevocationBody.timestamp:type_name -> google.protobuf.Timestamp
+	6, // 3: proto.control_plane.v1.RevocationBody.ttl:type_name -> google.protobuf.Duration
+	1, // 4: proto.control_plane.v1.RevocationService.Revocation:input_type -> proto.control_plane.v1.RevocationRequest
+	3, // 5: proto.control_plane.v1.RevocationService.Revocation:output_type -> proto.control_plane.v1.RevocationResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_proto_control_plane_v1_revocation_proto_init() }
+func file_proto_control_plane_v1_revocation_proto_init() {
+	if File_proto_control_plane_v1_revocation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_control_plane_v1_revocation_proto_rawDesc), len(file_proto_control_plane_v1_revocation_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_control_plane_v1_revocation_proto_goTypes,
+		DependencyIndexes: file_proto_control_plane_v1_revocation_proto_depIdxs,
+		EnumInfos:         file_proto_control_plane_v1_revocation_proto_enumTypes,
+		MessageInfos:      file_proto_control_plane_v1_revocation_proto_msgTypes,
+	}.Build()
+	File_proto_control_plane_v1_revocation_proto = out.File
+	file_proto_control_plane_v1_revocation_proto_goTypes = nil
+	file_proto_control_plane_v1_revocation_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// RevocationServiceClient is the client API for RevocationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RevocationServiceClient interface {
+	Revocation(ctx context.Context, in *RevocationRequest, opts ...grpc.CallOption) (*RevocationResponse, error)
+}
+
+type revocationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRevocationServiceClient(cc grpc.ClientConnInterface) RevocationServiceClient {
+	return &revocationServiceClient{cc}
+}
+
+func (c *revocationServiceClient) Revocation(ctx context.Context, in *RevocationRequest, opts ...grpc.CallOption) (*RevocationResponse, error) {
+	out := new(RevocationResponse)
+	err := c.cc.Invoke(ctx, "/proto.control_plane.v1.RevocationService/Revocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RevocationServiceServer is the server API for RevocationService service.
+type RevocationServiceServer interface {
+	Revocation(context.Context, *RevocationRequest) (*RevocationResponse, error)
+}
+
+// UnimplementedRevocationServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedRevocationServiceServer struct {
+}
+
+func (*UnimplementedRevocationServiceServer) Revocation(context.Context, *RevocationRequest) (*RevocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Revocation not implemented")
+}
+
+func RegisterRevocationServiceServer(s *grpc.Server, srv RevocationServiceServer) {
+	s.RegisterService(&_RevocationService_serviceDesc, srv)
+}
+
+func _RevocationService_Revocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RevocationServiceServer).Revocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.control_plane.v1.RevocationService/Revocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RevocationServiceServer).Revocation(ctx, req.(*RevocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RevocationService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.control_plane.v1.RevocationService",
+	HandlerType: (*RevocationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Revocation",
+			Handler:    _RevocationService_Revocation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/control_plane/v1/revocation.proto",
+}
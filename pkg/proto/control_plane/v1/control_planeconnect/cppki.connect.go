@@ -23,6 +23,8 @@ const _ = connect.IsAtLeastVersion1_13_0
 const (
 	// TrustMaterialServiceName is the fully-qualified name of the TrustMaterialService service.
 	TrustMaterialServiceName = "proto.control_plane.v1.TrustMaterialService"
+	// TRCAnnouncementServiceName is the fully-qualified name of the TRCAnnouncementService service.
+	TRCAnnouncementServiceName = "proto.control_plane.v1.TRCAnnouncementService"
 )
 
 // These constants are the fully-qualified names of the RPCs defined in this package. They're
@@ -39,6 +41,12 @@ const (
 	// TrustMaterialServiceTRCProcedure is the fully-qualified name of the TrustMaterialService's TRC
 	// RPC.
 	TrustMaterialServiceTRCProcedure = "/proto.control_plane.v1.TrustMaterialService/TRC"
+	// TrustMaterialServiceCRLsProcedure is the fully-qualified name of the TrustMaterialService's CRLs
+	// RPC.
+	TrustMaterialServiceCRLsProcedure = "/proto.control_plane.v1.TrustMaterialService/CRLs"
+	// TRCAnnouncementServiceTRCAnnouncementProcedure is the fully-qualified name of the
+	// TRCAnnouncementService's TRCAnnouncement RPC.
+	TRCAnnouncementServiceTRCAnnouncementProcedure = "/proto.control_plane.v1.TRCAnnouncementService/TRCAnnouncement"
 )
 
 // TrustMaterialServiceClient is a client for the proto.control_plane.v1.TrustMaterialService
@@ -46,6 +54,7 @@ const (
 type TrustMaterialServiceClient interface {
 	Chains(context.Context, *connect.Request[control_plane.ChainsRequest]) (*connect.Response[control_plane.ChainsResponse], error)
 	TRC(context.Context, *connect.Request[control_plane.TRCRequest]) (*connect.Response[control_plane.TRCResponse], error)
+	CRLs(context.Context, *connect.Request[control_plane.CRLsRequest]) (*connect.Response[control_plane.CRLsResponse], error)
 }
 
 // NewTrustMaterialServiceClient constructs a client for the
@@ -72,6 +81,12 @@ func NewTrustMaterialServiceClient(httpClient connect.HTTPClient, baseURL string
 			connect.WithSchema(trustMaterialServiceMethods.ByName("TRC")),
 			connect.WithClientOptions(opts...),
 		),
+		cRLs: connect.NewClient[control_plane.CRLsRequest, control_plane.CRLsResponse](
+			httpClient,
+			baseURL+TrustMaterialServiceCRLsProcedure,
+			connect.WithSchema(trustMaterialServiceMethods.ByName("CRLs")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -79,6 +94,7 @@ func NewTrustMaterialServiceClient(httpClient connect.HTTPClient, baseURL string
 type trustMaterialServiceClient struct {
 	chains *connect.Client[control_plane.ChainsRequest, control_plane.ChainsResponse]
 	tRC    *connect.Client[control_plane.TRCRequest, control_plane.TRCResponse]
+	cRLs   *connect.Client[control_plane.CRLsRequest, control_plane.CRLsResponse]
 }
 
 // Chains calls proto.control_plane.v1.TrustMaterialService.Chains.
@@ -91,11 +107,17 @@ func (c *trustMaterialServiceClient) TRC(ctx context.Context, req *connect.Reque
 	return c.tRC.CallUnary(ctx, req)
 }
 
+// CRLs calls proto.control_plane.v1.TrustMaterialService.CRLs.
+func (c *trustMaterialServiceClient) CRLs(ctx context.Context, req *connect.Request[control_plane.CRLsRequest]) (*connect.Response[control_plane.CRLsResponse], error) {
+	return c.cRLs.CallUnary(ctx, req)
+}
+
 // TrustMaterialServiceHandler is an implementation of the
 // proto.control_plane.v1.TrustMaterialService service.
 type TrustMaterialServiceHandler interface {
 	Chains(context.Context, *connect.Request[control_plane.ChainsRequest]) (*connect.Response[control_plane.ChainsResponse], error)
 	TRC(context.Context, *connect.Request[control_plane.TRCRequest]) (*connect.Response[control_plane.TRCResponse], error)
+	CRLs(context.Context, *connect.Request[control_plane.CRLsRequest]) (*connect.Response[control_plane.CRLsResponse], error)
 }
 
 // NewTrustMaterialServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -117,12 +139,20 @@ func NewTrustMaterialServiceHandler(svc TrustMaterialServiceHandler, opts ...con
 		connect.WithSchema(trustMaterialServiceMethods.ByName("TRC")),
 		connect.WithHandlerOptions(opts...),
 	)
+	trustMaterialServiceCRLsHandler := connect.NewUnaryHandler(
+		TrustMaterialServiceCRLsProcedure,
+		svc.CRLs,
+		connect.WithSchema(trustMaterialServiceMethods.ByName("CRLs")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/proto.control_plane.v1.TrustMaterialService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TrustMaterialServiceChainsProcedure:
 			trustMaterialServiceChainsHandler.ServeHTTP(w, r)
 		case TrustMaterialServiceTRCProcedure:
 			trustMaterialServiceTRCHandler.ServeHTTP(w, r)
+		case TrustMaterialServiceCRLsProcedure:
+			trustMaterialServiceCRLsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -139,3 +169,80 @@ func (UnimplementedTrustMaterialServiceHandler) Chains(context.Context, *connect
 func (UnimplementedTrustMaterialServiceHandler) TRC(context.Context, *connect.Request[control_plane.TRCRequest]) (*connect.Response[control_plane.TRCResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.control_plane.v1.TrustMaterialService.TRC is not implemented"))
 }
+
+func (UnimplementedTrustMaterialServiceHandler) CRLs(context.Context, *connect.Request[control_plane.CRLsRequest]) (*connect.Response[control_plane.CRLsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.control_plane.v1.TrustMaterialService.CRLs is not implemented"))
+}
+
+// TRCAnnouncementServiceClient is a client for the proto.control_plane.v1.TRCAnnouncementService
+// service.
+type TRCAnnouncementServiceClient interface {
+	TRCAnnouncement(context.Context, *connect.Request[control_plane.TRCAnnouncementRequest]) (*connect.Response[control_plane.TRCAnnouncementResponse], error)
+}
+
+// NewTRCAnnouncementServiceClient constructs a client for the
+// proto.control_plane.v1.TRCAnnouncementService service. By default, it uses the Connect protocol
+// with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To
+// use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb()
+// options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTRCAnnouncementServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TRCAnnouncementServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	tRCAnnouncementServiceMethods := control_plane.File_proto_control_plane_v1_cppki_proto.Services().ByName("TRCAnnouncementService").Methods()
+	return &tRCAnnouncementServiceClient{
+		tRCAnnouncement: connect.NewClient[control_plane.TRCAnnouncementRequest, control_plane.TRCAnnouncementResponse](
+			httpClient,
+			baseURL+TRCAnnouncementServiceTRCAnnouncementProcedure,
+			connect.WithSchema(tRCAnnouncementServiceMethods.ByName("TRCAnnouncement")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// tRCAnnouncementServiceClient implements TRCAnnouncementServiceClient.
+type tRCAnnouncementServiceClient struct {
+	tRCAnnouncement *connect.Client[control_plane.TRCAnnouncementRequest, control_plane.TRCAnnouncementResponse]
+}
+
+// TRCAnnouncement calls proto.control_plane.v1.TRCAnnouncementService.TRCAnnouncement.
+func (c *tRCAnnouncementServiceClient) TRCAnnouncement(ctx context.Context, req *connect.Request[control_plane.TRCAnnouncementRequest]) (*connect.Response[control_plane.TRCAnnouncementResponse], error) {
+	return c.tRCAnnouncement.CallUnary(ctx, req)
+}
+
+// TRCAnnouncementServiceHandler is an implementation of the
+// proto.control_plane.v1.TRCAnnouncementService service.
+type TRCAnnouncementServiceHandler interface {
+	TRCAnnouncement(context.Context, *connect.Request[control_plane.TRCAnnouncementRequest]) (*connect.Response[control_plane.TRCAnnouncementResponse], error)
+}
+
+// NewTRCAnnouncementServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTRCAnnouncementServiceHandler(svc TRCAnnouncementServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	tRCAnnouncementServiceMethods := control_plane.File_proto_control_plane_v1_cppki_proto.Services().ByName("TRCAnnouncementService").Methods()
+	tRCAnnouncementServiceTRCAnnouncementHandler := connect.NewUnaryHandler(
+		TRCAnnouncementServiceTRCAnnouncementProcedure,
+		svc.TRCAnnouncement,
+		connect.WithSchema(tRCAnnouncementServiceMethods.ByName("TRCAnnouncement")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/proto.control_plane.v1.TRCAnnouncementService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TRCAnnouncementServiceTRCAnnouncementProcedure:
+			tRCAnnouncementServiceTRCAnnouncementHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTRCAnnouncementServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTRCAnnouncementServiceHandler struct{}
+
+func (UnimplementedTRCAnnouncementServiceHandler) TRCAnnouncement(context.Context, *connect.Request[control_plane.TRCAnnouncementRequest]) (*connect.Response[control_plane.TRCAnnouncementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.control_plane.v1.TRCAnnouncementService.TRCAnnouncement is not implemented"))
+}
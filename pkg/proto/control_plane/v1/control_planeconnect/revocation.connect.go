@@ -0,0 +1,110 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/control_plane/v1/revocation.proto
+
+package control_planeconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	control_plane "github.com/scionproto/scion/pkg/proto/control_plane"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// RevocationServiceName is the fully-qualified name of the RevocationService service.
+	RevocationServiceName = "proto.control_plane.v1.RevocationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// RevocationServiceRevocationProcedure is the fully-qualified name of the RevocationService's
+	// Revocation RPC.
+	RevocationServiceRevocationProcedure = "/proto.control_plane.v1.RevocationService/Revocation"
+)
+
+// RevocationServiceClient is a client for the proto.control_plane.v1.RevocationService service.
+type RevocationServiceClient interface {
+	Revocation(context.Context, *connect.Request[control_plane.RevocationRequest]) (*connect.Response[control_plane.RevocationResponse], error)
+}
+
+// NewRevocationServiceClient constructs a client for the proto.control_plane.v1.RevocationService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRevocationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RevocationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	revocationServiceMethods := control_plane.File_proto_control_plane_v1_revocation_proto.Services().ByName("RevocationService").Methods()
+	return &revocationServiceClient{
+		revocation: connect.NewClient[control_plane.RevocationRequest, control_plane.RevocationResponse](
+			httpClient,
+			baseURL+RevocationServiceRevocationProcedure,
+			connect.WithSchema(revocationServiceMethods.ByName("Revocation")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// revocationServiceClient implements RevocationServiceClient.
+type revocationServiceClient struct {
+	revocation *connect.Client[control_plane.RevocationRequest, control_plane.RevocationResponse]
+}
+
+// Revocation calls proto.control_plane.v1.RevocationService.Revocation.
+func (c *revocationServiceClient) Revocation(ctx context.Context, req *connect.Request[control_plane.RevocationRequest]) (*connect.Response[control_plane.RevocationResponse], error) {
+	return c.revocation.CallUnary(ctx, req)
+}
+
+// RevocationServiceHandler is an implementation of the proto.control_plane.v1.RevocationService
+// service.
+type RevocationServiceHandler interface {
+	Revocation(context.Context, *connect.Request[control_plane.RevocationRequest]) (*connect.Response[control_plane.RevocationResponse], error)
+}
+
+// NewRevocationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRevocationServiceHandler(svc RevocationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	revocationServiceMethods := control_plane.File_proto_control_plane_v1_revocation_proto.Services().ByName("RevocationService").Methods()
+	revocationServiceRevocationHandler := connect.NewUnaryHandler(
+		RevocationServiceRevocationProcedure,
+		svc.Revocation,
+		connect.WithSchema(revocationServiceMethods.ByName("Revocation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/proto.control_plane.v1.RevocationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RevocationServiceRevocationProcedure:
+			revocationServiceRevocationHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRevocationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRevocationServiceHandler struct{}
+
+func (UnimplementedRevocationServiceHandler) Revocation(context.Context, *connect.Request[control_plane.RevocationRequest]) (*connect.Response[control_plane.RevocationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.control_plane.v1.RevocationService.Revocation is not implemented"))
+}
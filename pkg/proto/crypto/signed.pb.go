@@ -29,6 +29,7 @@ const (
 	SignatureAlgorithm_SIGNATURE_ALGORITHM_ECDSA_WITH_SHA256 SignatureAlgorithm = 1
 	SignatureAlgorithm_SIGNATURE_ALGORITHM_ECDSA_WITH_SHA384 SignatureAlgorithm = 2
 	SignatureAlgorithm_SIGNATURE_ALGORITHM_ECDSA_WITH_SHA512 SignatureAlgorithm = 3
+	SignatureAlgorithm_SIGNATURE_ALGORITHM_ED25519           SignatureAlgorithm = 4
 )
 
 // Enum value maps for SignatureAlgorithm.
@@ -38,12 +39,14 @@ var (
 		1: "SIGNATURE_ALGORITHM_ECDSA_WITH_SHA256",
 		2: "SIGNATURE_ALGORITHM_ECDSA_WITH_SHA384",
 		3: "SIGNATURE_ALGORITHM_ECDSA_WITH_SHA512",
+		4: "SIGNATURE_ALGORITHM_ED25519",
 	}
 	SignatureAlgorithm_value = map[string]int32{
 		"SIGNATURE_ALGORITHM_UNSPECIFIED":       0,
 		"SIGNATURE_ALGORITHM_ECDSA_WITH_SHA256": 1,
 		"SIGNATURE_ALGORITHM_ECDSA_WITH_SHA384": 2,
 		"SIGNATURE_ALGORITHM_ECDSA_WITH_SHA512": 3,
+		"SIGNATURE_ALGORITHM_ED25519":           4,
 	}
 )
 
@@ -270,12 +273,13 @@ const file_proto_crypto_v1_signed_proto_rawDesc = "" +
 	"\x16associated_data_length\x18\x05 \x01(\x05R\x14associatedDataLength\"C\n" +
 	"\x15HeaderAndBodyInternal\x12\x16\n" +
 	"\x06header\x18\x01 \x01(\fR\x06header\x12\x12\n" +
-	"\x04body\x18\x02 \x01(\fR\x04body*\xba\x01\n" +
+	"\x04body\x18\x02 \x01(\fR\x04body*\xdb\x01\n" +
 	"\x12SignatureAlgorithm\x12#\n" +
 	"\x1fSIGNATURE_ALGORITHM_UNSPECIFIED\x10\x00\x12)\n" +
 	"%SIGNATURE_ALGORITHM_ECDSA_WITH_SHA256\x10\x01\x12)\n" +
 	"%SIGNATURE_ALGORITHM_ECDSA_WITH_SHA384\x10\x02\x12)\n" +
-	"%SIGNATURE_ALGORITHM_ECDSA_WITH_SHA512\x10\x03B.Z,github.com/scionproto/scion/pkg/proto/cryptob\x06proto3"
+	"%SIGNATURE_ALGORITHM_ECDSA_WITH_SHA512\x10\x03\x12\x1f\n" +
+	"\x1bSIGNATURE_ALGORITHM_ED25519\x10\x04B.Z,github.com/scionproto/scion/pkg/proto/cryptob\x06proto3"
 
 var (
 	file_proto_crypto_v1_signed_proto_rawDescOnce sync.Once
@@ -29,6 +29,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PathEventType int32
+
+const (
+	PathEventType_PATH_EVENT_TYPE_UNSPECIFIED PathEventType = 0
+	PathEventType_PATH_EVENT_TYPE_ADDED       PathEventType = 1
+	PathEventType_PATH_EVENT_TYPE_REMOVED     PathEventType = 2
+)
+
+// Enum value maps for PathEventType.
+var (
+	PathEventType_name = map[int32]string{
+		0: "PATH_EVENT_TYPE_UNSPECIFIED",
+		1: "PATH_EVENT_TYPE_ADDED",
+		2: "PATH_EVENT_TYPE_REMOVED",
+	}
+	PathEventType_value = map[string]int32{
+		"PATH_EVENT_TYPE_UNSPECIFIED": 0,
+		"PATH_EVENT_TYPE_ADDED":       1,
+		"PATH_EVENT_TYPE_REMOVED":     2,
+	}
+)
+
+func (x PathEventType) Enum() *PathEventType {
+	p := new(PathEventType)
+	*p = x
+	return p
+}
+
+func (x PathEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PathEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_daemon_v1_daemon_proto_enumTypes[0].Descriptor()
+}
+
+func (PathEventType) Type() protoreflect.EnumType {
+	return &file_proto_daemon_v1_daemon_proto_enumTypes[0]
+}
+
+func (x PathEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PathEventType.Descriptor instead.
+func (PathEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{0}
+}
+
 type LinkType int32
 
 const (
@@ -65,11 +114,11 @@ func (x LinkType) String() string {
 }
 
 func (LinkType) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_daemon_v1_daemon_proto_enumTypes[0].Descriptor()
+	return file_proto_daemon_v1_daemon_proto_enumTypes[1].Descriptor()
 }
 
 func (LinkType) Type() protoreflect.EnumType {
-	return &file_proto_daemon_v1_daemon_proto_enumTypes[0]
+	return &file_proto_daemon_v1_daemon_proto_enumTypes[1]
 }
 
 func (x LinkType) Number() protoreflect.EnumNumber {
@@ -78,7 +127,7 @@ func (x LinkType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LinkType.Descriptor instead.
 func (LinkType) EnumDescriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{0}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{1}
 }
 
 type PathsRequest struct {
@@ -193,6 +242,118 @@ func (x *PathsResponse) GetPaths() []*Path {
 	return nil
 }
 
+type WatchPathsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SourceIsdAs      uint64                 `protobuf:"varint,1,opt,name=source_isd_as,json=sourceIsdAs,proto3" json:"source_isd_as,omitempty"`
+	DestinationIsdAs uint64                 `protobuf:"varint,2,opt,name=destination_isd_as,json=destinationIsdAs,proto3" json:"destination_isd_as,omitempty"`
+	Policy           []byte                 `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WatchPathsRequest) Reset() {
+	*x = WatchPathsRequest{}
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPathsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPathsRequest) ProtoMessage() {}
+
+func (x *WatchPathsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPathsRequest.ProtoReflect.Descriptor instead.
+func (*WatchPathsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WatchPathsRequest) GetSourceIsdAs() uint64 {
+	if x != nil {
+		return x.SourceIsdAs
+	}
+	return 0
+}
+
+func (x *WatchPathsRequest) GetDestinationIsdAs() uint64 {
+	if x != nil {
+		return x.DestinationIsdAs
+	}
+	return 0
+}
+
+func (x *WatchPathsRequest) GetPolicy() []byte {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type WatchPathsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          PathEventType          `protobuf:"varint,1,opt,name=type,proto3,enum=proto.daemon.v1.PathEventType" json:"type,omitempty"`
+	Path          *Path                  `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPathsResponse) Reset() {
+	*x = WatchPathsResponse{}
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPathsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPathsResponse) ProtoMessage() {}
+
+func (x *WatchPathsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPathsResponse.ProtoReflect.Descriptor instead.
+func (*WatchPathsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WatchPathsResponse) GetType() PathEventType {
+	if x != nil {
+		return x.Type
+	}
+	return PathEventType_PATH_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WatchPathsResponse) GetPath() *Path {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
 type Path struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Raw           []byte                 `protobuf:"bytes,1,opt,name=raw,proto3" json:"raw,omitempty"`
@@ -213,7 +374,7 @@ type Path struct {
 
 func (x *Path) Reset() {
 	*x = Path{}
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[2]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -225,7 +386,7 @@ func (x *Path) String() string {
 func (*Path) ProtoMessage() {}
 
 func (x *Path) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[2]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -238,7 +399,7 @@ func (x *Path) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Path.ProtoReflect.Descriptor instead.
 func (*Path) Descriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{2}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Path) GetRaw() []byte {
@@ -335,7 +496,7 @@ type EpicAuths struct {
 
 func (x *EpicAuths) Reset() {
 	*x = EpicAuths{}
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[3]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -347,7 +508,7 @@ func (x *EpicAuths) String() string {
 func (*EpicAuths) ProtoMessage() {}
 
 func (x *EpicAuths) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[3]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +521,7 @@ func (x *EpicAuths) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EpicAuths.ProtoReflect.Descriptor instead.
 func (*EpicAuths) Descriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{3}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *EpicAuths) GetAuthPhvf() []byte {
@@ -387,7 +548,7 @@ type PathInterface struct {
 
 func (x *PathInterface) Reset() {
 	*x = PathInterface{}
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[4]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -399,7 +560,7 @@ func (x *PathInterface) String() string {
 func (*PathInterface) ProtoMessage() {}
 
 func (x *PathInterface) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[4]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,7 +573,7 @@ func (x *PathInterface) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PathInterface.ProtoReflect.Descriptor instead.
 func (*PathInterface) Descriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{4}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PathInterface) GetIsdAs() uint64 {
@@ -440,7 +601,7 @@ type GeoCoordinates struct {
 
 func (x *GeoCoordinates) Reset() {
 	*x = GeoCoordinates{}
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[5]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -452,7 +613,7 @@ func (x *GeoCoordinates) String() string {
 func (*GeoCoordinates) ProtoMessage() {}
 
 func (x *GeoCoordinates) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[5]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -465,7 +626,7 @@ func (x *GeoCoordinates) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GeoCoordinates.ProtoReflect.Descriptor instead.
 func (*GeoCoordinates) Descriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{5}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GeoCoordinates) GetLatitude() float32 {
@@ -498,7 +659,7 @@ type ASRequest struct {
 
 func (x *ASRequest) Reset() {
 	*x = ASRequest{}
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[6]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -510,7 +671,7 @@ func (x *ASRequest) String() string {
 func (*ASRequest) ProtoMessage() {}
 
 func (x *ASRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[6]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -523,7 +684,7 @@ func (x *ASRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ASRequest.ProtoReflect.Descriptor instead.
 func (*ASRequest) Descriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{6}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ASRequest) GetIsdAs() uint64 {
@@ -544,7 +705,7 @@ type ASResponse struct {
 
 func (x *ASResponse) Reset() {
 	*x = ASResponse{}
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[7]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -556,7 +717,7 @@ func (x *ASResponse) String() string {
 func (*ASResponse) ProtoMessage() {}
 
 func (x *ASResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[7]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -569,7 +730,7 @@ func (x *ASResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ASResponse.ProtoReflect.Descriptor instead.
 func (*ASResponse) Descriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{7}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ASResponse) GetIsdAs() uint64 {
@@ -601,7 +762,7 @@ type InterfacesRequest struct {
 
 func (x *InterfacesRequest) Reset() {
 	*x = InterfacesRequest{}
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[8]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -613,7 +774,7 @@ func (x *InterfacesRequest) String() string {
 func (*InterfacesRequest) ProtoMessage() {}
 
 func (x *InterfacesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[8]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -626,7 +787,7 @@ func (x *InterfacesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InterfacesRequest.ProtoReflect.Descriptor instead.
 func (*InterfacesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{8}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{10}
 }
 
 type InterfacesResponse struct {
@@ -638,7 +799,7 @@ type InterfacesResponse struct {
 
 func (x *InterfacesResponse) Reset() {
 	*x = InterfacesResponse{}
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[9]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -650,7 +811,7 @@ func (x *InterfacesResponse) String() string {
 func (*InterfacesResponse) ProtoMessage() {}
 
 func (x *InterfacesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[9]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -663,7 +824,7 @@ func (x *InterfacesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InterfacesResponse.ProtoReflect.Descriptor instead.
 func (*InterfacesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{9}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *InterfacesResponse) GetInterfaces() map[uint64]*Interface {
@@ -682,7 +843,7 @@ type Interface struct {
 
 func (x *Interface) Reset() {
 	*x = Interface{}
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[10]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -694,7 +855,7 @@ func (x *Interface) String() string {
 func (*Interface) ProtoMessage() {}
 
 func (x *Interface) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[10]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -707,7 +868,7 @@ func (x *Interface) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Interface.ProtoReflect.Descriptor instead.
 func (*Interface) Descriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{10}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Interface) GetAddress() *Underlay {
@@ -725,7 +886,7 @@ type ServicesRequest struct {
 
 func (x *ServicesRequest) Reset() {
 	*x = ServicesRequest{}
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[11]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -737,7 +898,7 @@ func (x *ServicesRequest) String() string {
 func (*ServicesRequest) ProtoMessage() {}
 
 func (x *ServicesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[11]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -750,7 +911,7 @@ func (x *ServicesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServicesRequest.ProtoReflect.Descriptor instead.
 func (*ServicesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{11}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{13}
 }
 
 type ServicesResponse struct {
@@ -762,7 +923,7 @@ type ServicesResponse struct {
 
 func (x *ServicesResponse) Reset() {
 	*x = ServicesResponse{}
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[12]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -774,7 +935,7 @@ func (x *ServicesResponse) String() string {
 func (*ServicesResponse) ProtoMessage() {}
 
 func (x *ServicesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[12]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -787,7 +948,7 @@ func (x *ServicesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServicesResponse.ProtoReflect.Descriptor instead.
 func (*ServicesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{12}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ServicesResponse) GetServices() map[string]*ListService {
@@ -806,7 +967,7 @@ type ListService struct {
 
 func (x *ListService) Reset() {
 	*x = ListService{}
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[13]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -818,7 +979,7 @@ func (x *ListService) String() string {
 func (*ListService) ProtoMessage() {}
 
 func (x *ListService) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[13]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -831,7 +992,7 @@ func (x *ListService) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListService.ProtoReflect.Descriptor instead.
 func (*ListService) Descriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{13}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListService) GetServices() []*Service {
@@ -850,7 +1011,7 @@ type Service struct {
 
 func (x *Service) Reset() {
 	*x = Service{}
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[14]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -862,7 +1023,7 @@ func (x *Service) String() string {
 func (*Service) ProtoMessage() {}
 
 func (x *Service) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[14]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -875,7 +1036,7 @@ func (x *Service) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Service.ProtoReflect.Descriptor instead.
 func (*Service) Descriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{14}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Service) GetUri() string {
@@ -894,7 +1055,7 @@ type Underlay struct {
 
 func (x *Underlay) Reset() {
 	*x = Underlay{}
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[15]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -906,7 +1067,7 @@ func (x *Underlay) String() string {
 func (*Underlay) ProtoMessage() {}
 
 func (x *Underlay) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[15]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -919,7 +1080,7 @@ func (x *Underlay) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Underlay.ProtoReflect.Descriptor instead.
 func (*Underlay) Descriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{15}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *Underlay) GetAddress() string {
@@ -939,7 +1100,7 @@ type NotifyInterfaceDownRequest struct {
 
 func (x *NotifyInterfaceDownRequest) Reset() {
 	*x = NotifyInterfaceDownRequest{}
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[16]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -951,7 +1112,7 @@ func (x *NotifyInterfaceDownRequest) String() string {
 func (*NotifyInterfaceDownRequest) ProtoMessage() {}
 
 func (x *NotifyInterfaceDownRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[16]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -964,7 +1125,7 @@ func (x *NotifyInterfaceDownRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotifyInterfaceDownRequest.ProtoReflect.Descriptor instead.
 func (*NotifyInterfaceDownRequest) Descriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{16}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *NotifyInterfaceDownRequest) GetIsdAs() uint64 {
@@ -989,7 +1150,7 @@ type NotifyInterfaceDownResponse struct {
 
 func (x *NotifyInterfaceDownResponse) Reset() {
 	*x = NotifyInterfaceDownResponse{}
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[17]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1001,7 +1162,7 @@ func (x *NotifyInterfaceDownResponse) String() string {
 func (*NotifyInterfaceDownResponse) ProtoMessage() {}
 
 func (x *NotifyInterfaceDownResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[17]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1014,7 +1175,7 @@ func (x *NotifyInterfaceDownResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotifyInterfaceDownResponse.ProtoReflect.Descriptor instead.
 func (*NotifyInterfaceDownResponse) Descriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{17}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{19}
 }
 
 type PortRangeResponse struct {
@@ -1027,7 +1188,7 @@ type PortRangeResponse struct {
 
 func (x *PortRangeResponse) Reset() {
 	*x = PortRangeResponse{}
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[18]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1039,7 +1200,7 @@ func (x *PortRangeResponse) String() string {
 func (*PortRangeResponse) ProtoMessage() {}
 
 func (x *PortRangeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[18]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1052,7 +1213,7 @@ func (x *PortRangeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PortRangeResponse.ProtoReflect.Descriptor instead.
 func (*PortRangeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{18}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *PortRangeResponse) GetDispatchedPortStart() uint32 {
@@ -1082,7 +1243,7 @@ type DRKeyHostASRequest struct {
 
 func (x *DRKeyHostASRequest) Reset() {
 	*x = DRKeyHostASRequest{}
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[19]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1094,7 +1255,7 @@ func (x *DRKeyHostASRequest) String() string {
 func (*DRKeyHostASRequest) ProtoMessage() {}
 
 func (x *DRKeyHostASRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[19]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1107,7 +1268,7 @@ func (x *DRKeyHostASRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DRKeyHostASRequest.ProtoReflect.Descriptor instead.
 func (*DRKeyHostASRequest) Descriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{19}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DRKeyHostASRequest) GetValTime() *timestamppb.Timestamp {
@@ -1156,7 +1317,7 @@ type DRKeyHostASResponse struct {
 
 func (x *DRKeyHostASResponse) Reset() {
 	*x = DRKeyHostASResponse{}
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[20]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1168,7 +1329,7 @@ func (x *DRKeyHostASResponse) String() string {
 func (*DRKeyHostASResponse) ProtoMessage() {}
 
 func (x *DRKeyHostASResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[20]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1181,7 +1342,7 @@ func (x *DRKeyHostASResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DRKeyHostASResponse.ProtoReflect.Descriptor instead.
 func (*DRKeyHostASResponse) Descriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{20}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DRKeyHostASResponse) GetEpochBegin() *timestamppb.Timestamp {
@@ -1218,7 +1379,7 @@ type DRKeyASHostRequest struct {
 
 func (x *DRKeyASHostRequest) Reset() {
 	*x = DRKeyASHostRequest{}
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[21]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1230,7 +1391,7 @@ func (x *DRKeyASHostRequest) String() string {
 func (*DRKeyASHostRequest) ProtoMessage() {}
 
 func (x *DRKeyASHostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[21]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1243,7 +1404,7 @@ func (x *DRKeyASHostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DRKeyASHostRequest.ProtoReflect.Descriptor instead.
 func (*DRKeyASHostRequest) Descriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{21}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *DRKeyASHostRequest) GetValTime() *timestamppb.Timestamp {
@@ -1292,7 +1453,7 @@ type DRKeyASHostResponse struct {
 
 func (x *DRKeyASHostResponse) Reset() {
 	*x = DRKeyASHostResponse{}
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[22]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1304,7 +1465,7 @@ func (x *DRKeyASHostResponse) String() string {
 func (*DRKeyASHostResponse) ProtoMessage() {}
 
 func (x *DRKeyASHostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[22]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1317,7 +1478,7 @@ func (x *DRKeyASHostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DRKeyASHostResponse.ProtoReflect.Descriptor instead.
 func (*DRKeyASHostResponse) Descriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{22}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *DRKeyASHostResponse) GetEpochBegin() *timestamppb.Timestamp {
@@ -1355,7 +1516,7 @@ type DRKeyHostHostRequest struct {
 
 func (x *DRKeyHostHostRequest) Reset() {
 	*x = DRKeyHostHostRequest{}
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[23]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1367,7 +1528,7 @@ func (x *DRKeyHostHostRequest) String() string {
 func (*DRKeyHostHostRequest) ProtoMessage() {}
 
 func (x *DRKeyHostHostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[23]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1380,7 +1541,7 @@ func (x *DRKeyHostHostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DRKeyHostHostRequest.ProtoReflect.Descriptor instead.
 func (*DRKeyHostHostRequest) Descriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{23}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *DRKeyHostHostRequest) GetValTime() *timestamppb.Timestamp {
@@ -1436,7 +1597,7 @@ type DRKeyHostHostResponse struct {
 
 func (x *DRKeyHostHostResponse) Reset() {
 	*x = DRKeyHostHostResponse{}
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[24]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1448,7 +1609,7 @@ func (x *DRKeyHostHostResponse) String() string {
 func (*DRKeyHostHostResponse) ProtoMessage() {}
 
 func (x *DRKeyHostHostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[24]
+	mi := &file_proto_daemon_v1_daemon_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1461,7 +1622,7 @@ func (x *DRKeyHostHostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DRKeyHostHostResponse.ProtoReflect.Descriptor instead.
 func (*DRKeyHostHostResponse) Descriptor() ([]byte, []int) {
-	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{24}
+	return file_proto_daemon_v1_daemon_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *DRKeyHostHostResponse) GetEpochBegin() *timestamppb.Timestamp {
@@ -1496,7 +1657,14 @@ const file_proto_daemon_v1_daemon_proto_rawDesc = "" +
 	"\arefresh\x18\x03 \x01(\bR\arefresh\x12\x16\n" +
 	"\x06hidden\x18\x04 \x01(\bR\x06hidden\"<\n" +
 	"\rPathsResponse\x12+\n" +
-	"\x05paths\x18\x01 \x03(\v2\x15.proto.daemon.v1.PathR\x05paths\"\x94\x04\n" +
+	"\x05paths\x18\x01 \x03(\v2\x15.proto.daemon.v1.PathR\x05paths\"}\n" +
+	"\x11WatchPathsRequest\x12\"\n" +
+	"\rsource_isd_as\x18\x01 \x01(\x04R\vsourceIsdAs\x12,\n" +
+	"\x12destination_isd_as\x18\x02 \x01(\x04R\x10destinationIsdAs\x12\x16\n" +
+	"\x06policy\x18\x03 \x01(\fR\x06policy\"s\n" +
+	"\x12WatchPathsResponse\x122\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1e.proto.daemon.v1.PathEventTypeR\x04type\x12)\n" +
+	"\x04path\x18\x02 \x01(\v2\x15.proto.daemon.v1.PathR\x04path\"\x94\x04\n" +
 	"\x04Path\x12\x10\n" +
 	"\x03raw\x18\x01 \x01(\fR\x03raw\x128\n" +
 	"\tinterface\x18\x02 \x01(\v2\x1a.proto.daemon.v1.InterfaceR\tinterface\x12>\n" +
@@ -1598,14 +1766,20 @@ const file_proto_daemon_v1_daemon_proto_rawDesc = "" +
 	"\vepoch_begin\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"epochBegin\x127\n" +
 	"\tepoch_end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bepochEnd\x12\x10\n" +
-	"\x03key\x18\x03 \x01(\fR\x03key*l\n" +
+	"\x03key\x18\x03 \x01(\fR\x03key*h\n" +
+	"\rPathEventType\x12\x1f\n" +
+	"\x1bPATH_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15PATH_EVENT_TYPE_ADDED\x10\x01\x12\x1b\n" +
+	"\x17PATH_EVENT_TYPE_REMOVED\x10\x02*l\n" +
 	"\bLinkType\x12\x19\n" +
 	"\x15LINK_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10LINK_TYPE_DIRECT\x10\x01\x12\x17\n" +
 	"\x13LINK_TYPE_MULTI_HOP\x10\x02\x12\x16\n" +
-	"\x12LINK_TYPE_OPEN_NET\x10\x032\x9f\x06\n" +
+	"\x12LINK_TYPE_OPEN_NET\x10\x032\xfa\x06\n" +
 	"\rDaemonService\x12H\n" +
-	"\x05Paths\x12\x1d.proto.daemon.v1.PathsRequest\x1a\x1e.proto.daemon.v1.PathsResponse\"\x00\x12?\n" +
+	"\x05Paths\x12\x1d.proto.daemon.v1.PathsRequest\x1a\x1e.proto.daemon.v1.PathsResponse\"\x00\x12Y\n" +
+	"\n" +
+	"WatchPaths\x12\".proto.daemon.v1.WatchPathsRequest\x1a#.proto.daemon.v1.WatchPathsResponse\"\x000\x01\x12?\n" +
 	"\x02AS\x12\x1a.proto.daemon.v1.ASRequest\x1a\x1b.proto.daemon.v1.ASResponse\"\x00\x12W\n" +
 	"\n" +
 	"Interfaces\x12\".proto.daemon.v1.InterfacesRequest\x1a#.proto.daemon.v1.InterfacesResponse\"\x00\x12Q\n" +
@@ -1628,92 +1802,99 @@ func file_proto_daemon_v1_daemon_proto_rawDescGZIP() []byte {
 	return file_proto_daemon_v1_daemon_proto_rawDescData
 }
 
-var file_proto_daemon_v1_daemon_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_daemon_v1_daemon_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_proto_daemon_v1_daemon_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_daemon_v1_daemon_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_proto_daemon_v1_daemon_proto_goTypes = []any{
-	(LinkType)(0),                       // 0: proto.daemon.v1.LinkType
-	(*PathsRequest)(nil),                // 1: proto.daemon.v1.PathsRequest
-	(*PathsResponse)(nil),               // 2: proto.daemon.v1.PathsResponse
-	(*Path)(nil),                        // 3: proto.daemon.v1.Path
-	(*EpicAuths)(nil),                   // 4: proto.daemon.v1.EpicAuths
-	(*PathInterface)(nil),               // 5: proto.daemon.v1.PathInterface
-	(*GeoCoordinates)(nil),              // 6: proto.daemon.v1.GeoCoordinates
-	(*ASRequest)(nil),                   // 7: proto.daemon.v1.ASRequest
-	(*ASResponse)(nil),                  // 8: proto.daemon.v1.ASResponse
-	(*InterfacesRequest)(nil),           // 9: proto.daemon.v1.InterfacesRequest
-	(*InterfacesResponse)(nil),          // 10: proto.daemon.v1.InterfacesResponse
-	(*Interface)(nil),                   // 11: proto.daemon.v1.Interface
-	(*ServicesRequest)(nil),             // 12: proto.daemon.v1.ServicesRequest
-	(*ServicesResponse)(nil),            // 13: proto.daemon.v1.ServicesResponse
-	(*ListService)(nil),                 // 14: proto.daemon.v1.ListService
-	(*Service)(nil),                     // 15: proto.daemon.v1.Service
-	(*Underlay)(nil),                    // 16: proto.daemon.v1.Underlay
-	(*NotifyInterfaceDownRequest)(nil),  // 17: proto.daemon.v1.NotifyInterfaceDownRequest
-	(*NotifyInterfaceDownResponse)(nil), // 18: proto.daemon.v1.NotifyInterfaceDownResponse
-	(*PortRangeResponse)(nil),           // 19: proto.daemon.v1.PortRangeResponse
-	(*DRKeyHostASRequest)(nil),          // 20: proto.daemon.v1.DRKeyHostASRequest
-	(*DRKeyHostASResponse)(nil),         // 21: proto.daemon.v1.DRKeyHostASResponse
-	(*DRKeyASHostRequest)(nil),          // 22: proto.daemon.v1.DRKeyASHostRequest
-	(*DRKeyASHostResponse)(nil),         // 23: proto.daemon.v1.DRKeyASHostResponse
-	(*DRKeyHostHostRequest)(nil),        // 24: proto.daemon.v1.DRKeyHostHostRequest
-	(*DRKeyHostHostResponse)(nil),       // 25: proto.daemon.v1.DRKeyHostHostResponse
-	nil,                                 // 26: proto.daemon.v1.InterfacesResponse.InterfacesEntry
-	nil,                                 // 27: proto.daemon.v1.ServicesResponse.ServicesEntry
-	(*timestamppb.Timestamp)(nil),       // 28: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),         // 29: google.protobuf.Duration
-	(drkey.Protocol)(0),                 // 30: proto.drkey.v1.Protocol
-	(*emptypb.Empty)(nil),               // 31: google.protobuf.Empty
+	(PathEventType)(0),                  // 0: proto.daemon.v1.PathEventType
+	(LinkType)(0),                       // 1: proto.daemon.v1.LinkType
+	(*PathsRequest)(nil),                // 2: proto.daemon.v1.PathsRequest
+	(*PathsResponse)(nil),               // 3: proto.daemon.v1.PathsResponse
+	(*WatchPathsRequest)(nil),           // 4: proto.daemon.v1.WatchPathsRequest
+	(*WatchPathsResponse)(nil),          // 5: proto.daemon.v1.WatchPathsResponse
+	(*Path)(nil),                        // 6: proto.daemon.v1.Path
+	(*EpicAuths)(nil),                   // 7: proto.daemon.v1.EpicAuths
+	(*PathInterface)(nil),               // 8: proto.daemon.v1.PathInterface
+	(*GeoCoordinates)(nil),              // 9: proto.daemon.v1.GeoCoordinates
+	(*ASRequest)(nil),                   // 10: proto.daemon.v1.ASRequest
+	(*ASResponse)(nil),                  // 11: proto.daemon.v1.ASResponse
+	(*InterfacesRequest)(nil),           // 12: proto.daemon.v1.InterfacesRequest
+	(*InterfacesResponse)(nil),          // 13: proto.daemon.v1.InterfacesResponse
+	(*Interface)(nil),                   // 14: proto.daemon.v1.Interface
+	(*ServicesRequest)(nil),             // 15: proto.daemon.v1.ServicesRequest
+	(*ServicesResponse)(nil),            // 16: proto.daemon.v1.ServicesResponse
+	(*ListService)(nil),                 // 17: proto.daemon.v1.ListService
+	(*Service)(nil),                     // 18: proto.daemon.v1.Service
+	(*Underlay)(nil),                    // 19: proto.daemon.v1.Underlay
+	(*NotifyInterfaceDownRequest)(nil),  // 20: proto.daemon.v1.NotifyInterfaceDownRequest
+	(*NotifyInterfaceDownResponse)(nil), // 21: proto.daemon.v1.NotifyInterfaceDownResponse
+	(*PortRangeResponse)(nil),           // 22: proto.daemon.v1.PortRangeResponse
+	(*DRKeyHostASRequest)(nil),          // 23: proto.daemon.v1.DRKeyHostASRequest
+	(*DRKeyHostASResponse)(nil),         // 24: proto.daemon.v1.DRKeyHostASResponse
+	(*DRKeyASHostRequest)(nil),          // 25: proto.daemon.v1.DRKeyASHostRequest
+	(*DRKeyASHostResponse)(nil),         // 26: proto.daemon.v1.DRKeyASHostResponse
+	(*DRKeyHostHostRequest)(nil),        // 27: proto.daemon.v1.DRKeyHostHostRequest
+	(*DRKeyHostHostResponse)(nil),       // 28: proto.daemon.v1.DRKeyHostHostResponse
+	nil,                                 // 29: proto.daemon.v1.InterfacesResponse.InterfacesEntry
+	nil,                                 // 30: proto.daemon.v1.ServicesResponse.ServicesEntry
+	(*timestamppb.Timestamp)(nil),       // 31: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),         // 32: google.protobuf.Duration
+	(drkey.Protocol)(0),                 // 33: proto.drkey.v1.Protocol
+	(*emptypb.Empty)(nil),               // 34: google.protobuf.Empty
 }
 var file_proto_daemon_v1_daemon_proto_depIdxs = []int32{
-	3,  // 0: proto.daemon.v1.PathsResponse.paths:type_name -> proto.daemon.v1.Path
-	11, // 1: proto.daemon.v1.Path.interface:type_name -> proto.daemon.v1.Interface
-	5,  // 2: proto.daemon.v1.Path.interfaces:type_name -> proto.daemon.v1.PathInterface
-	28, // 3: proto.daemon.v1.Path.expiration:type_name -> google.protobuf.Timestamp
-	29, // 4: proto.daemon.v1.Path.latency:type_name -> google.protobuf.Duration
-	6,  // 5: proto.daemon.v1.Path.geo:type_name -> proto.daemon.v1.GeoCoordinates
-	0,  // 6: proto.daemon.v1.Path.link_type:type_name -> proto.daemon.v1.LinkType
-	4,  // 7: proto.daemon.v1.Path.epic_auths:type_name -> proto.daemon.v1.EpicAuths
-	26, // 8: proto.daemon.v1.InterfacesResponse.interfaces:type_name -> proto.daemon.v1.InterfacesResponse.InterfacesEntry
-	16, // 9: proto.daemon.v1.Interface.address:type_name -> proto.daemon.v1.Underlay
-	27, // 10: proto.daemon.v1.ServicesResponse.services:type_name -> proto.daemon.v1.ServicesResponse.ServicesEntry
-	15, // 11: proto.daemon.v1.ListService.services:type_name -> proto.daemon.v1.Service
-	28, // 12: proto.daemon.v1.DRKeyHostASRequest.val_time:type_name -> google.protobuf.Timestamp
-	30, // 13: proto.daemon.v1.DRKeyHostASRequest.protocol_id:type_name -> proto.drkey.v1.Protocol
-	28, // 14: proto.daemon.v1.DRKeyHostASResponse.epoch_begin:type_name -> google.protobuf.Timestamp
-	28, // 15: proto.daemon.v1.DRKeyHostASResponse.epoch_end:type_name -> google.protobuf.Timestamp
-	28, // 16: proto.daemon.v1.DRKeyASHostRequest.val_time:type_name -> google.protobuf.Timestamp
-	30, // 17: proto.daemon.v1.DRKeyASHostRequest.protocol_id:type_name -> proto.drkey.v1.Protocol
-	28, // 18: proto.daemon.v1.DRKeyASHostResponse.epoch_begin:type_name -> google.protobuf.Timestamp
-	28, // 19: proto.daemon.v1.DRKeyASHostResponse.epoch_end:type_name -> google.protobuf.Timestamp
-	28, // 20: proto.daemon.v1.DRKeyHostHostRequest.val_time:type_name -> google.protobuf.Timestamp
-	30, // 21: proto.daemon.v1.DRKeyHostHostRequest.protocol_id:type_name -> proto.drkey.v1.Protocol
-	28, // 22: proto.daemon.v1.DRKeyHostHostResponse.epoch_begin:type_name -> google.protobuf.Timestamp
-	28, // 23: proto.daemon.v1.DRKeyHostHostResponse.epoch_end:type_name -> google.protobuf.Timestamp
-	11, // 24: proto.daemon.v1.InterfacesResponse.InterfacesEntry.value:type_name -> proto.daemon.v1.Interface
-	14, // 25: proto.daemon.v1.ServicesResponse.ServicesEntry.value:type_name -> proto.daemon.v1.ListService
-	1,  // 26: proto.daemon.v1.DaemonService.Paths:input_type -> proto.daemon.v1.PathsRequest
-	7,  // 27: proto.daemon.v1.DaemonService.AS:input_type -> proto.daemon.v1.ASRequest
-	9,  // 28: proto.daemon.v1.DaemonService.Interfaces:input_type -> proto.daemon.v1.InterfacesRequest
-	12, // 29: proto.daemon.v1.DaemonService.Services:input_type -> proto.daemon.v1.ServicesRequest
-	17, // 30: proto.daemon.v1.DaemonService.NotifyInterfaceDown:input_type -> proto.daemon.v1.NotifyInterfaceDownRequest
-	31, // 31: proto.daemon.v1.DaemonService.PortRange:input_type -> google.protobuf.Empty
-	22, // 32: proto.daemon.v1.DaemonService.DRKeyASHost:input_type -> proto.daemon.v1.DRKeyASHostRequest
-	20, // 33: proto.daemon.v1.DaemonService.DRKeyHostAS:input_type -> proto.daemon.v1.DRKeyHostASRequest
-	24, // 34: proto.daemon.v1.DaemonService.DRKeyHostHost:input_type -> proto.daemon.v1.DRKeyHostHostRequest
-	2,  // 35: proto.daemon.v1.DaemonService.Paths:output_type -> proto.daemon.v1.PathsResponse
-	8,  // 36: proto.daemon.v1.DaemonService.AS:output_type -> proto.daemon.v1.ASResponse
-	10, // 37: proto.daemon.v1.DaemonService.Interfaces:output_type -> proto.daemon.v1.InterfacesResponse
-	13, // 38: proto.daemon.v1.DaemonService.Services:output_type -> proto.daemon.v1.ServicesResponse
-	18, // 39: proto.daemon.v1.DaemonService.NotifyInterfaceDown:output_type -> proto.daemon.v1.NotifyInterfaceDownResponse
-	19, // 40: proto.daemon.v1.DaemonService.PortRange:output_type -> proto.daemon.v1.PortRangeResponse
-	23, // 41: proto.daemon.v1.DaemonService.DRKeyASHost:output_type -> proto.daemon.v1.DRKeyASHostResponse
-	21, // 42: proto.daemon.v1.DaemonService.DRKeyHostAS:output_type -> proto.daemon.v1.DRKeyHostASResponse
-	25, // 43: proto.daemon.v1.DaemonService.DRKeyHostHost:output_type -> proto.daemon.v1.DRKeyHostHostResponse
-	35, // [35:44] is the sub-list for method output_type
-	26, // [26:35] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	6,  // 0: proto.daemon.v1.PathsResponse.paths:type_name -> proto.daemon.v1.Path
+	0,  // 1: proto.daemon.v1.WatchPathsResponse.type:type_name -> proto.daemon.v1.PathEventType
+	6,  // 2: proto.daemon.v1.WatchPathsResponse.path:type_name -> proto.daemon.v1.Path
+	14, // 3: proto.daemon.v1.Path.interface:type_name -> proto.daemon.v1.Interface
+	8,  // 4: proto.daemon.v1.Path.interfaces:type_name -> proto.daemon.v1.PathInterface
+	31, // 5: proto.daemon.v1.Path.expiration:type_name -> google.protobuf.Timestamp
+	32, // 6: proto.daemon.v1.Path.latency:type_name -> google.protobuf.Duration
+	9,  // 7: proto.daemon.v1.Path.geo:type_name -> proto.daemon.v1.GeoCoordinates
+	1,  // 8: proto.daemon.v1.Path.link_type:type_name -> proto.daemon.v1.LinkType
+	7,  // 9: proto.daemon.v1.Path.epic_auths:type_name -> proto.daemon.v1.EpicAuths
+	29, // 10: proto.daemon.v1.InterfacesResponse.interfaces:type_name -> proto.daemon.v1.InterfacesResponse.InterfacesEntry
+	19, // 11: proto.daemon.v1.Interface.address:type_name -> proto.daemon.v1.Underlay
+	30, // 12: proto.daemon.v1.ServicesResponse.services:type_name -> proto.daemon.v1.ServicesResponse.ServicesEntry
+	18, // 13: proto.daemon.v1.ListService.services:type_name -> proto.daemon.v1.Service
+	31, // 14: proto.daemon.v1.DRKeyHostASRequest.val_time:type_name -> google.protobuf.Timestamp
+	33, // 15: proto.daemon.v1.DRKeyHostASRequest.protocol_id:type_name -> proto.drkey.v1.Protocol
+	31, // 16: proto.daemon.v1.DRKeyHostASResponse.epoch_begin:type_name -> google.protobuf.Timestamp
+	31, // 17: proto.daemon.v1.DRKeyHostASResponse.epoch_end:type_name -> google.protobuf.Timestamp
+	31, // 18: proto.daemon.v1.DRKeyASHostRequest.val_time:type_name -> google.protobuf.Timestamp
+	33, // 19: proto.daemon.v1.DRKeyASHostRequest.protocol_id:type_name -> proto.drkey.v1.Protocol
+	31, // 20: proto.daemon.v1.DRKeyASHostResponse.epoch_begin:type_name -> google.protobuf.Timestamp
+	31, // 21: proto.daemon.v1.DRKeyASHostResponse.epoch_end:type_name -> google.protobuf.Timestamp
+	31, // 22: proto.daemon.v1.DRKeyHostHostRequest.val_time:type_name -> google.protobuf.Timestamp
+	33, // 23: proto.daemon.v1.DRKeyHostHostRequest.protocol_id:type_name -> proto.drkey.v1.Protocol
+	31, // 24: proto.daemon.v1.DRKeyHostHostResponse.epoch_begin:type_name -> google.protobuf.Timestamp
+	31, // 25: proto.daemon.v1.DRKeyHostHostResponse.epoch_end:type_name -> google.protobuf.Timestamp
+	14, // 26: proto.daemon.v1.InterfacesResponse.InterfacesEntry.value:type_name -> proto.daemon.v1.Interface
+	17, // 27: proto.daemon.v1.ServicesResponse.ServicesEntry.value:type_name -> proto.daemon.v1.ListService
+	2,  // 28: proto.daemon.v1.DaemonService.Paths:input_type -> proto.daemon.v1.PathsRequest
+	4,  // 29: proto.daemon.v1.DaemonService.WatchPaths:input_type -> proto.daemon.v1.WatchPathsRequest
+	10, // 30: proto.daemon.v1.DaemonService.AS:input_type -> proto.daemon.v1.ASRequest
+	12, // 31: proto.daemon.v1.DaemonService.Interfaces:input_type -> proto.daemon.v1.InterfacesRequest
+	15, // 32: proto.daemon.v1.DaemonService.Services:input_type -> proto.daemon.v1.ServicesRequest
+	20, // 33: proto.daemon.v1.DaemonService.NotifyInterfaceDown:input_type -> proto.daemon.v1.NotifyInterfaceDownRequest
+	34, // 34: proto.daemon.v1.DaemonService.PortRange:input_type -> google.protobuf.Empty
+	25, // 35: proto.daemon.v1.DaemonService.DRKeyASHost:input_type -> proto.daemon.v1.DRKeyASHostRequest
+	23, // 36: proto.daemon.v1.DaemonService.DRKeyHostAS:input_type -> proto.daemon.v1.DRKeyHostASRequest
+	27, // 37: proto.daemon.v1.DaemonService.DRKeyHostHost:input_type -> proto.daemon.v1.DRKeyHostHostRequest
+	3,  // 38: proto.daemon.v1.DaemonService.Paths:output_type -> proto.daemon.v1.PathsResponse
+	5,  // 39: proto.daemon.v1.DaemonService.WatchPaths:output_type -> proto.daemon.v1.WatchPathsResponse
+	11, // 40: proto.daemon.v1.DaemonService.AS:output_type -> proto.daemon.v1.ASResponse
+	13, // 41: proto.daemon.v1.DaemonService.Interfaces:output_type -> proto.daemon.v1.InterfacesResponse
+	16, // 42: proto.daemon.v1.DaemonService.Services:output_type -> proto.daemon.v1.ServicesResponse
+	21, // 43: proto.daemon.v1.DaemonService.NotifyInterfaceDown:output_type -> proto.daemon.v1.NotifyInterfaceDownResponse
+	22, // 44: proto.daemon.v1.DaemonService.PortRange:output_type -> proto.daemon.v1.PortRangeResponse
+	26, // 45: proto.daemon.v1.DaemonService.DRKeyASHost:output_type -> proto.daemon.v1.DRKeyASHostResponse
+	24, // 46: proto.daemon.v1.DaemonService.DRKeyHostAS:output_type -> proto.daemon.v1.DRKeyHostASResponse
+	28, // 47: proto.daemon.v1.DaemonService.DRKeyHostHost:output_type -> proto.daemon.v1.DRKeyHostHostResponse
+	38, // [38:48] is the sub-list for method output_type
+	28, // [28:38] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_proto_daemon_v1_daemon_proto_init() }
@@ -1726,8 +1907,8 @@ func file_proto_daemon_v1_daemon_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_daemon_v1_daemon_proto_rawDesc), len(file_proto_daemon_v1_daemon_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   27,
+			NumEnums:      2,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1754,6 +1935,7 @@ const _ = grpc.SupportPackageIsVersion6
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type DaemonServiceClient interface {
 	Paths(ctx context.Context, in *PathsRequest, opts ...grpc.CallOption) (*PathsResponse, error)
+	WatchPaths(ctx context.Context, in *WatchPathsRequest, opts ...grpc.CallOption) (DaemonService_WatchPathsClient, error)
 	AS(ctx context.Context, in *ASRequest, opts ...grpc.CallOption) (*ASResponse, error)
 	Interfaces(ctx context.Context, in *InterfacesRequest, opts ...grpc.CallOption) (*InterfacesResponse, error)
 	Services(ctx context.Context, in *ServicesRequest, opts ...grpc.CallOption) (*ServicesResponse, error)
@@ -1781,6 +1963,38 @@ func (c *daemonServiceClient) Paths(ctx context.Context, in *PathsRequest, opts
 	return out, nil
 }
 
+func (c *daemonServiceClient) WatchPaths(ctx context.Context, in *WatchPathsRequest, opts ...grpc.CallOption) (DaemonService_WatchPathsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DaemonService_serviceDesc.Streams[0], "/proto.daemon.v1.DaemonService/WatchPaths", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonServiceWatchPathsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DaemonService_WatchPathsClient interface {
+	Recv() (*WatchPathsResponse, error)
+	grpc.ClientStream
+}
+
+type daemonServiceWatchPathsClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonServiceWatchPathsClient) Recv() (*WatchPathsResponse, error) {
+	m := new(WatchPathsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *daemonServiceClient) AS(ctx context.Context, in *ASRequest, opts ...grpc.CallOption) (*ASResponse, error) {
 	out := new(ASResponse)
 	err := c.cc.Invoke(ctx, "/proto.daemon.v1.DaemonService/AS", in, out, opts...)
@@ -1856,6 +2070,7 @@ func (c *daemonServiceClient) DRKeyHostHost(ctx context.Context, in *DRKeyHostHo
 // DaemonServiceServer is the server API for DaemonService service.
 type DaemonServiceServer interface {
 	Paths(context.Context, *PathsRequest) (*PathsResponse, error)
+	WatchPaths(*WatchPathsRequest, DaemonService_WatchPathsServer) error
 	AS(context.Context, *ASRequest) (*ASResponse, error)
 	Interfaces(context.Context, *InterfacesRequest) (*InterfacesResponse, error)
 	Services(context.Context, *ServicesRequest) (*ServicesResponse, error)
@@ -1873,6 +2088,9 @@ type UnimplementedDaemonServiceServer struct {
 func (*UnimplementedDaemonServiceServer) Paths(context.Context, *PathsRequest) (*PathsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Paths not implemented")
 }
+func (*UnimplementedDaemonServiceServer) WatchPaths(*WatchPathsRequest, DaemonService_WatchPathsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPaths not implemented")
+}
 func (*UnimplementedDaemonServiceServer) AS(context.Context, *ASRequest) (*ASResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AS not implemented")
 }
@@ -1920,6 +2138,27 @@ func _DaemonService_Paths_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DaemonService_WatchPaths_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPathsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServiceServer).WatchPaths(m, &daemonServiceWatchPathsServer{stream})
+}
+
+type DaemonService_WatchPathsServer interface {
+	Send(*WatchPathsResponse) error
+	grpc.ServerStream
+}
+
+type daemonServiceWatchPathsServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonServiceWatchPathsServer) Send(m *WatchPathsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _DaemonService_AS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ASRequest)
 	if err := dec(in); err != nil {
@@ -2105,6 +2344,12 @@ var _DaemonService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _DaemonService_DRKeyHostHost_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchPaths",
+			Handler:       _DaemonService_WatchPaths_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "proto/daemon/v1/daemon.proto",
 }
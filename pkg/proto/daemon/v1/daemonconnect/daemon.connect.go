@@ -36,6 +36,9 @@ const (
 const (
 	// DaemonServicePathsProcedure is the fully-qualified name of the DaemonService's Paths RPC.
 	DaemonServicePathsProcedure = "/proto.daemon.v1.DaemonService/Paths"
+	// DaemonServiceWatchPathsProcedure is the fully-qualified name of the DaemonService's WatchPaths
+	// RPC.
+	DaemonServiceWatchPathsProcedure = "/proto.daemon.v1.DaemonService/WatchPaths"
 	// DaemonServiceASProcedure is the fully-qualified name of the DaemonService's AS RPC.
 	DaemonServiceASProcedure = "/proto.daemon.v1.DaemonService/AS"
 	// DaemonServiceInterfacesProcedure is the fully-qualified name of the DaemonService's Interfaces
@@ -62,6 +65,7 @@ const (
 // DaemonServiceClient is a client for the proto.daemon.v1.DaemonService service.
 type DaemonServiceClient interface {
 	Paths(context.Context, *connect.Request[daemon.PathsRequest]) (*connect.Response[daemon.PathsResponse], error)
+	WatchPaths(context.Context, *connect.Request[daemon.WatchPathsRequest]) (*connect.ServerStreamForClient[daemon.WatchPathsResponse], error)
 	AS(context.Context, *connect.Request[daemon.ASRequest]) (*connect.Response[daemon.ASResponse], error)
 	Interfaces(context.Context, *connect.Request[daemon.InterfacesRequest]) (*connect.Response[daemon.InterfacesResponse], error)
 	Services(context.Context, *connect.Request[daemon.ServicesRequest]) (*connect.Response[daemon.ServicesResponse], error)
@@ -89,6 +93,12 @@ func NewDaemonServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(daemonServiceMethods.ByName("Paths")),
 			connect.WithClientOptions(opts...),
 		),
+		watchPaths: connect.NewClient[daemon.WatchPathsRequest, daemon.WatchPathsResponse](
+			httpClient,
+			baseURL+DaemonServiceWatchPathsProcedure,
+			connect.WithSchema(daemonServiceMethods.ByName("WatchPaths")),
+			connect.WithClientOptions(opts...),
+		),
 		aS: connect.NewClient[daemon.ASRequest, daemon.ASResponse](
 			httpClient,
 			baseURL+DaemonServiceASProcedure,
@@ -143,6 +153,7 @@ func NewDaemonServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 // daemonServiceClient implements DaemonServiceClient.
 type daemonServiceClient struct {
 	paths               *connect.Client[daemon.PathsRequest, daemon.PathsResponse]
+	watchPaths          *connect.Client[daemon.WatchPathsRequest, daemon.WatchPathsResponse]
 	aS                  *connect.Client[daemon.ASRequest, daemon.ASResponse]
 	interfaces          *connect.Client[daemon.InterfacesRequest, daemon.InterfacesResponse]
 	services            *connect.Client[daemon.ServicesRequest, daemon.ServicesResponse]
@@ -158,6 +169,11 @@ func (c *daemonServiceClient) Paths(ctx context.Context, req *connect.Request[da
 	return c.paths.CallUnary(ctx, req)
 }
 
+// WatchPaths calls proto.daemon.v1.DaemonService.WatchPaths.
+func (c *daemonServiceClient) WatchPaths(ctx context.Context, req *connect.Request[daemon.WatchPathsRequest]) (*connect.ServerStreamForClient[daemon.WatchPathsResponse], error) {
+	return c.watchPaths.CallServerStream(ctx, req)
+}
+
 // AS calls proto.daemon.v1.DaemonService.AS.
 func (c *daemonServiceClient) AS(ctx context.Context, req *connect.Request[daemon.ASRequest]) (*connect.Response[daemon.ASResponse], error) {
 	return c.aS.CallUnary(ctx, req)
@@ -201,6 +217,7 @@ func (c *daemonServiceClient) DRKeyHostHost(ctx context.Context, req *connect.Re
 // DaemonServiceHandler is an implementation of the proto.daemon.v1.DaemonService service.
 type DaemonServiceHandler interface {
 	Paths(context.Context, *connect.Request[daemon.PathsRequest]) (*connect.Response[daemon.PathsResponse], error)
+	WatchPaths(context.Context, *connect.Request[daemon.WatchPathsRequest], *connect.ServerStream[daemon.WatchPathsResponse]) error
 	AS(context.Context, *connect.Request[daemon.ASRequest]) (*connect.Response[daemon.ASResponse], error)
 	Interfaces(context.Context, *connect.Request[daemon.InterfacesRequest]) (*connect.Response[daemon.InterfacesResponse], error)
 	Services(context.Context, *connect.Request[daemon.ServicesRequest]) (*connect.Response[daemon.ServicesResponse], error)
@@ -224,6 +241,12 @@ func NewDaemonServiceHandler(svc DaemonServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(daemonServiceMethods.ByName("Paths")),
 		connect.WithHandlerOptions(opts...),
 	)
+	daemonServiceWatchPathsHandler := connect.NewServerStreamHandler(
+		DaemonServiceWatchPathsProcedure,
+		svc.WatchPaths,
+		connect.WithSchema(daemonServiceMethods.ByName("WatchPaths")),
+		connect.WithHandlerOptions(opts...),
+	)
 	daemonServiceASHandler := connect.NewUnaryHandler(
 		DaemonServiceASProcedure,
 		svc.AS,
@@ -276,6 +299,8 @@ func NewDaemonServiceHandler(svc DaemonServiceHandler, opts ...connect.HandlerOp
 		switch r.URL.Path {
 		case DaemonServicePathsProcedure:
 			daemonServicePathsHandler.ServeHTTP(w, r)
+		case DaemonServiceWatchPathsProcedure:
+			daemonServiceWatchPathsHandler.ServeHTTP(w, r)
 		case DaemonServiceASProcedure:
 			daemonServiceASHandler.ServeHTTP(w, r)
 		case DaemonServiceInterfacesProcedure:
@@ -305,6 +330,10 @@ func (UnimplementedDaemonServiceHandler) Paths(context.Context, *connect.Request
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.daemon.v1.DaemonService.Paths is not implemented"))
 }
 
+func (UnimplementedDaemonServiceHandler) WatchPaths(context.Context, *connect.Request[daemon.WatchPathsRequest], *connect.ServerStream[daemon.WatchPathsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("proto.daemon.v1.DaemonService.WatchPaths is not implemented"))
+}
+
 func (UnimplementedDaemonServiceHandler) AS(context.Context, *connect.Request[daemon.ASRequest]) (*connect.Response[daemon.ASResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.daemon.v1.DaemonService.AS is not implemented"))
 }
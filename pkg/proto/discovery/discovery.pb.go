@@ -349,6 +349,86 @@ func (x *HiddenSegmentRegistrationServer) GetAddress() string {
 	return ""
 }
 
+type TopologyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopologyRequest) Reset() {
+	*x = TopologyRequest{}
+	mi := &file_proto_discovery_v1_discovery_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopologyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopologyRequest) ProtoMessage() {}
+
+func (x *TopologyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_discovery_v1_discovery_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopologyRequest.ProtoReflect.Descriptor instead.
+func (*TopologyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_discovery_v1_discovery_proto_rawDescGZIP(), []int{7}
+}
+
+type TopologyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topology      []byte                 `protobuf:"bytes,1,opt,name=topology,proto3" json:"topology,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopologyResponse) Reset() {
+	*x = TopologyResponse{}
+	mi := &file_proto_discovery_v1_discovery_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopologyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopologyResponse) ProtoMessage() {}
+
+func (x *TopologyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_discovery_v1_discovery_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopologyResponse.ProtoReflect.Descriptor instead.
+func (*TopologyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_discovery_v1_discovery_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TopologyResponse) GetTopology() []byte {
+	if x != nil {
+		return x.Topology
+	}
+	return nil
+}
+
 var File_proto_discovery_v1_discovery_proto protoreflect.FileDescriptor
 
 const file_proto_discovery_v1_discovery_proto_rawDesc = "" +
@@ -369,10 +449,14 @@ const file_proto_discovery_v1_discovery_proto_rawDesc = "" +
 	"\x19HiddenSegmentLookupServer\x12\x18\n" +
 	"\aaddress\x18\x01 \x01(\tR\aaddress\";\n" +
 	"\x1fHiddenSegmentRegistrationServer\x12\x18\n" +
-	"\aaddress\x18\x01 \x01(\tR\aaddress2\xeb\x01\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\"\x11\n" +
+	"\x0fTopologyRequest\".\n" +
+	"\x10TopologyResponse\x12\x1a\n" +
+	"\btopology\x18\x01 \x01(\fR\btopology2\xc4\x02\n" +
 	"\x10DiscoveryService\x12W\n" +
 	"\bGateways\x12#.proto.discovery.v1.GatewaysRequest\x1a$.proto.discovery.v1.GatewaysResponse\"\x00\x12~\n" +
-	"\x15HiddenSegmentServices\x120.proto.discovery.v1.HiddenSegmentServicesRequest\x1a1.proto.discovery.v1.HiddenSegmentServicesResponse\"\x00B1Z/github.com/scionproto/scion/pkg/proto/discoveryb\x06proto3"
+	"\x15HiddenSegmentServices\x120.proto.discovery.v1.HiddenSegmentServicesRequest\x1a1.proto.discovery.v1.HiddenSegmentServicesResponse\"\x00\x12W\n" +
+	"\bTopology\x12#.proto.discovery.v1.TopologyRequest\x1a$.proto.discovery.v1.TopologyResponse\"\x00B1Z/github.com/scionproto/scion/pkg/proto/discoveryb\x06proto3"
 
 var (
 	file_proto_discovery_v1_discovery_proto_rawDescOnce sync.Once
@@ -386,7 +470,7 @@ func file_proto_discovery_v1_discovery_proto_rawDescGZIP() []byte {
 	return file_proto_discovery_v1_discovery_proto_rawDescData
 }
 
-var file_proto_discovery_v1_discovery_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_discovery_v1_discovery_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_proto_discovery_v1_discovery_proto_goTypes = []any{
 	(*GatewaysRequest)(nil),                 // 0: proto.discovery.v1.GatewaysRequest
 	(*GatewaysResponse)(nil),                // 1: proto.discovery.v1.GatewaysResponse
@@ -395,6 +479,8 @@ var file_proto_discovery_v1_discovery_proto_goTypes = []any{
 	(*HiddenSegmentServicesResponse)(nil),   // 4: proto.discovery.v1.HiddenSegmentServicesResponse
 	(*HiddenSegmentLookupServer)(nil),       // 5: proto.discovery.v1.HiddenSegmentLookupServer
 	(*HiddenSegmentRegistrationServer)(nil), // 6: proto.discovery.v1.HiddenSegmentRegistrationServer
+	(*TopologyRequest)(nil),                 // 7: proto.discovery.v1.TopologyRequest
+	(*TopologyResponse)(nil),                // 8: proto.discovery.v1.TopologyResponse
 }
 var file_proto_discovery_v1_discovery_proto_depIdxs = []int32{
 	2, // 0: proto.discovery.v1.GatewaysResponse.gateways:type_name -> proto.discovery.v1.Gateway
@@ -402,10 +488,12 @@ var file_proto_discovery_v1_discovery_proto_depIdxs = []int32{
 	6, // 2: proto.discovery.v1.HiddenSegmentServicesResponse.registration:type_name -> proto.discovery.v1.HiddenSegmentRegistrationServer
 	0, // 3: proto.discovery.v1.DiscoveryService.Gateways:input_type -> proto.discovery.v1.GatewaysRequest
 	3, // 4: proto.discovery.v1.DiscoveryService.HiddenSegmentServices:input_type -> proto.discovery.v1.HiddenSegmentServicesRequest
-	1, // 5: proto.discovery.v1.DiscoveryService.Gateways:output_type -> proto.discovery.v1.GatewaysResponse
-	4, // 6: proto.discovery.v1.DiscoveryService.HiddenSegmentServices:output_type -> proto.discovery.v1.HiddenSegmentServicesResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
+	7, // 5: proto.discovery.v1.DiscoveryService.Topology:input_type -> proto.discovery.v1.TopologyRequest
+	1, // 6: proto.discovery.v1.DiscoveryService.Gateways:output_type -> proto.discovery.v1.GatewaysResponse
+	4, // 7: proto.discovery.v1.DiscoveryService.HiddenSegmentServices:output_type -> proto.discovery.v1.HiddenSegmentServicesResponse
+	8, // 8: proto.discovery.v1.DiscoveryService.Topology:output_type -> proto.discovery.v1.TopologyResponse
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
 	3, // [3:3] is the sub-list for extension type_name
 	3, // [3:3] is the sub-list for extension extendee
 	0, // [0:3] is the sub-list for field type_name
@@ -422,7 +510,7 @@ func file_proto_discovery_v1_discovery_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_discovery_v1_discovery_proto_rawDesc), len(file_proto_discovery_v1_discovery_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -449,6 +537,7 @@ const _ = grpc.SupportPackageIsVersion6
 type DiscoveryServiceClient interface {
 	Gateways(ctx context.Context, in *GatewaysRequest, opts ...grpc.CallOption) (*GatewaysResponse, error)
 	HiddenSegmentServices(ctx context.Context, in *HiddenSegmentServicesRequest, opts ...grpc.CallOption) (*HiddenSegmentServicesResponse, error)
+	Topology(ctx context.Context, in *TopologyRequest, opts ...grpc.CallOption) (*TopologyResponse, error)
 }
 
 type discoveryServiceClient struct {
@@ -477,10 +566,20 @@ func (c *discoveryServiceClient) HiddenSegmentServices(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *discoveryServiceClient) Topology(ctx context.Context, in *TopologyRequest, opts ...grpc.CallOption) (*TopologyResponse, error) {
+	out := new(TopologyResponse)
+	err := c.cc.Invoke(ctx, "/proto.discovery.v1.DiscoveryService/Topology", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DiscoveryServiceServer is the server API for DiscoveryService service.
 type DiscoveryServiceServer interface {
 	Gateways(context.Context, *GatewaysRequest) (*GatewaysResponse, error)
 	HiddenSegmentServices(context.Context, *HiddenSegmentServicesRequest) (*HiddenSegmentServicesResponse, error)
+	Topology(context.Context, *TopologyRequest) (*TopologyResponse, error)
 }
 
 // UnimplementedDiscoveryServiceServer can be embedded to have forward compatible implementations.
@@ -493,6 +592,9 @@ func (*UnimplementedDiscoveryServiceServer) Gateways(context.Context, *GatewaysR
 func (*UnimplementedDiscoveryServiceServer) HiddenSegmentServices(context.Context, *HiddenSegmentServicesRequest) (*HiddenSegmentServicesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HiddenSegmentServices not implemented")
 }
+func (*UnimplementedDiscoveryServiceServer) Topology(context.Context, *TopologyRequest) (*TopologyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Topology not implemented")
+}
 
 func RegisterDiscoveryServiceServer(s *grpc.Server, srv DiscoveryServiceServer) {
 	s.RegisterService(&_DiscoveryService_serviceDesc, srv)
@@ -534,6 +636,24 @@ func _DiscoveryService_HiddenSegmentServices_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DiscoveryService_Topology_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopologyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryServiceServer).Topology(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.discovery.v1.DiscoveryService/Topology",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryServiceServer).Topology(ctx, req.(*TopologyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DiscoveryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "proto.discovery.v1.DiscoveryService",
 	HandlerType: (*DiscoveryServiceServer)(nil),
@@ -546,6 +666,10 @@ var _DiscoveryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HiddenSegmentServices",
 			Handler:    _DiscoveryService_HiddenSegmentServices_Handler,
 		},
+		{
+			MethodName: "Topology",
+			Handler:    _DiscoveryService_Topology_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/discovery/v1/discovery.proto",
@@ -64,3 +64,18 @@ func (mr *MockDiscoveryServiceServerMockRecorder) HiddenSegmentServices(arg0, ar
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HiddenSegmentServices", reflect.TypeOf((*MockDiscoveryServiceServer)(nil).HiddenSegmentServices), arg0, arg1)
 }
+
+// Topology mocks base method.
+func (m *MockDiscoveryServiceServer) Topology(arg0 context.Context, arg1 *discovery.TopologyRequest) (*discovery.TopologyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Topology", arg0, arg1)
+	ret0, _ := ret[0].(*discovery.TopologyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Topology indicates an expected call of Topology.
+func (mr *MockDiscoveryServiceServerMockRecorder) Topology(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Topology", reflect.TypeOf((*MockDiscoveryServiceServer)(nil).Topology), arg0, arg1)
+}
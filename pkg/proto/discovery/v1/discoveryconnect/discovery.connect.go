@@ -39,12 +39,16 @@ const (
 	// DiscoveryServiceHiddenSegmentServicesProcedure is the fully-qualified name of the
 	// DiscoveryService's HiddenSegmentServices RPC.
 	DiscoveryServiceHiddenSegmentServicesProcedure = "/proto.discovery.v1.DiscoveryService/HiddenSegmentServices"
+	// DiscoveryServiceTopologyProcedure is the fully-qualified name of the DiscoveryService's Topology
+	// RPC.
+	DiscoveryServiceTopologyProcedure = "/proto.discovery.v1.DiscoveryService/Topology"
 )
 
 // DiscoveryServiceClient is a client for the proto.discovery.v1.DiscoveryService service.
 type DiscoveryServiceClient interface {
 	Gateways(context.Context, *connect.Request[discovery.GatewaysRequest]) (*connect.Response[discovery.GatewaysResponse], error)
 	HiddenSegmentServices(context.Context, *connect.Request[discovery.HiddenSegmentServicesRequest]) (*connect.Response[discovery.HiddenSegmentServicesResponse], error)
+	Topology(context.Context, *connect.Request[discovery.TopologyRequest]) (*connect.Response[discovery.TopologyResponse], error)
 }
 
 // NewDiscoveryServiceClient constructs a client for the proto.discovery.v1.DiscoveryService
@@ -70,6 +74,12 @@ func NewDiscoveryServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(discoveryServiceMethods.ByName("HiddenSegmentServices")),
 			connect.WithClientOptions(opts...),
 		),
+		topology: connect.NewClient[discovery.TopologyRequest, discovery.TopologyResponse](
+			httpClient,
+			baseURL+DiscoveryServiceTopologyProcedure,
+			connect.WithSchema(discoveryServiceMethods.ByName("Topology")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -77,6 +87,7 @@ func NewDiscoveryServiceClient(httpClient connect.HTTPClient, baseURL string, op
 type discoveryServiceClient struct {
 	gateways              *connect.Client[discovery.GatewaysRequest, discovery.GatewaysResponse]
 	hiddenSegmentServices *connect.Client[discovery.HiddenSegmentServicesRequest, discovery.HiddenSegmentServicesResponse]
+	topology              *connect.Client[discovery.TopologyRequest, discovery.TopologyResponse]
 }
 
 // Gateways calls proto.discovery.v1.DiscoveryService.Gateways.
@@ -89,10 +100,16 @@ func (c *discoveryServiceClient) HiddenSegmentServices(ctx context.Context, req
 	return c.hiddenSegmentServices.CallUnary(ctx, req)
 }
 
+// Topology calls proto.discovery.v1.DiscoveryService.Topology.
+func (c *discoveryServiceClient) Topology(ctx context.Context, req *connect.Request[discovery.TopologyRequest]) (*connect.Response[discovery.TopologyResponse], error) {
+	return c.topology.CallUnary(ctx, req)
+}
+
 // DiscoveryServiceHandler is an implementation of the proto.discovery.v1.DiscoveryService service.
 type DiscoveryServiceHandler interface {
 	Gateways(context.Context, *connect.Request[discovery.GatewaysRequest]) (*connect.Response[discovery.GatewaysResponse], error)
 	HiddenSegmentServices(context.Context, *connect.Request[discovery.HiddenSegmentServicesRequest]) (*connect.Response[discovery.HiddenSegmentServicesResponse], error)
+	Topology(context.Context, *connect.Request[discovery.TopologyRequest]) (*connect.Response[discovery.TopologyResponse], error)
 }
 
 // NewDiscoveryServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -114,12 +131,20 @@ func NewDiscoveryServiceHandler(svc DiscoveryServiceHandler, opts ...connect.Han
 		connect.WithSchema(discoveryServiceMethods.ByName("HiddenSegmentServices")),
 		connect.WithHandlerOptions(opts...),
 	)
+	discoveryServiceTopologyHandler := connect.NewUnaryHandler(
+		DiscoveryServiceTopologyProcedure,
+		svc.Topology,
+		connect.WithSchema(discoveryServiceMethods.ByName("Topology")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/proto.discovery.v1.DiscoveryService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case DiscoveryServiceGatewaysProcedure:
 			discoveryServiceGatewaysHandler.ServeHTTP(w, r)
 		case DiscoveryServiceHiddenSegmentServicesProcedure:
 			discoveryServiceHiddenSegmentServicesHandler.ServeHTTP(w, r)
+		case DiscoveryServiceTopologyProcedure:
+			discoveryServiceTopologyHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -136,3 +161,7 @@ func (UnimplementedDiscoveryServiceHandler) Gateways(context.Context, *connect.R
 func (UnimplementedDiscoveryServiceHandler) HiddenSegmentServices(context.Context, *connect.Request[discovery.HiddenSegmentServicesRequest]) (*connect.Response[discovery.HiddenSegmentServicesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.discovery.v1.DiscoveryService.HiddenSegmentServices is not implemented"))
 }
+
+func (UnimplementedDiscoveryServiceHandler) Topology(context.Context, *connect.Request[discovery.TopologyRequest]) (*connect.Response[discovery.TopologyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.discovery.v1.DiscoveryService.Topology is not implemented"))
+}
@@ -46,6 +46,9 @@ var (
 var (
 	PublicKeyAlgorithmRSA   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}
 	PublicKeyAlgorithmECDSA = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	// PublicKeyAlgorithmEd25519 is the Ed25519 OID defined in RFC 8410. The
+	// same OID identifies the signature algorithm.
+	PublicKeyAlgorithmEd25519 = asn1.ObjectIdentifier{1, 3, 101, 112}
 )
 
 // Digest algorithms
@@ -73,6 +76,7 @@ var (
 	SignatureAlgorithmECDSAWithSHA384 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 3}
 	SignatureAlgorithmECDSAWithSHA512 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 4}
 	SignatureAlgorithmISOSHA1WithRSA  = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 29}
+	SignatureAlgorithmEd25519         = asn1.ObjectIdentifier{1, 3, 101, 112}
 )
 
 // X.509v3 exetension identifiers.
@@ -159,6 +163,7 @@ var SignatureAlgorithmToX509SignatureAlgorithm = map[string]x509.SignatureAlgori
 	SignatureAlgorithmECDSAWithSHA384.String(): x509.ECDSAWithSHA384,
 	SignatureAlgorithmECDSAWithSHA512.String(): x509.ECDSAWithSHA512,
 	SignatureAlgorithmDSAWithSHA1.String():     x509.DSAWithSHA1,
+	SignatureAlgorithmEd25519.String():         x509.PureEd25519,
 }
 
 // X509PublicKeyAndDigestAlgorithmToSignatureAlgorithm maps X509 public key and
@@ -177,4 +182,8 @@ var X509PublicKeyAndDigestAlgorithmToSignatureAlgorithm = map[x509.PublicKeyAlgo
 		DigestAlgorithmSHA384.String(): SignatureAlgorithmECDSAWithSHA384,
 		DigestAlgorithmSHA512.String(): SignatureAlgorithmECDSAWithSHA512,
 	},
+	// RFC 8419: Ed25519 is used with SHA-512 as the message digest algorithm.
+	x509.Ed25519: {
+		DigestAlgorithmSHA512.String(): SignatureAlgorithmEd25519,
+	},
 }
@@ -648,6 +648,10 @@ func (sd *SignedData) AddSignerInfo(chain []*x509.Certificate, signer crypto.Sig
 		return err
 	}
 	digestAlgorithmID := digestAlgorithmForPublicKey(pub)
+	if cert.PublicKeyAlgorithm == x509.Ed25519 {
+		// RFC 8419: Ed25519 is used with SHA-512 as the message digest algorithm.
+		digestAlgorithmID = pkix.AlgorithmIdentifier{Algorithm: oid.DigestAlgorithmSHA512}
+	}
 
 	signatureAlgorithmOID, ok := oid.X509PublicKeyAndDigestAlgorithmToSignatureAlgorithm[cert.PublicKeyAlgorithm][digestAlgorithmID.Algorithm.String()] // nolint:lll
 	if !ok {
@@ -709,12 +713,19 @@ func (sd *SignedData) AddSignerInfo(chain []*x509.Certificate, signer crypto.Sig
 	if err != nil {
 		return err
 	}
-	smd := hash.New()
-	if _, errr := smd.Write(sm); errr != nil {
-		return errr
-	}
-	if si.Signature, err = signer.Sign(rand.Reader, smd.Sum(nil), hash); err != nil {
-		return err
+	if cert.PublicKeyAlgorithm == x509.Ed25519 {
+		// RFC 8419: PureEdDSA signs the signed attributes without pre-hashing.
+		if si.Signature, err = signer.Sign(rand.Reader, sm, crypto.Hash(0)); err != nil {
+			return err
+		}
+	} else {
+		smd := hash.New()
+		if _, errr := smd.Write(sm); errr != nil {
+			return errr
+		}
+		if si.Signature, err = signer.Sign(rand.Reader, smd.Sum(nil), hash); err != nil {
+			return err
+		}
 	}
 	sd.AddDigestAlgorithm(si.DigestAlgorithm)
 	sd.SignerInfos = append(sd.SignerInfos, si)
@@ -3,12 +3,18 @@ package protocol
 import (
 	"bytes"
 	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/asn1"
 	"encoding/base64"
 	"io"
+	"math/big"
 	"strings"
 	"testing"
+	"time"
 
 	"golang.org/x/crypto/pkcs12"
 
@@ -88,6 +94,61 @@ func TestSignerInfo(t *testing.T) {
 	}
 }
 
+func TestSignerInfoEd25519(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "ed25519"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	raw, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, pub, priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := []byte("hello, world!")
+	eci, err := NewDataEncapsulatedContentInfo(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sd, err := NewSignedData(eci)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = sd.AddSignerInfo([]*x509.Certificate{cert}, priv); err != nil {
+		t.Fatal(err)
+	}
+	si := sd.SignerInfos[0]
+	if !si.DigestAlgorithm.Algorithm.Equal(oid.DigestAlgorithmSHA512) {
+		t.Fatal("unexpected digest algorithm", si.DigestAlgorithm.Algorithm)
+	}
+	if algo := si.X509SignatureAlgorithm(); algo != x509.PureEd25519 {
+		t.Fatal("unexpected signature algorithm", algo)
+	}
+	digest, err := si.GetMessageDigestAttribute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := sha512.Sum512(msg); !bytes.Equal(expected[:], digest) {
+		t.Fatal("message digest mismatch")
+	}
+	input, err := si.SignedAttrs.MarshaledForVerifying()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cert.CheckSignature(si.X509SignatureAlgorithm(), input, si.Signature); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestEncapsulatedContentInfo(t *testing.T) {
 	ci, _ := ParseContentInfo(fixtureSignatureOpenSSLAttached)
 	sd, _ := ci.SignedDataContent()
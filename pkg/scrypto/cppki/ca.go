@@ -17,6 +17,7 @@ package cppki
 import (
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/sha1"
@@ -25,6 +26,7 @@ import (
 	"time"
 
 	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/scrypto"
 )
 
 // CAPolicy defines how AS certificates are generated.
@@ -43,6 +45,7 @@ type CAPolicy struct {
 	// signature algorithm for signing the issued certificate. This field
 	// forces the old behavior extending the acceptable signature algorithms
 	// in https://github.com/scionproto/scion/commit/df8565dc97cb6ef7c7925c26f23f3e9954ab2a97.
+	// It only applies to CAs with an ECDSA key.
 	//
 	// Experimental: This field is experimental and will be subject to change.
 	ForceECDSAWithSHA512 bool
@@ -78,7 +81,7 @@ func (ca CAPolicy) CreateChain(csr *x509.CertificateRequest) ([]*x509.Certificat
 
 	// x509 stdlib selects the appropriate signature algorithm based on the curve.
 	var signatureAlgo x509.SignatureAlgorithm
-	if ca.ForceECDSAWithSHA512 {
+	if _, ok := ca.Signer.Public().(*ecdsa.PublicKey); ok && ca.ForceECDSAWithSHA512 {
 		signatureAlgo = x509.ECDSAWithSHA512
 	}
 	tmpl := &x509.Certificate{
@@ -135,6 +138,12 @@ func SubjectKeyID(pub crypto.PublicKey) ([]byte, error) {
 		//nolint:staticcheck
 		skid := sha1.Sum(elliptic.Marshal(k.Curve, k.X, k.Y))
 		return skid[:], nil
+	case ed25519.PublicKey:
+		if !scrypto.Ed25519Enabled() {
+			return nil, serrors.New("ed25519 support not enabled")
+		}
+		skid := sha1.Sum(k)
+		return skid[:], nil
 	default:
 		return nil, serrors.New("not supported")
 	}
@@ -20,6 +20,7 @@ import (
 	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/sha1"
 	"crypto/x509"
 	"testing"
 	"time"
@@ -28,6 +29,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/scionproto/scion/pkg/private/xtest"
+	"github.com/scionproto/scion/pkg/scrypto"
 	"github.com/scionproto/scion/pkg/scrypto/cppki"
 )
 
@@ -45,6 +47,7 @@ func TestCAPolicyCreateChain(t *testing.T) {
 		Signer                func(t *testing.T) crypto.Signer
 		Validity              time.Duration
 		ForceECDSAWithSHA512  bool
+		Ed25519               bool
 		ExpectedSignatureAlgo x509.SignatureAlgorithm
 		ErrAssertion          assert.ErrorAssertionFunc
 	}{
@@ -85,6 +88,19 @@ func TestCAPolicyCreateChain(t *testing.T) {
 			ExpectedSignatureAlgo: x509.ECDSAWithSHA512,
 			ErrAssertion:          assert.NoError,
 		},
+		"valid ed25519": {
+			CSR: func(t *testing.T) *x509.CertificateRequest { return &csr },
+			Signer: func(t *testing.T) crypto.Signer {
+				_, priv, err := ed25519.GenerateKey(rand.Reader)
+				require.NoError(t, err)
+				return priv
+			},
+			Validity:              chain[0].NotAfter.Sub(chain[0].NotBefore),
+			ForceECDSAWithSHA512:  true,
+			Ed25519:               true,
+			ExpectedSignatureAlgo: x509.PureEd25519,
+			ErrAssertion:          assert.NoError,
+		},
 		"validity not covered": {
 			CSR:          func(t *testing.T) *x509.CertificateRequest { return &csr },
 			Signer:       func(t *testing.T) crypto.Signer { return p256 },
@@ -119,6 +135,8 @@ func TestCAPolicyCreateChain(t *testing.T) {
 		// This test must not be run in parallel because we modify the CA
 		// certificate struct.
 		t.Run(name, func(t *testing.T) {
+			scrypto.EnableEd25519(tc.Ed25519)
+			defer scrypto.EnableEd25519(false)
 			ca := cppki.CAPolicy{
 				Validity:             tc.Validity,
 				Certificate:          chain[1],
@@ -157,4 +175,16 @@ func TestSubjectKeyID(t *testing.T) {
 	skid, err := cppki.SubjectKeyID(chain[0].PublicKey.(crypto.PublicKey))
 	require.NoError(t, err)
 	assert.Equal(t, chain[0].SubjectKeyId, skid)
+
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, err = cppki.SubjectKeyID(pub)
+	assert.Error(t, err)
+
+	scrypto.EnableEd25519(true)
+	defer scrypto.EnableEd25519(false)
+	skid, err = cppki.SubjectKeyID(pub)
+	require.NoError(t, err)
+	expected := sha1.Sum(pub)
+	assert.Equal(t, expected[:], skid)
 }
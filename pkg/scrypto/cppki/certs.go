@@ -25,6 +25,7 @@ import (
 
 	"github.com/scionproto/scion/pkg/addr"
 	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/scrypto"
 )
 
 const (
@@ -489,6 +490,9 @@ func validateSignatureAlg(cert *x509.Certificate) error {
 			return nil
 		}
 	}
+	if cert.SignatureAlgorithm == x509.PureEd25519 && scrypto.Ed25519Enabled() {
+		return nil
+	}
 	return serrors.New("invalid signature algorithm used",
 		"cert_alg", cert.SignatureAlgorithm, "valid_algs", ValidSCIONSignatureAlgs)
 }
@@ -29,6 +29,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/scionproto/scion/pkg/private/xtest"
+	"github.com/scionproto/scion/pkg/scrypto"
 	"github.com/scionproto/scion/pkg/scrypto/cppki"
 )
 
@@ -705,6 +706,24 @@ func TestValidateCert(t *testing.T) {
 	}
 }
 
+func TestValidateCertEd25519(t *testing.T) {
+	for _, file := range []string{"cp-root.crt", "regular-voting.crt", "sensitive-voting.crt"} {
+		t.Run(file, func(t *testing.T) {
+			certs, err := cppki.ReadPEMCerts(filepath.Join("./testdata", file))
+			require.NoError(t, err)
+			certs[0].SignatureAlgorithm = x509.PureEd25519
+
+			_, err = cppki.ValidateCert(certs[0])
+			assert.Error(t, err)
+
+			scrypto.EnableEd25519(true)
+			defer scrypto.EnableEd25519(false)
+			_, err = cppki.ValidateCert(certs[0])
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func TestValidateChain(t *testing.T) {
 	validChainFile := "./testdata/verifychain/ISD1-ASff00_0_110.pem"
 	testCases := map[string]struct {
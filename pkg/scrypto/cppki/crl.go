@@ -0,0 +1,159 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cppki
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/x509"
+	"math/big"
+	"time"
+
+	"github.com/scionproto/scion/pkg/private/serrors"
+)
+
+// CreateCRL creates a certificate revocation list that revokes the listed AS
+// certificates issued by the CA. The CRL is valid from the current time of the
+// policy until nextUpdate. The number must be larger than the number of any
+// CRL previously issued by the CA.
+func (ca CAPolicy) CreateCRL(
+	number *big.Int,
+	revoked []x509.RevocationListEntry,
+	nextUpdate time.Time,
+) (*x509.RevocationList, error) {
+
+	now := ca.CurrentTime
+	if now.IsZero() {
+		now = time.Now()
+	}
+	if number == nil || number.Sign() <= 0 {
+		return nil, serrors.New("CRL number must be positive")
+	}
+	// The CP-PKI does not require the cRLSign key usage for CA certificates.
+	// The x509 stdlib refuses to create a CRL without it, thus, we set it on a
+	// copy of the CA certificate. It is not part of the created CRL.
+	issuer := *ca.Certificate
+	issuer.KeyUsage |= x509.KeyUsageCRLSign
+	raw, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:                    number,
+		ThisUpdate:                now,
+		NextUpdate:                nextUpdate,
+		RevokedCertificateEntries: revoked,
+	}, &issuer, ca.Signer)
+	if err != nil {
+		return nil, serrors.Wrap("creating CRL", err)
+	}
+	crl, err := x509.ParseRevocationList(raw)
+	if err != nil {
+		return nil, serrors.Wrap("parsing created CRL", err)
+	}
+	return crl, nil
+}
+
+// ValidateCRL validates that the certificate revocation list is a valid CP-PKI
+// CRL. The issuer must contain the ISD-AS of the CA, the authority key ID and
+// the CRL number must be set, and the next update must be after this update.
+func ValidateCRL(crl *x509.RevocationList) error {
+	if crl == nil {
+		return serrors.New("CRL is nil")
+	}
+	var errs serrors.List
+	if _, err := ExtractIA(crl.Issuer); err != nil {
+		errs = append(errs, serrors.Wrap("extracting issuer ISD-AS", err))
+	}
+	if len(crl.AuthorityKeyId) == 0 {
+		errs = append(errs, serrors.New("authority key ID not set"))
+	}
+	if crl.Number == nil || crl.Number.Sign() <= 0 {
+		errs = append(errs, serrors.New("CRL number not set"))
+	}
+	if crl.NextUpdate.IsZero() || !crl.NextUpdate.After(crl.ThisUpdate) {
+		errs = append(errs, serrors.New("next update must be after this update",
+			"this_update", crl.ThisUpdate, "next_update", crl.NextUpdate))
+	}
+	return errs.ToError()
+}
+
+// VerifyCRL verifies that the certificate revocation list was issued by the CA
+// certificate, and that the CA certificate is verifiable against at least one
+// of the TRCs included in opts.
+func VerifyCRL(crl *x509.RevocationList, ca *x509.Certificate, opts VerifyOptions) error {
+	if err := ValidateCRL(crl); err != nil {
+		return serrors.Wrap("validating CRL", err)
+	}
+	ct, err := ValidateCert(ca)
+	if err != nil {
+		return serrors.Wrap("validating CA certificate", err)
+	}
+	if ct != CA {
+		return serrors.New("issuer certificate of invalid type", "expected", CA, "actual", ct)
+	}
+	if !bytes.Equal(crl.RawIssuer, ca.RawSubject) {
+		return serrors.New("CRL issuer does not match CA subject")
+	}
+	if !bytes.Equal(crl.AuthorityKeyId, ca.SubjectKeyId) {
+		return serrors.New("CRL authority key ID does not match CA subject key ID")
+	}
+	if err := ca.CheckSignature(crl.SignatureAlgorithm, crl.RawTBSRevocationList,
+		crl.Signature); err != nil {
+
+		return serrors.Wrap("verifying CRL signature", err)
+	}
+	var errs []error
+	for _, trc := range opts.TRC {
+		if err := verifyCA(ca, trc, opts.CurrentTime); err != nil {
+			errs = append(errs,
+				serrors.Wrap("verifying CA certificate", err,
+					"trc_base", trc.ID.Base,
+					"trc_serial", trc.ID.Serial,
+				),
+			)
+		} else {
+			return nil
+		}
+	}
+	return serrors.New("CA certificate did not verify against any selected TRC", "errors", errs)
+}
+
+func verifyCA(ca *x509.Certificate, trc *TRC, now time.Time) error {
+	if trc == nil || trc.IsZero() {
+		return serrors.New("TRC required for CA certificate verification")
+	}
+	rootPool, err := trc.RootPool()
+	if err != nil {
+		return serrors.Wrap("failed to extract root certs", err, "trc", trc.ID)
+	}
+	_, err = ca.Verify(x509.VerifyOptions{
+		Roots:       rootPool,
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		CurrentTime: now,
+	})
+	return err
+}
+
+// IsRevoked reports whether the certificate is revoked by the certificate
+// revocation list. Only certificates issued by the CRL issuer can be revoked,
+// i.e., the authority key IDs must match.
+func IsRevoked(crl *x509.RevocationList, cert *x509.Certificate) bool {
+	if !bytes.Equal(crl.AuthorityKeyId, cert.AuthorityKeyId) {
+		return false
+	}
+	for _, entry := range crl.RevokedCertificateEntries {
+		if entry.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cppki_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/pkg/private/xtest"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+)
+
+// testdata/crl files generated with scion-pki:
+/*
+	scion-pki testcrypto -t private/trust/testdata/golden.topo -o gen
+	cp gen/trcs/ISD1-B1-S1.trc pkg/scrypto/cppki/testdata/crl
+	cp gen/ASff00_0_110/crypto/ca/{ISD1-ASff00_0_110.ca.crt,cp-ca.key} \
+		pkg/scrypto/cppki/testdata/crl
+	cp gen/ASff00_0_111/crypto/as/ISD1-ASff00_0_111.pem pkg/scrypto/cppki/testdata/crl
+	cp gen/ASff00_0_112/crypto/as/ISD1-ASff00_0_112.pem pkg/scrypto/cppki/testdata/crl
+*/
+
+func TestCAPolicyCreateCRL(t *testing.T) {
+	ca := xtest.LoadChain(t, "testdata/crl/ISD1-ASff00_0_110.ca.crt")[0]
+	revoked := xtest.LoadChain(t, "testdata/crl/ISD1-ASff00_0_111.pem")
+	other := xtest.LoadChain(t, "testdata/crl/ISD1-ASff00_0_112.pem")
+	now := ca.NotBefore.Add(time.Hour)
+	policy := cppki.CAPolicy{
+		Certificate: ca,
+		Signer:      xtest.LoadSigner(t, "testdata/crl/cp-ca.key"),
+		CurrentTime: now,
+	}
+
+	crl, err := policy.CreateCRL(big.NewInt(1), []x509.RevocationListEntry{
+		{SerialNumber: revoked[0].SerialNumber, RevocationTime: now},
+	}, now.Add(24*time.Hour))
+	require.NoError(t, err)
+	assert.NoError(t, cppki.ValidateCRL(crl))
+	assert.Equal(t, ca.SubjectKeyId, crl.AuthorityKeyId)
+	assert.True(t, cppki.IsRevoked(crl, revoked[0]))
+	assert.False(t, cppki.IsRevoked(crl, other[0]))
+	// The CA certificate is not issued by the CA itself.
+	assert.False(t, cppki.IsRevoked(crl, revoked[1]))
+
+	_, err = policy.CreateCRL(big.NewInt(0), nil, now.Add(24*time.Hour))
+	assert.Error(t, err)
+	_, err = policy.CreateCRL(big.NewInt(1), nil, now.Add(-time.Hour))
+	assert.Error(t, err)
+
+	// The CA certificate is not modified.
+	assert.Zero(t, ca.KeyUsage&x509.KeyUsageCRLSign)
+}
+
+func TestVerifyCRL(t *testing.T) {
+	trc := xtest.LoadTRC(t, "testdata/crl/ISD1-B1-S1.trc")
+	ca := xtest.LoadChain(t, "testdata/crl/ISD1-ASff00_0_110.ca.crt")[0]
+	as := xtest.LoadChain(t, "testdata/crl/ISD1-ASff00_0_111.pem")[0]
+	now := ca.NotBefore.Add(time.Hour)
+	policy := cppki.CAPolicy{
+		Certificate: ca,
+		Signer:      xtest.LoadSigner(t, "testdata/crl/cp-ca.key"),
+		CurrentTime: now,
+	}
+	crl, err := policy.CreateCRL(big.NewInt(1), nil, now.Add(24*time.Hour))
+	require.NoError(t, err)
+
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	forged, err := cppki.CAPolicy{
+		Certificate: ca,
+		Signer:      otherKey,
+		CurrentTime: now,
+	}.CreateCRL(big.NewInt(2), nil, now.Add(24*time.Hour))
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		CRL          *x509.RevocationList
+		CA           *x509.Certificate
+		Opts         cppki.VerifyOptions
+		ErrAssertion assert.ErrorAssertionFunc
+	}{
+		"valid": {
+			CRL:          crl,
+			CA:           ca,
+			Opts:         cppki.VerifyOptions{TRC: []*cppki.TRC{&trc.TRC}, CurrentTime: now},
+			ErrAssertion: assert.NoError,
+		},
+		"forged signature": {
+			CRL:          forged,
+			CA:           ca,
+			Opts:         cppki.VerifyOptions{TRC: []*cppki.TRC{&trc.TRC}, CurrentTime: now},
+			ErrAssertion: assert.Error,
+		},
+		"not a CA certificate": {
+			CRL:          crl,
+			CA:           as,
+			Opts:         cppki.VerifyOptions{TRC: []*cppki.TRC{&trc.TRC}, CurrentTime: now},
+			ErrAssertion: assert.Error,
+		},
+		"no TRC": {
+			CRL:          crl,
+			CA:           ca,
+			Opts:         cppki.VerifyOptions{CurrentTime: now},
+			ErrAssertion: assert.Error,
+		},
+		"CA expired": {
+			CRL: crl,
+			CA:  ca,
+			Opts: cppki.VerifyOptions{
+				TRC:         []*cppki.TRC{&trc.TRC},
+				CurrentTime: ca.NotAfter.Add(time.Hour),
+			},
+			ErrAssertion: assert.Error,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.ErrAssertion(t, cppki.VerifyCRL(tc.CRL, tc.CA, tc.Opts))
+		})
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scrypto
+
+import "sync/atomic"
+
+var ed25519Enabled atomic.Bool
+
+// EnableEd25519 enables or disables support for Ed25519 keys in the control
+// plane PKI. Ed25519 is not part of the control plane PKI specification yet,
+// thus, it is disabled by default. While disabled, certificates with Ed25519
+// signatures are rejected, and signatures with Ed25519 keys can neither be
+// created nor verified.
+//
+// Experimental: This function is experimental and will be subject to change.
+func EnableEd25519(enable bool) {
+	ed25519Enabled.Store(enable)
+}
+
+// Ed25519Enabled indicates whether support for Ed25519 keys in the control
+// plane PKI is enabled.
+//
+// Experimental: This function is experimental and will be subject to change.
+func Ed25519Enabled() bool {
+	return ed25519Enabled.Load()
+}
@@ -17,11 +17,13 @@ package signed
 import (
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"fmt"
 
 	"github.com/scionproto/scion/pkg/private/serrors"
 	pbcrypto "github.com/scionproto/scion/pkg/proto/crypto"
+	"github.com/scionproto/scion/pkg/scrypto"
 )
 
 // List of supported signature algorithms
@@ -30,6 +32,12 @@ const (
 	ECDSAWithSHA256
 	ECDSAWithSHA384
 	ECDSAWithSHA512
+	// PureEd25519 is only supported if Ed25519 is enabled with
+	// scrypto.EnableEd25519.
+	//
+	// Experimental: Ed25519 is not yet part of the control plane PKI
+	// specification.
+	PureEd25519
 )
 
 type SignatureAlgorithm int
@@ -49,6 +57,11 @@ func SelectSignatureAlgorithm(pub crypto.PublicKey) (SignatureAlgorithm, error)
 		default:
 			return 0, serrors.New("ecdsa: unsupported curve", "curve", p.Curve)
 		}
+	case ed25519.PublicKey:
+		if !scrypto.Ed25519Enabled() {
+			return 0, serrors.New("ed25519 support not enabled")
+		}
+		return PureEd25519, nil
 	default:
 		return 0, serrors.New("unsupported public key algorithm", "type", fmt.Sprintf("%T", pub))
 	}
@@ -62,6 +75,8 @@ func signatureAlgorithmFromPB(a pbcrypto.SignatureAlgorithm) SignatureAlgorithm
 		return ECDSAWithSHA384
 	case pbcrypto.SignatureAlgorithm_SIGNATURE_ALGORITHM_ECDSA_WITH_SHA512:
 		return ECDSAWithSHA512
+	case pbcrypto.SignatureAlgorithm_SIGNATURE_ALGORITHM_ED25519:
+		return PureEd25519
 	default:
 		return UnknownSignatureAlgorithm
 	}
@@ -75,6 +90,8 @@ func (a SignatureAlgorithm) toPB() pbcrypto.SignatureAlgorithm {
 		return pbcrypto.SignatureAlgorithm_SIGNATURE_ALGORITHM_ECDSA_WITH_SHA384
 	case ECDSAWithSHA512:
 		return pbcrypto.SignatureAlgorithm_SIGNATURE_ALGORITHM_ECDSA_WITH_SHA512
+	case PureEd25519:
+		return pbcrypto.SignatureAlgorithm_SIGNATURE_ALGORITHM_ED25519
 	default:
 		return pbcrypto.SignatureAlgorithm_SIGNATURE_ALGORITHM_UNSPECIFIED
 	}
@@ -83,6 +100,7 @@ func (a SignatureAlgorithm) toPB() pbcrypto.SignatureAlgorithm {
 const (
 	unknownPublicKeyAlgorithm publicKeyAlgorithm = iota //nolint:golint,deadcode,varcheck
 	pkECDSA
+	pkEd25519
 )
 
 type publicKeyAlgorithm int
@@ -95,6 +113,7 @@ var signatureAlgorithmDetails = map[SignatureAlgorithm]struct {
 	ECDSAWithSHA256: {name: "ECDSA-SHA256", pubKeyAlgo: pkECDSA, hash: crypto.SHA256},
 	ECDSAWithSHA384: {name: "ECDSA-SHA384", pubKeyAlgo: pkECDSA, hash: crypto.SHA384},
 	ECDSAWithSHA512: {name: "ECDSA-SHA512", pubKeyAlgo: pkECDSA, hash: crypto.SHA512},
+	PureEd25519:     {name: "Ed25519", pubKeyAlgo: pkEd25519, hash: 0},
 }
 
 func (a SignatureAlgorithm) String() string {
@@ -14,27 +14,4 @@
 
 package signed
 
-import (
-	"crypto"
-)
-
-const (
-	// PurePureEd25519 is used to check signature input creation is correct.
-	// Officially, we do not yet support ed25519.
-	PureEd25519 SignatureAlgorithm = 1 << 16
-	pkEd25519   publicKeyAlgorithm = 1 << 16
-)
-
-func init() {
-	signatureAlgorithmDetails[PureEd25519] = struct {
-		name       string
-		pubKeyAlgo publicKeyAlgorithm
-		hash       crypto.Hash
-	}{
-		name:       "Ed25519",
-		pubKeyAlgo: pkEd25519,
-		hash:       0,
-	}
-}
-
 var ComputeSignatureInput = computeSignatureInput
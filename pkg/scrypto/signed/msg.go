@@ -17,6 +17,7 @@ package signed
 import (
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rand"
 	"errors"
 	"fmt"
@@ -27,6 +28,7 @@ import (
 
 	"github.com/scionproto/scion/pkg/private/serrors"
 	cryptopb "github.com/scionproto/scion/pkg/proto/crypto"
+	"github.com/scionproto/scion/pkg/scrypto"
 )
 
 // Header represents the signed message header.
@@ -127,6 +129,10 @@ func Verify(signed *cryptopb.SignedMessage, key crypto.PublicKey,
 		if !ecdsa.VerifyASN1(pub, input, signed.Signature) {
 			return nil, errors.New("ECDSA verification failure")
 		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(pub, input, signed.Signature) {
+			return nil, errors.New("Ed25519 verification failure")
+		}
 	default:
 		return nil, serrors.New("public key algorithm not implemented")
 	}
@@ -173,6 +179,15 @@ func checkPubKeyAlgo(signAlgo SignatureAlgorithm, pubKey crypto.PublicKey) error
 				"signature_algorithm", signAlgo, "public_key_algorithm", "ECDSA")
 		}
 		return nil
+	case ed25519.PublicKey:
+		if d.pubKeyAlgo != pkEd25519 {
+			return serrors.New("signature algorithm is incompatible with key",
+				"signature_algorithm", signAlgo, "public_key_algorithm", "Ed25519")
+		}
+		if !scrypto.Ed25519Enabled() {
+			return serrors.New("ed25519 support not enabled")
+		}
+		return nil
 	default:
 		return serrors.New("unsupported public key algorithm", "type", fmt.Sprintf("%T", pubKey))
 	}
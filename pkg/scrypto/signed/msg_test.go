@@ -30,6 +30,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	cryptopb "github.com/scionproto/scion/pkg/proto/crypto"
+	"github.com/scionproto/scion/pkg/scrypto"
 	"github.com/scionproto/scion/pkg/scrypto/signed"
 )
 
@@ -467,3 +468,44 @@ func TestComputeSignatureInput(t *testing.T) {
 		})
 	}
 }
+
+func TestEd25519(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hdr := signed.Header{
+		SignatureAlgorithm:   signed.PureEd25519,
+		Metadata:             []byte("some metadata"),
+		Timestamp:            time.Now().UTC(),
+		VerificationKeyID:    []byte("some key id"),
+		AssociatedDataLength: 10,
+	}
+	body := []byte("some body")
+
+	t.Run("disabled", func(t *testing.T) {
+		_, err := signed.SelectSignatureAlgorithm(pub)
+		assert.Error(t, err)
+		_, err = signed.Sign(hdr, body, priv, []byte("associated"))
+		assert.Error(t, err)
+	})
+	t.Run("enabled", func(t *testing.T) {
+		scrypto.EnableEd25519(true)
+		defer scrypto.EnableEd25519(false)
+
+		algo, err := signed.SelectSignatureAlgorithm(pub)
+		require.NoError(t, err)
+		assert.Equal(t, signed.PureEd25519, algo)
+
+		msg, err := signed.Sign(hdr, body, priv, []byte("associated"))
+		require.NoError(t, err)
+		verified, err := signed.Verify(msg, pub, []byte("associated"))
+		require.NoError(t, err)
+		assert.Equal(t, &signed.Message{Header: hdr, Body: body}, verified)
+
+		_, err = signed.Verify(msg, pub, []byte("modified!!"))
+		assert.Error(t, err)
+
+		scrypto.EnableEd25519(false)
+		_, err = signed.Verify(msg, pub, []byte("associated"))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,297 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrutil
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/snet"
+)
+
+const (
+	// DefaultHostsFile is the default SCION hosts file.
+	DefaultHostsFile = "/etc/scion/hosts"
+	// DefaultCacheTTL is the default duration for which names resolved with DNS are cached.
+	DefaultCacheTTL = 5 * time.Minute
+
+	// txtPrefix is the prefix of the DNS TXT records that contain SCION addresses.
+	txtPrefix = "scion="
+)
+
+// ErrHostNotFound is returned if a name has no SCION address.
+var ErrHostNotFound = errors.New("host not found")
+
+// DefaultResolver is the resolver used by the package level functions. It uses the default hosts
+// file and the DNS resolver of the system.
+var DefaultResolver = &Resolver{
+	HostsFile: DefaultHostsFile,
+	DNS:       net.DefaultResolver,
+}
+
+// Resolver resolves host names to SCION addresses. It looks up names in the SCION hosts file
+// first, and then in the DNS TXT records of the name, in the form "scion=ISD-AS,IP".
+//
+// The hosts file has the format of /etc/hosts, with SCION addresses instead of IP addresses:
+//
+//	# SCION hosts
+//	1-ff00:0:110,10.0.0.1      server.example.org server
+//	1-ff00:0:111,[2001:db8::1] other.example.org
+//
+// The file is read again whenever it changes. Names resolved with DNS are cached. The zero value
+// resolves numeric addresses only. A Resolver is safe for concurrent use.
+type Resolver struct {
+	// HostsFile is the SCION hosts file. If empty, or if the file does not exist, no hosts file
+	// is used.
+	HostsFile string
+	// DNS looks up the TXT records of names. If nil, DNS is not used.
+	DNS *net.Resolver
+	// CacheTTL is the duration for which names resolved with DNS are cached. If zero,
+	// DefaultCacheTTL is used. If negative, nothing is cached.
+	CacheTTL time.Duration
+
+	mtx      sync.Mutex
+	hosts    map[string][]addr.Addr
+	hostsMod time.Time
+	cache    map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	addrs  []addr.Addr
+	expiry time.Time
+}
+
+// ResolveAddr resolves s with the DefaultResolver, see Resolver.ResolveAddr.
+func ResolveAddr(ctx context.Context, s string) (addr.Addr, error) {
+	return DefaultResolver.ResolveAddr(ctx, s)
+}
+
+// ResolveIA resolves s with the DefaultResolver, see Resolver.ResolveIA.
+func ResolveIA(ctx context.Context, s string) (addr.IA, error) {
+	return DefaultResolver.ResolveIA(ctx, s)
+}
+
+// ResolveUDPAddr resolves s with the DefaultResolver, see Resolver.ResolveUDPAddr.
+func ResolveUDPAddr(ctx context.Context, s string) (*snet.UDPAddr, error) {
+	return DefaultResolver.ResolveUDPAddr(ctx, s)
+}
+
+// ResolveAddr returns the SCION address of s, which is either a numeric address in the form
+// ISD-AS,IP or a host name. If the name has several addresses, the first one is returned.
+func (r *Resolver) ResolveAddr(ctx context.Context, s string) (addr.Addr, error) {
+	if a, err := addr.ParseAddr(s); err == nil {
+		return a, nil
+	}
+	addrs, err := r.LookupAddrs(ctx, s)
+	if err != nil {
+		return addr.Addr{}, err
+	}
+	return addrs[0], nil
+}
+
+// ResolveIA returns the ISD-AS of s, which is either an ISD-AS or a host name. The ISD-AS of a
+// host name is that of its address.
+func (r *Resolver) ResolveIA(ctx context.Context, s string) (addr.IA, error) {
+	if ia, err := addr.ParseIA(s); err == nil {
+		return ia, nil
+	}
+	a, err := r.ResolveAddr(ctx, s)
+	if err != nil {
+		return 0, err
+	}
+	return a.IA, nil
+}
+
+// ResolveUDPAddr returns the SCION UDP address of s, which is either in one of the formats of
+// snet.ParseUDPAddr or in the form name:port.
+func (r *Resolver) ResolveUDPAddr(ctx context.Context, s string) (*snet.UDPAddr, error) {
+	if a, err := snet.ParseUDPAddr(s); err == nil {
+		return a, nil
+	}
+	name, portStr, err := net.SplitHostPort(s)
+	if err != nil {
+		return nil, serrors.Wrap("invalid address: split host:port", err, "addr", s)
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return nil, serrors.Wrap("invalid address: port invalid", err, "port", portStr)
+	}
+	a, err := r.ResolveAddr(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if a.Host.Type() != addr.HostTypeIP {
+		return nil, serrors.New("address is not an IP address", "name", name, "addr", a)
+	}
+	return &snet.UDPAddr{
+		IA: a.IA,
+		Host: &net.UDPAddr{
+			IP:   a.Host.IP().AsSlice(),
+			Zone: a.Host.IP().Zone(),
+			Port: int(port),
+		},
+	}, nil
+}
+
+// LookupAddrs returns the SCION addresses of the host name. The addresses in the hosts file take
+// precedence over those in DNS. It returns an error wrapping ErrHostNotFound if the name has no
+// SCION address.
+func (r *Resolver) LookupAddrs(ctx context.Context, name string) ([]addr.Addr, error) {
+	name = canonicalName(name)
+	if name == "" {
+		return nil, serrors.New("empty host name")
+	}
+	addrs, err := r.lookupHosts(name)
+	if err != nil {
+		return nil, err
+	}
+	if len(addrs) > 0 {
+		return addrs, nil
+	}
+	if r.DNS == nil {
+		return nil, serrors.Wrap("resolving name", ErrHostNotFound, "name", name)
+	}
+	return r.lookupDNS(ctx, name)
+}
+
+func (r *Resolver) lookupHosts(name string) ([]addr.Addr, error) {
+	if r.HostsFile == "" {
+		return nil, nil
+	}
+	info, err := os.Stat(r.HostsFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, serrors.Wrap("reading hosts file", err, "file", r.HostsFile)
+	}
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if r.hosts == nil || !info.ModTime().Equal(r.hostsMod) {
+		f, err := os.Open(r.HostsFile)
+		if err != nil {
+			return nil, serrors.Wrap("reading hosts file", err, "file", r.HostsFile)
+		}
+		defer f.Close()
+		hosts, err := parseHosts(f)
+		if err != nil {
+			return nil, serrors.Wrap("parsing hosts file", err, "file", r.HostsFile)
+		}
+		r.hosts, r.hostsMod = hosts, info.ModTime()
+	}
+	return r.hosts[name], nil
+}
+
+func (r *Resolver) lookupDNS(ctx context.Context, name string) ([]addr.Addr, error) {
+	now := time.Now()
+	r.mtx.Lock()
+	entry, ok := r.cache[name]
+	r.mtx.Unlock()
+	if ok && now.Before(entry.expiry) {
+		return entry.addrs, nil
+	}
+
+	txts, err := r.DNS.LookupTXT(ctx, name)
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+		return nil, serrors.Wrap("resolving name", ErrHostNotFound, "name", name)
+	}
+	if err != nil {
+		return nil, serrors.Wrap("looking up TXT records", err, "name", name)
+	}
+	var addrs []addr.Addr
+	for _, txt := range txts {
+		if !strings.HasPrefix(txt, txtPrefix) {
+			continue
+		}
+		a, err := parseAddr(strings.TrimPrefix(txt, txtPrefix))
+		if err != nil {
+			return nil, serrors.Wrap("parsing TXT record", err, "name", name, "record", txt)
+		}
+		addrs = append(addrs, a)
+	}
+	if len(addrs) == 0 {
+		return nil, serrors.Wrap("resolving name", ErrHostNotFound, "name", name)
+	}
+
+	ttl := r.CacheTTL
+	if ttl == 0 {
+		ttl = DefaultCacheTTL
+	}
+	if ttl > 0 {
+		r.mtx.Lock()
+		if r.cache == nil {
+			r.cache = make(map[string]cacheEntry)
+		}
+		r.cache[name] = cacheEntry{addrs: addrs, expiry: now.Add(ttl)}
+		r.mtx.Unlock()
+	}
+	return addrs, nil
+}
+
+// parseHosts parses a hosts file into a map from the canonical names to their addresses, in the
+// order of the file.
+func parseHosts(rd io.Reader) (map[string][]addr.Addr, error) {
+	hosts := make(map[string][]addr.Addr)
+	scanner := bufio.NewScanner(rd)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 2 {
+			return nil, serrors.New("address without name", "line", lineNo)
+		}
+		a, err := parseAddr(fields[0])
+		if err != nil {
+			return nil, serrors.Wrap("parsing address", err, "line", lineNo)
+		}
+		for _, name := range fields[1:] {
+			name = canonicalName(name)
+			hosts[name] = append(hosts[name], a)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return hosts, nil
+}
+
+// parseAddr parses a SCION address in the form ISD-AS,IP. For compatibility with existing hosts
+// files, the IP may be enclosed in brackets.
+func parseAddr(s string) (addr.Addr, error) {
+	ia, host, ok := strings.Cut(s, ",")
+	if ok && strings.HasPrefix(host, "[") && strings.HasSuffix(host, "]") {
+		s = ia + "," + host[1:len(host)-1]
+	}
+	return addr.ParseAddr(s)
+}
+
+// canonicalName returns the name in lower case, without the trailing dot of fully qualified
+// names.
+func canonicalName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, "."))
+}
@@ -0,0 +1,219 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addrutil_test
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/snet/addrutil"
+)
+
+// testDNSServer is a DNS server that answers TXT queries from a fixed set of records, and
+// NXDOMAIN for all other names.
+type testDNSServer struct {
+	conn    net.PacketConn
+	txt     map[string][]string
+	queries atomic.Int32
+}
+
+func newTestDNSServer(t *testing.T, txt map[string][]string) *testDNSServer {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &testDNSServer{conn: conn, txt: txt}
+	t.Cleanup(func() { conn.Close() })
+	go s.serve()
+	return s
+}
+
+func (s *testDNSServer) serve() {
+	buf := make([]byte, 512)
+	for {
+		n, from, err := s.conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		var p dnsmessage.Parser
+		hdr, err := p.Start(buf[:n])
+		if err != nil {
+			continue
+		}
+		q, err := p.Question()
+		if err != nil {
+			continue
+		}
+		records, ok := s.txt[q.Name.String()]
+		if q.Type == dnsmessage.TypeTXT {
+			s.queries.Add(1)
+		}
+		rcode := dnsmessage.RCodeSuccess
+		if !ok {
+			rcode = dnsmessage.RCodeNameError
+		}
+		b := dnsmessage.NewBuilder(nil, dnsmessage.Header{
+			ID:                 hdr.ID,
+			Response:           true,
+			Authoritative:      true,
+			RecursionDesired:   hdr.RecursionDesired,
+			RecursionAvailable: true,
+			RCode:              rcode,
+		})
+		b.EnableCompression()
+		_ = b.StartQuestions()
+		_ = b.Question(q)
+		_ = b.StartAnswers()
+		if q.Type == dnsmessage.TypeTXT {
+			for _, r := range records {
+				_ = b.TXTResource(dnsmessage.ResourceHeader{
+					Name:  q.Name,
+					Class: dnsmessage.ClassINET,
+					TTL:   60,
+				}, dnsmessage.TXTResource{TXT: []string{r}})
+			}
+		}
+		msg, err := b.Finish()
+		if err != nil {
+			continue
+		}
+		_, _ = s.conn.WriteTo(msg, from)
+	}
+}
+
+func (s *testDNSServer) resolver() *net.Resolver {
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "udp", s.conn.LocalAddr().String())
+		},
+	}
+}
+
+func TestResolver(t *testing.T) {
+	hostsFile := filepath.Join(t.TempDir(), "hosts")
+	require.NoError(t, os.WriteFile(hostsFile, []byte(`
+# SCION hosts
+1-ff00:0:110,10.0.0.1        server.example.org server
+1-ff00:0:111,[2001:db8::1]   v6.example.org # legacy brackets
+1-ff00:0:112,10.0.0.2        server.example.org
+`), 0644))
+	dns := newTestDNSServer(t, map[string][]string{
+		"dns.example.org.":    {"v=spf1 -all", "scion=2-ff00:0:210,192.0.2.1"},
+		"server.example.org.": {"scion=2-ff00:0:210,192.0.2.2"},
+		"bad.example.org.":    {"scion=garbage"},
+		"plain.example.org.":  {"v=spf1 -all"},
+	})
+	r := &addrutil.Resolver{HostsFile: hostsFile, DNS: dns.resolver()}
+
+	ctx, cancelF := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelF()
+
+	t.Run("numeric", func(t *testing.T) {
+		a, err := r.ResolveAddr(ctx, "1-ff00:0:113,10.0.0.3")
+		require.NoError(t, err)
+		assert.Equal(t, addr.MustParseAddr("1-ff00:0:113,10.0.0.3"), a)
+	})
+	t.Run("hosts file", func(t *testing.T) {
+		addrs, err := r.LookupAddrs(ctx, "Server.Example.Org.")
+		require.NoError(t, err)
+		assert.Equal(t, []addr.Addr{
+			addr.MustParseAddr("1-ff00:0:110,10.0.0.1"),
+			addr.MustParseAddr("1-ff00:0:112,10.0.0.2"),
+		}, addrs)
+
+		a, err := r.ResolveAddr(ctx, "server")
+		require.NoError(t, err)
+		assert.Equal(t, addr.MustParseAddr("1-ff00:0:110,10.0.0.1"), a)
+
+		a, err = r.ResolveAddr(ctx, "v6.example.org")
+		require.NoError(t, err)
+		assert.Equal(t, addr.MustParseAddr("1-ff00:0:111,2001:db8::1"), a)
+	})
+	t.Run("dns", func(t *testing.T) {
+		a, err := r.ResolveAddr(ctx, "dns.example.org")
+		require.NoError(t, err)
+		assert.Equal(t, addr.MustParseAddr("2-ff00:0:210,192.0.2.1"), a)
+	})
+	t.Run("dns is cached", func(t *testing.T) {
+		r := &addrutil.Resolver{DNS: dns.resolver()}
+		before := dns.queries.Load()
+		for range 3 {
+			_, err := r.ResolveAddr(ctx, "dns.example.org")
+			require.NoError(t, err)
+		}
+		assert.Equal(t, before+1, dns.queries.Load())
+	})
+	t.Run("not found", func(t *testing.T) {
+		_, err := r.ResolveAddr(ctx, "unknown.example.org")
+		assert.ErrorIs(t, err, addrutil.ErrHostNotFound)
+		_, err = r.ResolveAddr(ctx, "plain.example.org")
+		assert.ErrorIs(t, err, addrutil.ErrHostNotFound)
+		_, err = (&addrutil.Resolver{}).ResolveAddr(ctx, "server")
+		assert.ErrorIs(t, err, addrutil.ErrHostNotFound)
+	})
+	t.Run("invalid record", func(t *testing.T) {
+		_, err := r.ResolveAddr(ctx, "bad.example.org")
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, addrutil.ErrHostNotFound)
+	})
+	t.Run("ia", func(t *testing.T) {
+		ia, err := r.ResolveIA(ctx, "1-ff00:0:110")
+		require.NoError(t, err)
+		assert.Equal(t, addr.MustParseIA("1-ff00:0:110"), ia)
+
+		ia, err = r.ResolveIA(ctx, "dns.example.org")
+		require.NoError(t, err)
+		assert.Equal(t, addr.MustParseIA("2-ff00:0:210"), ia)
+	})
+	t.Run("udp address", func(t *testing.T) {
+		a, err := r.ResolveUDPAddr(ctx, "[1-ff00:0:113,10.0.0.3]:80")
+		require.NoError(t, err)
+		assert.Equal(t, "[1-ff00:0:113,10.0.0.3]:80", a.String())
+
+		a, err = r.ResolveUDPAddr(ctx, "server:443")
+		require.NoError(t, err)
+		assert.Equal(t, "[1-ff00:0:110,10.0.0.1]:443", a.String())
+
+		_, err = r.ResolveUDPAddr(ctx, "server")
+		assert.Error(t, err)
+	})
+	t.Run("hosts file is reloaded", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(hostsFile,
+			[]byte("1-ff00:0:114,10.0.0.4 server\n"), 0644))
+		// Make sure the modification time changes on file systems with a coarse resolution.
+		later := time.Now().Add(time.Minute)
+		require.NoError(t, os.Chtimes(hostsFile, later, later))
+
+		a, err := r.ResolveAddr(ctx, "server")
+		require.NoError(t, err)
+		assert.Equal(t, addr.MustParseAddr("1-ff00:0:114,10.0.0.4"), a)
+	})
+	t.Run("invalid hosts file", func(t *testing.T) {
+		badFile := filepath.Join(t.TempDir(), "hosts")
+		require.NoError(t, os.WriteFile(badFile, []byte("1-ff00:0:110,10.0.0.1\n"), 0644))
+		_, err := (&addrutil.Resolver{HostsFile: badFile}).ResolveAddr(ctx, "server")
+		assert.Error(t, err)
+	})
+}
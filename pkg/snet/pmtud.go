@@ -0,0 +1,475 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snet
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/gopacket/gopacket"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/common"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/slayers"
+	"github.com/scionproto/scion/pkg/slayers/path"
+	"github.com/scionproto/scion/pkg/slayers/path/scion"
+	"github.com/scionproto/scion/private/topology"
+)
+
+const (
+	// DefaultMTUProbeTimeout is the default duration after which an MTU probe without reply is
+	// considered lost.
+	DefaultMTUProbeTimeout = time.Second
+	// DefaultMTUMaxProbes is the default number of lost probes after which a size is considered
+	// too big for the path.
+	DefaultMTUMaxProbes = 3
+	// DefaultMTUCacheTTL is the default duration for which a discovered MTU is cached. After it,
+	// the path is probed again, so that the MTU can also grow.
+	DefaultMTUCacheTTL = 10 * time.Minute
+)
+
+// MTUDiscoverer discovers the effective MTU of SCION paths with packetization layer path MTU
+// discovery (PLPMTUD, RFC 8899). Unlike the MTU in the path metadata, which is announced from the
+// static MTUs in the topologies, the effective MTU accounts for everything on the path, e.g., the
+// encapsulation of the underlay.
+//
+// A path is probed with SCMP echo requests to the remote host, padded to the probed size. The
+// sizes are searched between common.MinMTU and the MTU in the path metadata. A probe that is
+// answered confirms its size, and an SCMP packet too big message caps the MTU at the reported
+// size. The discovered MTUs are cached per path. A path is identified by its hop fields, so that a
+// path with metadata and the same path without, e.g., the dataplane path of a UDPAddr, share the
+// cached MTU. A packet too big message also lowers the cached MTU of the path of the packet that
+// was too big, if that path was probed before.
+//
+// The replies to the probes and the SCMP errors are received by the connection that sends the
+// probes, so its SCMP handler must pass them on to the discoverer, e.g., by being the discoverer:
+//
+//	d := &snet.MTUDiscoverer{Handler: snet.DefaultSCMPHandler{}}
+//	network := &snet.SCIONNetwork{Topology: topo, SCMPHandler: d}
+//	raw, err := network.OpenRaw(ctx, listen)
+//	...
+//	d.Conn = raw
+//	d.Local = &snet.UDPAddr{IA: localIA, Host: raw.LocalAddr().(*net.UDPAddr)}
+//	conn, err := snet.NewCookedConn(raw, topo)
+//
+// The application must keep reading from the connection, e.g., from the cooked connection, or
+// from the QUIC transport running on it, so that the SCMP messages are handled.
+type MTUDiscoverer struct {
+	// Conn sends the probes.
+	Conn PacketConn
+	// Local is the local address of Conn. Its port is the identifier of the probes, so that the
+	// replies are delivered to Conn.
+	Local *UDPAddr
+	// Handler handles the SCMP messages that are not replies to the probes. If nil, they are
+	// ignored.
+	Handler SCMPHandler
+	// ProbeTimeout is the duration after which a probe without reply is considered lost. If zero,
+	// DefaultMTUProbeTimeout is used.
+	ProbeTimeout time.Duration
+	// MaxProbes is the number of lost probes after which a size is considered too big for the
+	// path. If zero, DefaultMTUMaxProbes is used.
+	MaxProbes int
+	// CacheTTL is the duration for which a discovered MTU is cached. If zero, DefaultMTUCacheTTL
+	// is used.
+	CacheTTL time.Duration
+
+	mtx sync.Mutex
+	// cache contains the discovered MTUs.
+	cache map[PathFingerprint]*mtuEntry
+	// hops maps the hop fields of the probed and cached paths to their fingerprints, see hopKey.
+	hops map[string]PathFingerprint
+	// probing contains the ongoing discoveries.
+	probing map[PathFingerprint]*mtuDiscovery
+	// pending contains the probes that wait for a reply, by sequence number.
+	pending map[uint16]*mtuProbe
+	seq     uint16
+}
+
+type mtuEntry struct {
+	mtu    uint16
+	hopKey string
+	expiry time.Time
+}
+
+type mtuDiscovery struct {
+	done chan struct{}
+	mtu  uint16
+	err  error
+}
+
+type mtuProbe struct {
+	fp     PathFingerprint
+	size   uint16
+	result chan mtuProbeResult
+}
+
+type mtuProbeResult struct {
+	// acked is set if the probe was answered.
+	acked bool
+	// limit is the MTU reported by a packet too big message.
+	limit uint16
+}
+
+// MTU returns the effective MTU of the path to the remote host, i.e., the size of the largest
+// SCION packet that reaches the remote on the path. It returns the cached MTU if there is one, and
+// probes the path otherwise. Concurrent calls for the same path share the probing.
+func (d *MTUDiscoverer) MTU(ctx context.Context, remote addr.Addr, p Path) (uint16, error) {
+	fp := mtuKey(p)
+	d.mtx.Lock()
+	d.sweepLocked(time.Now())
+	if e, ok := d.cache[fp]; ok {
+		d.mtx.Unlock()
+		return e.mtu, nil
+	}
+	if disc, ok := d.probing[fp]; ok {
+		d.mtx.Unlock()
+		select {
+		case <-disc.done:
+			return disc.mtu, disc.err
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+	disc := &mtuDiscovery{done: make(chan struct{})}
+	if d.probing == nil {
+		d.probing = make(map[PathFingerprint]*mtuDiscovery)
+		d.hops = make(map[string]PathFingerprint)
+	}
+	d.probing[fp] = disc
+	// The hop fields are registered before probing, so that the packet too big messages for the
+	// probes are matched to the path.
+	key, _ := hopKey(p.Dataplane())
+	if key != "" {
+		d.hops[key] = fp
+	}
+	d.mtx.Unlock()
+
+	disc.mtu, disc.err = d.discover(ctx, remote, p, fp)
+
+	d.mtx.Lock()
+	delete(d.probing, fp)
+	if disc.err == nil {
+		d.storeLocked(fp, key, disc.mtu)
+	} else if _, ok := d.cache[fp]; !ok {
+		delete(d.hops, key)
+	}
+	d.mtx.Unlock()
+	close(disc.done)
+	return disc.mtu, disc.err
+}
+
+// CachedMTU returns the cached MTU of the path, if there is one.
+func (d *MTUDiscoverer) CachedMTU(p Path) (uint16, bool) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	e, ok := d.cache[mtuKey(p)]
+	if !ok || !time.Now().Before(e.expiry) {
+		return 0, false
+	}
+	return e.mtu, true
+}
+
+// Handle handles the replies to the probes and the SCMP packet too big messages. The other SCMP
+// messages, and the packet too big messages after they are handled, are passed to the Handler.
+func (d *MTUDiscoverer) Handle(pkt *Packet) error {
+	switch m := pkt.Payload.(type) {
+	case SCMPEchoReply:
+		if d.Local != nil && m.Identifier == uint16(d.Local.Host.Port) &&
+			d.resolve(m.SeqNumber, mtuProbeResult{acked: true}) {
+			return nil
+		}
+	case SCMPPacketTooBig:
+		d.packetTooBig(m)
+	}
+	if d.Handler == nil {
+		return nil
+	}
+	return d.Handler.Handle(pkt)
+}
+
+// discover searches the MTU of the path.
+func (d *MTUDiscoverer) discover(
+	ctx context.Context,
+	remote addr.Addr,
+	p Path,
+	fp PathFingerprint,
+) (uint16, error) {
+
+	if d.Conn == nil || d.Local == nil {
+		return 0, serrors.New("MTU discoverer without connection")
+	}
+	hi := uint16(common.SupportedMTU)
+	if md := p.Metadata(); md != nil && md.MTU != 0 {
+		hi = min(hi, md.MTU)
+	}
+	lo := min(uint16(common.MinMTU), hi)
+
+	// The base size must pass, otherwise the path or the remote is not usable for probing.
+	acked, limit, err := d.probe(ctx, remote, p, fp, lo)
+	if err != nil {
+		return 0, err
+	}
+	if !acked {
+		return 0, serrors.New("probe of base MTU not answered", "size", lo, "ptb_mtu", limit,
+			"remote", remote, "path", fp)
+	}
+	// Most paths support the announced MTU, so it is probed first.
+	next := hi
+	for lo < hi {
+		acked, limit, err := d.probe(ctx, remote, p, fp, next)
+		if err != nil {
+			return 0, err
+		}
+		switch {
+		case acked:
+			lo = next
+		case limit >= lo && limit < next:
+			// The reported MTU is probed next.
+			hi, next = limit, limit
+			continue
+		default:
+			hi = next - 1
+		}
+		next = lo + (hi-lo+1)/2
+	}
+	log.Debug("Discovered path MTU", "remote", remote, "path", fp, "mtu", lo)
+	return lo, nil
+}
+
+// probe probes the path with packets of the size. It returns whether a probe was answered, and
+// the MTU of a packet too big message, if one was received.
+func (d *MTUDiscoverer) probe(
+	ctx context.Context,
+	remote addr.Addr,
+	p Path,
+	fp PathFingerprint,
+	size uint16,
+) (bool, uint16, error) {
+
+	timeout := d.ProbeTimeout
+	if timeout == 0 {
+		timeout = DefaultMTUProbeTimeout
+	}
+	probes := d.MaxProbes
+	if probes == 0 {
+		probes = DefaultMTUMaxProbes
+	}
+	for range probes {
+		seq, probe := d.register(fp, size)
+		if err := d.send(remote, p, seq, size); err != nil {
+			d.unregister(seq)
+			return false, 0, serrors.Wrap("sending MTU probe", err, "size", size)
+		}
+		timer := time.NewTimer(timeout)
+		select {
+		case r := <-probe.result:
+			timer.Stop()
+			d.unregister(seq)
+			if r.acked || r.limit != 0 {
+				return r.acked, r.limit, nil
+			}
+		case <-timer.C:
+			d.unregister(seq)
+		case <-ctx.Done():
+			timer.Stop()
+			d.unregister(seq)
+			return false, 0, ctx.Err()
+		}
+	}
+	return false, 0, nil
+}
+
+func (d *MTUDiscoverer) send(remote addr.Addr, p Path, seq, size uint16) error {
+	localIP, ok := netip.AddrFromSlice(d.Local.Host.IP)
+	if !ok {
+		return serrors.New("invalid local IP", "ip", d.Local.Host.IP)
+	}
+	req := SCMPEchoRequest{Identifier: uint16(d.Local.Host.Port), SeqNumber: seq}
+	pkt := &Packet{
+		PacketInfo: PacketInfo{
+			Destination: remote,
+			Source:      SCIONAddress{IA: d.Local.IA, Host: addr.HostIP(localIP.Unmap())},
+			Path:        p.Dataplane(),
+			Payload:     req,
+		},
+	}
+	if err := pkt.Serialize(); err != nil {
+		return err
+	}
+	if overhead := len(pkt.Bytes); int(size) > overhead {
+		req.Payload = make([]byte, int(size)-overhead)
+		pkt.Payload = req
+	}
+
+	nextHop := p.UnderlayNextHop()
+	if nextHop == nil && d.Local.IA.Equal(remote.IA) && remote.Host.Type() == addr.HostTypeIP {
+		nextHop = &net.UDPAddr{
+			IP:   remote.Host.IP().AsSlice(),
+			Port: topology.EndhostPort,
+			Zone: remote.Host.IP().Zone(),
+		}
+	}
+	return d.Conn.WriteTo(pkt, nextHop)
+}
+
+func (d *MTUDiscoverer) register(fp PathFingerprint, size uint16) (uint16, *mtuProbe) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	if d.pending == nil {
+		d.pending = make(map[uint16]*mtuProbe)
+	}
+	d.seq++
+	probe := &mtuProbe{fp: fp, size: size, result: make(chan mtuProbeResult, 1)}
+	d.pending[d.seq] = probe
+	return d.seq, probe
+}
+
+func (d *MTUDiscoverer) unregister(seq uint16) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	delete(d.pending, seq)
+}
+
+// resolve passes the result to the pending probe. It returns false if there is no such probe.
+func (d *MTUDiscoverer) resolve(seq uint16, r mtuProbeResult) bool {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	probe, ok := d.pending[seq]
+	if !ok {
+		return false
+	}
+	select {
+	case probe.result <- r:
+	default:
+	}
+	return true
+}
+
+// packetTooBig lowers the MTU of the path of the packet that was too big.
+func (d *MTUDiscoverer) packetTooBig(m SCMPPacketTooBig) {
+	// As recommended by RFC 8899, reports below the base MTU are ignored.
+	if m.MTU < common.MinMTU {
+		return
+	}
+	var quote slayers.SCION
+	if err := quote.DecodeFromBytes(m.Payload, gopacket.NilDecodeFeedback); err != nil {
+		return
+	}
+	key, ok := hopKeyOf(quote.Path)
+	if !ok {
+		return
+	}
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	fp, ok := d.hops[key]
+	if !ok {
+		return
+	}
+	if e, ok := d.cache[fp]; ok && m.MTU < e.mtu {
+		e.mtu = m.MTU
+	}
+	for seq, probe := range d.pending {
+		if probe.fp == fp && m.MTU < probe.size {
+			select {
+			case probe.result <- mtuProbeResult{limit: m.MTU}:
+			default:
+			}
+			delete(d.pending, seq)
+		}
+	}
+}
+
+func (d *MTUDiscoverer) storeLocked(fp PathFingerprint, key string, mtu uint16) {
+	ttl := d.CacheTTL
+	if ttl == 0 {
+		ttl = DefaultMTUCacheTTL
+	}
+	if d.cache == nil {
+		d.cache = make(map[PathFingerprint]*mtuEntry)
+	}
+	d.cache[fp] = &mtuEntry{mtu: mtu, hopKey: key, expiry: time.Now().Add(ttl)}
+}
+
+// sweepLocked removes the expired MTUs.
+func (d *MTUDiscoverer) sweepLocked(now time.Time) {
+	for fp, e := range d.cache {
+		if !now.Before(e.expiry) {
+			delete(d.cache, fp)
+			delete(d.hops, e.hopKey)
+		}
+	}
+}
+
+// mtuKey returns the key of the path in the cache. It is the key of the hop fields of SCION paths,
+// and the fingerprint of other paths.
+func mtuKey(p Path) PathFingerprint {
+	if key, ok := hopKey(p.Dataplane()); ok {
+		return PathFingerprint(key)
+	}
+	return Fingerprint(p)
+}
+
+// hopKey returns a key of the hop fields of the SCION path. The routers update the info fields
+// and the pointers of a path on the way, but not the hop fields. The key of a path quoted in an
+// SCMP error thus matches the key of the path that the packet was sent on.
+func hopKey(dp DataplanePath) (string, bool) {
+	var s slayers.SCION
+	if dp == nil || dp.SetPath(&s) != nil {
+		return "", false
+	}
+	return hopKeyOf(s.Path)
+}
+
+func hopKeyOf(p path.Path) (string, bool) {
+	var decoded *scion.Decoded
+	switch v := p.(type) {
+	case *scion.Raw:
+		var err error
+		if decoded, err = v.ToDecoded(); err != nil {
+			return "", false
+		}
+	case *scion.Decoded:
+		decoded = v
+	default:
+		return "", false
+	}
+	key := make([]byte, 0, len(decoded.HopFields)*path.MacLen)
+	for _, hf := range decoded.HopFields {
+		key = append(key, hf.Mac[:]...)
+	}
+	return string(key), true
+}
+
+// UDPPayloadMTU returns the size of the largest UDP payload from local to remote on the path,
+// that fits into a SCION packet of the MTU.
+func UDPPayloadMTU(mtu int, local, remote addr.Addr, dp DataplanePath) (int, error) {
+	pkt := &Packet{
+		PacketInfo: PacketInfo{
+			Destination: remote,
+			Source:      local,
+			Path:        dp,
+			Payload:     UDPPayload{},
+		},
+	}
+	if err := pkt.Serialize(); err != nil {
+		return 0, serrors.Wrap("serializing packet", err)
+	}
+	return max(mtu-len(pkt.Bytes), 0), nil
+}
@@ -0,0 +1,222 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snet_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/slayers/path"
+	"github.com/scionproto/scion/pkg/slayers/path/scion"
+	"github.com/scionproto/scion/pkg/snet"
+	snetpath "github.com/scionproto/scion/pkg/snet/path"
+)
+
+var (
+	mtuLocal  = addr.MustParseAddr("1-ff00:0:110,10.0.0.1")
+	mtuRemote = addr.MustParseAddr("1-ff00:0:111,10.0.0.2")
+)
+
+// mtuTestConn answers the probes that fit into the MTU, and, if ptb is set, sends packet too big
+// messages for the others.
+type mtuTestConn struct {
+	snet.PacketConn
+	d     *snet.MTUDiscoverer
+	mtu   int
+	ptb   bool
+	sizes []int
+}
+
+func (c *mtuTestConn) WriteTo(pkt *snet.Packet, _ *net.UDPAddr) error {
+	if err := pkt.Serialize(); err != nil {
+		return err
+	}
+	size := len(pkt.Bytes)
+	c.sizes = append(c.sizes, size)
+	req := pkt.Payload.(snet.SCMPEchoRequest)
+	switch {
+	case size <= c.mtu:
+		return c.d.Handle(&snet.Packet{PacketInfo: snet.PacketInfo{
+			Payload: snet.SCMPEchoReply{Identifier: req.Identifier, SeqNumber: req.SeqNumber},
+		}})
+	case c.ptb:
+		return c.d.Handle(&snet.Packet{PacketInfo: snet.PacketInfo{
+			Payload: snet.SCMPPacketTooBig{
+				MTU:     uint16(c.mtu),
+				Payload: append([]byte(nil), pkt.Bytes...),
+			},
+		}})
+	}
+	return nil
+}
+
+// mtuTestPath returns a path with the announced MTU. If forwarded is set, the info field and
+// pointers are those of a packet that was forwarded by the first router.
+func mtuTestPath(t *testing.T, mtu uint16, forwarded bool) snet.Path {
+	sp := scion.Decoded{
+		Base: scion.Base{
+			PathMeta: scion.MetaHdr{SegLen: [3]uint8{2, 0, 0}},
+			NumINF:   1,
+			NumHops:  2,
+		},
+		InfoFields: []path.InfoField{{ConsDir: true, SegID: 0x1111}},
+		HopFields: []path.HopField{
+			{ConsEgress: 4, Mac: [path.MacLen]byte{1, 2, 3, 4, 5, 6}},
+			{ConsIngress: 1, Mac: [path.MacLen]byte{6, 5, 4, 3, 2, 1}},
+		},
+	}
+	if forwarded {
+		sp.PathMeta.CurrHF = 1
+		sp.InfoFields[0].SegID = 0x2222
+	}
+	raw := make([]byte, sp.Len())
+	require.NoError(t, sp.SerializeTo(raw))
+	return snetpath.Path{
+		Src:           mtuLocal.IA,
+		Dst:           mtuRemote.IA,
+		DataplanePath: snetpath.SCION{Raw: raw},
+		NextHop:       &net.UDPAddr{IP: net.IP{10, 0, 0, 254}, Port: 30041},
+		Meta: snet.PathMetadata{
+			Interfaces: []snet.PathInterface{
+				{IA: mtuLocal.IA, ID: 4},
+				{IA: mtuRemote.IA, ID: 1},
+			},
+			MTU: mtu,
+		},
+	}
+}
+
+func newMTUTest(mtu int, ptb bool) (*snet.MTUDiscoverer, *mtuTestConn) {
+	d := &snet.MTUDiscoverer{
+		Local: &snet.UDPAddr{
+			IA:   mtuLocal.IA,
+			Host: &net.UDPAddr{IP: net.IP{10, 0, 0, 1}, Port: 40000},
+		},
+		ProbeTimeout: 10 * time.Millisecond,
+		MaxProbes:    1,
+	}
+	conn := &mtuTestConn{d: d, mtu: mtu, ptb: ptb}
+	d.Conn = conn
+	return d, conn
+}
+
+func TestMTUDiscoverer(t *testing.T) {
+	ctx, cancelF := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelF()
+
+	t.Run("announced MTU", func(t *testing.T) {
+		d, conn := newMTUTest(1472, false)
+		mtu, err := d.MTU(ctx, mtuRemote, mtuTestPath(t, 1472, false))
+		require.NoError(t, err)
+		assert.Equal(t, uint16(1472), mtu)
+		assert.Equal(t, []int{1280, 1472}, conn.sizes)
+	})
+	t.Run("lost probes", func(t *testing.T) {
+		d, conn := newMTUTest(1400, false)
+		p := mtuTestPath(t, 1472, false)
+		mtu, err := d.MTU(ctx, mtuRemote, p)
+		require.NoError(t, err)
+		assert.Equal(t, uint16(1400), mtu)
+
+		// The MTU is cached.
+		probes := len(conn.sizes)
+		mtu, err = d.MTU(ctx, mtuRemote, p)
+		require.NoError(t, err)
+		assert.Equal(t, uint16(1400), mtu)
+		assert.Len(t, conn.sizes, probes)
+		cached, ok := d.CachedMTU(p)
+		assert.True(t, ok)
+		assert.Equal(t, uint16(1400), cached)
+	})
+	t.Run("packet too big", func(t *testing.T) {
+		d, conn := newMTUTest(1350, true)
+		mtu, err := d.MTU(ctx, mtuRemote, mtuTestPath(t, 1472, false))
+		require.NoError(t, err)
+		assert.Equal(t, uint16(1350), mtu)
+		assert.Equal(t, []int{1280, 1472, 1350}, conn.sizes)
+	})
+	t.Run("packet too big after discovery", func(t *testing.T) {
+		d, _ := newMTUTest(1472, false)
+		p := mtuTestPath(t, 1472, false)
+		_, err := d.MTU(ctx, mtuRemote, p)
+		require.NoError(t, err)
+
+		// The quoted packet was forwarded by a router, which updated its path.
+		quote := &snet.Packet{PacketInfo: snet.PacketInfo{
+			Destination: mtuRemote,
+			Source:      mtuLocal,
+			Path:        mtuTestPath(t, 1472, true).Dataplane(),
+			Payload:     snet.UDPPayload{SrcPort: 40000, DstPort: 443},
+		}}
+		require.NoError(t, quote.Serialize())
+		require.NoError(t, d.Handle(&snet.Packet{PacketInfo: snet.PacketInfo{
+			Payload: snet.SCMPPacketTooBig{MTU: 1300, Payload: quote.Bytes},
+		}}))
+		mtu, ok := d.CachedMTU(p)
+		assert.True(t, ok)
+		assert.Equal(t, uint16(1300), mtu)
+	})
+	t.Run("path without metadata", func(t *testing.T) {
+		d, _ := newMTUTest(1400, false)
+		p := mtuTestPath(t, 1472, false)
+		_, err := d.MTU(ctx, mtuRemote, p)
+		require.NoError(t, err)
+
+		// The path of a UDPAddr shares the cached MTU.
+		mtu, ok := d.CachedMTU(snetpath.Path{DataplanePath: p.Dataplane()})
+		assert.True(t, ok)
+		assert.Equal(t, uint16(1400), mtu)
+	})
+	t.Run("base MTU not answered", func(t *testing.T) {
+		d, _ := newMTUTest(1000, false)
+		_, err := d.MTU(ctx, mtuRemote, mtuTestPath(t, 1472, false))
+		assert.Error(t, err)
+		_, ok := d.CachedMTU(mtuTestPath(t, 1472, false))
+		assert.False(t, ok)
+	})
+	t.Run("other SCMP messages", func(t *testing.T) {
+		var handled []*snet.Packet
+		d := &snet.MTUDiscoverer{Handler: scmpHandlerFunc(func(pkt *snet.Packet) error {
+			handled = append(handled, pkt)
+			return nil
+		})}
+		pkt := &snet.Packet{PacketInfo: snet.PacketInfo{
+			Payload: snet.SCMPEchoReply{Identifier: 1, SeqNumber: 1},
+		}}
+		require.NoError(t, d.Handle(pkt))
+		assert.Equal(t, []*snet.Packet{pkt}, handled)
+	})
+}
+
+func TestUDPPayloadMTU(t *testing.T) {
+	p := mtuTestPath(t, 1472, false)
+	n, err := snet.UDPPayloadMTU(1472, mtuLocal, mtuRemote, p.Dataplane())
+	require.NoError(t, err)
+	// Common header, address header with IPv4 hosts, path with one info and two hop fields, UDP
+	// header.
+	assert.Equal(t, 1472-(12+24+4+8+2*12+8), n)
+}
+
+type scmpHandlerFunc func(*snet.Packet) error
+
+func (f scmpHandlerFunc) Handle(pkt *snet.Packet) error {
+	return f(pkt)
+}
@@ -0,0 +1,483 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package squic
+
+import (
+	"context"
+	"errors"
+	"net"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/util"
+	"github.com/scionproto/scion/pkg/segment/iface"
+	"github.com/scionproto/scion/pkg/slayers/path"
+	"github.com/scionproto/scion/pkg/slayers/path/scion"
+	"github.com/scionproto/scion/pkg/snet"
+	snetpath "github.com/scionproto/scion/pkg/snet/path"
+)
+
+const (
+	// DefaultProbeTimeout is the default duration after which the paths to a remote are
+	// considered broken, if nothing is received from the remote while sending to it.
+	DefaultProbeTimeout = 3 * time.Second
+
+	// selectTimeout bounds a path selection.
+	selectTimeout = 2 * time.Second
+	// selectBackoff is the duration after a failed path selection during which no new selection
+	// is attempted.
+	selectBackoff = time.Second
+	// failedPathHold is the duration for which a failed path is not used again, unless there is
+	// no other path.
+	failedPathHold = 30 * time.Second
+	// remoteIdleTimeout is the duration after which the state of a remote is removed, if no
+	// packet was sent to or received from it.
+	remoteIdleTimeout = 5 * time.Minute
+)
+
+// PathSelector selects the SCION paths of the QUIC connections dialed on a MigratingConn.
+type PathSelector interface {
+	// Select returns the paths to the remote, in order of preference.
+	Select(ctx context.Context, remote *snet.UDPAddr) ([]snet.Path, error)
+}
+
+// RouterSelector selects the paths of a router, in the order the router returns them.
+type RouterSelector struct {
+	Router snet.Router
+}
+
+func (s RouterSelector) Select(ctx context.Context, remote *snet.UDPAddr) ([]snet.Path, error) {
+	return s.Router.AllRoutes(ctx, remote.IA)
+}
+
+// MigratingConn is a SCION packet connection for a QUIC transport that moves the QUIC connections
+// to new paths when their paths fail. QUIC sees a single remote address per connection, and
+// MigratingConn chooses the path of every packet sent to it. It has two roles:
+//
+//   - With a Selector, it migrates the connections dialed on the transport. A connection starts on
+//     the path it is dialed with, and moves to the paths returned by the Selector when its path
+//     expires, when an SCMP interface down message reports an interface on the path, or when
+//     nothing is received from the remote for ProbeTimeout while sending to it. If MaxPaths is
+//     greater than one, the packets are spread over several paths at once. Only the connections
+//     dialed with a SCION path are migrated; the connections dialed with other paths, e.g., one-hop
+//     paths for beaconing or empty paths within the local AS, keep their paths. The dialed path
+//     has no metadata, so its expiry is taken from its info and hop fields. Its hop fields do not
+//     contain the ISD-ASes of the interfaces, so an SCMP interface down message fails the dialed
+//     path if the path has an interface with the reported ID, in any ISD-AS.
+//   - Without a Selector, it follows the migrations of the connections accepted on the transport.
+//     The packets are sent on the reverse of the path of the last packet received from the
+//     remote.
+//
+// MigratingConn handles the SCMP errors returned by the wrapped connection and does not return
+// them to QUIC. The wrapped connection must therefore propagate the SCMP errors, e.g., with
+// snet.DefaultSCMPHandler instead of snet.SCMPPropagationStopper.
+type MigratingConn struct {
+	// PacketConn is the wrapped SCION connection.
+	net.PacketConn
+	// Selector selects the paths of the dialed connections. If nil, the dialed connections keep
+	// the paths they were dialed with, and the accepted connections follow the paths of the
+	// remotes.
+	Selector PathSelector
+	// MaxPaths is the number of paths that the packets to a remote are spread over. If zero, one
+	// path is used.
+	MaxPaths int
+	// ProbeTimeout is the duration after which the paths to a remote are considered broken, if
+	// nothing is received from the remote while sending to it. If zero, DefaultProbeTimeout is
+	// used.
+	ProbeTimeout time.Duration
+
+	mtx sync.Mutex
+	// dialed contains the state of the dialed connections, by remote address and by the path
+	// they were dialed with.
+	dialed map[string]map[string]*remote
+	// accepted contains the state of the accepted connections, by remote address.
+	accepted  map[string]*accepted
+	lastSweep time.Time
+}
+
+// accepted is the state of a remote that dialed a connection.
+type accepted struct {
+	lastUsed time.Time
+	// path and nextHop are those of the last packet received from the remote.
+	path    snet.DataplanePath
+	nextHop *net.UDPAddr
+}
+
+// remote is the state of a connection dialed to a remote.
+type remote struct {
+	lastUsed time.Time
+
+	// dialedPath is the path the connection was dialed with.
+	dialedPath snet.Path
+	// candidates are the usable paths to the remote, in order of preference. The first MaxPaths
+	// are in use.
+	candidates      []snet.Path
+	failed          map[string]time.Time
+	next            int
+	selected        bool
+	selecting       bool
+	selectFailed    time.Time
+	lastWrite       time.Time
+	unansweredSince time.Time
+}
+
+// WriteTo writes the packet to the address, on the path chosen for the remote.
+func (c *MigratingConn) WriteTo(b []byte, a net.Addr) (int, error) {
+	dst, ok := a.(*snet.UDPAddr)
+	if !ok {
+		return c.PacketConn.WriteTo(b, a)
+	}
+	return c.PacketConn.WriteTo(b, c.route(dst))
+}
+
+// ReadFrom reads a packet. SCMP errors are handled and not returned.
+func (c *MigratingConn) ReadFrom(b []byte) (int, net.Addr, error) {
+	for {
+		n, a, err := c.PacketConn.ReadFrom(b)
+		var opErr *snet.OpError
+		if errors.As(err, &opErr) {
+			if rev := opErr.RevInfo(); rev != nil {
+				c.interfaceDown(rev.IA(), rev.IfID)
+			}
+			continue
+		}
+		if err != nil {
+			return n, a, err
+		}
+		if src, ok := a.(*snet.UDPAddr); ok {
+			c.received(src)
+		}
+		return n, a, nil
+	}
+}
+
+// route returns the address with the path for the next packet to dst.
+func (c *MigratingConn) route(dst *snet.UDPAddr) *snet.UDPAddr {
+	if c.Selector == nil {
+		return c.routeAccepted(dst)
+	}
+	if _, ok := dst.Path.(snetpath.SCION); !ok {
+		return dst
+	}
+	now := time.Now()
+	c.mtx.Lock()
+	c.sweepLocked(now)
+	r := c.dialedLocked(dst, now)
+	r.probeLocked(now, c.probeTimeout(), c.maxPaths())
+	r.pruneLocked(now)
+	doSelect := !r.selecting && now.Sub(r.selectFailed) > selectBackoff &&
+		(len(r.candidates) == 0 || (!r.selected && c.maxPaths() > 1))
+	if doSelect {
+		r.selecting = true
+		c.mtx.Unlock()
+		// The selection is done outside of the lock, so that it does not block the packets to
+		// other remotes.
+		paths := c.selectPaths(dst)
+		c.mtx.Lock()
+		r.selecting = false
+		r.installLocked(paths, now)
+	}
+	path := r.nextLocked(c.maxPaths())
+	c.mtx.Unlock()
+	if path == nil {
+		return dst
+	}
+	return withPath(dst, path.Dataplane(), path.UnderlayNextHop())
+}
+
+// routeAccepted returns the address with the reverse of the path of the last packet received
+// from dst.
+func (c *MigratingConn) routeAccepted(dst *snet.UDPAddr) *snet.UDPAddr {
+	now := time.Now()
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.sweepLocked(now)
+	r, ok := c.accepted[dst.String()]
+	if !ok {
+		return dst
+	}
+	r.lastUsed = now
+	return withPath(dst, r.path, r.nextHop)
+}
+
+func (c *MigratingConn) selectPaths(dst *snet.UDPAddr) []snet.Path {
+	ctx, cancelF := context.WithTimeout(context.Background(), selectTimeout)
+	defer cancelF()
+	paths, err := c.Selector.Select(ctx, dst)
+	if err != nil {
+		log.Debug("Selecting paths for QUIC connection failed", "remote", dst, "err", err)
+		return nil
+	}
+	return paths
+}
+
+// received records that a packet was received from src.
+func (c *MigratingConn) received(src *snet.UDPAddr) {
+	now := time.Now()
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	// The string representation of the address does not contain the path.
+	key := src.String()
+	if c.Selector != nil {
+		// The packet cannot be attributed to one of the paths, it confirms all connections to
+		// the remote.
+		for _, r := range c.dialed[key] {
+			r.unansweredSince = time.Time{}
+		}
+		return
+	}
+	if c.accepted == nil {
+		c.accepted = make(map[string]*accepted)
+	}
+	c.accepted[key] = &accepted{lastUsed: now, path: src.Path, nextHop: src.NextHop}
+}
+
+// interfaceDown fails the paths that traverse the interface.
+func (c *MigratingConn) interfaceDown(ia addr.IA, ifID iface.ID) {
+	now := time.Now()
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	for _, byPath := range c.dialed {
+		for _, r := range byPath {
+			for _, p := range slices.Clone(r.candidates) {
+				if traverses(p, ia, ifID) {
+					r.failLocked(p, now)
+				}
+			}
+		}
+	}
+}
+
+// dialedLocked returns the state of the connection dialed to dst with the path of dst.
+func (c *MigratingConn) dialedLocked(dst *snet.UDPAddr, now time.Time) *remote {
+	if c.dialed == nil {
+		c.dialed = make(map[string]map[string]*remote)
+	}
+	key := dst.String()
+	byPath, ok := c.dialed[key]
+	if !ok {
+		byPath = make(map[string]*remote)
+		c.dialed[key] = byPath
+	}
+	dialedPath := snetpath.Path{
+		Dst:           dst.IA,
+		DataplanePath: dst.Path,
+		NextHop:       dst.NextHop,
+	}
+	pk := pathKey(dialedPath)
+	r, ok := byPath[pk]
+	if !ok {
+		dialedPath.Meta = dialedMetadata(dst.Path)
+		r = &remote{
+			dialedPath: dialedPath,
+			candidates: []snet.Path{dialedPath},
+		}
+		byPath[pk] = r
+	}
+	r.lastUsed = now
+	return r
+}
+
+// sweepLocked removes the state of the remotes that were not used recently.
+func (c *MigratingConn) sweepLocked(now time.Time) {
+	if now.Sub(c.lastSweep) < remoteIdleTimeout {
+		return
+	}
+	c.lastSweep = now
+	for key, byPath := range c.dialed {
+		for pk, r := range byPath {
+			if !r.selecting && now.Sub(r.lastUsed) > remoteIdleTimeout {
+				delete(byPath, pk)
+			}
+		}
+		if len(byPath) == 0 {
+			delete(c.dialed, key)
+		}
+	}
+	for key, r := range c.accepted {
+		if now.Sub(r.lastUsed) > remoteIdleTimeout {
+			delete(c.accepted, key)
+		}
+	}
+}
+
+func (c *MigratingConn) maxPaths() int {
+	return max(c.MaxPaths, 1)
+}
+
+func (c *MigratingConn) probeTimeout() time.Duration {
+	if c.ProbeTimeout == 0 {
+		return DefaultProbeTimeout
+	}
+	return c.ProbeTimeout
+}
+
+// probeLocked fails the paths in use if nothing was received from the remote while sending to
+// it for longer than the timeout. A pause in sending longer than the timeout starts over.
+func (r *remote) probeLocked(now time.Time, timeout time.Duration, maxPaths int) {
+	switch {
+	case r.unansweredSince.IsZero() || now.Sub(r.lastWrite) > timeout:
+		r.unansweredSince = now
+	case now.Sub(r.unansweredSince) > timeout:
+		for _, p := range slices.Clone(r.candidates[:min(maxPaths, len(r.candidates))]) {
+			r.failLocked(p, now)
+		}
+		r.unansweredSince = now
+	}
+	r.lastWrite = now
+}
+
+// pruneLocked removes the expired paths from the candidates.
+func (r *remote) pruneLocked(now time.Time) {
+	r.candidates = slices.DeleteFunc(r.candidates, func(p snet.Path) bool {
+		return expired(p, now)
+	})
+}
+
+// nextLocked returns the path for the next packet, or nil if there is no usable path.
+func (r *remote) nextLocked(maxPaths int) snet.Path {
+	if len(r.candidates) == 0 {
+		return nil
+	}
+	p := r.candidates[r.next%min(maxPaths, len(r.candidates))]
+	r.next++
+	return p
+}
+
+// installLocked replaces the candidates with the dialed path, followed by the selected paths.
+// The failed paths are skipped, unless all paths failed.
+func (r *remote) installLocked(paths []snet.Path, now time.Time) {
+	if len(paths) == 0 {
+		r.selectFailed = now
+		return
+	}
+	r.selected = true
+	for pk, t := range r.failed {
+		if now.Sub(t) > failedPathHold {
+			delete(r.failed, pk)
+		}
+	}
+	r.candidates = r.usable(paths, now, true)
+	if len(r.candidates) == 0 {
+		r.failed = nil
+		r.candidates = r.usable(paths, now, false)
+	}
+	r.next = 0
+	r.unansweredSince = time.Time{}
+}
+
+// usable returns the dialed path and the selected paths that are not expired and, if skipFailed
+// is set, did not fail.
+func (r *remote) usable(paths []snet.Path, now time.Time, skipFailed bool) []snet.Path {
+	seen := make(map[string]struct{}, len(paths)+1)
+	var usable []snet.Path
+	for _, p := range append([]snet.Path{r.dialedPath}, paths...) {
+		pk := pathKey(p)
+		if _, ok := seen[pk]; ok {
+			continue
+		}
+		seen[pk] = struct{}{}
+		if _, failed := r.failed[pk]; (failed && skipFailed) || expired(p, now) {
+			continue
+		}
+		usable = append(usable, p)
+	}
+	return usable
+}
+
+func (r *remote) failLocked(p snet.Path, now time.Time) {
+	pk := pathKey(p)
+	if r.failed == nil {
+		r.failed = make(map[string]time.Time)
+	}
+	r.failed[pk] = now
+	r.candidates = slices.DeleteFunc(r.candidates, func(c snet.Path) bool {
+		return pathKey(c) == pk
+	})
+	log.Debug("Migrating QUIC connection away from failed path", "path", snet.Fingerprint(p))
+}
+
+// pathKey identifies the dataplane path. The dialed path, which has no metadata, and the same
+// path returned by the Selector have the same key.
+func pathKey(p snet.Path) string {
+	if sp, ok := p.Dataplane().(snetpath.SCION); ok {
+		return string(sp.Raw)
+	}
+	return string(snet.Fingerprint(p))
+}
+
+func expired(p snet.Path, now time.Time) bool {
+	md := p.Metadata()
+	return md != nil && !md.Expiry.IsZero() && !md.Expiry.After(now)
+}
+
+// traverses reports whether the path traverses the interface. An interface of the path without
+// ISD-AS, see dialedMetadata, matches the interface ID in any ISD-AS.
+func traverses(p snet.Path, ia addr.IA, ifID iface.ID) bool {
+	md := p.Metadata()
+	if md == nil {
+		return false
+	}
+	for _, intf := range md.Interfaces {
+		if (intf.IA == ia || intf.IA.IsZero()) && intf.ID == ifID {
+			return true
+		}
+	}
+	return false
+}
+
+// dialedMetadata returns the metadata of a dialed SCION path that can be derived from its info
+// and hop fields: the expiry, and the interfaces. The ISD-ASes of the interfaces are not encoded
+// in the path, so the interfaces have none.
+func dialedMetadata(dp snet.DataplanePath) snet.PathMetadata {
+	sp, ok := dp.(snetpath.SCION)
+	if !ok {
+		return snet.PathMetadata{}
+	}
+	var decoded scion.Decoded
+	if err := decoded.DecodeFromBytes(sp.Raw); err != nil {
+		return snet.PathMetadata{}
+	}
+	var md snet.PathMetadata
+	hops := decoded.HopFields
+	for i, info := range decoded.InfoFields {
+		segLen := int(decoded.PathMeta.SegLen[i])
+		for _, hf := range hops[:segLen] {
+			exp := util.SecsToTime(info.Timestamp).Add(path.ExpTimeToDuration(hf.ExpTime))
+			if md.Expiry.IsZero() || exp.Before(md.Expiry) {
+				md.Expiry = exp
+			}
+			for _, ifID := range []uint16{hf.ConsIngress, hf.ConsEgress} {
+				if ifID != 0 {
+					md.Interfaces = append(md.Interfaces, snet.PathInterface{ID: iface.ID(ifID)})
+				}
+			}
+		}
+		hops = hops[segLen:]
+	}
+	return md
+}
+
+func withPath(a *snet.UDPAddr, path snet.DataplanePath, nextHop *net.UDPAddr) *snet.UDPAddr {
+	return &snet.UDPAddr{
+		IA:      a.IA,
+		Host:    a.Host,
+		Path:    path,
+		NextHop: nextHop,
+	}
+}
@@ -0,0 +1,358 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package squic_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/util"
+	"github.com/scionproto/scion/pkg/segment/iface"
+	"github.com/scionproto/scion/pkg/slayers/path"
+	"github.com/scionproto/scion/pkg/slayers/path/scion"
+	"github.com/scionproto/scion/pkg/snet"
+	snetpath "github.com/scionproto/scion/pkg/snet/path"
+	"github.com/scionproto/scion/pkg/snet/squic"
+)
+
+var (
+	localIA  = addr.MustParseIA("1-ff00:0:110")
+	remoteIA = addr.MustParseIA("1-ff00:0:111")
+)
+
+type readResult struct {
+	addr net.Addr
+	err  error
+}
+
+// testPacketConn records the addresses written to, and returns the queued reads.
+type testPacketConn struct {
+	net.PacketConn
+	writes []*snet.UDPAddr
+	reads  chan readResult
+}
+
+func newTestPacketConn() *testPacketConn {
+	return &testPacketConn{reads: make(chan readResult, 10)}
+}
+
+func (c *testPacketConn) WriteTo(b []byte, a net.Addr) (int, error) {
+	c.writes = append(c.writes, a.(*snet.UDPAddr))
+	return len(b), nil
+}
+
+func (c *testPacketConn) ReadFrom(b []byte) (int, net.Addr, error) {
+	r := <-c.reads
+	return len(b), r.addr, r.err
+}
+
+// lastPath returns the raw path of the last packet written.
+func (c *testPacketConn) lastPath(t *testing.T) byte {
+	require.NotEmpty(t, c.writes)
+	return c.writes[len(c.writes)-1].Path.(snetpath.SCION).Raw[0]
+}
+
+type selectorFunc func(context.Context, *snet.UDPAddr) ([]snet.Path, error)
+
+func (f selectorFunc) Select(ctx context.Context, remote *snet.UDPAddr) ([]snet.Path, error) {
+	return f(ctx, remote)
+}
+
+// testPath returns a path with the raw path id, that leaves the local AS on interface id.
+func testPath(id byte, expiry time.Time) snet.Path {
+	return snetpath.Path{
+		Src:           localIA,
+		Dst:           remoteIA,
+		DataplanePath: snetpath.SCION{Raw: []byte{id}},
+		NextHop:       &net.UDPAddr{IP: net.IP{10, 0, 0, id}, Port: 30041},
+		Meta: snet.PathMetadata{
+			Interfaces: []snet.PathInterface{
+				{IA: localIA, ID: iface.ID(id)},
+				{IA: remoteIA, ID: 100},
+			},
+			Expiry: expiry,
+		},
+	}
+}
+
+func testRemote() *snet.UDPAddr {
+	return &snet.UDPAddr{
+		IA:   remoteIA,
+		Host: &net.UDPAddr{IP: net.IP{192, 0, 2, 1}, Port: 443},
+		Path: snetpath.SCION{Raw: []byte{0}},
+	}
+}
+
+// testSCIONPath returns a SCION path created at ts, that leaves the local AS on interface 4 and
+// enters the remote AS on interface 1. The hop fields expire after about 5 minutes.
+func testSCIONPath(t *testing.T, ts time.Time) snetpath.SCION {
+	sp := scion.Decoded{
+		Base: scion.Base{
+			PathMeta: scion.MetaHdr{SegLen: [3]uint8{2, 0, 0}},
+			NumINF:   1,
+			NumHops:  2,
+		},
+		InfoFields: []path.InfoField{
+			{ConsDir: true, SegID: 0x1111, Timestamp: util.TimeToSecs(ts)},
+		},
+		HopFields: []path.HopField{
+			{ConsEgress: 4, Mac: [path.MacLen]byte{1, 2, 3, 4, 5, 6}},
+			{ConsIngress: 1, Mac: [path.MacLen]byte{6, 5, 4, 3, 2, 1}},
+		},
+	}
+	raw := make([]byte, sp.Len())
+	require.NoError(t, sp.SerializeTo(raw))
+	return snetpath.SCION{Raw: raw}
+}
+
+func interfaceDown(ifID iface.ID) readResult {
+	pkt := &snet.Packet{PacketInfo: snet.PacketInfo{
+		Payload: snet.SCMPExternalInterfaceDown{IA: localIA, Interface: uint64(ifID)},
+	}}
+	return readResult{err: snet.DefaultSCMPHandler{}.Handle(pkt)}
+}
+
+// migrate writes to the remote until the connection moves away from the dialed path, because
+// nothing is received from the remote.
+func migrate(t *testing.T, c *squic.MigratingConn, pc *testPacketConn) {
+	_, err := c.WriteTo(nil, testRemote())
+	require.NoError(t, err)
+	for pc.lastPath(t) == 0 {
+		time.Sleep(5 * time.Millisecond)
+		_, err := c.WriteTo(nil, testRemote())
+		require.NoError(t, err)
+	}
+}
+
+func TestMigratingConn(t *testing.T) {
+	expiry := time.Now().Add(time.Hour)
+	paths := []snet.Path{testPath(1, expiry), testPath(2, expiry), testPath(3, expiry)}
+	selector := selectorFunc(func(context.Context, *snet.UDPAddr) ([]snet.Path, error) {
+		return paths, nil
+	})
+	probeTimeout := 20 * time.Millisecond
+
+	t.Run("dialed path", func(t *testing.T) {
+		pc := newTestPacketConn()
+		var selected int
+		c := &squic.MigratingConn{
+			PacketConn: pc,
+			Selector: selectorFunc(func(context.Context, *snet.UDPAddr) ([]snet.Path, error) {
+				selected++
+				return paths, nil
+			}),
+		}
+		for range 3 {
+			_, err := c.WriteTo(nil, testRemote())
+			require.NoError(t, err)
+			assert.Equal(t, byte(0), pc.lastPath(t))
+		}
+		assert.Zero(t, selected)
+	})
+	t.Run("several paths", func(t *testing.T) {
+		pc := newTestPacketConn()
+		c := &squic.MigratingConn{PacketConn: pc, Selector: selector, MaxPaths: 2}
+		var used []byte
+		for range 4 {
+			_, err := c.WriteTo(nil, testRemote())
+			require.NoError(t, err)
+			used = append(used, pc.lastPath(t))
+		}
+		assert.Equal(t, []byte{0, 1, 0, 1}, used)
+	})
+	t.Run("probe timeout", func(t *testing.T) {
+		pc := newTestPacketConn()
+		c := &squic.MigratingConn{PacketConn: pc, Selector: selector, ProbeTimeout: probeTimeout}
+		migrate(t, c, pc)
+		assert.Equal(t, byte(1), pc.lastPath(t))
+		assert.Equal(t, paths[0].UnderlayNextHop(), pc.writes[len(pc.writes)-1].NextHop)
+		assert.Equal(t, testRemote().Host, pc.writes[len(pc.writes)-1].Host)
+
+		// Receiving from the remote confirms the path.
+		pc.reads <- readResult{addr: testRemote()}
+		_, _, err := c.ReadFrom(nil)
+		require.NoError(t, err)
+		time.Sleep(15 * time.Millisecond)
+		_, err = c.WriteTo(nil, testRemote())
+		require.NoError(t, err)
+		time.Sleep(15 * time.Millisecond)
+		_, err = c.WriteTo(nil, testRemote())
+		require.NoError(t, err)
+		assert.Equal(t, byte(1), pc.lastPath(t))
+	})
+	t.Run("interface down", func(t *testing.T) {
+		pc := newTestPacketConn()
+		c := &squic.MigratingConn{PacketConn: pc, Selector: selector, ProbeTimeout: probeTimeout}
+		migrate(t, c, pc)
+		c.ProbeTimeout = time.Hour
+
+		// The SCMP error is handled, and the next packet is returned.
+		pc.reads <- interfaceDown(1)
+		pc.reads <- readResult{addr: testRemote()}
+		_, a, err := c.ReadFrom(nil)
+		require.NoError(t, err)
+		assert.Equal(t, testRemote().String(), a.String())
+
+		_, err = c.WriteTo(nil, testRemote())
+		require.NoError(t, err)
+		assert.Equal(t, byte(2), pc.lastPath(t))
+	})
+	t.Run("interface down on dialed path", func(t *testing.T) {
+		pc := newTestPacketConn()
+		c := &squic.MigratingConn{PacketConn: pc, Selector: selector}
+		remote := testRemote()
+		remote.Path = testSCIONPath(t, time.Now())
+		_, err := c.WriteTo(nil, remote)
+		require.NoError(t, err)
+		assert.Equal(t, remote.Path, pc.writes[len(pc.writes)-1].Path)
+
+		// Interfaces that are not on the dialed path do not matter.
+		pc.reads <- interfaceDown(7)
+		pc.reads <- readResult{addr: remote}
+		_, _, err = c.ReadFrom(nil)
+		require.NoError(t, err)
+		_, err = c.WriteTo(nil, remote)
+		require.NoError(t, err)
+		assert.Equal(t, remote.Path, pc.writes[len(pc.writes)-1].Path)
+
+		// The dialed path leaves the local AS on interface 4.
+		pc.reads <- interfaceDown(4)
+		pc.reads <- readResult{addr: remote}
+		_, _, err = c.ReadFrom(nil)
+		require.NoError(t, err)
+		_, err = c.WriteTo(nil, remote)
+		require.NoError(t, err)
+		assert.Equal(t, byte(1), pc.lastPath(t))
+	})
+	t.Run("expired dialed path", func(t *testing.T) {
+		pc := newTestPacketConn()
+		c := &squic.MigratingConn{PacketConn: pc, Selector: selector}
+		remote := testRemote()
+		remote.Path = testSCIONPath(t, time.Now().Add(-time.Hour))
+		_, err := c.WriteTo(nil, remote)
+		require.NoError(t, err)
+		assert.Equal(t, byte(1), pc.lastPath(t))
+	})
+	t.Run("selected dialed path", func(t *testing.T) {
+		pc := newTestPacketConn()
+		c := &squic.MigratingConn{
+			PacketConn: pc,
+			Selector: selectorFunc(func(context.Context, *snet.UDPAddr) ([]snet.Path, error) {
+				return []snet.Path{testPath(0, expiry), testPath(2, expiry)}, nil
+			}),
+			ProbeTimeout: probeTimeout,
+		}
+		// The failed dialed path is not used again, although it is selected.
+		migrate(t, c, pc)
+		assert.Equal(t, byte(2), pc.lastPath(t))
+	})
+	t.Run("expired path", func(t *testing.T) {
+		pc := newTestPacketConn()
+		expiring := []snet.Path{
+			testPath(1, time.Now().Add(-time.Second)),
+			testPath(2, expiry),
+		}
+		c := &squic.MigratingConn{
+			PacketConn: pc,
+			Selector: selectorFunc(func(context.Context, *snet.UDPAddr) ([]snet.Path, error) {
+				return expiring, nil
+			}),
+			ProbeTimeout: probeTimeout,
+		}
+		migrate(t, c, pc)
+		assert.Equal(t, byte(2), pc.lastPath(t))
+	})
+	t.Run("all paths failed", func(t *testing.T) {
+		pc := newTestPacketConn()
+		c := &squic.MigratingConn{PacketConn: pc, Selector: selector, ProbeTimeout: probeTimeout}
+		migrate(t, c, pc)
+		c.ProbeTimeout = time.Hour
+		for _, ifID := range []iface.ID{1, 2, 3} {
+			pc.reads <- interfaceDown(ifID)
+		}
+		pc.reads <- readResult{addr: testRemote()}
+		_, _, err := c.ReadFrom(nil)
+		require.NoError(t, err)
+
+		// The failed paths are used again, starting with the dialed path, rather than none.
+		_, err = c.WriteTo(nil, testRemote())
+		require.NoError(t, err)
+		assert.Equal(t, byte(0), pc.lastPath(t))
+	})
+	t.Run("state per dialed path", func(t *testing.T) {
+		pc := newTestPacketConn()
+		c := &squic.MigratingConn{PacketConn: pc, Selector: selector, ProbeTimeout: probeTimeout}
+		migrate(t, c, pc)
+
+		other := testRemote()
+		other.Path = snetpath.SCION{Raw: []byte{9}}
+		_, err := c.WriteTo(nil, other)
+		require.NoError(t, err)
+		assert.Equal(t, byte(9), pc.lastPath(t))
+	})
+	t.Run("one-hop and empty paths are kept", func(t *testing.T) {
+		pc := newTestPacketConn()
+		c := &squic.MigratingConn{PacketConn: pc, Selector: selector, ProbeTimeout: probeTimeout}
+		for _, p := range []snet.DataplanePath{snetpath.OneHop{}, snetpath.Empty{}} {
+			dst := testRemote()
+			dst.Path = p
+			for range 5 {
+				_, err := c.WriteTo(nil, dst)
+				require.NoError(t, err)
+				time.Sleep(10 * time.Millisecond)
+			}
+			for _, w := range pc.writes {
+				assert.Equal(t, p, w.Path)
+			}
+			pc.writes = nil
+		}
+	})
+	t.Run("accepted remote follows reply path", func(t *testing.T) {
+		pc := newTestPacketConn()
+		c := &squic.MigratingConn{PacketConn: pc}
+		for _, id := range []byte{5, 6} {
+			from := testRemote()
+			from.Path = snetpath.SCION{Raw: []byte{id}}
+			pc.reads <- readResult{addr: from}
+			_, _, err := c.ReadFrom(nil)
+			require.NoError(t, err)
+
+			// QUIC sends to the address of the first packet.
+			_, err = c.WriteTo(nil, testRemote())
+			require.NoError(t, err)
+			assert.Equal(t, id, pc.lastPath(t))
+		}
+	})
+	t.Run("without selector", func(t *testing.T) {
+		pc := newTestPacketConn()
+		c := &squic.MigratingConn{PacketConn: pc}
+		_, err := c.WriteTo(nil, testRemote())
+		require.NoError(t, err)
+		assert.Equal(t, byte(0), pc.lastPath(t))
+	})
+	t.Run("other errors are returned", func(t *testing.T) {
+		pc := newTestPacketConn()
+		c := &squic.MigratingConn{PacketConn: pc}
+		pc.reads <- readResult{err: net.ErrClosed}
+		_, _, err := c.ReadFrom(nil)
+		assert.ErrorIs(t, err, net.ErrClosed)
+	})
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package squic_test
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/quic-go/quic-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/snet"
+	snetpath "github.com/scionproto/scion/pkg/snet/path"
+	"github.com/scionproto/scion/pkg/snet/squic"
+)
+
+// echoConn answers the MTU probes that fit into the MTU.
+type echoConn struct {
+	snet.PacketConn
+	d   *snet.MTUDiscoverer
+	mtu int
+}
+
+func (c *echoConn) WriteTo(pkt *snet.Packet, _ *net.UDPAddr) error {
+	if err := pkt.Serialize(); err != nil {
+		return err
+	}
+	if len(pkt.Bytes) > c.mtu {
+		return nil
+	}
+	req := pkt.Payload.(snet.SCMPEchoRequest)
+	return c.d.Handle(&snet.Packet{PacketInfo: snet.PacketInfo{
+		Payload: snet.SCMPEchoReply{Identifier: req.Identifier, SeqNumber: req.SeqNumber},
+	}})
+}
+
+// sizeConn records the sizes of the packets written to it, and never receives a packet. Setting
+// a read deadline, which the QUIC transport does when it is closed, unblocks the reads.
+type sizeConn struct {
+	net.PacketConn
+	mtx     sync.Mutex
+	sizes   []int
+	done    chan struct{}
+	doneOne sync.Once
+}
+
+func (c *sizeConn) WriteTo(b []byte, _ net.Addr) (int, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.sizes = append(c.sizes, len(b))
+	return len(b), nil
+}
+
+func (c *sizeConn) ReadFrom([]byte) (int, net.Addr, error) {
+	<-c.done
+	return 0, nil, net.ErrClosed
+}
+
+func (c *sizeConn) LocalAddr() net.Addr {
+	return &net.UDPAddr{IP: net.IP{10, 0, 0, 1}, Port: 40000}
+}
+
+func (c *sizeConn) SetReadDeadline(t time.Time) error {
+	if !t.IsZero() {
+		c.doneOne.Do(func() { close(c.done) })
+	}
+	return nil
+}
+
+// firstSize dials dst and returns the size of the first packet sent.
+func firstSize(t *testing.T, dialer *squic.ConnDialer, conn *sizeConn, dst net.Addr) int {
+	ctx, cancelF := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancelF()
+	conn.mtx.Lock()
+	conn.sizes = nil
+	conn.mtx.Unlock()
+	_, err := dialer.DialQUIC(ctx, dst)
+	require.Error(t, err)
+	conn.mtx.Lock()
+	defer conn.mtx.Unlock()
+	require.NotEmpty(t, conn.sizes)
+	return conn.sizes[0]
+}
+
+func mtuTestRemote(t *testing.T) *snet.UDPAddr {
+	return &snet.UDPAddr{
+		IA:      remoteIA,
+		Host:    &net.UDPAddr{IP: net.IP{10, 0, 0, 2}, Port: 443},
+		Path:    testSCIONPath(t, time.Now()),
+		NextHop: &net.UDPAddr{IP: net.IP{10, 0, 0, 254}, Port: 30041},
+	}
+}
+
+func TestConnDialerMTU(t *testing.T) {
+	d := &snet.MTUDiscoverer{
+		Local: &snet.UDPAddr{
+			IA:   localIA,
+			Host: &net.UDPAddr{IP: net.IP{10, 0, 0, 1}, Port: 40000},
+		},
+		ProbeTimeout: 10 * time.Millisecond,
+		MaxProbes:    1,
+	}
+	d.Conn = &echoConn{d: d, mtu: 1400}
+	conn := &sizeConn{done: make(chan struct{})}
+	transport := &quic.Transport{Conn: conn}
+	defer transport.Close()
+	dialer := &squic.ConnDialer{
+		Transport:     transport,
+		TLSConfig:     &tls.Config{InsecureSkipVerify: true, NextProtos: []string{"SCION"}},
+		MTUDiscoverer: d,
+	}
+	remote := mtuTestRemote(t)
+
+	// The first dial uses the default packet size, and starts the discovery of the MTU.
+	assert.Equal(t, 1280, firstSize(t, dialer, conn, remote))
+	require.Eventually(t, func() bool {
+		_, ok := d.CachedMTU(snetpath.Path{DataplanePath: remote.Path})
+		return ok
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The next dials use the largest packets that fit into the MTU.
+	expected, err := snet.UDPPayloadMTU(1400,
+		addr.Addr{IA: localIA, Host: addr.MustParseHost("10.0.0.1")},
+		addr.Addr{IA: remoteIA, Host: addr.MustParseHost("10.0.0.2")},
+		remote.Path,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, expected, firstSize(t, dialer, conn, remote))
+
+	// Connections within the local AS are not probed.
+	local := &snet.UDPAddr{
+		IA:   localIA,
+		Host: &net.UDPAddr{IP: net.IP{10, 0, 0, 3}, Port: 443},
+		Path: snetpath.Empty{},
+	}
+	assert.Equal(t, 1280, firstSize(t, dialer, conn, local))
+}
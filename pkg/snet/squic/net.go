@@ -21,14 +21,17 @@ import (
 	"fmt"
 	"math/rand/v2"
 	"net"
+	"net/netip"
 	"sync"
 	"time"
 
 	"github.com/quic-go/quic-go"
 
+	"github.com/scionproto/scion/pkg/addr"
 	"github.com/scionproto/scion/pkg/log"
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/pkg/snet"
+	snetpath "github.com/scionproto/scion/pkg/snet/path"
 )
 
 const (
@@ -46,6 +49,18 @@ const (
 // streamAcceptTimeout is the default timeout for accepting connections.
 const streamAcceptTimeout = 5 * time.Second
 
+// mtuDiscoveryTimeout bounds the discovery of the MTU of a path.
+const mtuDiscoveryTimeout = 30 * time.Second
+
+// Conn is the net.Conn of the QUIC stream that ConnDialer dials and ConnListener accepts.
+// Connection returns the QUIC connection of the stream, which gives access to the other streams
+// and to the datagrams of the connection. Closing the Conn closes the QUIC connection.
+type Conn interface {
+	net.Conn
+	// Connection returns the QUIC connection of the stream.
+	Connection() quic.Connection
+}
+
 // ConnListener wraps a quic.Listener as a net.Listener.
 type ConnListener struct {
 	*quic.Listener
@@ -65,7 +80,8 @@ func NewConnListener(l *quic.Listener) *ConnListener {
 	return c
 }
 
-// Accept accepts the first stream on a session and wraps it as a net.Conn.
+// Accept accepts the first stream on a session and wraps it as a net.Conn. The returned net.Conn
+// implements Conn.
 func (l *ConnListener) Accept() (net.Conn, error) {
 	session, err := l.Listener.Accept(l.ctx)
 	if err != nil {
@@ -319,6 +335,10 @@ func (c *acceptingConn) ConnectionState() tls.ConnectionState {
 	return c.session.ConnectionState().TLS
 }
 
+func (c *acceptingConn) Connection() quic.Connection {
+	return c.session
+}
+
 func (c *acceptingConn) Close() error {
 	// Prevent the stream from being accepted.
 	c.once.Do(func() {
@@ -355,20 +375,52 @@ type ConnDialer struct {
 	// propagated. You can for example use
 	// [github.com/scionproto/scion/pkg/snet.SCMPPropagationStopper]. Otherwise,
 	// the QUIC transport will close the listening side on SCMP errors and enter
-	// a broken state.
+	// a broken state. A MigratingConn handles the SCMP errors itself.
 	Transport *quic.Transport
 	// TLSConfig is the client's TLS configuration for starting QUIC connections.
 	TLSConfig *tls.Config
 	// QUICConfig is the client's QUIC configuration.
 	QUICConfig *quic.Config
+	// MTUDiscoverer, if set, discovers the MTU of the SCION paths the connections are dialed on.
+	// A connection starts with the largest QUIC packets that fit into the discovered MTU of its
+	// path, instead of the InitialPacketSize of QUICConfig. QUIC does not discover the MTU on
+	// SCION connections itself, so the size is kept for the lifetime of the connection. The MTU
+	// of a path is discovered in the background when the path is first dialed, and the
+	// connections dialed before it is known use the InitialPacketSize of QUICConfig. A connection
+	// that migrates to another path, see MigratingConn, keeps the packet size of the dialed path.
+	//
+	// The SCMP handler of the transport's connection must pass the SCMP messages to the
+	// discoverer, see snet.MTUDiscoverer.
+	MTUDiscoverer *snet.MTUDiscoverer
 }
 
-// Dial dials a QUIC stream and returns it as a net.Conn.
+// Dial dials a QUIC stream and returns it as a net.Conn. The returned net.Conn implements Conn.
 //
 // Note: This method dials with exponential backoff in case the dialing attempt
 // fails due to a SERVER_BUSY error. Timers, number of attempts are EXPERIMENTAL
 // and subject to change.
 func (d ConnDialer) Dial(ctx context.Context, dst net.Addr) (net.Conn, error) {
+	session, err := d.DialQUIC(ctx, dst)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := session.OpenStreamSync(ctx)
+	if err != nil {
+		_ = session.CloseWithError(OpenStreamError, "")
+		return nil, serrors.Wrap("opening stream", err)
+	}
+	return &acceptedConn{
+		stream:  stream,
+		session: session,
+	}, nil
+}
+
+// DialQUIC dials a QUIC connection, for applications that use several streams or datagrams. It
+// dials with backoff like Dial.
+//
+// If the transport's connection is a MigratingConn with a Selector and dst has a SCION path, the
+// connection migrates to new paths when its path fails.
+func (d ConnDialer) DialQUIC(ctx context.Context, dst net.Addr) (quic.Connection, error) {
 	if d.TLSConfig == nil {
 		return nil, serrors.New("tls.Config not set")
 	}
@@ -377,6 +429,8 @@ func (d ConnDialer) Dial(ctx context.Context, dst net.Addr) (net.Conn, error) {
 		serverName = computeServerName(dst)
 	}
 
+	baseQUICConfig := d.quicConfig(dst)
+
 	var session quic.Connection
 	for sleep := 2 * time.Millisecond; ctx.Err() == nil; sleep = sleep * 2 {
 		// Clone TLS config to avoid data races.
@@ -384,8 +438,8 @@ func (d ConnDialer) Dial(ctx context.Context, dst net.Addr) (net.Conn, error) {
 		tlsConfig.ServerName = serverName
 		// Clone QUIC config to avoid data races, if it exists.
 		var quicConfig *quic.Config
-		if d.QUICConfig != nil {
-			quicConfig = d.QUICConfig.Clone()
+		if baseQUICConfig != nil {
+			quicConfig = baseQUICConfig.Clone()
 		}
 
 		var err error
@@ -408,16 +462,73 @@ func (d ConnDialer) Dial(ctx context.Context, dst net.Addr) (net.Conn, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, serrors.Wrap("dialing QUIC/SCION, after loop", err)
 	}
-	stream, err := session.OpenStreamSync(ctx)
-	if err != nil {
-		_ = session.CloseWithError(OpenStreamError, "")
-		return nil, serrors.Wrap("opening stream", err)
+	return session, nil
+}
+
+// quicConfig returns the QUIC configuration for a connection to dst. The initial packet size is
+// the largest that fits into the MTU of the path to dst, if the MTU is known.
+func (d ConnDialer) quicConfig(dst net.Addr) *quic.Config {
+	size, ok := d.packetSize(dst)
+	if !ok {
+		return d.QUICConfig
 	}
-	return &acceptedConn{
-		stream:  stream,
-		session: session,
-	}, nil
+	cfg := &quic.Config{}
+	if d.QUICConfig != nil {
+		cfg = d.QUICConfig.Clone()
+	}
+	cfg.InitialPacketSize = size
+	return cfg
+}
 
+// packetSize returns the largest UDP payload that fits into the discovered MTU of the path to
+// dst. If the MTU is not known yet, its discovery is started in the background.
+func (d ConnDialer) packetSize(dst net.Addr) (uint16, bool) {
+	remote, ok := dst.(*snet.UDPAddr)
+	if !ok || d.MTUDiscoverer == nil || d.MTUDiscoverer.Local == nil {
+		return 0, false
+	}
+	// Only SCION paths are probed. Within the local AS, the MTU of the AS applies, and one-hop
+	// paths are only used to bootstrap beaconing.
+	dp, ok := remote.Path.(snetpath.SCION)
+	if !ok {
+		return 0, false
+	}
+	localIP, ok := netip.AddrFromSlice(d.MTUDiscoverer.Local.Host.IP)
+	if !ok {
+		return 0, false
+	}
+	remoteIP, ok := netip.AddrFromSlice(remote.Host.IP)
+	if !ok {
+		return 0, false
+	}
+	local := addr.Addr{IA: d.MTUDiscoverer.Local.IA, Host: addr.HostIP(localIP.Unmap())}
+	dstAddr := addr.Addr{IA: remote.IA, Host: addr.HostIP(remoteIP.Unmap())}
+	p := snetpath.Path{
+		Src:           local.IA,
+		Dst:           remote.IA,
+		DataplanePath: remote.Path,
+		NextHop:       remote.NextHop,
+	}
+	mtu, ok := d.MTUDiscoverer.CachedMTU(p)
+	if !ok {
+		// Serializing a packet updates the raw path in place, so the probes
+		// must not share it with the connection.
+		p.DataplanePath = snetpath.SCION{Raw: append([]byte(nil), dp.Raw...)}
+		go func() {
+			defer log.HandlePanic()
+			ctx, cancelF := context.WithTimeout(context.Background(), mtuDiscoveryTimeout)
+			defer cancelF()
+			if _, err := d.MTUDiscoverer.MTU(ctx, dstAddr, p); err != nil {
+				log.Debug("Discovering path MTU failed", "remote", remote, "err", err)
+			}
+		}()
+		return 0, false
+	}
+	size, err := snet.UDPPayloadMTU(int(mtu), local, dstAddr, remote.Path)
+	if err != nil {
+		return 0, false
+	}
+	return uint16(size), true
 }
 
 // computeServerName returns a parseable version of the SCION address for use
@@ -476,6 +587,10 @@ func (c *acceptedConn) ConnectionState() tls.ConnectionState {
 	return c.session.ConnectionState().TLS
 }
 
+func (c *acceptedConn) Connection() quic.Connection {
+	return c.session
+}
+
 func (c *acceptedConn) Close() error {
 	var errs []error
 	if err := c.stream.Close(); err != nil {
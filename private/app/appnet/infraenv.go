@@ -67,6 +67,17 @@ type NetworkConfig struct {
 	// ignore SCMP messages. Otherwise, the server will shutdown when receiving
 	// an SCMP error message.
 	SCMPHandler snet.SCMPHandler
+	// QUICPathSelector selects the paths of the dialed QUIC connections. If set,
+	// the connections migrate to new paths when their paths fail, and the
+	// accepted connections follow the migrations of the remotes, see
+	// squic.MigratingConn. If nil, the connections keep the paths they are
+	// dialed with.
+	QUICPathSelector squic.PathSelector
+	// PathMTUDiscovery enables the discovery of the MTUs of the paths of the
+	// dialed QUIC connections, see snet.MTUDiscoverer. The connections to a
+	// remote use the largest packets that fit into the discovered MTU of its
+	// path. If false, the connections use the default packet size of QUIC.
+	PathMTUDiscovery bool
 	// Metrics injected into SCIONNetwork.
 	SCIONNetworkMetrics snet.SCIONNetworkMetrics
 	// Metrics injected into SCIONPacketConn.
@@ -94,7 +105,7 @@ func (nc *NetworkConfig) TCPStack() (net.Listener, error) {
 }
 
 func (nc *NetworkConfig) QUICStack(ctx context.Context) (*QUICStack, error) {
-	client, server, err := nc.initQUICSockets(ctx)
+	client, server, mtuDiscoverer, err := nc.initQUICSockets(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -131,12 +142,14 @@ func (nc *NetworkConfig) QUICStack(ctx context.Context) (*QUICStack, error) {
 	return &QUICStack{
 		Listener: squic.NewConnListener(listener),
 		InsecureDialer: &squic.ConnDialer{
-			Transport: clientTransport,
-			TLSConfig: insecureClientTLSConfig,
+			Transport:     clientTransport,
+			TLSConfig:     insecureClientTLSConfig,
+			MTUDiscoverer: mtuDiscoverer,
 		},
 		Dialer: &squic.ConnDialer{
-			Transport: clientTransport,
-			TLSConfig: clientTLSConfig,
+			Transport:     clientTransport,
+			TLSConfig:     clientTLSConfig,
+			MTUDiscoverer: mtuDiscoverer,
 		},
 	}, nil
 }
@@ -209,7 +222,7 @@ func (nc *NetworkConfig) AddressRewriter() *AddressRewriter {
 
 func (nc *NetworkConfig) initQUICSockets(
 	ctx context.Context,
-) (net.PacketConn, net.PacketConn, error) {
+) (net.PacketConn, net.PacketConn, *snet.MTUDiscoverer, error) {
 	reply := &svc.Reply{
 		Transports: map[svc.Transport]string{
 			svc.QUIC: nc.Public.String(),
@@ -218,7 +231,7 @@ func (nc *NetworkConfig) initQUICSockets(
 
 	svcResolutionReply, err := reply.Marshal()
 	if err != nil {
-		return nil, nil, serrors.Wrap("building SVC resolution reply", err)
+		return nil, nil, nil, serrors.Wrap("building SVC resolution reply", err)
 	}
 
 	serverNet := &snet.SCIONNetwork{
@@ -231,7 +244,7 @@ func (nc *NetworkConfig) initQUICSockets(
 	}
 	pconn, err := serverNet.OpenRaw(ctx, nc.Public)
 	if err != nil {
-		return nil, nil, serrors.Wrap("creating server raw PacketConn", err)
+		return nil, nil, nil, serrors.Wrap("creating server raw PacketConn", err)
 	}
 	resolvedPacketConn := &svc.ResolverPacketConn{
 		PacketConn: pconn,
@@ -243,19 +256,41 @@ func (nc *NetworkConfig) initQUICSockets(
 			Message: svcResolutionReply,
 		},
 	}
-	server, err := snet.NewCookedConn(resolvedPacketConn, nc.Topology)
+	cookedServer, err := snet.NewCookedConn(resolvedPacketConn, nc.Topology)
 	if err != nil {
-		return nil, nil, serrors.Wrap("creating server connection", err)
+		return nil, nil, nil, serrors.Wrap("creating server connection", err)
+	}
+	var server net.PacketConn = cookedServer
+	if nc.QUICPathSelector != nil {
+		// Reply on the latest path of the clients, so that the server
+		// follows the clients that migrate their connections to new paths.
+		server = &squic.MigratingConn{PacketConn: cookedServer}
 	}
 
+	// Discard all SCMP propagation, to avoid read errors on the QUIC
+	// client. With a path selector, the SCMP errors are handled by the
+	// migrating connection instead.
+	var clientSCMPHandler snet.SCMPHandler = snet.SCMPPropagationStopper{
+		Handler: nc.SCMPHandler,
+		Log:     log.Debug,
+	}
+	if nc.QUICPathSelector != nil {
+		clientSCMPHandler = nc.SCMPHandler
+		if clientSCMPHandler == nil {
+			clientSCMPHandler = snet.DefaultSCMPHandler{}
+		}
+	}
+	// The MTU probes are sent on the client connection, so that the replies
+	// and the SCMP errors reach the discoverer through the SCMP handler of the
+	// client connection.
+	var mtuDiscoverer *snet.MTUDiscoverer
+	if nc.PathMTUDiscovery {
+		mtuDiscoverer = &snet.MTUDiscoverer{Handler: clientSCMPHandler}
+		clientSCMPHandler = mtuDiscoverer
+	}
 	clientNet := &snet.SCIONNetwork{
-		Topology: nc.Topology,
-		// Discard all SCMP propagation, to avoid read errors on the QUIC
-		// client.
-		SCMPHandler: snet.SCMPPropagationStopper{
-			Handler: nc.SCMPHandler,
-			Log:     log.Debug,
-		},
+		Topology:          nc.Topology,
+		SCMPHandler:       clientSCMPHandler,
 		Metrics:           nc.SCIONNetworkMetrics,
 		PacketConnMetrics: nc.SCIONPacketConnMetrics,
 	}
@@ -263,11 +298,32 @@ func (nc *NetworkConfig) initQUICSockets(
 		IP:   nc.Public.IP,
 		Zone: nc.Public.Zone,
 	}
-	client, err := clientNet.Listen(ctx, "udp", clientAddr)
-	if err != nil {
-		return nil, nil, serrors.Wrap("creating client connection", err)
+	var client net.PacketConn
+	if mtuDiscoverer != nil {
+		raw, err := clientNet.OpenRaw(ctx, clientAddr)
+		if err != nil {
+			return nil, nil, nil, serrors.Wrap("creating client raw PacketConn", err)
+		}
+		mtuDiscoverer.Conn = raw
+		mtuDiscoverer.Local = &snet.UDPAddr{
+			IA:   nc.IA,
+			Host: raw.LocalAddr().(*net.UDPAddr),
+		}
+		client, err = snet.NewCookedConn(raw, nc.Topology,
+			snet.WithReplyPather(clientNet.ReplyPather))
+		if err != nil {
+			return nil, nil, nil, serrors.Wrap("creating client connection", err)
+		}
+	} else {
+		client, err = clientNet.Listen(ctx, "udp", clientAddr)
+		if err != nil {
+			return nil, nil, nil, serrors.Wrap("creating client connection", err)
+		}
+	}
+	if nc.QUICPathSelector != nil {
+		client = &squic.MigratingConn{PacketConn: client, Selector: nc.QUICPathSelector}
 	}
-	return client, server, nil
+	return client, server, mtuDiscoverer, nil
 }
 
 // NewRouter constructs a path router for paths starting from localIA.
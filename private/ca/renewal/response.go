@@ -0,0 +1,83 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package renewal
+
+import (
+	"crypto/x509"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/scionproto/scion/pkg/private/serrors"
+	cppb "github.com/scionproto/scion/pkg/proto/control_plane"
+	"github.com/scionproto/scion/pkg/scrypto/cms/protocol"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	"github.com/scionproto/scion/pkg/scrypto/signed"
+)
+
+// ExtractChainFromResponse extracts the renewed certificate chain from a
+// ChainRenewalResponse. The chain is validated, but it is not verified against
+// a TRC. If the response does not contain a CMS signed response, the chain is
+// extracted from the legacy signed response.
+func ExtractChainFromResponse(rep *cppb.ChainRenewalResponse) ([]*x509.Certificate, error) {
+	// XXX(karampok). We should verify the signature on the payload, but that
+	// implies having a full trust engine that is capable of resolving missing
+	// certificate chains for the CA. We skip it, since the chain itself is
+	// verified against the TRC, and thus, risk is very low.
+	if len(rep.CmsSignedResponse) == 0 {
+		return extractChainLegacy(rep)
+	}
+	ci, err := protocol.ParseContentInfo(rep.CmsSignedResponse)
+	if err != nil {
+		return nil, err
+	}
+	sd, err := ci.SignedDataContent()
+	if err != nil {
+		return nil, err
+	}
+	raw, err := sd.EncapContentInfo.DataEContent()
+	if err != nil {
+		return nil, err
+	}
+	chain, err := x509.ParseCertificates(raw)
+	if err != nil {
+		return nil, err
+	}
+	if err := cppki.ValidateChain(chain); err != nil {
+		return nil, err
+	}
+	return chain, nil
+}
+
+func extractChainLegacy(rep *cppb.ChainRenewalResponse) ([]*x509.Certificate, error) {
+	body, err := signed.ExtractUnverifiedBody(rep.SignedResponse)
+	if err != nil {
+		return nil, err
+	}
+	var replyBody cppb.ChainRenewalResponseBody
+	if err := proto.Unmarshal(body, &replyBody); err != nil {
+		return nil, err
+	}
+	chain := make([]*x509.Certificate, 2)
+	if chain[0], err = x509.ParseCertificate(replyBody.Chain.AsCert); err != nil {
+		return nil, serrors.Wrap("parsing AS certificate", err)
+	}
+	if chain[1], err = x509.ParseCertificate(replyBody.Chain.CaCert); err != nil {
+		return nil, serrors.Wrap("parsing CA certificate", err)
+	}
+	if err := cppki.ValidateChain(chain); err != nil {
+		return nil, err
+	}
+	return chain, nil
+}
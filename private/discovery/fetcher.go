@@ -0,0 +1,50 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery
+
+import (
+	"context"
+	"net"
+
+	libgrpc "github.com/scionproto/scion/pkg/grpc"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	dpb "github.com/scionproto/scion/pkg/proto/discovery"
+)
+
+// TopologyFetcher fetches the topology of the AS from the discovery service.
+type TopologyFetcher struct {
+	// Dialer dials the discovery service.
+	Dialer libgrpc.Dialer
+	// Address is the address of the discovery service.
+	Address net.Addr
+}
+
+// Fetch fetches the topology in the JSON format of the topology file.
+func (f TopologyFetcher) Fetch(ctx context.Context) ([]byte, error) {
+	conn, err := f.Dialer.Dial(ctx, f.Address)
+	if err != nil {
+		return nil, serrors.Wrap("dialing discovery service", err, "addr", f.Address)
+	}
+	defer conn.Close()
+	rep, err := dpb.NewDiscoveryServiceClient(conn).Topology(ctx, &dpb.TopologyRequest{},
+		libgrpc.RetryProfile...)
+	if err != nil {
+		return nil, serrors.Wrap("requesting topology", err, "addr", f.Address)
+	}
+	if len(rep.Topology) == 0 {
+		return nil, serrors.New("empty topology", "addr", f.Address)
+	}
+	return rep.Topology, nil
+}
@@ -79,3 +79,18 @@ func (mr *MockTopologyInformationMockRecorder) HiddenSegmentRegistrationAddresse
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HiddenSegmentRegistrationAddresses", reflect.TypeOf((*MockTopologyInformation)(nil).HiddenSegmentRegistrationAddresses))
 }
+
+// TopologyJSON mocks base method.
+func (m *MockTopologyInformation) TopologyJSON() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopologyJSON")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopologyJSON indicates an expected call of TopologyJSON.
+func (mr *MockTopologyInformationMockRecorder) TopologyJSON() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopologyJSON", reflect.TypeOf((*MockTopologyInformation)(nil).TopologyJSON))
+}
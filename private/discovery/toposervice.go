@@ -40,6 +40,9 @@ type TopologyInformation interface {
 	// HiddenSegmentRegistrationAddresses returns the addresses of the hidden
 	// segment registration services.
 	HiddenSegmentRegistrationAddresses() ([]*net.UDPAddr, error)
+	// TopologyJSON returns the topology of the AS in the JSON format of the
+	// topology file.
+	TopologyJSON() ([]byte, error)
 }
 
 // Topology implements a service discovery server based on the topology
@@ -47,6 +50,11 @@ type TopologyInformation interface {
 type Topology struct {
 	// Information is the topology information.
 	Information TopologyInformation
+	// ServeTopology enables the Topology RPC. The topology contains the
+	// internal addresses of the AS, so it must only be served to the end
+	// hosts in the AS, e.g., on a server that is not reachable from other
+	// ASes. If false, the Topology RPC fails with codes.Unimplemented.
+	ServeTopology bool
 
 	// Requests aggregates all the incoming requests received by the handler.
 	// If it is not initialized, nothing is reported.
@@ -118,6 +126,30 @@ func (t Topology) HiddenSegmentServices(ctx context.Context,
 	return response, nil
 }
 
+// Topology returns the topology of this AS, if ServeTopology is set.
+func (t Topology) Topology(ctx context.Context,
+	_ *dpb.TopologyRequest) (*dpb.TopologyResponse, error) {
+
+	span := opentracing.SpanFromContext(ctx)
+	labels := requestLabels{ReqType: "topology"}
+	logger := log.FromCtx(ctx)
+
+	if !t.ServeTopology {
+		logger.Debug("Rejected topology request, topology not served")
+		t.updateTelemetry(span, labels.WithResult(prom.ErrInvalidReq), nil)
+		return nil, status.Error(codes.Unimplemented, "topology not served")
+	}
+	raw, err := t.Information.TopologyJSON()
+	if err != nil {
+		logger.Debug("Failed to get topology", "err", err)
+		t.updateTelemetry(span, labels.WithResult(prom.ErrInternal), err)
+		return nil, status.Error(codes.Internal, "failed to get topology")
+	}
+	logger.Debug("Replied with topology", "size", len(raw))
+	t.updateTelemetry(span, labels.WithResult(prom.Success), nil)
+	return &dpb.TopologyResponse{Topology: raw}, nil
+}
+
 // RequestsLabels exposes the labels required by the Requests metric.
 func (t Topology) RequestsLabels() []string {
 	return []string{"req_type", prom.LabelResult}
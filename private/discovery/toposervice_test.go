@@ -16,6 +16,7 @@ package discovery_test
 
 import (
 	"context"
+	"errors"
 	"net"
 	"sort"
 	"testing"
@@ -23,6 +24,8 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/scionproto/scion/pkg/private/xtest"
 	dpb "github.com/scionproto/scion/pkg/proto/discovery"
@@ -216,3 +219,29 @@ func TestHiddenSegmentServices(t *testing.T) {
 		})
 	}
 }
+
+func TestTopology(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	info := mock_discovery.NewMockTopologyInformation(ctrl)
+	info.EXPECT().TopologyJSON().Return([]byte(`{"isd_as": "1-ff00:0:110"}`), nil)
+	info.EXPECT().TopologyJSON().Return(nil, errors.New("internal"))
+	d := discovery.Topology{Information: info, ServeTopology: true}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	got, err := d.Topology(ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &dpb.TopologyResponse{Topology: []byte(`{"isd_as": "1-ff00:0:110"}`)}, got)
+
+	got, err = d.Topology(ctx, nil)
+	assert.Error(t, err)
+	assert.Nil(t, got)
+
+	t.Run("not served", func(t *testing.T) {
+		d := discovery.Topology{Information: mock_discovery.NewMockTopologyInformation(ctrl)}
+		got, err := d.Topology(ctx, nil)
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+		assert.Nil(t, got)
+	})
+}
@@ -45,6 +45,25 @@ type Features struct {
 	//
 	// Experimental: This field is experimental and will be subject to change.
 	ExperimentalSCMPAuthentication bool `toml:"experimental_scmp_authentication"`
+
+	// ExperimentalEd25519 enables support for Ed25519 keys and signatures in
+	// the control-plane PKI. Ed25519 is not yet part of the control-plane PKI
+	// specification.
+	//
+	// Experimental: This field is experimental and will be subject to change.
+	ExperimentalEd25519 bool `toml:"experimental_ed25519"`
+
+	// ExperimentalQUICMigration enables the migration of the control-plane
+	// QUIC connections to new paths when their paths fail.
+	//
+	// Experimental: This field is experimental and will be subject to change.
+	ExperimentalQUICMigration bool `toml:"experimental_quic_migration"`
+
+	// ExperimentalPathMTUDiscovery enables the discovery of the MTUs of the
+	// paths of the control-plane QUIC connections.
+	//
+	// Experimental: This field is experimental and will be subject to change.
+	ExperimentalPathMTUDiscovery bool `toml:"experimental_path_mtu_discovery"`
 }
 
 func (cfg *Features) Sample(dst io.Writer, path config.Path, ctx config.CtxMap) {
@@ -24,12 +24,23 @@ const apiSample = `
 # The address to expose the API on (host:port or ip:port).
 # If not set, the API is not exposed.
 addr = ""
+
+# The HTTP header that carries the authenticated principal of a request, as set
+# by an authenticating reverse proxy in front of the API. Changes made through
+# the API are attributed to this principal in the logs, and changes requested
+# without the header are rejected. The proxy must remove the header from the
+# requests of its clients. If not set, changes cannot be authenticated and are
+# rejected.
+principal_header = ""
 `
 
 type Config struct {
 	config.NoDefaulter
 	config.NoValidator
 	Addr string `toml:"addr,omitempty"`
+	// PrincipalHeader is the HTTP header that carries the authenticated
+	// principal of a request.
+	PrincipalHeader string `toml:"principal_header,omitempty"`
 }
 
 func (cfg *Config) Sample(dst io.Writer, path config.Path, _ config.CtxMap) {
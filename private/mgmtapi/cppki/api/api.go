@@ -32,10 +32,20 @@ import (
 	"github.com/scionproto/scion/private/storage"
 	truststorage "github.com/scionproto/scion/private/storage/trust"
 	"github.com/scionproto/scion/private/trust"
+	"github.com/scionproto/scion/private/trust/expiry"
 )
 
+// ExpiryReporter reports the expiration of the trust material of the local AS.
+type ExpiryReporter interface {
+	// Report returns the report of the last expiry check.
+	Report() expiry.Report
+}
+
 type Server struct {
 	TrustDB storage.TrustDB
+	// Expiry reports the expiration of the trust material. If it is nil, the
+	// trust health is not available.
+	Expiry ExpiryReporter
 }
 
 // GetCertificates lists the certificate chains
@@ -385,6 +395,76 @@ func (s *Server) GetTrcBlob(w http.ResponseWriter, r *http.Request, isd int, bas
 	}
 }
 
+// GetTrustHealth reports the expiration state of the trust material of the
+// local AS.
+func (s *Server) GetTrustHealth(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if s.Expiry == nil {
+		Error(w, Problem{
+			Status: http.StatusNotImplemented,
+			Title:  "trust health not available",
+			Type:   api.StringRef(api.NotImplemented),
+		})
+		return
+	}
+	report := s.Expiry.Report()
+	if report.Time.IsZero() {
+		Error(w, Problem{
+			Status: http.StatusServiceUnavailable,
+			Title:  "trust material not checked yet",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	now := time.Now()
+	rep := TrustHealth{
+		CheckedAt:  report.Time,
+		Material:   make([]TrustMaterial, 0, len(report.Entries)),
+		WarnBefore: report.WarnBefore.String(),
+	}
+	switch report.State(now) {
+	case expiry.Expired:
+		rep.Status = Failing
+	case expiry.Expiring:
+		rep.Status = Degraded
+	default:
+		rep.Status = Passing
+	}
+	if report.Err != nil {
+		rep.Error = api.StringRef(report.Err.Error())
+		if rep.Status == Passing {
+			rep.Status = Degraded
+		}
+	}
+	for _, t := range expiry.Types {
+		for _, e := range report.Entries {
+			if e.Type != t {
+				continue
+			}
+			rep.Material = append(rep.Material, TrustMaterial{
+				Type:     string(e.Type),
+				Subject:  e.Subject,
+				Id:       e.ID,
+				Source:   e.Source,
+				NotAfter: e.NotAfter,
+				State:    ExpiryState(e.State(now, report.WarnBefore)),
+			})
+		}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		Error(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
 // Error creates an detailed error response.
 func Error(w http.ResponseWriter, p Problem) {
 	w.Header().Set("Content-Type", "application/problem+json")
@@ -23,6 +23,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -35,6 +36,7 @@ import (
 	"github.com/scionproto/scion/pkg/scrypto/cppki"
 	"github.com/scionproto/scion/private/storage/mock_storage"
 	truststorage "github.com/scionproto/scion/private/storage/trust"
+	"github.com/scionproto/scion/private/trust/expiry"
 )
 
 var update = xtest.UpdateGoldenFiles()
@@ -333,6 +335,43 @@ func TestAPI(t *testing.T) {
 			RequestURL:   "/certificates/garbage/blob",
 			Status:       http.StatusBadRequest,
 		},
+		"trust health": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				checkedAt := time.Date(2021, 2, 12, 10, 49, 17, 0, time.UTC)
+				s := &Server{Expiry: expiryReport{
+					Time:       checkedAt,
+					WarnBefore: 72 * time.Hour,
+					Entries: []expiry.Entry{
+						{
+							Type:     expiry.TRC,
+							Subject:  "1",
+							ID:       "ISD1-B1-S1",
+							Source:   expiry.SourceDB,
+							NotAfter: time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC),
+						},
+						{
+							Type:     expiry.Chain,
+							Subject:  "1-ff00:0:110",
+							ID:       "0eb62f3c851c650945e100bfe08074114b29cf50",
+							Source:   "crypto/as/ISD1-ASff00_0_110.pem",
+							NotAfter: checkedAt,
+						},
+					},
+				}}
+				return Handler(s)
+			},
+			ResponseFile: "testdata/trust-health.json",
+			RequestURL:   "/trust/health",
+			Status:       http.StatusOK,
+		},
+		"trust health not available": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return Handler(&Server{})
+			},
+			ResponseFile: "testdata/trust-health-not-available.json",
+			RequestURL:   "/trust/health",
+			Status:       http.StatusNotImplemented,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -360,3 +399,9 @@ func TestAPI(t *testing.T) {
 		})
 	}
 }
+
+type expiryReport expiry.Report
+
+func (r expiryReport) Report() expiry.Report {
+	return expiry.Report(r)
+}
@@ -105,6 +105,9 @@ type ClientInterface interface {
 
 	// GetTrcBlob request
 	GetTrcBlob(ctx context.Context, isd int, base int, serial int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTrustHealth request
+	GetTrustHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) GetCertificates(ctx context.Context, params *GetCertificatesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -179,6 +182,18 @@ func (c *Client) GetTrcBlob(ctx context.Context, isd int, base int, serial int,
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetTrustHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTrustHealthRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewGetCertificatesRequest generates requests for GetCertificates
 func NewGetCertificatesRequest(server string, params *GetCertificatesParams) (*http.Request, error) {
 	var err error
@@ -489,6 +504,33 @@ func NewGetTrcBlobRequest(server string, isd int, base int, serial int) (*http.R
 	return req, nil
 }
 
+// NewGetTrustHealthRequest generates requests for GetTrustHealth
+func NewGetTrustHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trust/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -549,6 +591,9 @@ type ClientWithResponsesInterface interface {
 
 	// GetTrcBlobWithResponse request
 	GetTrcBlobWithResponse(ctx context.Context, isd int, base int, serial int, reqEditors ...RequestEditorFn) (*GetTrcBlobResponse, error)
+
+	// GetTrustHealthWithResponse request
+	GetTrustHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTrustHealthResponse, error)
 }
 
 type GetCertificatesResponse struct {
@@ -687,6 +732,29 @@ func (r GetTrcBlobResponse) StatusCode() int {
 	return 0
 }
 
+type GetTrustHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TrustHealth
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTrustHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTrustHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 // GetCertificatesWithResponse request returning *GetCertificatesResponse
 func (c *ClientWithResponses) GetCertificatesWithResponse(ctx context.Context, params *GetCertificatesParams, reqEditors ...RequestEditorFn) (*GetCertificatesResponse, error) {
 	rsp, err := c.GetCertificates(ctx, params, reqEditors...)
@@ -741,6 +809,15 @@ func (c *ClientWithResponses) GetTrcBlobWithResponse(ctx context.Context, isd in
 	return ParseGetTrcBlobResponse(rsp)
 }
 
+// GetTrustHealthWithResponse request returning *GetTrustHealthResponse
+func (c *ClientWithResponses) GetTrustHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTrustHealthResponse, error) {
+	rsp, err := c.GetTrustHealth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTrustHealthResponse(rsp)
+}
+
 // ParseGetCertificatesResponse parses an HTTP response from a GetCertificatesWithResponse call
 func ParseGetCertificatesResponse(rsp *http.Response) (*GetCertificatesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -924,3 +1001,36 @@ func ParseGetTrcBlobResponse(rsp *http.Response) (*GetTrcBlobResponse, error) {
 
 	return response, nil
 }
+
+// ParseGetTrustHealthResponse parses an HTTP response from a GetTrustHealthWithResponse call
+func ParseGetTrustHealthResponse(rsp *http.Response) (*GetTrustHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTrustHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TrustHealth
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
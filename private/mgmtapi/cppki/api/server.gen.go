@@ -31,6 +31,9 @@ type ServerInterface interface {
 	// Get the TRC blob
 	// (GET /trcs/isd{isd}-b{base}-s{serial}/blob)
 	GetTrcBlob(w http.ResponseWriter, r *http.Request, isd int, base int, serial int)
+	// Get the health of the trust material
+	// (GET /trust/health)
+	GetTrustHealth(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -73,6 +76,12 @@ func (_ Unimplemented) GetTrcBlob(w http.ResponseWriter, r *http.Request, isd in
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get the health of the trust material
+// (GET /trust/health)
+func (_ Unimplemented) GetTrustHealth(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -296,6 +305,20 @@ func (siw *ServerInterfaceWrapper) GetTrcBlob(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
+// GetTrustHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetTrustHealth(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTrustHealth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -427,6 +450,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/trcs/isd{isd}-b{base}-s{serial}/blob", wrapper.GetTrcBlob)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trust/health", wrapper.GetTrustHealth)
+	})
 
 	return r
 }
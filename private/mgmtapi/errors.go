@@ -21,4 +21,5 @@ const (
 	Forbidden      = "/problems/forbidden"
 	NotFound       = "/problems/not-found"
 	NotImplemented = "/problems/not-implemented"
+	Unauthorized   = "/problems/unauthorized"
 )
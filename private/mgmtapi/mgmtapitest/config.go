@@ -25,9 +25,11 @@ import (
 // InitConfig prepares the api config for testing.
 func InitConfig(cfg *api.Config) {
 	cfg.Addr = "8.8.8.8:8080"
+	cfg.PrincipalHeader = "X-Test"
 }
 
 // CheckConfig checks that the given config matches the sample values.
 func CheckConfig(t *testing.T, cfg *api.Config) {
 	assert.Empty(t, cfg.Addr)
+	assert.Empty(t, cfg.PrincipalHeader)
 }
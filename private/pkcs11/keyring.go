@@ -0,0 +1,346 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+
+package pkcs11
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/miekg/pkcs11"
+	"github.com/miekg/pkcs11/p11"
+
+	"github.com/scionproto/scion/pkg/private/serrors"
+)
+
+var (
+	oidPublicKeyECDSA = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidNamedCurveP256 = asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7}
+	oidNamedCurveP384 = asn1.ObjectIdentifier{1, 3, 132, 0, 34}
+	oidNamedCurveP521 = asn1.ObjectIdentifier{1, 3, 132, 0, 35}
+)
+
+// KeyRing provides the private keys on a PKCS#11 token that match the URI.
+// The session with the token is opened on first use, and it is reopened if
+// the token becomes unavailable.
+type KeyRing struct {
+	uri URI
+
+	mtx     sync.Mutex
+	session p11.Session
+}
+
+// NewKeyRing creates a key ring for the keys that match the PKCS#11 URI. The
+// token is not accessed until the keys are requested.
+func NewKeyRing(rawURI string) (*KeyRing, error) {
+	uri, err := ParseURI(rawURI)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyRing{uri: uri}, nil
+}
+
+// PrivateKeys returns the private keys on the token that match the URI. Only
+// ECDSA keys are supported, other keys are ignored.
+func (r *KeyRing) PrivateKeys(_ context.Context) ([]crypto.Signer, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	session, err := r.openSession()
+	if err != nil {
+		return nil, err
+	}
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_EC),
+	}
+	template = append(template, r.selector()...)
+	objects, err := session.FindObjects(template)
+	if err != nil {
+		r.closeSession()
+		return nil, serrors.Wrap("searching private keys", err)
+	}
+	signers := make([]crypto.Signer, 0, len(objects))
+	for _, obj := range objects {
+		pub, err := publicKey(session, obj)
+		if err != nil {
+			continue
+		}
+		signers = append(signers, &signer{
+			ring:    r,
+			session: session,
+			key:     p11.PrivateKey(obj),
+			pub:     pub,
+		})
+	}
+	return signers, nil
+}
+
+// GenerateKey generates a fresh ECDSA key pair on the token. The curve is
+// the same as the curve of the provided public key. The key pair is labeled
+// with the object label of the URI and gets a random ID.
+func (r *KeyRing) GenerateKey(_ context.Context, pub crypto.PublicKey) (crypto.Signer, error) {
+	ecPub, ok := pub.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, serrors.New("unsupported key type", "type", fmt.Sprintf("%T", pub))
+	}
+	oid, err := curveOID(ecPub.Curve)
+	if err != nil {
+		return nil, err
+	}
+	params, err := asn1.Marshal(oid)
+	if err != nil {
+		return nil, serrors.Wrap("encoding curve", err)
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, serrors.Wrap("creating key ID", err)
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	session, err := r.openSession()
+	if err != nil {
+		return nil, err
+	}
+	common := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_ID, id),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, r.uri.Object),
+	}
+	pair, err := session.GenerateKeyPair(p11.GenerateKeyPairRequest{
+		Mechanism: *pkcs11.NewMechanism(pkcs11.CKM_EC_KEY_PAIR_GEN, nil),
+		PublicKeyAttributes: append([]*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, params),
+			pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+		}, common...),
+		PrivateKeyAttributes: append([]*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+			pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+			pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+		}, common...),
+	})
+	if err != nil {
+		r.closeSession()
+		return nil, serrors.Wrap("generating key pair", err)
+	}
+	generated, err := publicKeyFromObject(p11.Object(pair.Public))
+	if err != nil {
+		return nil, err
+	}
+	return &signer{ring: r, session: session, key: pair.Private, pub: generated}, nil
+}
+
+// selector returns the attributes that select the key objects.
+func (r *KeyRing) selector() []*pkcs11.Attribute {
+	var attrs []*pkcs11.Attribute
+	if r.uri.Object != "" {
+		attrs = append(attrs, pkcs11.NewAttribute(pkcs11.CKA_LABEL, r.uri.Object))
+	}
+	if len(r.uri.ID) != 0 {
+		attrs = append(attrs, pkcs11.NewAttribute(pkcs11.CKA_ID, r.uri.ID))
+	}
+	return attrs
+}
+
+func (r *KeyRing) openSession() (p11.Session, error) {
+	if r.session != nil {
+		return r.session, nil
+	}
+	module, err := p11.OpenModule(r.uri.ModulePath)
+	if err != nil {
+		return nil, serrors.Wrap("opening module", err, "module", r.uri.ModulePath)
+	}
+	slot, err := r.findSlot(module)
+	if err != nil {
+		return nil, err
+	}
+	session, err := slot.OpenWriteSession()
+	if err != nil {
+		return nil, serrors.Wrap("opening session", err)
+	}
+	pin, err := r.uri.ReadPIN()
+	if err != nil {
+		session.Close()
+		return nil, err
+	}
+	if pin != "" {
+		err := session.Login(pin)
+		if err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN)) {
+			session.Close()
+			return nil, serrors.Wrap("logging in", err)
+		}
+	}
+	r.session = session
+	return session, nil
+}
+
+func (r *KeyRing) closeSession() {
+	if r.session != nil {
+		r.session.Close()
+		r.session = nil
+	}
+}
+
+// invalidate closes the session if it is still the active session. The next
+// request opens a new session.
+func (r *KeyRing) invalidate(session p11.Session) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if r.session == session {
+		r.closeSession()
+	}
+}
+
+func (r *KeyRing) findSlot(module p11.Module) (p11.Slot, error) {
+	slots, err := module.Slots()
+	if err != nil {
+		return p11.Slot{}, serrors.Wrap("listing slots", err)
+	}
+	for _, slot := range slots {
+		info, err := slot.TokenInfo()
+		if err != nil {
+			continue
+		}
+		if r.uri.Token != "" && strings.TrimRight(info.Label, " \x00") != r.uri.Token {
+			continue
+		}
+		if r.uri.Serial != "" &&
+			strings.TrimRight(info.SerialNumber, " \x00") != r.uri.Serial {
+
+			continue
+		}
+		return slot, nil
+	}
+	return p11.Slot{}, serrors.New("token not found",
+		"token", r.uri.Token, "serial", r.uri.Serial)
+}
+
+// publicKey finds the public key object that belongs to the private key.
+func publicKey(session p11.Session, priv p11.Object) (*ecdsa.PublicKey, error) {
+	id, err := priv.Attribute(pkcs11.CKA_ID)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := session.FindObject([]*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PUBLIC_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_ID, id),
+	})
+	if err != nil {
+		return nil, serrors.Wrap("searching public key", err)
+	}
+	return publicKeyFromObject(pub)
+}
+
+// publicKeyFromObject decodes the ECDSA public key from the EC parameters and
+// the EC point of the public key object.
+func publicKeyFromObject(obj p11.Object) (*ecdsa.PublicKey, error) {
+	params, err := obj.Attribute(pkcs11.CKA_EC_PARAMS)
+	if err != nil {
+		return nil, serrors.Wrap("reading EC parameters", err)
+	}
+	point, err := obj.Attribute(pkcs11.CKA_EC_POINT)
+	if err != nil {
+		return nil, serrors.Wrap("reading EC point", err)
+	}
+	// The EC point is a DER encoded octet string, but some modules omit the
+	// encoding.
+	var raw []byte
+	if rest, err := asn1.Unmarshal(point, &raw); err != nil || len(rest) != 0 {
+		raw = point
+	}
+	spki, err := asn1.Marshal(struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}{
+		Algorithm: pkix.AlgorithmIdentifier{
+			Algorithm:  oidPublicKeyECDSA,
+			Parameters: asn1.RawValue{FullBytes: params},
+		},
+		PublicKey: asn1.BitString{Bytes: raw, BitLength: 8 * len(raw)},
+	})
+	if err != nil {
+		return nil, serrors.Wrap("encoding public key", err)
+	}
+	pub, err := x509.ParsePKIXPublicKey(spki)
+	if err != nil {
+		return nil, serrors.Wrap("parsing public key", err)
+	}
+	ecPub, ok := pub.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, serrors.New("unsupported key type", "type", fmt.Sprintf("%T", pub))
+	}
+	return ecPub, nil
+}
+
+func curveOID(curve elliptic.Curve) (asn1.ObjectIdentifier, error) {
+	switch curve {
+	case elliptic.P256():
+		return oidNamedCurveP256, nil
+	case elliptic.P384():
+		return oidNamedCurveP384, nil
+	case elliptic.P521():
+		return oidNamedCurveP521, nil
+	default:
+		return nil, serrors.New("unsupported curve", "curve", curve.Params().Name)
+	}
+}
+
+// signer is a crypto.Signer backed by a private key on a PKCS#11 token. The
+// private key is bound to the session it was found in.
+type signer struct {
+	ring    *KeyRing
+	session p11.Session
+	key     p11.PrivateKey
+	pub     *ecdsa.PublicKey
+}
+
+func (s *signer) Public() crypto.PublicKey {
+	return s.pub
+}
+
+// Sign signs the digest with the private key on the token. The signature is
+// returned in the ASN.1 encoding that is also used by ecdsa.SignASN1.
+func (s *signer) Sign(_ io.Reader, digest []byte, _ crypto.SignerOpts) ([]byte, error) {
+	raw, err := s.key.Sign(*pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil), digest)
+	if err != nil {
+		s.ring.invalidate(s.session)
+		return nil, serrors.Wrap("signing with token", err)
+	}
+	if len(raw) == 0 || len(raw)%2 != 0 {
+		return nil, serrors.New("invalid signature length", "length", len(raw))
+	}
+	n := len(raw) / 2
+	return asn1.Marshal(struct {
+		R, S *big.Int
+	}{
+		R: new(big.Int).SetBytes(raw[:n]),
+		S: new(big.Int).SetBytes(raw[n:]),
+	})
+}
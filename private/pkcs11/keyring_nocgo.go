@@ -0,0 +1,52 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The PKCS#11 modules are loaded with cgo. Without cgo, the key ring is not available.
+//go:build !cgo
+
+package pkcs11
+
+import (
+	"context"
+	"crypto"
+
+	"github.com/scionproto/scion/pkg/private/serrors"
+)
+
+var errNotSupported = serrors.New("PKCS#11 not supported in this build")
+
+// KeyRing provides the private keys on a PKCS#11 token. It is not supported
+// in builds without cgo.
+type KeyRing struct{}
+
+// NewKeyRing returns an error, because PKCS#11 is not supported in builds
+// without cgo.
+func NewKeyRing(rawURI string) (*KeyRing, error) {
+	if _, err := ParseURI(rawURI); err != nil {
+		return nil, err
+	}
+	return nil, errNotSupported
+}
+
+// PrivateKeys returns an error, because PKCS#11 is not supported in builds
+// without cgo.
+func (r *KeyRing) PrivateKeys(_ context.Context) ([]crypto.Signer, error) {
+	return nil, errNotSupported
+}
+
+// GenerateKey returns an error, because PKCS#11 is not supported in builds
+// without cgo.
+func (r *KeyRing) GenerateKey(_ context.Context, _ crypto.PublicKey) (crypto.Signer, error) {
+	return nil, errNotSupported
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+
+package pkcs11_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	mpkcs11 "github.com/miekg/pkcs11"
+	"github.com/miekg/pkcs11/p11"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/private/pkcs11"
+)
+
+// softHSMPaths are the default install locations of the SoftHSM module. The
+// location can be overridden with the SOFTHSM2_MODULE environment variable.
+var softHSMPaths = []string{
+	"/usr/lib/softhsm/libsofthsm2.so",
+	"/usr/lib/x86_64-linux-gnu/softhsm/libsofthsm2.so",
+	"/usr/local/lib/softhsm/libsofthsm2.so",
+	"/opt/homebrew/lib/softhsm/libsofthsm2.so",
+}
+
+func TestKeyRingSoftHSM(t *testing.T) {
+	module := setupSoftHSM(t)
+	uri := fmt.Sprintf("pkcs11:token=scion;object=cp-as?module-path=%s&pin-value=1234", module)
+	ring, err := pkcs11.NewKeyRing(uri)
+	require.NoError(t, err)
+
+	keys, err := ring.PrivateKeys(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	template, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	generated, err := ring.GenerateKey(context.Background(), template.Public())
+	require.NoError(t, err)
+
+	keys, err = ring.PrivateKeys(context.Background())
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, generated.Public(), keys[0].Public())
+
+	digest := sha256.Sum256([]byte("message"))
+	sig, err := keys[0].Sign(rand.Reader, digest[:], crypto.SHA256)
+	require.NoError(t, err)
+	assert.True(t, ecdsa.VerifyASN1(keys[0].Public().(*ecdsa.PublicKey), digest[:], sig))
+
+	// Keys with a different label are not part of the key ring.
+	other, err := pkcs11.NewKeyRing(
+		fmt.Sprintf("pkcs11:token=scion;object=cp-ca?module-path=%s&pin-value=1234", module))
+	require.NoError(t, err)
+	keys, err = other.PrivateKeys(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+}
+
+// setupSoftHSM initializes a SoftHSM token with label "scion" and user PIN
+// "1234" in a temporary directory. The test is skipped if SoftHSM is not
+// installed.
+func setupSoftHSM(t *testing.T) string {
+	module := os.Getenv("SOFTHSM2_MODULE")
+	if module == "" {
+		for _, path := range softHSMPaths {
+			if _, err := os.Stat(path); err == nil {
+				module = path
+				break
+			}
+		}
+	}
+	if module == "" {
+		t.Skip("SoftHSM not installed")
+	}
+
+	dir := t.TempDir()
+	tokens := filepath.Join(dir, "tokens")
+	require.NoError(t, os.Mkdir(tokens, 0o700))
+	conf := filepath.Join(dir, "softhsm2.conf")
+	require.NoError(t, os.WriteFile(conf,
+		[]byte(fmt.Sprintf("directories.tokendir = %s\nobjectstore.backend = file\n", tokens)),
+		0o600,
+	))
+	t.Setenv("SOFTHSM2_CONF", conf)
+
+	m, err := p11.OpenModule(module)
+	require.NoError(t, err)
+	slots, err := m.Slots()
+	require.NoError(t, err)
+	require.NotEmpty(t, slots)
+	require.NoError(t, slots[0].InitToken("5678", "scion"))
+
+	// The token is moved to a new slot after initialization.
+	slots, err = m.Slots()
+	require.NoError(t, err)
+	for _, slot := range slots {
+		info, err := slot.TokenInfo()
+		if err != nil || info.Flags&mpkcs11.CKF_TOKEN_INITIALIZED == 0 {
+			continue
+		}
+		session, err := slot.OpenWriteSession()
+		require.NoError(t, err)
+		require.NoError(t, session.LoginSecurityOfficer("5678"))
+		require.NoError(t, session.InitPIN("1234"))
+		require.NoError(t, session.Logout())
+		require.NoError(t, session.Close())
+		return module
+	}
+	t.Fatal("initialized token not found")
+	return ""
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pkcs11 provides private keys that are stored on a PKCS#11 token,
+// e.g., a hardware security module. The keys never leave the token, all
+// signing operations are performed by the token.
+//
+// The keys are selected with a PKCS#11 URI as defined in RFC 7512, e.g.:
+//
+//	pkcs11:token=scion;object=cp-as?module-path=/usr/lib/softhsm/libsofthsm2.so&pin-value=1234
+package pkcs11
+
+import (
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/scionproto/scion/pkg/private/serrors"
+)
+
+const scheme = "pkcs11:"
+
+// URI is a parsed PKCS#11 URI.
+type URI struct {
+	// ModulePath is the path of the PKCS#11 module that is loaded.
+	ModulePath string
+	// Token is the label of the token. If it is empty, the first token that
+	// matches the serial number is used.
+	Token string
+	// Serial is the serial number of the token.
+	Serial string
+	// Object is the label of the key objects. If it is empty, all keys on the
+	// token match.
+	Object string
+	// ID is the ID of the key objects. If it is empty, all keys on the token
+	// match.
+	ID []byte
+	// PIN is the user PIN of the token.
+	PIN string
+	// PINSource is the file the user PIN of the token is read from.
+	PINSource string
+}
+
+// ParseURI parses a PKCS#11 URI. The module-path query attribute is
+// required.
+func ParseURI(raw string) (URI, error) {
+	if !strings.HasPrefix(raw, scheme) {
+		return URI{}, serrors.New("missing pkcs11 scheme", "uri", raw)
+	}
+	path, query, _ := strings.Cut(strings.TrimPrefix(raw, scheme), "?")
+	var u URI
+	for _, attr := range splitAttrs(path, ";") {
+		k, v, err := parseAttr(attr)
+		if err != nil {
+			return URI{}, serrors.Wrap("parsing path attribute", err, "attribute", attr)
+		}
+		switch k {
+		case "token":
+			u.Token = v
+		case "serial":
+			u.Serial = v
+		case "object":
+			u.Object = v
+		case "id":
+			u.ID = []byte(v)
+		case "type":
+			if v != "private" {
+				return URI{}, serrors.New("unsupported object type", "type", v)
+			}
+		}
+	}
+	for _, attr := range splitAttrs(query, "&") {
+		k, v, err := parseAttr(attr)
+		if err != nil {
+			return URI{}, serrors.Wrap("parsing query attribute", err, "attribute", attr)
+		}
+		switch k {
+		case "module-path":
+			u.ModulePath = v
+		case "pin-value":
+			u.PIN = v
+		case "pin-source":
+			u.PINSource = strings.TrimPrefix(v, "file:")
+		}
+	}
+	if u.ModulePath == "" {
+		return URI{}, serrors.New("module-path not specified", "uri", raw)
+	}
+	if u.PIN != "" && u.PINSource != "" {
+		return URI{}, serrors.New("pin-value and pin-source are mutually exclusive")
+	}
+	return u, nil
+}
+
+// ReadPIN returns the user PIN. If a PIN source is configured, the PIN is read
+// from the file, otherwise the PIN value is returned.
+func (u URI) ReadPIN() (string, error) {
+	if u.PINSource == "" {
+		return u.PIN, nil
+	}
+	raw, err := os.ReadFile(u.PINSource)
+	if err != nil {
+		return "", serrors.Wrap("reading PIN", err, "file", u.PINSource)
+	}
+	return strings.TrimRight(string(raw), "\r\n"), nil
+}
+
+func splitAttrs(s, sep string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, sep)
+}
+
+func parseAttr(attr string) (string, string, error) {
+	k, v, ok := strings.Cut(attr, "=")
+	if !ok {
+		return "", "", serrors.New("missing value")
+	}
+	v, err := url.PathUnescape(v)
+	if err != nil {
+		return "", "", err
+	}
+	return k, v, nil
+}
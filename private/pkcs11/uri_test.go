@@ -0,0 +1,100 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkcs11_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/private/pkcs11"
+)
+
+func TestParseURI(t *testing.T) {
+	testCases := map[string]struct {
+		Input     string
+		Expected  pkcs11.URI
+		Assertion assert.ErrorAssertionFunc
+	}{
+		"full": {
+			Input: "pkcs11:token=scion%20hsm;serial=42;object=cp-as;id=%01%02;type=private" +
+				"?module-path=/usr/lib/softhsm/libsofthsm2.so&pin-value=1234",
+			Expected: pkcs11.URI{
+				ModulePath: "/usr/lib/softhsm/libsofthsm2.so",
+				Token:      "scion hsm",
+				Serial:     "42",
+				Object:     "cp-as",
+				ID:         []byte{1, 2},
+				PIN:        "1234",
+			},
+			Assertion: assert.NoError,
+		},
+		"pin source": {
+			Input: "pkcs11:token=scion?module-path=/lib/p11.so&pin-source=file:/etc/scion/pin",
+			Expected: pkcs11.URI{
+				ModulePath: "/lib/p11.so",
+				Token:      "scion",
+				PINSource:  "/etc/scion/pin",
+			},
+			Assertion: assert.NoError,
+		},
+		"missing scheme": {
+			Input:     "token=scion?module-path=/lib/p11.so",
+			Assertion: assert.Error,
+		},
+		"missing module path": {
+			Input:     "pkcs11:token=scion",
+			Assertion: assert.Error,
+		},
+		"public key": {
+			Input:     "pkcs11:token=scion;type=public?module-path=/lib/p11.so",
+			Assertion: assert.Error,
+		},
+		"pin value and source": {
+			Input:     "pkcs11:token=scion?module-path=/lib/p11.so&pin-value=1&pin-source=/pin",
+			Assertion: assert.Error,
+		},
+		"invalid escape": {
+			Input:     "pkcs11:token=%zz?module-path=/lib/p11.so",
+			Assertion: assert.Error,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			uri, err := pkcs11.ParseURI(tc.Input)
+			tc.Assertion(t, err)
+			assert.Equal(t, tc.Expected, uri)
+		})
+	}
+}
+
+func TestURIReadPIN(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "pin")
+	require.NoError(t, os.WriteFile(file, []byte("1234\n"), 0o600))
+
+	pin, err := pkcs11.URI{PINSource: file}.ReadPIN()
+	require.NoError(t, err)
+	assert.Equal(t, "1234", pin)
+
+	pin, err = pkcs11.URI{PIN: "5678"}.ReadPIN()
+	require.NoError(t, err)
+	assert.Equal(t, "5678", pin)
+
+	_, err = pkcs11.URI{PINSource: filepath.Join(t.TempDir(), "missing")}.ReadPIN()
+	assert.Error(t, err)
+}
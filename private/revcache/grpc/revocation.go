@@ -0,0 +1,90 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package grpc contains the gRPC client and server for pushing signed
+// revocations between the control services and to the daemons.
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/ctrl/path_mgmt"
+	pmproto "github.com/scionproto/scion/pkg/private/ctrl/path_mgmt/proto"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/private/util"
+	cppb "github.com/scionproto/scion/pkg/proto/control_plane"
+	cryptopb "github.com/scionproto/scion/pkg/proto/crypto"
+	"github.com/scionproto/scion/pkg/segment/iface"
+)
+
+// Signer signs revocations.
+type Signer interface {
+	// Sign signs the msg and returns a signed message.
+	Sign(ctx context.Context, msg []byte, associatedData ...[]byte) (*cryptopb.SignedMessage, error)
+}
+
+// SignRevocation encodes the revocation and signs it with the signer.
+func SignRevocation(
+	ctx context.Context,
+	signer Signer,
+	rev *path_mgmt.RevInfo,
+) (*cryptopb.SignedMessage, error) {
+
+	raw, err := proto.Marshal(RevInfoToPB(rev))
+	if err != nil {
+		return nil, serrors.Wrap("encoding revocation", err)
+	}
+	signed, err := signer.Sign(ctx, raw)
+	if err != nil {
+		return nil, serrors.Wrap("signing revocation", err)
+	}
+	return signed, nil
+}
+
+// RevInfoToPB converts the revocation to its protobuf representation.
+func RevInfoToPB(rev *path_mgmt.RevInfo) *cppb.RevocationBody {
+	return &cppb.RevocationBody{
+		IsdAs:       uint64(rev.IA()),
+		InterfaceId: uint64(rev.IfID),
+		LinkType:    cppb.RevocationBody_LinkType(rev.LinkType),
+		Timestamp:   timestamppb.New(rev.Timestamp()),
+		Ttl:         durationpb.New(rev.TTL()),
+	}
+}
+
+// RevInfoFromPB converts the protobuf representation to a revocation.
+func RevInfoFromPB(pb *cppb.RevocationBody) (*path_mgmt.RevInfo, error) {
+	if pb.Timestamp == nil {
+		return nil, serrors.New("timestamp missing")
+	}
+	if pb.Ttl == nil {
+		return nil, serrors.New("ttl missing")
+	}
+	if pb.InterfaceId == 0 || pb.InterfaceId > uint64(^uint16(0)) {
+		return nil, serrors.New("invalid interface ID", "interface_id", pb.InterfaceId)
+	}
+	return &path_mgmt.RevInfo{
+		IfID:         iface.ID(pb.InterfaceId),
+		RawIsdas:     addr.IA(pb.IsdAs),
+		LinkType:     pmproto.LinkType(pb.LinkType),
+		RawTimestamp: util.TimeToSecs(pb.Timestamp.AsTime()),
+		RawTTL:       uint32(pb.Ttl.AsDuration() / time.Second),
+	}, nil
+}
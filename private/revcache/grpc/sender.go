@@ -0,0 +1,51 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"net"
+
+	libgrpc "github.com/scionproto/scion/pkg/grpc"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	cppb "github.com/scionproto/scion/pkg/proto/control_plane"
+	cryptopb "github.com/scionproto/scion/pkg/proto/crypto"
+)
+
+// Sender sends signed revocations to remote revocation services.
+type Sender struct {
+	// Dialer dials a new gRPC connection.
+	Dialer libgrpc.Dialer
+}
+
+// SendRevocation sends the signed revocation to the remote.
+func (s Sender) SendRevocation(
+	ctx context.Context,
+	signed *cryptopb.SignedMessage,
+	remote net.Addr,
+) error {
+
+	conn, err := s.Dialer.Dial(ctx, remote)
+	if err != nil {
+		return serrors.Wrap("dialing gRPC conn", err)
+	}
+	defer conn.Close()
+	client := cppb.NewRevocationServiceClient(conn)
+	_, err = client.Revocation(ctx,
+		&cppb.RevocationRequest{SignedRevocation: signed},
+		libgrpc.RetryProfile...,
+	)
+	return err
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/ctrl/path_mgmt"
+	cppb "github.com/scionproto/scion/pkg/proto/control_plane"
+	cryptopb "github.com/scionproto/scion/pkg/proto/crypto"
+	"github.com/scionproto/scion/pkg/scrypto/signed"
+	"github.com/scionproto/scion/pkg/snet"
+	infra "github.com/scionproto/scion/private/segment/verifier"
+)
+
+// Handler handles verified revocations.
+type Handler interface {
+	// HandleRevocation handles the revocation. The signed message is the
+	// revocation as received, it can be forwarded to other revocation
+	// services unchanged. The sender is the ISD-AS of the peer the revocation
+	// was received from, or 0 if it was received from within the AS.
+	HandleRevocation(
+		ctx context.Context,
+		rev *path_mgmt.RevInfo,
+		signed *cryptopb.SignedMessage,
+		sender addr.IA,
+	) error
+}
+
+// RevocationServer handles gRPC revocation requests. The revocations must be
+// signed by the AS that owns the revoked interface.
+type RevocationServer struct {
+	// Verifier verifies the signature of the revocations.
+	Verifier infra.Verifier
+	// Handler handles the verified revocations.
+	Handler Handler
+}
+
+// Revocation handles the gRPC revocation request.
+func (s RevocationServer) Revocation(
+	ctx context.Context,
+	req *cppb.RevocationRequest,
+) (*cppb.RevocationResponse, error) {
+
+	logger := log.FromCtx(ctx)
+
+	// The body is only used to determine the ISD-AS that is expected to have
+	// signed the revocation.
+	rawBody, err := signed.ExtractUnverifiedBody(req.SignedRevocation)
+	if err != nil {
+		logger.Debug("Failed to extract body", "err", err)
+		return nil, status.Error(codes.InvalidArgument, "parsing signed message")
+	}
+	var unverified cppb.RevocationBody
+	if err := proto.Unmarshal(rawBody, &unverified); err != nil {
+		logger.Debug("Failed to parse body", "err", err)
+		return nil, status.Error(codes.InvalidArgument, "parsing body")
+	}
+
+	verifier := s.Verifier.WithIA(addr.IA(unverified.IsdAs))
+	var sender addr.IA
+	if p, ok := peer.FromContext(ctx); ok {
+		// Revocations received from other ASes are verified with the crypto
+		// material of the sending control service.
+		if a, ok := p.Addr.(*snet.UDPAddr); ok {
+			sender = a.IA
+			verifier = verifier.WithServer(&snet.SVCAddr{
+				IA:      a.IA,
+				Path:    a.Path,
+				NextHop: a.NextHop,
+				SVC:     addr.SvcCS,
+			})
+		}
+	}
+	msg, err := verifier.Verify(ctx, req.SignedRevocation)
+	if err != nil {
+		logger.Debug("Failed to verify signature", "err", err)
+		return nil, status.Error(codes.Unauthenticated, "verifying signature")
+	}
+	var body cppb.RevocationBody
+	if err := proto.Unmarshal(msg.Body, &body); err != nil {
+		logger.Debug("Failed to parse body", "err", err)
+		return nil, status.Error(codes.InvalidArgument, "parsing body")
+	}
+	rev, err := RevInfoFromPB(&body)
+	if err != nil {
+		logger.Debug("Invalid revocation", "err", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := rev.Active(); err != nil {
+		logger.Debug("Inactive revocation", "revocation", rev, "err", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.Handler.HandleRevocation(ctx, rev, req.SignedRevocation, sender); err != nil {
+		logger.Debug("Failed to handle revocation", "revocation", rev, "err", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &cppb.RevocationResponse{}, nil
+}
@@ -0,0 +1,184 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/ctrl/path_mgmt"
+	pmproto "github.com/scionproto/scion/pkg/private/ctrl/path_mgmt/proto"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/private/util"
+	cppb "github.com/scionproto/scion/pkg/proto/control_plane"
+	cryptopb "github.com/scionproto/scion/pkg/proto/crypto"
+	"github.com/scionproto/scion/pkg/scrypto/signed"
+	"github.com/scionproto/scion/pkg/snet"
+	revgrpc "github.com/scionproto/scion/private/revcache/grpc"
+	infra "github.com/scionproto/scion/private/segment/verifier"
+	mock_infra "github.com/scionproto/scion/private/segment/verifier/mock_verifier"
+)
+
+func TestRevocationServerRevocation(t *testing.T) {
+	ia110 := addr.MustParseIA("1-ff00:0:110")
+	ia111 := addr.MustParseIA("1-ff00:0:111")
+
+	newRev := func(ts time.Time) *path_mgmt.RevInfo {
+		return &path_mgmt.RevInfo{
+			IfID:         11,
+			RawIsdas:     ia110,
+			LinkType:     pmproto.LinkType_child,
+			RawTimestamp: util.TimeToSecs(ts),
+			RawTTL:       10,
+		}
+	}
+	sign := func(t *testing.T, rev *path_mgmt.RevInfo) *cryptopb.SignedMessage {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		raw, err := proto.Marshal(revgrpc.RevInfoToPB(rev))
+		require.NoError(t, err)
+		msg, err := signed.Sign(signed.Header{SignatureAlgorithm: signed.ECDSAWithSHA256},
+			raw, key)
+		require.NoError(t, err)
+		return msg
+	}
+	active := newRev(time.Now())
+	expired := newRev(time.Now().Add(-time.Minute))
+	interAS := peer.NewContext(context.Background(), &peer.Peer{Addr: &snet.UDPAddr{IA: ia111}})
+	intraAS := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{}})
+
+	testCases := map[string]struct {
+		ctx        context.Context
+		rev        *path_mgmt.RevInfo
+		verifier   func(*gomock.Controller, *cryptopb.SignedMessage) infra.Verifier
+		wantSender addr.IA
+		assertErr  assert.ErrorAssertionFunc
+	}{
+		"from neighbor": {
+			ctx: interAS,
+			rev: active,
+			verifier: func(ctrl *gomock.Controller, msg *cryptopb.SignedMessage) infra.Verifier {
+				v := mock_infra.NewMockVerifier(ctrl)
+				v.EXPECT().WithIA(ia110).Return(v)
+				v.EXPECT().WithServer(gomock.Any()).Return(v)
+				v.EXPECT().Verify(gomock.Any(), msg).DoAndReturn(
+					func(_ context.Context, msg *cryptopb.SignedMessage,
+						_ ...[]byte) (*signed.Message, error) {
+
+						body, err := signed.ExtractUnverifiedBody(msg)
+						return &signed.Message{Body: body}, err
+					},
+				)
+				return v
+			},
+			wantSender: ia111,
+			assertErr:  assert.NoError,
+		},
+		"from local AS": {
+			ctx: intraAS,
+			rev: active,
+			verifier: func(ctrl *gomock.Controller, msg *cryptopb.SignedMessage) infra.Verifier {
+				v := mock_infra.NewMockVerifier(ctrl)
+				v.EXPECT().WithIA(ia110).Return(v)
+				v.EXPECT().Verify(gomock.Any(), msg).DoAndReturn(
+					func(_ context.Context, msg *cryptopb.SignedMessage,
+						_ ...[]byte) (*signed.Message, error) {
+
+						body, err := signed.ExtractUnverifiedBody(msg)
+						return &signed.Message{Body: body}, err
+					},
+				)
+				return v
+			},
+			assertErr: assert.NoError,
+		},
+		"signature verification error": {
+			ctx: interAS,
+			rev: active,
+			verifier: func(ctrl *gomock.Controller, msg *cryptopb.SignedMessage) infra.Verifier {
+				v := mock_infra.NewMockVerifier(ctrl)
+				v.EXPECT().WithIA(ia110).Return(v)
+				v.EXPECT().WithServer(gomock.Any()).Return(v)
+				v.EXPECT().Verify(gomock.Any(), msg).Return(nil, serrors.New("test"))
+				return v
+			},
+			assertErr: assert.Error,
+		},
+		"expired revocation": {
+			ctx: interAS,
+			rev: expired,
+			verifier: func(ctrl *gomock.Controller, msg *cryptopb.SignedMessage) infra.Verifier {
+				v := mock_infra.NewMockVerifier(ctrl)
+				v.EXPECT().WithIA(ia110).Return(v)
+				v.EXPECT().WithServer(gomock.Any()).Return(v)
+				v.EXPECT().Verify(gomock.Any(), msg).DoAndReturn(
+					func(_ context.Context, msg *cryptopb.SignedMessage,
+						_ ...[]byte) (*signed.Message, error) {
+
+						body, err := signed.ExtractUnverifiedBody(msg)
+						return &signed.Message{Body: body}, err
+					},
+				)
+				return v
+			},
+			assertErr: assert.Error,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			msg := sign(t, tc.rev)
+
+			var handled bool
+			s := revgrpc.RevocationServer{
+				Verifier: tc.verifier(ctrl, msg),
+				Handler: handlerFunc(func(_ context.Context, rev *path_mgmt.RevInfo,
+					fwd *cryptopb.SignedMessage, sender addr.IA) error {
+
+					handled = true
+					assert.True(t, tc.rev.Equal(rev))
+					assert.Equal(t, msg, fwd)
+					assert.Equal(t, tc.wantSender, sender)
+					return nil
+				}),
+			}
+			_, err := s.Revocation(tc.ctx, &cppb.RevocationRequest{SignedRevocation: msg})
+			tc.assertErr(t, err)
+			assert.Equal(t, err == nil, handled)
+		})
+	}
+}
+
+type handlerFunc func(context.Context, *path_mgmt.RevInfo, *cryptopb.SignedMessage,
+	addr.IA) error
+
+func (f handlerFunc) HandleRevocation(ctx context.Context, rev *path_mgmt.RevInfo,
+	msg *cryptopb.SignedMessage, sender addr.IA) error {
+
+	return f(ctx, rev, msg, sender)
+}
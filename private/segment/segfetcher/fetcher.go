@@ -16,11 +16,14 @@ package segfetcher
 
 import (
 	"context"
+	"errors"
 	"math/rand/v2"
 	"net"
+	"sync"
 	"time"
 
 	"github.com/scionproto/scion/pkg/log"
+	libmetrics "github.com/scionproto/scion/pkg/metrics"
 	"github.com/scionproto/scion/pkg/private/serrors"
 	seg "github.com/scionproto/scion/pkg/segment"
 	"github.com/scionproto/scion/private/pathdb"
@@ -33,6 +36,10 @@ const (
 	expirationLeadTime = 2 * time.Minute
 )
 
+// DefaultNegativeCacheTTL is the default duration for which requests for
+// unreachable destinations are not sent to a remote server again.
+const DefaultNegativeCacheTTL = 5 * time.Second
+
 // errors for metrics classification.
 var (
 	errFetch = serrors.New("fetching failed")
@@ -53,6 +60,11 @@ func NewFetcherMetrics(ns string) metrics.Fetcher {
 }
 
 // Fetcher fetches, verifies and stores segments for a path segment request.
+//
+// Identical requests that are fetched concurrently are coalesced, i.e., only
+// the first one is sent to the remote server and the others wait for its
+// result. Requests for destinations that were not reachable are answered
+// without contacting a remote server for the duration of NegativeCacheTTL.
 type Fetcher struct {
 	Resolver     Resolver
 	Requester    Requester
@@ -61,7 +73,26 @@ type Fetcher struct {
 	// QueryInterval specifies after how much time segments should be
 	// refetched at the remote server.
 	QueryInterval time.Duration
-	Metrics       metrics.Fetcher
+	// NegativeCacheTTL specifies for how long a request for an unreachable
+	// destination is not sent to a remote server again. If it is zero,
+	// unreachable destinations are not cached.
+	NegativeCacheTTL time.Duration
+	Metrics          metrics.Fetcher
+	// RequestMetrics are the metrics for the requests that are not resolved
+	// locally.
+	RequestMetrics Metrics
+
+	mu       sync.Mutex
+	inflight map[Request]*inflightRequest
+	negative map[Request]time.Time
+}
+
+// inflightRequest is a request that is currently being fetched from a remote
+// server. The result is set before done is closed.
+type inflightRequest struct {
+	done chan struct{}
+	segs Segments
+	err  error
 }
 
 // Fetch loads the requested segments from the path DB or requests them from a remote path server.
@@ -71,6 +102,9 @@ func (f *Fetcher) Fetch(ctx context.Context, reqs Requests, refresh bool) (Segme
 	if err != nil {
 		return Segments{}, serrors.JoinNoStack(errDB, err)
 	}
+	if !refresh {
+		fetchReqs = f.filterUnreachable(fetchReqs)
+	}
 	if len(fetchReqs) == 0 {
 		return loadedSegs, nil
 	}
@@ -82,19 +116,147 @@ func (f *Fetcher) Fetch(ctx context.Context, reqs Requests, refresh bool) (Segme
 	return append(loadedSegs, fetchedSegs...), err
 }
 
+// Request requests the segments from the remote path servers. Requests that
+// are already in flight are not sent again, instead the result of the
+// in-flight request is awaited.
 func (f *Fetcher) Request(ctx context.Context, reqs Requests) (Segments, error) {
-	// Pass shorter context for requesting, such that we can reply even if a
-	// request hangs.
-	earlyCtx, cancel := earlyContext(ctx, 500*time.Millisecond)
-	defer cancel()
-	replies := f.Requester.Request(earlyCtx, reqs)
-	return f.waitOnProcessed(ctx, replies)
+	owned, pending := f.claim(reqs)
+	var segs Segments
+	var errs serrors.List
+	if len(owned) > 0 {
+		// Pass shorter context for requesting, such that we can reply even if a
+		// request hangs.
+		earlyCtx, cancel := earlyContext(ctx, 500*time.Millisecond)
+		defer cancel()
+		replies := f.Requester.Request(earlyCtx, owned.requests())
+		fetched, err := f.waitOnProcessed(ctx, replies, owned)
+		segs = append(segs, fetched...)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, call := range pending {
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			errs = append(errs, ctx.Err())
+			return segs, errs.ToError()
+		}
+		segs = append(segs, call.segs...)
+		if call.err != nil {
+			errs = append(errs, call.err)
+		}
+	}
+	return segs, errs.ToError()
+}
+
+// ownedRequests are the in-flight requests that are fetched by the caller.
+type ownedRequests map[Request]*inflightRequest
+
+func (o ownedRequests) requests() Requests {
+	reqs := make(Requests, 0, len(o))
+	for req := range o {
+		reqs = append(reqs, req)
+	}
+	return reqs
+}
+
+// claim registers the requests that are not yet in flight as owned by the
+// caller. It returns the owned requests and the in-flight requests of other
+// callers that have to be awaited.
+func (f *Fetcher) claim(reqs Requests) (ownedRequests, []*inflightRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.inflight == nil {
+		f.inflight = make(map[Request]*inflightRequest)
+	}
+	owned := make(ownedRequests)
+	var pending []*inflightRequest
+	for _, req := range reqs {
+		if _, ok := owned[req]; ok {
+			continue
+		}
+		if call, ok := f.inflight[req]; ok {
+			libmetrics.CounterInc(f.RequestMetrics.CoalescedRequests)
+			pending = append(pending, call)
+			continue
+		}
+		libmetrics.CounterInc(f.RequestMetrics.FetchedRequests)
+		call := &inflightRequest{done: make(chan struct{})}
+		f.inflight[req] = call
+		owned[req] = call
+	}
+	return owned, pending
+}
+
+// release completes the owned request with the given result and removes it
+// from the in-flight requests.
+func (f *Fetcher) release(owned ownedRequests, req Request, segs Segments, err error) {
+	call, ok := owned[req]
+	if !ok {
+		return
+	}
+	delete(owned, req)
+	call.segs, call.err = segs, err
+	f.mu.Lock()
+	delete(f.inflight, req)
+	f.mu.Unlock()
+	close(call.done)
+}
+
+// filterUnreachable removes the requests for destinations that were recently
+// not reachable.
+func (f *Fetcher) filterUnreachable(reqs Requests) Requests {
+	if f.NegativeCacheTTL == 0 || len(reqs) == 0 {
+		return reqs
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	now := time.Now()
+	filtered := reqs[:0:0]
+	for _, req := range reqs {
+		if expiry, ok := f.negative[req]; ok {
+			if now.Before(expiry) {
+				libmetrics.CounterInc(f.RequestMetrics.CachedRequests)
+				continue
+			}
+			delete(f.negative, req)
+		}
+		filtered = append(filtered, req)
+	}
+	return filtered
+}
+
+// markUnreachable adds the request to the negative cache.
+func (f *Fetcher) markUnreachable(req Request) {
+	if f.NegativeCacheTTL == 0 {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.negative == nil {
+		f.negative = make(map[Request]time.Time)
+	}
+	now := time.Now()
+	for r, expiry := range f.negative {
+		if !now.Before(expiry) {
+			delete(f.negative, r)
+		}
+	}
+	f.negative[req] = now.Add(f.NegativeCacheTTL)
 }
 
 func (f *Fetcher) waitOnProcessed(ctx context.Context,
-	replies <-chan ReplyOrErr) (Segments, error) {
+	replies <-chan ReplyOrErr, owned ownedRequests) (Segments, error) {
 
 	var segs Segments
+	var err error
+	defer func() {
+		// Complete the requests for which no reply was processed.
+		for req := range owned {
+			f.release(owned, req, nil, err)
+		}
+	}()
 	logger := log.FromCtx(ctx)
 	for reply := range replies {
 		// TODO(lukedirtwalker): Should we do this in go routines?
@@ -103,17 +265,23 @@ func (f *Fetcher) waitOnProcessed(ctx context.Context,
 			if serrors.IsTimeout(reply.Err) {
 				labels.Result = metrics.ErrTimeout
 			}
+			if errors.Is(reply.Err, ErrNotReachable) {
+				f.markUnreachable(reply.Req)
+			}
 			f.Metrics.SegRequests(labels).Inc()
+			f.release(owned, reply.Req, nil, nil)
 			continue
 		}
 		if len(reply.Segments) == 0 {
 			f.Metrics.SegRequests(labels.WithResult(metrics.OkSuccess)).Inc()
+			f.release(owned, reply.Req, nil, nil)
 			continue
 		}
 		r := f.ReplyHandler.Handle(ctx, replyToRecs(reply.Segments), reply.Peer)
-		if err := r.Err(); err != nil {
+		if err = r.Err(); err != nil {
 			f.Metrics.SegRequests(labels.WithResult(metrics.ErrProcess)).Inc()
-			return segs, serrors.Wrap("processing reply", err)
+			err = serrors.Wrap("processing reply", err)
+			return segs, err
 		}
 		if len(r.VerificationErrors()) > 0 {
 			log.FromCtx(ctx).Info("Errors during verification of segments/revocations",
@@ -121,13 +289,15 @@ func (f *Fetcher) waitOnProcessed(ctx context.Context,
 			log.FromCtx(ctx).Debug("Error during verification of segments/revocations",
 				"errors", r.VerificationErrors().ToError())
 		}
-		segs = append(segs, Segments(r.Stats().VerifiedSegs)...)
+		verified := Segments(r.Stats().VerifiedSegs)
+		segs = append(segs, verified...)
 		nextQuery := f.nextQuery(segs)
-		_, err := f.PathDB.InsertNextQuery(ctx, reply.Req.Src, reply.Req.Dst, nextQuery)
-		if err != nil {
-			logger.Info("NextQuery insertion failed", "err", err)
+		_, dbErr := f.PathDB.InsertNextQuery(ctx, reply.Req.Src, reply.Req.Dst, nextQuery)
+		if dbErr != nil {
+			logger.Info("NextQuery insertion failed", "err", dbErr)
 		}
 		f.Metrics.SegRequests(labels.WithResult(metrics.OkSuccess)).Inc()
+		f.release(owned, reply.Req, verified, nil)
 	}
 	return segs, nil
 }
@@ -24,17 +24,22 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/scionproto/scion/pkg/metrics"
 	"github.com/scionproto/scion/private/pathdb/mock_pathdb"
 	"github.com/scionproto/scion/private/segment/segfetcher"
 	"github.com/scionproto/scion/private/segment/segfetcher/mock_segfetcher"
 )
 
+var fetcherMetrics = segfetcher.NewFetcherMetrics("test")
+
 type TestableFetcher struct {
 	Resolver      *mock_segfetcher.MockResolver
 	Requester     *mock_segfetcher.MockRequester
 	ReplyHandler  *mock_segfetcher.MockReplyHandler
 	PathDB        *mock_pathdb.MockDB
 	QueryInterval time.Duration
+	NegativeTTL   time.Duration
+	Metrics       segfetcher.Metrics
 }
 
 func NewTestFetcher(ctrl *gomock.Controller) *TestableFetcher {
@@ -44,16 +49,24 @@ func NewTestFetcher(ctrl *gomock.Controller) *TestableFetcher {
 		ReplyHandler:  mock_segfetcher.NewMockReplyHandler(ctrl),
 		PathDB:        mock_pathdb.NewMockDB(ctrl),
 		QueryInterval: time.Minute,
+		Metrics: segfetcher.Metrics{
+			FetchedRequests:   metrics.NewTestCounter(),
+			CoalescedRequests: metrics.NewTestCounter(),
+			CachedRequests:    metrics.NewTestCounter(),
+		},
 	}
 }
 
 func (f *TestableFetcher) Fetcher() *segfetcher.Fetcher {
 	return &segfetcher.Fetcher{
-		Resolver:      f.Resolver,
-		Requester:     f.Requester,
-		ReplyHandler:  f.ReplyHandler,
-		PathDB:        f.PathDB,
-		QueryInterval: f.QueryInterval,
+		Resolver:         f.Resolver,
+		Requester:        f.Requester,
+		ReplyHandler:     f.ReplyHandler,
+		PathDB:           f.PathDB,
+		QueryInterval:    f.QueryInterval,
+		NegativeCacheTTL: f.NegativeTTL,
+		Metrics:          fetcherMetrics,
+		RequestMetrics:   f.Metrics,
 	}
 }
 
@@ -102,3 +115,68 @@ func TestFetcher(t *testing.T) {
 		})
 	}
 }
+
+func TestFetcherCoalescing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx, cancelF := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelF()
+	req := segfetcher.Request{SegType: Down, Src: core_130, Dst: non_core_111}
+	f := NewTestFetcher(ctrl)
+	f.Resolver.EXPECT().Resolve(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(segfetcher.Segments{}, segfetcher.Requests{req}, nil).Times(2)
+	replies := make(chan segfetcher.ReplyOrErr, 1)
+	f.Requester.EXPECT().Request(gomock.Any(), segfetcher.Requests{req}).
+		Return(replies).Times(1)
+	fetcher := f.Fetcher()
+
+	errs := make(chan error, 2)
+	fetch := func() {
+		_, err := fetcher.Fetch(ctx, segfetcher.Requests{req}, false)
+		errs <- err
+	}
+	go fetch()
+	require.Eventually(t, func() bool {
+		return metrics.CounterValue(f.Metrics.FetchedRequests) == 1
+	}, time.Second, 10*time.Millisecond)
+	go fetch()
+	require.Eventually(t, func() bool {
+		return metrics.CounterValue(f.Metrics.CoalescedRequests) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	replies <- segfetcher.ReplyOrErr{Req: req}
+	close(replies)
+	require.NoError(t, <-errs)
+	require.NoError(t, <-errs)
+}
+
+func TestFetcherNegativeCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx, cancelF := context.WithTimeout(context.Background(), time.Second)
+	defer cancelF()
+	req := segfetcher.Request{SegType: Down, Src: core_130, Dst: non_core_111}
+	f := NewTestFetcher(ctrl)
+	f.NegativeTTL = time.Minute
+	f.Resolver.EXPECT().Resolve(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(segfetcher.Segments{}, segfetcher.Requests{req}, nil).Times(3)
+	f.Requester.EXPECT().Request(gomock.Any(), segfetcher.Requests{req}).
+		DoAndReturn(func(context.Context, segfetcher.Requests) <-chan segfetcher.ReplyOrErr {
+			replies := make(chan segfetcher.ReplyOrErr, 1)
+			replies <- segfetcher.ReplyOrErr{Req: req, Err: segfetcher.ErrNotReachable}
+			close(replies)
+			return replies
+		}).Times(2)
+	fetcher := f.Fetcher()
+
+	segs, err := fetcher.Fetch(ctx, segfetcher.Requests{req}, false)
+	require.NoError(t, err)
+	assert.Empty(t, segs)
+	// The destination is not reachable, the request is not sent again.
+	segs, err = fetcher.Fetch(ctx, segfetcher.Requests{req}, false)
+	require.NoError(t, err)
+	assert.Empty(t, segs)
+	assert.Equal(t, float64(1), metrics.CounterValue(f.Metrics.CachedRequests))
+	// Refreshing bypasses the negative cache.
+	_, err = fetcher.Fetch(ctx, segfetcher.Requests{req}, true)
+	require.NoError(t, err)
+	assert.Equal(t, float64(2), metrics.CounterValue(f.Metrics.FetchedRequests))
+}
@@ -17,6 +17,9 @@ package segfetcher
 import (
 	"errors"
 
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/scionproto/scion/pkg/metrics"
 	"github.com/scionproto/scion/pkg/private/prom"
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/private/segment/seghandler"
@@ -38,3 +41,35 @@ func ErrToMetricsLabel(err error) string {
 		return prom.ErrNotClassified
 	}
 }
+
+// Metrics contains the metrics for the segment requests that are not resolved
+// locally and have to be fetched from a remote server. Nil counters are
+// ignored.
+type Metrics struct {
+	// FetchedRequests counts the requests that are sent to a remote server.
+	FetchedRequests metrics.Counter
+	// CoalescedRequests counts the requests that are answered by an identical
+	// request that is already in flight.
+	CoalescedRequests metrics.Counter
+	// CachedRequests counts the requests that are answered from the negative
+	// cache, i.e., without contacting a remote server.
+	CachedRequests metrics.Counter
+}
+
+// NewMetrics creates the request metrics in the given namespace.
+func NewMetrics(namespace string) Metrics {
+	requests := metrics.NewPromCounter(prom.SafeRegister(
+		prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "fetcher",
+			Name:      "requests_total",
+			Help: "The number of segment requests that are not resolved locally, " +
+				"by how they are answered.",
+		}, []string{prom.LabelResult})).(*prometheus.CounterVec),
+	)
+	return Metrics{
+		FetchedRequests:   requests.With(prom.LabelResult, "fetched"),
+		CoalescedRequests: requests.With(prom.LabelResult, "coalesced"),
+		CachedRequests:    requests.With(prom.LabelResult, "cached"),
+	}
+}
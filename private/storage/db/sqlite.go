@@ -24,10 +24,23 @@ import (
 
 const _ = buildtag_guard_either_sqlite_mattn_or_sqlite_modernc
 
+// Migrations maps a schema version to the statements that migrate a database from the
+// previous schema version to it.
+type Migrations map[int]string
+
 // NewSqlite returns a new SQLite backend opening a database at the given path. If
 // no database exists a new database is be created. If the schema version of the
 // stored database is different from schemaVersion, an error is returned.
 func NewSqlite(path string, schema string, schemaVersion int) (*sql.DB, error) {
+	return NewSqliteWithMigrations(path, schema, schemaVersion, nil)
+}
+
+// NewSqliteWithMigrations is like NewSqlite, but a stored database with an older
+// schema version is migrated to schemaVersion with the given migrations. If a
+// migration is missing, an error is returned.
+func NewSqliteWithMigrations(path string, schema string, schemaVersion int,
+	migrations Migrations) (*sql.DB, error) {
+
 	var err error
 	if path == "" {
 		return nil, serrors.New("Empty path not allowed for sqlite")
@@ -57,8 +70,9 @@ func NewSqlite(path string, schema string, schemaVersion int) (*sql.DB, error) {
 			return nil, err
 		}
 	} else if existingVersion != schemaVersion {
-		return nil, serrors.New("Database schema version mismatch",
-			"expected", schemaVersion, "have", existingVersion, "path", path)
+		if err = migrate(db, migrations, existingVersion, schemaVersion, path); err != nil {
+			return nil, err
+		}
 	}
 	return db, nil
 }
@@ -124,3 +138,41 @@ func setup(db *sql.DB, schema string, schemaVersion int, path string) error {
 	}
 	return nil
 }
+
+func migrate(db *sql.DB, migrations Migrations, existingVersion, schemaVersion int,
+	path string) error {
+
+	if existingVersion > schemaVersion {
+		return serrors.New("Database schema version mismatch",
+			"expected", schemaVersion, "have", existingVersion, "path", path)
+	}
+	for v := existingVersion + 1; v <= schemaVersion; v++ {
+		if _, ok := migrations[v]; !ok {
+			return serrors.New("Database schema version mismatch",
+				"expected", schemaVersion, "have", existingVersion, "path", path)
+		}
+	}
+	for v := existingVersion + 1; v <= schemaVersion; v++ {
+		if err := migrateTo(db, migrations[v], v); err != nil {
+			return serrors.Wrap("Failed to migrate database schema", err,
+				"version", v, "path", path)
+		}
+	}
+	return nil
+}
+
+// migrateTo applies the migration to the version in a single transaction, such that
+// the schema version is only increased if the migration succeeds.
+func migrateTo(db *sql.DB, migration string, version int) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec(migration); err != nil {
+		return serrors.Join(err, tx.Rollback())
+	}
+	if _, err := tx.Exec(fmt.Sprintf("PRAGMA user_version = %d", version)); err != nil {
+		return serrors.Join(err, tx.Rollback())
+	}
+	return tx.Commit()
+}
@@ -38,6 +38,21 @@ func (m *MockTrustDB) EXPECT() *MockTrustDBMockRecorder {
 	return m.recorder
 }
 
+// CRLs mocks base method.
+func (m *MockTrustDB) CRLs(arg0 context.Context, arg1 trust0.CRLQuery) ([]trust0.CRL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CRLs", arg0, arg1)
+	ret0, _ := ret[0].([]trust0.CRL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CRLs indicates an expected call of CRLs.
+func (mr *MockTrustDBMockRecorder) CRLs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CRLs", reflect.TypeOf((*MockTrustDB)(nil).CRLs), arg0, arg1)
+}
+
 // Chain mocks base method.
 func (m *MockTrustDB) Chain(arg0 context.Context, arg1 []byte) ([]*x509.Certificate, error) {
 	m.ctrl.T.Helper()
@@ -82,6 +97,21 @@ func (mr *MockTrustDBMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockTrustDB)(nil).Close))
 }
 
+// InsertCRL mocks base method.
+func (m *MockTrustDB) InsertCRL(arg0 context.Context, arg1 trust0.CRL) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertCRL", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertCRL indicates an expected call of InsertCRL.
+func (mr *MockTrustDBMockRecorder) InsertCRL(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertCRL", reflect.TypeOf((*MockTrustDB)(nil).InsertCRL), arg0, arg1)
+}
+
 // InsertChain mocks base method.
 func (m *MockTrustDB) InsertChain(arg0 context.Context, arg1 []*x509.Certificate) (bool, error) {
 	m.ctrl.T.Helper()
@@ -176,6 +176,30 @@ func (e *executor) InsertChain(ctx context.Context, chain []*x509.Certificate) (
 	return inserted, err
 }
 
+func (e *executor) CRLs(ctx context.Context, q trust.CRLQuery) ([]trust.CRL, error) {
+	var crls []trust.CRL
+	var err error
+	e.metrics.Observe(ctx, "get_crls", func(ctx context.Context) (string, error) {
+		crls, err = e.db.CRLs(ctx, q)
+		label := dblib.ErrToMetricLabel(err)
+		if len(crls) == 0 && err == nil {
+			label = errNotFound
+		}
+		return label, err
+	})
+	return crls, err
+}
+
+func (e *executor) InsertCRL(ctx context.Context, crl trust.CRL) (bool, error) {
+	var inserted bool
+	var err error
+	e.metrics.Observe(ctx, "insert_crl", func(ctx context.Context) (string, error) {
+		inserted, err = e.db.InsertCRL(ctx, crl)
+		return dblib.ErrToMetricLabel(err), err
+	})
+	return inserted, err
+}
+
 type queryLabels struct {
 	Driver    string
 	Operation string
@@ -20,6 +20,7 @@ import (
 	"crypto/x509"
 	"database/sql"
 	"fmt"
+	"math/big"
 	"strings"
 	"sync"
 
@@ -37,10 +38,11 @@ type DB struct {
 }
 
 // New returns a new SQLite backend opening a database at the given path. If
-// no database exists a new database is be created. If the schema version of the
-// stored database is different from the one in schema.go, an error is returned.
+// no database exists a new database is be created. A database with an older
+// schema version is migrated to the one in schema.go. If the schema version of
+// the stored database is newer, an error is returned.
 func New(path string) (DB, error) {
-	db, err := db.NewSqlite(path, Schema, SchemaVersion)
+	db, err := db.NewSqliteWithMigrations(path, Schema, SchemaVersion, migrations)
 	if err != nil {
 		return DB{}, err
 	}
@@ -267,6 +269,102 @@ func (e *executor) InsertChain(ctx context.Context, chain []*x509.Certificate) (
 	return inserted, nil
 }
 
+func (e *executor) CRLs(ctx context.Context, query trust.CRLQuery) ([]trust.CRL, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	sqlQuery := []string{"SELECT crl, ca_cert FROM crls"}
+	var args []any
+	var filters []string
+
+	if query.ISD != 0 {
+		args = append(args, query.ISD)
+		filters = append(filters, fmt.Sprintf("isd_id=$%d", len(args)))
+	}
+	if len(query.AuthorityKeyID) != 0 {
+		args = append(args, query.AuthorityKeyID)
+		filters = append(filters, fmt.Sprintf("authority_key_id=$%d", len(args)))
+	}
+	if len(filters) != 0 {
+		sqlQuery = append(sqlQuery, "WHERE")
+	}
+	sqlQuery = append(sqlQuery, strings.Join(filters, " AND "))
+	rows, err := e.db.QueryContext(ctx, strings.Join(sqlQuery, "\n"), args...)
+	if err != nil {
+		return nil, serrors.JoinNoStack(db.ErrReadFailed, err)
+	}
+	defer rows.Close()
+	var crls []trust.CRL
+	for rows.Next() {
+		var rawCRL, rawCA []byte
+		if err := rows.Scan(&rawCRL, &rawCA); err != nil {
+			return nil, serrors.JoinNoStack(db.ErrReadFailed, err)
+		}
+		crl, err := x509.ParseRevocationList(rawCRL)
+		if err != nil {
+			return nil, serrors.JoinNoStack(db.ErrDataInvalid, err)
+		}
+		ca, err := x509.ParseCertificate(rawCA)
+		if err != nil {
+			return nil, serrors.JoinNoStack(db.ErrDataInvalid, err)
+		}
+		crls = append(crls, trust.CRL{RevocationList: crl, CA: ca})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, serrors.JoinNoStack(db.ErrReadFailed, err)
+	}
+	return crls, nil
+}
+
+func (e *executor) InsertCRL(ctx context.Context, crl trust.CRL) (bool, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	if crl.RevocationList == nil || crl.CA == nil {
+		return false, serrors.JoinNoStack(db.ErrInvalidInputData, nil,
+			"msg", "CRL and CA certificate must be set")
+	}
+	ia, err := cppki.ExtractIA(crl.CA.Subject)
+	if err != nil {
+		return false, serrors.JoinNoStack(db.ErrInvalidInputData, err,
+			"msg", "invalid CA cert, invalid ISD-AS")
+	}
+	if crl.RevocationList.Number == nil {
+		return false, serrors.JoinNoStack(db.ErrInvalidInputData, nil,
+			"msg", "CRL number not set")
+	}
+	var inserted bool
+	err = db.DoInTx(ctx, e.db, func(ctx context.Context, tx *sql.Tx) error {
+		var rawNumber []byte
+		err := tx.QueryRowContext(ctx,
+			"SELECT number FROM crls WHERE isd_id=$1 AND authority_key_id=$2",
+			ia.ISD(), crl.RevocationList.AuthorityKeyId,
+		).Scan(&rawNumber)
+		switch {
+		case err == sql.ErrNoRows:
+		case err != nil:
+			return serrors.JoinNoStack(db.ErrReadFailed, err)
+		case new(big.Int).SetBytes(rawNumber).Cmp(crl.RevocationList.Number) >= 0:
+			return nil
+		}
+		_, err = tx.ExecContext(ctx, `INSERT OR REPLACE INTO crls
+			(isd_id, authority_key_id, number, crl, ca_cert)
+			VALUES ($1, $2, $3, $4, $5)`,
+			ia.ISD(), crl.RevocationList.AuthorityKeyId,
+			crl.RevocationList.Number.Bytes(), crl.RevocationList.Raw, crl.CA.Raw,
+		)
+		if err != nil {
+			return serrors.JoinNoStack(db.ErrWriteFailed, err)
+		}
+		inserted = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return inserted, nil
+}
+
 // SignedTRCs returns the TRC from each ISD in the trust database according to the query.
 func (e *executor) SignedTRCs(ctx context.Context,
 	query truststorage.TRCsQuery) (cppki.SignedTRCs, error) {
@@ -16,14 +16,41 @@ package sqlite_test
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/scionproto/scion/private/storage/db"
 	"github.com/scionproto/scion/private/storage/trust/dbtest"
 	"github.com/scionproto/scion/private/storage/trust/sqlite"
+	"github.com/scionproto/scion/private/trust"
 )
 
+// schemaV1 is the schema of version 1, before the CRLs were added.
+const schemaV1 = `
+	CREATE TABLE chains(
+		isd_id INTEGER NOT NULL,
+		as_id INTEGER NOT NULL,
+		key_id DATA NOT NULL,
+		not_before INTEGER NOT NULL,
+		not_after INTEGER NOT NULL,
+		chain_fingerprint DATA NOT NULL,
+		as_cert DATA NOT NULL,
+		ca_cert DATA NOT NULL,
+		PRIMARY KEY (isd_id, as_id, key_id, not_before, not_after, chain_fingerprint)
+	);
+	CREATE TABLE trcs(
+		isd_id INTEGER NOT NULL,
+		base INTEGER NOT NULL,
+		serial INTEGER NOT NULL,
+		fingerprint DATA NOT NULL,
+		trc DATA NOT NULL,
+		PRIMARY KEY (isd_id, base, serial)
+	);
+	`
+
 type testDB struct {
 	sqlite.DB
 }
@@ -41,3 +68,38 @@ func newDatabase(t *testing.T) sqlite.DB {
 	require.NoError(t, err)
 	return db
 }
+
+func TestMigrateV1(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trust.db")
+	v1, err := db.NewSqlite(path, schemaV1, 1)
+	require.NoError(t, err)
+	_, err = v1.Exec(`INSERT INTO trcs (isd_id, base, serial, fingerprint, trc)
+		VALUES (1, 1, 1, x'00', x'00')`)
+	require.NoError(t, err)
+	require.NoError(t, v1.Close())
+
+	trustDB, err := sqlite.New(path)
+	require.NoError(t, err)
+	crls, err := trustDB.CRLs(context.Background(), trust.CRLQuery{})
+	require.NoError(t, err)
+	assert.Empty(t, crls)
+	require.NoError(t, trustDB.Close())
+
+	// The migrated database is opened as is, and the existing data is kept.
+	migrated, err := db.NewSqlite(path, sqlite.Schema, sqlite.SchemaVersion)
+	require.NoError(t, err)
+	defer migrated.Close()
+	var trcs int
+	require.NoError(t, migrated.QueryRow("SELECT COUNT(*) FROM trcs").Scan(&trcs))
+	assert.Equal(t, 1, trcs)
+}
+
+func TestNewerSchemaVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trust.db")
+	newer, err := db.NewSqlite(path, sqlite.Schema, sqlite.SchemaVersion+1)
+	require.NoError(t, err)
+	require.NoError(t, newer.Close())
+
+	_, err = sqlite.New(path)
+	assert.Error(t, err)
+}
@@ -14,11 +14,13 @@
 
 package sqlite
 
+import "github.com/scionproto/scion/private/storage/db"
+
 const (
 	// SchemaVersion is the version of the SQLite schema understood by this backend.
 	// Whenever changes to the schema are made, this version number should be increased
 	// to prevent data corruption between incompatible database schemas.
-	SchemaVersion = 1
+	SchemaVersion = 2
 	// Schema is the SQLite database layout.
 	Schema = `
 	CREATE TABLE chains(
@@ -40,5 +42,22 @@ const (
 		trc DATA NOT NULL,
 		PRIMARY KEY (isd_id, base, serial)
 	);
+	` + crlsTable
+
+	// crlsTable is the table for the CRLs, which was added in schema version 2.
+	crlsTable = `
+	CREATE TABLE crls(
+		isd_id INTEGER NOT NULL,
+		authority_key_id DATA NOT NULL,
+		number DATA NOT NULL,
+		crl DATA NOT NULL,
+		ca_cert DATA NOT NULL,
+		PRIMARY KEY (isd_id, authority_key_id)
+	);
 	`
 )
+
+// migrations migrate the databases with older schema versions to SchemaVersion.
+var migrations = db.Migrations{
+	2: crlsTable,
+}
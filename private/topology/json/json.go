@@ -108,12 +108,15 @@ type GatewayInfo struct {
 // BRInterface contains the information for an data-plane BR socket that is external (i.e., facing
 // the neighboring AS).
 type BRInterface struct {
-	Underlay   Underlay `json:"underlay,omitempty"`
-	IA         string   `json:"isd_as"`
-	LinkTo     string   `json:"link_to"`
-	MTU        int      `json:"mtu"`
-	BFD        *BFD     `json:"bfd,omitempty"`
-	RemoteIfID iface.ID `json:"remote_interface_id,omitempty"`
+	Underlay Underlay `json:"underlay,omitempty"`
+	// Bundle lists the underlay connections of a link bundle. It is mutually exclusive with
+	// Underlay.
+	Bundle     []Underlay `json:"bundle,omitempty"`
+	IA         string     `json:"isd_as"`
+	LinkTo     string     `json:"link_to"`
+	MTU        int        `json:"mtu"`
+	BFD        *BFD       `json:"bfd,omitempty"`
+	RemoteIfID iface.ID   `json:"remote_interface_id,omitempty"`
 }
 
 // Underlay is the underlay information for a BR interface.
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"os"
 	"sync"
 
 	"github.com/scionproto/scion/pkg/addr"
@@ -54,6 +55,9 @@ type LoaderMetrics struct {
 type LoaderCfg struct {
 	// File is the file from which the topology should be loaded.
 	File string
+	// Source, if set, is used instead of File to read the topology in the JSON format of the
+	// topology file, e.g., to fetch it from the discovery service.
+	Source func() ([]byte, error)
 	// Reload is the channel on which reloads can be triggered.
 	Reload <-chan struct{}
 	// Validator is used to validate topology updates. If this field is not set,
@@ -73,6 +77,7 @@ type Loader struct {
 	mtx         sync.Mutex
 	subscribers map[*Subscription]chan struct{}
 	topo        Topology
+	raw         []byte
 }
 
 // NewLoader creates a topology loader from the given configuration. This method
@@ -97,7 +102,7 @@ func (l *Loader) Run(ctx context.Context) error {
 		select {
 		case <-l.cfg.Reload:
 			if err := l.reload(); err != nil {
-				log.FromCtx(ctx).Error("Failed to reload topology file",
+				log.FromCtx(ctx).Error("Failed to reload topology",
 					"file", l.cfg.File, "err", err)
 			} else {
 				log.FromCtx(ctx).Info("Reloaded topology")
@@ -231,6 +236,15 @@ func (l *Loader) Get() Topology {
 	return l.topo
 }
 
+// TopologyJSON returns the topology in the JSON format of the topology file, as it was read from
+// the file or the source.
+func (l *Loader) TopologyJSON() ([]byte, error) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	return l.raw, nil
+}
+
 func (l *Loader) HandleHTTP(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	bytes, err := json.MarshalIndent(l.Get().Writable(), "", "    ")
@@ -283,7 +297,7 @@ func (l *Loader) notifyAllLocked() {
 }
 
 func (l *Loader) reload() error {
-	newTopo, err := l.load()
+	newTopo, raw, err := l.load()
 	if err != nil {
 		metrics.CounterInc(l.cfg.Metrics.ReadErrors)
 		return serrors.Wrap("loading topology", err)
@@ -302,6 +316,7 @@ func (l *Loader) reload() error {
 		return serrors.Wrap("validating update", err)
 	}
 	l.topo = newTopo
+	l.raw = raw
 	metrics.CounterInc(l.cfg.Metrics.Updates)
 	metrics.GaugeSetCurrentTime(l.cfg.Metrics.LastUpdate)
 
@@ -309,12 +324,20 @@ func (l *Loader) reload() error {
 	return nil
 }
 
-func (l *Loader) load() (Topology, error) {
-	topo, err := FromJSONFile(l.cfg.File)
+func (l *Loader) load() (Topology, []byte, error) {
+	read := l.cfg.Source
+	if read == nil {
+		read = func() ([]byte, error) { return os.ReadFile(l.cfg.File) }
+	}
+	raw, err := read()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	topo, err := RWTopologyFromJSONBytes(raw)
+	if err != nil {
+		return nil, nil, err
 	}
-	return topo, nil
+	return FromRWTopology(topo), raw, nil
 }
 
 func (l *Loader) validate(new, old *RWTopology) error {
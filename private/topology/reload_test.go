@@ -93,6 +93,26 @@ func TestLoader(t *testing.T) {
 		assert.NoError(t, err)
 		testBasicTopo(t, l)
 	})
+	t.Run("topology is read from source", func(t *testing.T) {
+		raw, err := os.ReadFile("testdata/basic.json")
+		require.NoError(t, err)
+		l, err := topology.NewLoader(topology.LoaderCfg{
+			File:   "non-existing",
+			Source: func() ([]byte, error) { return raw, nil },
+		})
+		require.NoError(t, err)
+		testBasicTopo(t, l)
+		topoJSON, err := l.TopologyJSON()
+		require.NoError(t, err)
+		assert.Equal(t, raw, topoJSON)
+	})
+	t.Run("unreadable source fails", func(t *testing.T) {
+		l, err := topology.NewLoader(topology.LoaderCfg{
+			Source: func() ([]byte, error) { return nil, errors.New("unreachable") },
+		})
+		assert.Nil(t, l)
+		assert.Error(t, err)
+	})
 	t.Run("unreadable reload is ignored", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mockCtr := mock_metrics.NewMockCounter(ctrl)
@@ -22,6 +22,7 @@ import (
 	"net"
 	"net/netip"
 	"os"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -123,6 +124,7 @@ type (
 		Provider     string         // Underlay provider name
 		Local        string         // Underlay addr on owning router side
 		Remote       string         // Underlay addr on far router side
+		Bundle       []BundleMember // Underlay connections of a link bundle, nil if not a bundle
 		RemoteIfID   iface.ID       // ID of this interface for the far router
 		IA           addr.IA        // IA number of the remote AS
 		LinkType     LinkType       // (Child, Parent, Core or Peering)
@@ -130,6 +132,14 @@ type (
 		BFD          BFD            // (configuration of)
 	}
 
+	// BundleMember describes one of the underlay connections of a link bundle. The Provider, Local
+	// and Remote fields of the IFInfo of a bundle are those of its first member.
+	BundleMember struct {
+		Provider string
+		Local    string
+		Remote   string
+	}
+
 	// IDAddrMap maps process IDs to their topology addresses.
 	IDAddrMap map[string]TopoAddr
 
@@ -312,6 +322,26 @@ func (t *RWTopology) populateBR(raw *jsontopo.Topology) error {
 				}
 			}
 
+			if len(rawIntf.Bundle) > 0 {
+				if rawIntf.Underlay != (jsontopo.Underlay{}) {
+					return serrors.New("underlay and bundle are mutually exclusive",
+						"br", name, "if_id", ifID)
+				}
+				for _, u := range rawIntf.Bundle {
+					ifinfo.Bundle = append(ifinfo.Bundle, BundleMember{
+						Provider: underlayProvider(u.Provider),
+						Local:    u.Local,
+						Remote:   u.Remote,
+					})
+				}
+				ifinfo.Provider = ifinfo.Bundle[0].Provider
+				ifinfo.Local = ifinfo.Bundle[0].Local
+				ifinfo.Remote = ifinfo.Bundle[0].Remote
+				brInfo.IFs[ifID] = &ifinfo
+				t.IFInfoMap[ifID] = ifinfo
+				continue
+			}
+
 			// These fields are only necessary for the border router.
 			// Parsing should not fail if all fields are empty.
 			if rawIntf.Underlay == (jsontopo.Underlay{}) {
@@ -319,10 +349,7 @@ func (t *RWTopology) populateBR(raw *jsontopo.Topology) error {
 				t.IFInfoMap[ifID] = ifinfo
 				continue
 			}
-			ifinfo.Provider = rawIntf.Underlay.Provider
-			if ifinfo.Provider == "" { // Backward compatible with older configs
-				ifinfo.Provider = "udpip"
-			}
+			ifinfo.Provider = underlayProvider(rawIntf.Underlay.Provider)
 			ifinfo.Local = rawIntf.Underlay.Local
 			ifinfo.Remote = rawIntf.Underlay.Remote
 			brInfo.IFs[ifID] = &ifinfo
@@ -336,6 +363,15 @@ func (t *RWTopology) populateBR(raw *jsontopo.Topology) error {
 	return nil
 }
 
+// underlayProvider returns the name of the underlay provider, defaulting to "udpip" for
+// backward compatibility with older configs.
+func underlayProvider(provider string) string {
+	if provider == "" {
+		return "udpip"
+	}
+	return provider
+}
+
 func (t *RWTopology) populateServices(raw *jsontopo.Topology) error {
 	var err error
 	t.CS, err = svcMapFromRaw(raw.ControlService)
@@ -626,6 +662,7 @@ func (i *IFInfo) copy() *IFInfo {
 		return nil
 	}
 	cpy := *i
+	cpy.Bundle = slices.Clone(i.Bundle)
 	return &cpy
 }
 
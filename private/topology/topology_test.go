@@ -26,6 +26,7 @@ import (
 
 	"github.com/scionproto/scion/pkg/addr"
 	"github.com/scionproto/scion/pkg/segment/iface"
+	jsontopo "github.com/scionproto/scion/private/topology/json"
 )
 
 func TestMeta(t *testing.T) {
@@ -273,6 +274,56 @@ func TestIFInfoMapCoreAS(t *testing.T) {
 	assert.Equal(t, ifm, c.IFInfoMap)
 }
 
+func TestIFInfoMapBundle(t *testing.T) {
+	c := MustLoadTopo(t, "testdata/bundle.json")
+	ifm := IfInfoMap{
+		91: IFInfo{
+			ID:           91,
+			BRName:       "borderrouter6-ff00:0:362-1",
+			InternalAddr: netip.MustParseAddrPort("10.1.0.1:0"),
+			Provider:     "udpip",
+			Local:        "192.0.2.1:4997",
+			Remote:       "192.0.2.2:4998",
+			Bundle: []BundleMember{
+				{Provider: "udpip", Local: "192.0.2.1:4997", Remote: "192.0.2.2:4998"},
+				{Provider: "udpip", Local: "198.51.100.1:4997", Remote: "198.51.100.2:4998"},
+			},
+			IA:       addr.MustParseIA("6-ff00:0:363"),
+			LinkType: Core,
+			MTU:      1472,
+		},
+	}
+	assert.Equal(t, ifm, c.IFInfoMap)
+
+	t.Run("underlay and bundle", func(t *testing.T) {
+		raw := &jsontopo.Topology{
+			IA:         "6-ff00:0:362",
+			Attributes: []jsontopo.Attribute{jsontopo.AttrCore},
+			BorderRouters: map[string]*jsontopo.BRInfo{
+				"br": {
+					InternalAddr: "10.1.0.1:0",
+					Interfaces: map[iface.ID]*jsontopo.BRInterface{
+						91: {
+							Underlay: jsontopo.Underlay{
+								Local:  "192.0.2.1:4997",
+								Remote: "192.0.2.2:4998",
+							},
+							Bundle: []jsontopo.Underlay{{
+								Local:  "198.51.100.1:4997",
+								Remote: "198.51.100.2:4998",
+							}},
+							IA:     "6-ff00:0:363",
+							LinkTo: "CORE",
+						},
+					},
+				},
+			},
+		}
+		_, err := RWTopologyFromJSONTopology(raw)
+		assert.Error(t, err)
+	})
+}
+
 func TestBRsCoreAS(t *testing.T) {
 	c := MustLoadTopo(t, "testdata/core.json")
 	brCases := []struct {
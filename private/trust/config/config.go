@@ -24,22 +24,28 @@ import (
 	"github.com/scionproto/scion/private/config"
 )
 
-const defaultExpiration = time.Minute
+const (
+	defaultExpiration = time.Minute
+	defaultWarnBefore = 72 * time.Hour
+)
 
 type Config struct {
 	config.NoValidator
-	Cache Cache `toml:"cache"`
+	Cache  Cache  `toml:"cache"`
+	Expiry Expiry `toml:"expiry"`
 }
 
 func (cfg *Config) InitDefaults() {
 	config.InitAll(
 		&cfg.Cache,
+		&cfg.Expiry,
 	)
 }
 
 func (cfg *Config) Sample(dst io.Writer, path config.Path, ctx config.CtxMap) {
 	config.WriteSample(dst, path, ctx,
 		&cfg.Cache,
+		&cfg.Expiry,
 	)
 }
 
@@ -78,3 +84,25 @@ expiration = "1m"
 func (cfg *Cache) ConfigName() string {
 	return "cache"
 }
+
+// Expiry configures the monitoring of the expiration of the trust material.
+type Expiry struct {
+	WarnBefore util.DurWrap `toml:"warn_before,omitempty"`
+}
+
+func (cfg *Expiry) InitDefaults() {
+	if cfg.WarnBefore.Duration == 0 {
+		cfg.WarnBefore.Duration = defaultWarnBefore
+	}
+}
+
+func (cfg *Expiry) Sample(dst io.Writer, path config.Path, _ config.CtxMap) {
+	config.WriteString(dst, `
+# Remaining validity below which trust material is reported as expiring.
+warn_before = "72h"
+`)
+}
+
+func (cfg *Expiry) ConfigName() string {
+	return "expiry"
+}
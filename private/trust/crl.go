@@ -0,0 +1,105 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trust
+
+import (
+	"context"
+	"crypto/x509"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+)
+
+// ErrRevoked indicates that all certificate chains that match a query are
+// revoked.
+var ErrRevoked = serrors.New("certificate revoked")
+
+// CRL is a certificate revocation list together with the CA certificate that
+// issued it.
+type CRL struct {
+	// RevocationList is the certificate revocation list.
+	RevocationList *x509.RevocationList
+	// CA is the CA certificate that issued the revocation list.
+	CA *x509.Certificate
+}
+
+// CRLQuery identifies a set of certificate revocation lists that need to be
+// looked up.
+type CRLQuery struct {
+	// ISD is the ISD of the CAs that issued the CRLs. If it is zero, the CRLs
+	// of all ISDs match.
+	ISD addr.ISD
+	// AuthorityKeyID identifies the CA key that signed the CRL. If it is
+	// empty, the CRLs of all CAs match.
+	AuthorityKeyID []byte
+}
+
+// VerifyCRL verifies that the certificate revocation list is issued by a CA
+// that is verifiable against the active TRCs of its ISD.
+func VerifyCRL(ctx context.Context, db DB, crl CRL) error {
+	if crl.RevocationList == nil || crl.CA == nil {
+		return serrors.New("CRL and CA certificate must be set")
+	}
+	ia, err := cppki.ExtractIA(crl.CA.Subject)
+	if err != nil {
+		return serrors.Wrap("extracting CA ISD-AS", err)
+	}
+	trcs, _, err := activeTRCs(ctx, db, ia.ISD())
+	if err != nil {
+		return serrors.Wrap("loading active TRCs", err, "isd", ia.ISD())
+	}
+	opts := cppki.VerifyOptions{TRC: make([]*cppki.TRC, 0, len(trcs))}
+	for i := range trcs {
+		opts.TRC = append(opts.TRC, &trcs[i].TRC)
+	}
+	return cppki.VerifyCRL(crl.RevocationList, crl.CA, opts)
+}
+
+// filterRevoked removes the certificate chains with an AS certificate that is
+// revoked by the CRL of the issuing CA. The CRLs are looked up in the database.
+// They are verified before they are inserted, thus, they are not verified
+// again.
+func filterRevoked(ctx context.Context, db DB,
+	chains [][]*x509.Certificate) ([][]*x509.Certificate, error) {
+
+	valid := make([][]*x509.Certificate, 0, len(chains))
+	for _, chain := range chains {
+		ia, err := cppki.ExtractIA(chain[1].Subject)
+		if err != nil {
+			return nil, serrors.Wrap("extracting CA ISD-AS", err)
+		}
+		crls, err := db.CRLs(ctx, CRLQuery{
+			ISD:            ia.ISD(),
+			AuthorityKeyID: chain[0].AuthorityKeyId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !isRevoked(crls, chain[0]) {
+			valid = append(valid, chain)
+		}
+	}
+	return valid, nil
+}
+
+func isRevoked(crls []CRL, cert *x509.Certificate) bool {
+	for _, crl := range crls {
+		if cppki.IsRevoked(crl.RevocationList, cert) {
+			return true
+		}
+	}
+	return false
+}
@@ -65,4 +65,11 @@ type DB interface {
 	// InsertTRC inserts the given TRC. Returns true if the TRC was not yet in
 	// the DB.
 	InsertTRC(ctx context.Context, trc cppki.SignedTRC) (bool, error)
+
+	// CRLs looks up the latest CRLs that match the query.
+	CRLs(ctx context.Context, query CRLQuery) ([]CRL, error)
+	// InsertCRL inserts the given CRL. Only the latest CRL of every CA is
+	// kept. Returns true if the CRL is newer than the CRL of the same CA that
+	// is already in the DB.
+	InsertCRL(ctx context.Context, crl CRL) (bool, error)
 }
@@ -16,8 +16,12 @@ package dbtest
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/x509"
 	"fmt"
+	"math/big"
 	"path/filepath"
 	"testing"
 	"time"
@@ -74,6 +78,7 @@ func Run(t *testing.T, db TestableDB, cfg Config) {
 	tests := map[string]func(*testing.T, trust.DB, Config){
 		"test TRC":   testTRC,
 		"test chain": testChain,
+		"test CRL":   testCRL,
 	}
 	// Run test suite on DB directly.
 	for name, test := range tests {
@@ -334,6 +339,90 @@ func testChain(t *testing.T, db trust.DB, cfg Config) {
 	})
 }
 
+func testCRL(t *testing.T, db trust.DB, cfg Config) {
+	bernCA := loadCertFile(t, filepath.Join("bern", "cp-ca.crt"), cfg)
+	genevaCA := loadCertFile(t, filepath.Join("geneva", "cp-ca.crt"), cfg)
+	bern1 := createCRL(t, bernCA, 1)
+	bern2 := createCRL(t, bernCA, 2)
+	geneva1 := createCRL(t, genevaCA, 1)
+
+	ctx, cancelF := context.WithTimeout(context.Background(), cfg.Timeout)
+	defer cancelF()
+
+	// prefill DB with CRLs that we expect to exist.
+	in, err := db.InsertCRL(ctx, bern1)
+	require.NoError(t, err)
+	require.True(t, in)
+	in, err = db.InsertCRL(ctx, geneva1)
+	require.NoError(t, err)
+	require.True(t, in)
+
+	t.Run("InsertCRL", func(t *testing.T) {
+		t.Run("Invalid CRL", func(t *testing.T) {
+			in, err := db.InsertCRL(ctx, trust.CRL{CA: bernCA})
+			assert.False(t, in)
+			assert.Error(t, err)
+		})
+		t.Run("Insert existing CRL", func(t *testing.T) {
+			in, err := db.InsertCRL(ctx, bern1)
+			assert.False(t, in)
+			assert.NoError(t, err)
+		})
+		t.Run("Insert newer CRL", func(t *testing.T) {
+			in, err := db.InsertCRL(ctx, bern2)
+			assert.True(t, in)
+			assert.NoError(t, err)
+		})
+		t.Run("Insert older CRL", func(t *testing.T) {
+			in, err := db.InsertCRL(ctx, bern1)
+			assert.False(t, in)
+			assert.NoError(t, err)
+		})
+	})
+	t.Run("CRLs", func(t *testing.T) {
+		t.Run("All CRLs", func(t *testing.T) {
+			crls, err := db.CRLs(ctx, trust.CRLQuery{})
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, []trust.CRL{bern2, geneva1}, crls)
+		})
+		t.Run("CRLs for a given ISD", func(t *testing.T) {
+			crls, err := db.CRLs(ctx, trust.CRLQuery{ISD: 1})
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, []trust.CRL{bern2, geneva1}, crls)
+			crls, err = db.CRLs(ctx, trust.CRLQuery{ISD: 2})
+			assert.NoError(t, err)
+			assert.Empty(t, crls)
+		})
+		t.Run("CRL for a given CA", func(t *testing.T) {
+			crls, err := db.CRLs(ctx, trust.CRLQuery{
+				ISD:            1,
+				AuthorityKeyID: genevaCA.SubjectKeyId,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, []trust.CRL{geneva1}, crls)
+		})
+		t.Run("Non existing CA", func(t *testing.T) {
+			crls, err := db.CRLs(ctx, trust.CRLQuery{AuthorityKeyID: []byte("non-existing")})
+			assert.NoError(t, err)
+			assert.Empty(t, crls)
+		})
+	})
+}
+
+// createCRL creates a CRL for the CA certificate. The CRL is signed with a
+// fresh key, the signature is not checked by the database.
+func createCRL(t *testing.T, ca *x509.Certificate, number int64) trust.CRL {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	list, err := cppki.CAPolicy{
+		Certificate: ca,
+		Signer:      key,
+		CurrentTime: ca.NotBefore,
+	}.CreateCRL(big.NewInt(number), nil, ca.NotAfter)
+	require.NoError(t, err)
+	return trust.CRL{RevocationList: list, CA: ca}
+}
+
 func loadTRCFile(t *testing.T, file string, cfg Config) cppki.SignedTRC {
 	return xtest.LoadTRC(t, cfg.filePath(file))
 }
@@ -0,0 +1,268 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expiry
+
+import (
+	"context"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/scrypto"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	"github.com/scionproto/scion/private/trust"
+)
+
+// DefaultWarnBefore is the default remaining validity below which trust
+// material is considered expiring.
+const DefaultWarnBefore = 72 * time.Hour
+
+// SourceDB is the source of the trust material that is loaded from the trust
+// database.
+const SourceDB = "trust_db"
+
+// DB is the trust database the trust material is loaded from.
+type DB interface {
+	// SignedTRC looks up the TRC identified by the id.
+	SignedTRC(ctx context.Context, id cppki.TRCID) (cppki.SignedTRC, error)
+	// Chains looks up all chains that match the query.
+	Chains(ctx context.Context, query trust.ChainQuery) ([][]*x509.Certificate, error)
+}
+
+// Checker periodically checks the expiration of the trust material of the
+// local AS. It considers the currently valid AS certificate chains of the
+// local AS in the trust database, the latest TRC of the local ISD and the
+// grace period of its predecessor, and the certificate files in the configured
+// directories.
+type Checker struct {
+	// IA is the local ISD-AS.
+	IA addr.IA
+	// DB is the trust database.
+	DB DB
+	// ChainDir is the directory the AS certificate chains (*.pem) are loaded
+	// from. If it is empty, only the trust database is considered.
+	ChainDir string
+	// CADir is the directory the CA certificates (*.crt) are loaded from. If
+	// it is empty, no CA certificates are considered.
+	CADir string
+	// WarnBefore is the remaining validity below which trust material is
+	// considered expiring. If it is zero, DefaultWarnBefore is used.
+	WarnBefore time.Duration
+	// TimeToExpiry is the gauge that exposes the remaining validity in
+	// seconds per trust material. It is partitioned by type, subject and id.
+	// If it is nil, no metrics are exposed.
+	TimeToExpiry *prometheus.GaugeVec
+
+	mtx    sync.RWMutex
+	report Report
+}
+
+// Name returns the task name.
+func (c *Checker) Name() string {
+	return "trust_expiry_checker"
+}
+
+// Run checks the trust material, updates the metrics, and logs the material
+// that is about to expire.
+func (c *Checker) Run(ctx context.Context) {
+	now := time.Now()
+	report := c.Check(ctx, now)
+	c.mtx.Lock()
+	c.report = report
+	c.mtx.Unlock()
+
+	logger := log.FromCtx(ctx)
+	if report.Err != nil {
+		logger.Info("Failed to check expiration of trust material", "err", report.Err)
+	}
+	for _, t := range Types {
+		e, ok := report.Latest(t)
+		if !ok {
+			continue
+		}
+		switch e.State(now, report.WarnBefore) {
+		case Expired:
+			logger.Info("Trust material has expired", "type", e.Type, "subject", e.Subject,
+				"id", e.ID, "source", e.Source, "not_after", e.NotAfter)
+		case Expiring:
+			logger.Info("Trust material is about to expire", "type", e.Type,
+				"subject", e.Subject, "id", e.ID, "source", e.Source, "not_after", e.NotAfter,
+				"time_to_expiry", e.TimeToExpiry(now).Round(time.Second))
+		}
+	}
+	if c.TimeToExpiry == nil {
+		return
+	}
+	c.TimeToExpiry.Reset()
+	for _, e := range report.Entries {
+		c.TimeToExpiry.WithLabelValues(string(e.Type), e.Subject, e.ID).
+			Set(e.TimeToExpiry(now).Seconds())
+	}
+}
+
+// Report returns the report of the last run. The report is the zero value if
+// the checker has not run yet.
+func (c *Checker) Report() Report {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return c.report
+}
+
+// Check checks the expiration of the trust material at the given time.
+// Material that is found in multiple sources is reported once. Material with
+// the same ID, e.g., a chain that was renewed with the same key, is reported
+// with the latest expiration.
+func (c *Checker) Check(ctx context.Context, now time.Time) Report {
+	report := Report{
+		Time:       now,
+		WarnBefore: c.WarnBefore,
+	}
+	if report.WarnBefore == 0 {
+		report.WarnBefore = DefaultWarnBefore
+	}
+	// seen maps the type and ID of the reported material to its index in the
+	// entries.
+	seen := map[Type]map[string]int{}
+	add := func(e Entry) {
+		if seen[e.Type] == nil {
+			seen[e.Type] = map[string]int{}
+		}
+		if i, ok := seen[e.Type][e.ID]; ok {
+			if e.NotAfter.After(report.Entries[i].NotAfter) {
+				report.Entries[i] = e
+			}
+			return
+		}
+		seen[e.Type][e.ID] = len(report.Entries)
+		report.Entries = append(report.Entries, e)
+	}
+
+	var errs serrors.List
+	trc, err := c.DB.SignedTRC(ctx, cppki.TRCID{
+		ISD:    c.IA.ISD(),
+		Base:   scrypto.LatestVer,
+		Serial: scrypto.LatestVer,
+	})
+	switch {
+	case err != nil:
+		errs = append(errs, serrors.Wrap("loading TRC", err, "isd", c.IA.ISD()))
+	case trc.IsZero():
+		errs = append(errs, serrors.New("TRC not found", "isd", c.IA.ISD()))
+	default:
+		add(TRCEntry(&trc.TRC, SourceDB))
+	}
+
+	chains, err := c.DB.Chains(ctx, trust.ChainQuery{
+		IA:       c.IA,
+		Validity: cppki.Validity{NotBefore: now, NotAfter: now},
+	})
+	if err != nil {
+		errs = append(errs, serrors.Wrap("loading certificate chains", err))
+	}
+	for _, chain := range chains {
+		add(ChainEntry(chain, SourceDB))
+	}
+	if c.ChainDir != "" {
+		dirChains, err := c.checkFiles(c.ChainDir, "*.pem", add)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		chains = append(chains, dirChains...)
+	}
+	if c.CADir != "" {
+		if _, err := c.checkFiles(c.CADir, "*.crt", add); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// The grace period of the predecessor TRC is only relevant if none of the
+	// AS certificate chains is verifiable with the latest TRC. In that case,
+	// the AS certificate chains become unusable when the grace period ends.
+	if !trc.IsZero() && trc.TRC.InGracePeriod(now) && !verifiable(chains, &trc.TRC, now) {
+		predecessor := trc.TRC.ID
+		predecessor.Serial--
+		add(Entry{
+			Type:     TRCGracePeriod,
+			Subject:  c.IA.ISD().String(),
+			ID:       predecessor.String(),
+			Source:   SourceDB,
+			NotAfter: trc.TRC.GracePeriodEnd(),
+		})
+	}
+	report.Err = errs.ToError()
+	return report
+}
+
+// verifiable checks whether any of the currently valid chains is verifiable
+// with the TRC. If there are no chains, the TRC is not relevant and the
+// function returns true.
+func verifiable(chains [][]*x509.Certificate, trc *cppki.TRC, now time.Time) bool {
+	valid := false
+	for _, chain := range chains {
+		if !(cppki.Validity{NotBefore: chain[0].NotBefore, NotAfter: chain[0].NotAfter}).
+			Contains(now) {
+			continue
+		}
+		valid = true
+		opts := cppki.VerifyOptions{TRC: []*cppki.TRC{trc}, CurrentTime: now}
+		if cppki.VerifyChain(chain, opts) == nil {
+			return true
+		}
+	}
+	return !valid
+}
+
+// checkFiles adds the certificate files in the directory that match the
+// pattern, and returns the AS certificate chains. Files with two certificates
+// are treated as AS certificate chains, files with a single certificate are
+// only added if they contain a CA certificate. Files that cannot be parsed are
+// skipped.
+func (c *Checker) checkFiles(
+	dir, pattern string,
+	add func(Entry),
+) ([][]*x509.Certificate, error) {
+
+	if _, err := os.Stat(dir); err != nil {
+		return nil, serrors.Wrap("stating directory", err, "dir", dir)
+	}
+	files, err := filepath.Glob(filepath.Join(dir, pattern))
+	if err != nil {
+		return nil, serrors.Wrap("searching for certificates", err, "dir", dir)
+	}
+	var chains [][]*x509.Certificate
+	for _, f := range files {
+		certs, err := cppki.ReadPEMCerts(f)
+		if err != nil {
+			continue
+		}
+		switch len(certs) {
+		case 1:
+			if e := CertificateEntry(certs[0], f); e.Type == CACertificate {
+				add(e)
+			}
+		case 2:
+			add(ChainEntry(certs, f))
+			chains = append(chains, certs)
+		}
+	}
+	return chains, nil
+}
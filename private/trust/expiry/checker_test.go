@@ -0,0 +1,194 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expiry_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/xtest"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	"github.com/scionproto/scion/private/app/command"
+	"github.com/scionproto/scion/private/trust"
+	"github.com/scionproto/scion/private/trust/expiry"
+	"github.com/scionproto/scion/scion-pki/testcrypto"
+)
+
+func TestCheckerCheck(t *testing.T) {
+	dir := genCrypto(t)
+	trc := xtest.LoadTRC(t, filepath.Join(dir, "ISD1/trcs/ISD1-B1-S1.trc"))
+	asDir := filepath.Join(dir, "ISD1/ASff00_0_111/crypto/as")
+	chain := xtest.LoadChain(t, filepath.Join(asDir, "ISD1-ASff00_0_111.pem"))
+	caDir := filepath.Join(dir, "ISD1/ASff00_0_110/crypto/ca")
+	ca := xtest.LoadChain(t, filepath.Join(caDir, "ISD1-ASff00_0_110.ca.crt"))
+
+	now := time.Now()
+	ia := addr.MustParseIA("1-ff00:0:111")
+
+	t.Run("deduplicated", func(t *testing.T) {
+		c := expiry.Checker{
+			IA:       ia,
+			DB:       fakeDB{trc: trc, chains: [][]*x509.Certificate{chain}},
+			ChainDir: asDir,
+			CADir:    caDir,
+		}
+		r := c.Check(context.Background(), now)
+		require.NoError(t, r.Err)
+		assert.Equal(t, expiry.DefaultWarnBefore, r.WarnBefore)
+		assert.ElementsMatch(t, []expiry.Entry{
+			expiry.TRCEntry(&trc.TRC, expiry.SourceDB),
+			expiry.ChainEntry(chain, expiry.SourceDB),
+			expiry.CertificateEntry(ca[0], filepath.Join(caDir, "ISD1-ASff00_0_110.ca.crt")),
+		}, r.Entries)
+		assert.Equal(t, expiry.Valid, r.State(now))
+	})
+	t.Run("renewed with the same key", func(t *testing.T) {
+		// The chain expiring soon was renewed with the same key, so both have
+		// the same subject key ID.
+		expiring := *chain[0]
+		expiring.NotAfter = now.Add(time.Hour)
+		old := []*x509.Certificate{&expiring, chain[1]}
+		for name, chains := range map[string][][]*x509.Certificate{
+			"old first": {old, chain},
+			"old last":  {chain, old},
+		} {
+			t.Run(name, func(t *testing.T) {
+				c := expiry.Checker{
+					IA: ia,
+					DB: fakeDB{trc: trc, chains: chains},
+				}
+				r := c.Check(context.Background(), now)
+				require.NoError(t, r.Err)
+				assert.ElementsMatch(t, []expiry.Entry{
+					expiry.TRCEntry(&trc.TRC, expiry.SourceDB),
+					expiry.ChainEntry(chain, expiry.SourceDB),
+				}, r.Entries)
+				assert.Equal(t, expiry.Valid, r.State(now))
+			})
+		}
+	})
+	t.Run("expiring", func(t *testing.T) {
+		c := expiry.Checker{
+			IA:         ia,
+			DB:         fakeDB{trc: trc},
+			ChainDir:   asDir,
+			WarnBefore: 2 * 365 * 24 * time.Hour,
+		}
+		r := c.Check(context.Background(), now)
+		require.NoError(t, r.Err)
+		assert.ElementsMatch(t, []expiry.Entry{
+			expiry.TRCEntry(&trc.TRC, expiry.SourceDB),
+			expiry.ChainEntry(chain, filepath.Join(asDir, "ISD1-ASff00_0_111.pem")),
+		}, r.Entries)
+		assert.Equal(t, expiry.Expiring, r.State(now))
+	})
+	t.Run("grace period", func(t *testing.T) {
+		// Pretend the TRC is an update that no longer includes the roots
+		// the chain was issued under.
+		update := trc
+		update.TRC.ID.Serial = 2
+		update.TRC.GracePeriod = time.Hour
+		update.TRC.Validity.NotBefore = now.Add(-time.Minute)
+		update.TRC.Certificates = nil
+		c := expiry.Checker{
+			IA: ia,
+			DB: fakeDB{trc: update, chains: [][]*x509.Certificate{chain}},
+		}
+		r := c.Check(context.Background(), now)
+		require.NoError(t, r.Err)
+		e, ok := r.Latest(expiry.TRCGracePeriod)
+		require.True(t, ok)
+		assert.Equal(t, "ISD1-B1-S1", e.ID)
+		assert.Equal(t, now.Add(-time.Minute).Add(time.Hour), e.NotAfter)
+		assert.Equal(t, expiry.Expiring, r.State(now))
+	})
+	t.Run("missing TRC", func(t *testing.T) {
+		c := expiry.Checker{
+			IA:       ia,
+			DB:       fakeDB{},
+			ChainDir: filepath.Join(dir, "missing"),
+		}
+		r := c.Check(context.Background(), now)
+		assert.Error(t, r.Err)
+		assert.Empty(t, r.Entries)
+	})
+}
+
+func TestCheckerRun(t *testing.T) {
+	dir := genCrypto(t)
+	trc := xtest.LoadTRC(t, filepath.Join(dir, "ISD1/trcs/ISD1-B1-S1.trc"))
+	chain := xtest.LoadChain(t,
+		filepath.Join(dir, "ISD1/ASff00_0_111/crypto/as/ISD1-ASff00_0_111.pem"))
+
+	gauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{Name: "test_time_to_expiry_seconds"},
+		[]string{"type", "subject", "id"},
+	)
+	c := &expiry.Checker{
+		IA:           addr.MustParseIA("1-ff00:0:111"),
+		DB:           fakeDB{trc: trc, chains: [][]*x509.Certificate{chain}},
+		TimeToExpiry: gauge,
+	}
+	assert.True(t, c.Report().Time.IsZero())
+	c.Run(context.Background())
+	r := c.Report()
+	assert.False(t, r.Time.IsZero())
+	assert.Len(t, r.Entries, 2)
+	assert.Equal(t, 2, testutil.CollectAndCount(gauge))
+	remaining := testutil.ToFloat64(gauge.WithLabelValues(
+		string(expiry.TRC), "1", trc.TRC.ID.String()))
+	assert.InDelta(t, time.Until(trc.TRC.Validity.NotAfter).Seconds(), remaining, 60)
+}
+
+type fakeDB struct {
+	trc    cppki.SignedTRC
+	chains [][]*x509.Certificate
+}
+
+func (db fakeDB) SignedTRC(context.Context, cppki.TRCID) (cppki.SignedTRC, error) {
+	return db.trc, nil
+}
+
+func (db fakeDB) Chains(context.Context, trust.ChainQuery) ([][]*x509.Certificate, error) {
+	return db.chains, nil
+}
+
+func genCrypto(t testing.TB) string {
+	dir := t.TempDir()
+
+	var buf bytes.Buffer
+	cmd := testcrypto.Cmd(command.StringPather(""))
+	cmd.SetArgs([]string{
+		"-t", "testdata/golden.topo",
+		"-o", dir,
+		"--isd-dir",
+		"--as-validity", "1y",
+	})
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+	err := cmd.Execute()
+	require.NoError(t, err, buf.String())
+	return dir
+}
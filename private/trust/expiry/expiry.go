@@ -0,0 +1,195 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package expiry monitors the expiration of the control-plane PKI material of
+// the local AS, i.e., the AS certificate chains, the CA certificates and the
+// TRC of the local ISD.
+package expiry
+
+import (
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+)
+
+// Type is the type of the monitored trust material.
+type Type string
+
+const (
+	// Chain is an AS certificate chain. It expires with the first certificate
+	// in the chain that expires.
+	Chain Type = "chain"
+	// CACertificate is a CA certificate.
+	CACertificate Type = "ca_certificate"
+	// Certificate is any other control-plane certificate.
+	Certificate Type = "certificate"
+	// TRC is the latest TRC of an ISD.
+	TRC Type = "trc"
+	// TRCGracePeriod is the grace period of the predecessor of the latest
+	// TRC. It expires when the grace period ends. It is only reported if the
+	// AS certificate chains can only be verified with the predecessor.
+	TRCGracePeriod Type = "trc_grace_period"
+)
+
+// Types lists the types in the order they are reported.
+var Types = []Type{Chain, CACertificate, Certificate, TRC, TRCGracePeriod}
+
+// State is the expiration state of the trust material.
+type State string
+
+const (
+	// Valid indicates the material does not expire within the warning period.
+	Valid State = "valid"
+	// Expiring indicates the material expires within the warning period.
+	Expiring State = "expiring"
+	// Expired indicates the material has expired.
+	Expired State = "expired"
+)
+
+// Entry describes the expiration of a piece of trust material.
+type Entry struct {
+	// Type is the type of the material.
+	Type Type
+	// Subject is the ISD-AS of the certificate subject, or the ISD of the
+	// TRC.
+	Subject string
+	// ID identifies the material. For certificates, it is the hex encoded
+	// subject key ID of the first certificate. For TRCs, it is the TRC ID.
+	ID string
+	// Source is the file the material was loaded from, or "trust_db".
+	Source string
+	// NotAfter is the time the material expires.
+	NotAfter time.Time
+}
+
+// TimeToExpiry returns the remaining time until the material expires. It is
+// negative if the material has expired.
+func (e Entry) TimeToExpiry(now time.Time) time.Duration {
+	return e.NotAfter.Sub(now)
+}
+
+// State returns the expiration state of the material at the given time. The
+// material is expiring if it expires within warnBefore.
+func (e Entry) State(now time.Time, warnBefore time.Duration) State {
+	switch remaining := e.TimeToExpiry(now); {
+	case remaining <= 0:
+		return Expired
+	case remaining <= warnBefore:
+		return Expiring
+	default:
+		return Valid
+	}
+}
+
+// String returns a human readable description of the entry.
+func (e Entry) String() string {
+	return fmt.Sprintf("%s %s (subject: %s, source: %s, not_after: %s)", e.Type, e.ID,
+		e.Subject, e.Source, e.NotAfter.UTC().Format(time.RFC3339))
+}
+
+// ChainEntry returns the entry of an AS certificate chain. The chain expires
+// with the first certificate in the chain that expires.
+func ChainEntry(chain []*x509.Certificate, source string) Entry {
+	e := certEntry(chain[0], Chain, source)
+	for _, c := range chain[1:] {
+		if c.NotAfter.Before(e.NotAfter) {
+			e.NotAfter = c.NotAfter
+		}
+	}
+	return e
+}
+
+// CertificateEntry returns the entry of a single certificate. CA certificates
+// are of type CACertificate, all other certificates are of type Certificate.
+func CertificateEntry(cert *x509.Certificate, source string) Entry {
+	if ct, err := cppki.ValidateCert(cert); err == nil && ct == cppki.CA {
+		return certEntry(cert, CACertificate, source)
+	}
+	return certEntry(cert, Certificate, source)
+}
+
+// TRCEntry returns the entry of a TRC.
+func TRCEntry(trc *cppki.TRC, source string) Entry {
+	return Entry{
+		Type:     TRC,
+		Subject:  trc.ID.ISD.String(),
+		ID:       trc.ID.String(),
+		Source:   source,
+		NotAfter: trc.Validity.NotAfter,
+	}
+}
+
+func certEntry(cert *x509.Certificate, t Type, source string) Entry {
+	subject := cert.Subject.CommonName
+	if ia, err := cppki.ExtractIA(cert.Subject); err == nil {
+		subject = ia.String()
+	}
+	return Entry{
+		Type:     t,
+		Subject:  subject,
+		ID:       fmt.Sprintf("%x", cert.SubjectKeyId),
+		Source:   source,
+		NotAfter: cert.NotAfter,
+	}
+}
+
+// Report is the result of an expiry check.
+type Report struct {
+	// Time is the time the check was run at.
+	Time time.Time
+	// WarnBefore is the remaining validity below which material is
+	// considered expiring.
+	WarnBefore time.Duration
+	// Entries are the entries of all trust material that was found.
+	Entries []Entry
+	// Err is the error encountered during the check, if any. The report
+	// still contains the material that was found.
+	Err error
+}
+
+// Latest returns the entry of the given type that expires last. Trust
+// material that is superseded by material of the same type that is valid for
+// longer does not affect the health of the service.
+func (r Report) Latest(t Type) (Entry, bool) {
+	var latest Entry
+	var found bool
+	for _, e := range r.Entries {
+		if e.Type != t || (found && !e.NotAfter.After(latest.NotAfter)) {
+			continue
+		}
+		latest, found = e, true
+	}
+	return latest, found
+}
+
+// State returns the expiration state of the report at the given time. It is
+// the worst state of the latest entry of each type.
+func (r Report) State(now time.Time) State {
+	state := Valid
+	for _, t := range Types {
+		e, ok := r.Latest(t)
+		if !ok {
+			continue
+		}
+		switch e.State(now, r.WarnBefore) {
+		case Expired:
+			return Expired
+		case Expiring:
+			state = Expiring
+		}
+	}
+	return state
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expiry_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/scionproto/scion/private/trust/expiry"
+)
+
+func TestEntryState(t *testing.T) {
+	now := time.Now()
+	testCases := map[string]struct {
+		NotAfter time.Time
+		Expected expiry.State
+	}{
+		"valid": {
+			NotAfter: now.Add(100 * time.Hour),
+			Expected: expiry.Valid,
+		},
+		"expiring": {
+			NotAfter: now.Add(time.Hour),
+			Expected: expiry.Expiring,
+		},
+		"expired": {
+			NotAfter: now.Add(-time.Hour),
+			Expected: expiry.Expired,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			e := expiry.Entry{NotAfter: tc.NotAfter}
+			assert.Equal(t, tc.Expected, e.State(now, expiry.DefaultWarnBefore))
+		})
+	}
+}
+
+func TestReportState(t *testing.T) {
+	now := time.Now()
+	old := expiry.Entry{Type: expiry.Chain, ID: "old", NotAfter: now.Add(time.Hour)}
+	renewed := expiry.Entry{Type: expiry.Chain, ID: "new", NotAfter: now.Add(100 * time.Hour)}
+	trc := expiry.Entry{Type: expiry.TRC, ID: "trc", NotAfter: now.Add(100 * time.Hour)}
+	expiredTRC := expiry.Entry{Type: expiry.TRC, ID: "trc", NotAfter: now.Add(-time.Hour)}
+
+	testCases := map[string]struct {
+		Entries  []expiry.Entry
+		Expected expiry.State
+	}{
+		"empty": {
+			Expected: expiry.Valid,
+		},
+		"superseded chain": {
+			Entries:  []expiry.Entry{old, renewed, trc},
+			Expected: expiry.Valid,
+		},
+		"expiring chain": {
+			Entries:  []expiry.Entry{old, trc},
+			Expected: expiry.Expiring,
+		},
+		"expired trc": {
+			Entries:  []expiry.Entry{old, expiredTRC},
+			Expected: expiry.Expired,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := expiry.Report{
+				Time:       now,
+				WarnBefore: expiry.DefaultWarnBefore,
+				Entries:    tc.Entries,
+			}
+			assert.Equal(t, tc.Expected, r.State(now))
+		})
+	}
+}
+
+func TestReportLatest(t *testing.T) {
+	now := time.Now()
+	r := expiry.Report{
+		Entries: []expiry.Entry{
+			{Type: expiry.Chain, ID: "a", NotAfter: now.Add(time.Hour)},
+			{Type: expiry.Chain, ID: "b", NotAfter: now.Add(2 * time.Hour)},
+			{Type: expiry.TRC, ID: "c", NotAfter: now.Add(3 * time.Hour)},
+		},
+	}
+	e, ok := r.Latest(expiry.Chain)
+	assert.True(t, ok)
+	assert.Equal(t, "b", e.ID)
+	_, ok = r.Latest(expiry.CACertificate)
+	assert.False(t, ok)
+}
@@ -44,6 +44,9 @@ type Fetcher interface {
 	Chains(ctx context.Context, req ChainQuery, server net.Addr) ([][]*x509.Certificate, error)
 	// TRC fetches a specific TRC from the remote.
 	TRC(ctx context.Context, id cppki.TRCID, server net.Addr) (cppki.SignedTRC, error)
+	// CRLs fetches the certificate revocation lists of the CAs in the ISD
+	// from the remote.
+	CRLs(ctx context.Context, isd addr.ISD, server net.Addr) ([]CRL, error)
 }
 
 // FetchingProvider provides crypto material. The fetching provider is capable
@@ -68,6 +71,9 @@ type FetchingProvider struct {
 // this feature is not implemented. Thus, certificate chains that are fetched
 // over the network that are not verifiable against the active TRCs are ignored,
 // even if the AllowInactive option is set.
+//
+// Chains with an AS certificate that is revoked by a CRL in the database are
+// never returned. If all matching chains are revoked, ErrRevoked is returned.
 func (p FetchingProvider) GetChains(ctx context.Context, query ChainQuery,
 	opts ...Option) ([][]*x509.Certificate, error) {
 
@@ -100,8 +106,7 @@ func (p FetchingProvider) GetChains(ctx context.Context, query ChainQuery,
 	}
 
 	if o.allowInactive && len(chains) > 0 {
-		setProviderMetric(span, l.WithResult(metrics.Success), nil)
-		return chains, nil
+		return p.removeRevoked(ctx, span, l, chains)
 	}
 
 	trcs, result, err := activeTRCs(ctx, p.DB, query.IA.ISD())
@@ -114,8 +119,7 @@ func (p FetchingProvider) GetChains(ctx context.Context, query ChainQuery,
 
 	chains = filterVerifiableChains(chains, trcs)
 	if len(chains) > 0 {
-		setProviderMetric(span, l.WithResult(metrics.Success), nil)
-		return chains, nil
+		return p.removeRevoked(ctx, span, l, chains)
 	}
 
 	// No chain is available locally. Start fetching chains over the nework, if
@@ -150,8 +154,25 @@ func (p FetchingProvider) GetChains(ctx context.Context, query ChainQuery,
 		setProviderMetric(span, l.WithResult(metrics.ErrNotFound), nil)
 		return nil, nil
 	}
+	return p.removeRevoked(ctx, span, l, chains)
+}
+
+// removeRevoked removes the revoked chains and reports the result. If all
+// chains are revoked, ErrRevoked is returned.
+func (p FetchingProvider) removeRevoked(ctx context.Context, span opentracing.Span,
+	l metrics.ProviderLabels, chains [][]*x509.Certificate) ([][]*x509.Certificate, error) {
+
+	valid, err := filterRevoked(ctx, p.DB, chains)
+	if err != nil {
+		setProviderMetric(span, l.WithResult(metrics.ErrDB), err)
+		return nil, serrors.Wrap("checking revocation", err)
+	}
+	if len(valid) == 0 {
+		setProviderMetric(span, l.WithResult(metrics.ErrRevoked), ErrRevoked)
+		return nil, ErrRevoked
+	}
 	setProviderMetric(span, l.WithResult(metrics.Success), nil)
-	return chains, nil
+	return valid, nil
 }
 
 // GetSignedTRC returns the signed TRC. Currently, this method only uses the DB
@@ -233,6 +254,52 @@ func (p FetchingProvider) NotifyTRC(ctx context.Context, id cppki.TRCID, opts ..
 	return nil
 }
 
+// UpdateCRLs fetches the certificate revocation lists of the CAs in the ISD
+// and inserts the ones that are verifiable and newer than the ones in the
+// database. It returns the number of inserted CRLs.
+func (p FetchingProvider) UpdateCRLs(ctx context.Context, isd addr.ISD,
+	opts ...Option) (int, error) {
+
+	l := metrics.ProviderLabels{Type: metrics.CRL, Trigger: metrics.FromCtx(ctx)}
+	o := applyOptions(opts)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "trustengine.update_crls")
+	defer span.Finish()
+	opentracingext.Component.Set(span, "trust")
+	span.SetTag("isd", isd)
+
+	logger := log.FromCtx(ctx)
+	var err error
+	if o.server == nil {
+		if o.server, err = p.Router.ChooseServer(ctx, isd); err != nil {
+			setProviderMetric(span, l.WithResult(metrics.ErrInternal), err)
+			return 0, serrors.Wrap("choosing server", err)
+		}
+	}
+	crls, err := p.Fetcher.CRLs(ctx, isd, o.server)
+	if err != nil {
+		setProviderMetric(span, l.WithResult(metrics.ErrInternal), err)
+		return 0, serrors.Wrap("fetching CRLs from remote", err, "server", o.server)
+	}
+	var inserted int
+	for _, crl := range crls {
+		if err := VerifyCRL(ctx, p.DB, crl); err != nil {
+			logger.Info("Ignoring unverifiable CRL", "isd", isd, "err", err)
+			continue
+		}
+		ok, err := p.DB.InsertCRL(ctx, crl)
+		if err != nil {
+			setProviderMetric(span, l.WithResult(metrics.ErrDB), err)
+			return inserted, serrors.Wrap("inserting CRL", err)
+		}
+		if ok {
+			inserted++
+		}
+	}
+	setProviderMetric(span, l.WithResult(metrics.Success), nil)
+	return inserted, nil
+}
+
 func activeTRCs(ctx context.Context, db DB, isd addr.ISD) ([]cppki.SignedTRC, string, error) {
 	trc, err := db.SignedTRC(ctx, cppki.TRCID{
 		ISD:    isd,
@@ -17,6 +17,7 @@ package trust_test
 import (
 	"context"
 	"crypto/x509"
+	"math/big"
 	"net"
 	"path/filepath"
 	"testing"
@@ -24,6 +25,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/scionproto/scion/pkg/addr"
 	"github.com/scionproto/scion/pkg/private/serrors"
@@ -42,6 +44,17 @@ func TestFetchingProviderGetChains(t *testing.T) {
 	inactive[0].NotAfter = time.Now().Add(-time.Second)
 	all := append([][]*x509.Certificate{valid}, inactive)
 
+	caDir := filepath.Join(dir, "ISD1/ASff00_0_110/crypto/ca")
+	ca := xtest.LoadChain(t, filepath.Join(caDir, "ISD1-ASff00_0_110.ca.crt"))
+	crl, err := cppki.CAPolicy{
+		Certificate: ca[0],
+		Signer:      xtest.LoadSigner(t, filepath.Join(caDir, "cp-ca.key")),
+	}.CreateCRL(big.NewInt(1), []x509.RevocationListEntry{
+		{SerialNumber: valid[0].SerialNumber, RevocationTime: time.Now()},
+	}, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	revoked := trust.CRL{RevocationList: crl, CA: ca[0]}
+
 	now := time.Now()
 	query := trust.ChainQuery{
 		IA:           addr.MustParseIA("1-ff00:0:110"),
@@ -83,6 +96,7 @@ func TestFetchingProviderGetChains(t *testing.T) {
 		"chain in database, allow inactive, subject key ID not set": {
 			DB: func(t *testing.T, ctrl *gomock.Controller) trust.DB {
 				db := mock_trust.NewMockDB(ctrl)
+				db.EXPECT().CRLs(gomock.Any(), gomock.Any()).AnyTimes()
 				db.EXPECT().Chains(gomock.Any(), chainQueryMatcher{
 					ia: query.IA,
 				}).Return(all, nil)
@@ -108,6 +122,7 @@ func TestFetchingProviderGetChains(t *testing.T) {
 		"chain in database, allow inactive, time not set": {
 			DB: func(t *testing.T, ctrl *gomock.Controller) trust.DB {
 				db := mock_trust.NewMockDB(ctrl)
+				db.EXPECT().CRLs(gomock.Any(), gomock.Any()).AnyTimes()
 				db.EXPECT().Chains(gomock.Any(), chainQueryMatcher{
 					ia:   query.IA,
 					skid: query.SubjectKeyID,
@@ -134,6 +149,7 @@ func TestFetchingProviderGetChains(t *testing.T) {
 		"chain in database, allow inactive": {
 			DB: func(t *testing.T, ctrl *gomock.Controller) trust.DB {
 				db := mock_trust.NewMockDB(ctrl)
+				db.EXPECT().CRLs(gomock.Any(), gomock.Any()).AnyTimes()
 				db.EXPECT().Chains(gomock.Any(), chainQueryMatcher{
 					ia:   query.IA,
 					skid: query.SubjectKeyID,
@@ -157,6 +173,7 @@ func TestFetchingProviderGetChains(t *testing.T) {
 		"chain in database, no inactive": {
 			DB: func(t *testing.T, ctrl *gomock.Controller) trust.DB {
 				db := mock_trust.NewMockDB(ctrl)
+				db.EXPECT().CRLs(gomock.Any(), gomock.Any()).AnyTimes()
 				db.EXPECT().Chains(gomock.Any(), chainQueryMatcher{
 					ia:   query.IA,
 					skid: query.SubjectKeyID,
@@ -178,9 +195,39 @@ func TestFetchingProviderGetChains(t *testing.T) {
 			ErrAssertion:   assert.NoError,
 			ExpectedChains: [][]*x509.Certificate{valid},
 		},
+		"chain in database, revoked": {
+			DB: func(t *testing.T, ctrl *gomock.Controller) trust.DB {
+				db := mock_trust.NewMockDB(ctrl)
+				db.EXPECT().CRLs(gomock.Any(), trust.CRLQuery{
+					ISD:            query.IA.ISD(),
+					AuthorityKeyID: valid[0].AuthorityKeyId,
+				}).Return([]trust.CRL{revoked}, nil).AnyTimes()
+				db.EXPECT().Chains(gomock.Any(), chainQueryMatcher{
+					ia:   query.IA,
+					skid: query.SubjectKeyID,
+				}).Return(all, nil)
+				db.EXPECT().SignedTRC(gomock.Any(), gomock.Any()).Return(trc, nil)
+				return db
+			},
+			Recurser: func(t *testing.T, ctrl *gomock.Controller) trust.Recurser {
+				return mock_trust.NewMockRecurser(ctrl)
+			},
+			Router: func(t *testing.T, ctrl *gomock.Controller) trust.Router {
+				return mock_trust.NewMockRouter(ctrl)
+			},
+			Fetcher: func(t *testing.T, ctrl *gomock.Controller) trust.Fetcher {
+				return mock_trust.NewMockFetcher(ctrl)
+			},
+			Query:   query,
+			Options: []trust.Option{},
+			ErrAssertion: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, trust.ErrRevoked)
+			},
+		},
 		"chain remote, allow inactive": {
 			DB: func(t *testing.T, ctrl *gomock.Controller) trust.DB {
 				db := mock_trust.NewMockDB(ctrl)
+				db.EXPECT().CRLs(gomock.Any(), gomock.Any()).AnyTimes()
 				db.EXPECT().Chains(gomock.Any(), chainQueryMatcher{
 					ia:   query.IA,
 					skid: query.SubjectKeyID,
@@ -216,6 +263,7 @@ func TestFetchingProviderGetChains(t *testing.T) {
 		"chain remote, no inactive": {
 			DB: func(t *testing.T, ctrl *gomock.Controller) trust.DB {
 				db := mock_trust.NewMockDB(ctrl)
+				db.EXPECT().CRLs(gomock.Any(), gomock.Any()).AnyTimes()
 				db.EXPECT().Chains(gomock.Any(), chainQueryMatcher{
 					ia:   query.IA,
 					skid: query.SubjectKeyID,
@@ -245,6 +293,7 @@ func TestFetchingProviderGetChains(t *testing.T) {
 		"no chain found": {
 			DB: func(t *testing.T, ctrl *gomock.Controller) trust.DB {
 				db := mock_trust.NewMockDB(ctrl)
+				db.EXPECT().CRLs(gomock.Any(), gomock.Any()).AnyTimes()
 				db.EXPECT().Chains(gomock.Any(), chainQueryMatcher{
 					ia:   query.IA,
 					skid: query.SubjectKeyID,
@@ -272,6 +321,7 @@ func TestFetchingProviderGetChains(t *testing.T) {
 		"TRC from db fails": {
 			DB: func(t *testing.T, ctrl *gomock.Controller) trust.DB {
 				db := mock_trust.NewMockDB(ctrl)
+				db.EXPECT().CRLs(gomock.Any(), gomock.Any()).AnyTimes()
 				db.EXPECT().Chains(gomock.Any(), gomock.Any()).Return(nil, nil)
 				db.EXPECT().SignedTRC(gomock.Any(), gomock.Any()).Return(
 					cppki.SignedTRC{}, serrors.New("internal"))
@@ -292,6 +342,7 @@ func TestFetchingProviderGetChains(t *testing.T) {
 		"TRC not in db": {
 			DB: func(t *testing.T, ctrl *gomock.Controller) trust.DB {
 				db := mock_trust.NewMockDB(ctrl)
+				db.EXPECT().CRLs(gomock.Any(), gomock.Any()).AnyTimes()
 				db.EXPECT().Chains(gomock.Any(), gomock.Any()).Return(nil, nil)
 				db.EXPECT().SignedTRC(gomock.Any(), gomock.Any()).Return(cppki.SignedTRC{}, nil)
 				return db
@@ -311,6 +362,7 @@ func TestFetchingProviderGetChains(t *testing.T) {
 		"chains from db fails": {
 			DB: func(t *testing.T, ctrl *gomock.Controller) trust.DB {
 				db := mock_trust.NewMockDB(ctrl)
+				db.EXPECT().CRLs(gomock.Any(), gomock.Any()).AnyTimes()
 				db.EXPECT().Chains(gomock.Any(), gomock.Any()).Return(nil, serrors.New("internal"))
 				return db
 			},
@@ -329,6 +381,7 @@ func TestFetchingProviderGetChains(t *testing.T) {
 		"recursion not allowed": {
 			DB: func(t *testing.T, ctrl *gomock.Controller) trust.DB {
 				db := mock_trust.NewMockDB(ctrl)
+				db.EXPECT().CRLs(gomock.Any(), gomock.Any()).AnyTimes()
 				db.EXPECT().Chains(gomock.Any(), gomock.Any()).Return(nil, nil)
 				db.EXPECT().SignedTRC(gomock.Any(), gomock.Any()).Return(trc, nil)
 				return db
@@ -350,6 +403,7 @@ func TestFetchingProviderGetChains(t *testing.T) {
 		"router fails": {
 			DB: func(t *testing.T, ctrl *gomock.Controller) trust.DB {
 				db := mock_trust.NewMockDB(ctrl)
+				db.EXPECT().CRLs(gomock.Any(), gomock.Any()).AnyTimes()
 				db.EXPECT().Chains(gomock.Any(), gomock.Any()).Return(nil, nil)
 				db.EXPECT().SignedTRC(gomock.Any(), gomock.Any()).Return(trc, nil)
 				return db
@@ -374,6 +428,7 @@ func TestFetchingProviderGetChains(t *testing.T) {
 		"fetcher fails": {
 			DB: func(t *testing.T, ctrl *gomock.Controller) trust.DB {
 				db := mock_trust.NewMockDB(ctrl)
+				db.EXPECT().CRLs(gomock.Any(), gomock.Any()).AnyTimes()
 				db.EXPECT().Chains(gomock.Any(), gomock.Any()).Return(nil, nil)
 				db.EXPECT().SignedTRC(gomock.Any(), gomock.Any()).Return(trc, nil)
 				return db
@@ -399,6 +454,7 @@ func TestFetchingProviderGetChains(t *testing.T) {
 		"insert fails": {
 			DB: func(t *testing.T, ctrl *gomock.Controller) trust.DB {
 				db := mock_trust.NewMockDB(ctrl)
+				db.EXPECT().CRLs(gomock.Any(), gomock.Any()).AnyTimes()
 				db.EXPECT().Chains(gomock.Any(), gomock.Any()).Return(nil, nil)
 				db.EXPECT().SignedTRC(gomock.Any(), gomock.Any()).Return(trc, nil)
 				db.EXPECT().InsertChain(gomock.Any(), valid).Return(
@@ -425,6 +481,7 @@ func TestFetchingProviderGetChains(t *testing.T) {
 		"reject forged chain": {
 			DB: func(t *testing.T, ctrl *gomock.Controller) trust.DB {
 				db := mock_trust.NewMockDB(ctrl)
+				db.EXPECT().CRLs(gomock.Any(), gomock.Any()).AnyTimes()
 				db.EXPECT().Chains(gomock.Any(), chainQueryMatcher{
 					ia:   query.IA,
 					skid: query.SubjectKeyID,
@@ -0,0 +1,103 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/grpc"
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	cppb "github.com/scionproto/scion/pkg/proto/control_plane"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	"github.com/scionproto/scion/pkg/snet"
+	"github.com/scionproto/scion/private/trust"
+)
+
+// TRCNotifier is notified about the existence of TRCs.
+type TRCNotifier interface {
+	// NotifyTRC notifies the notifier of the existence of a TRC. Missing TRCs
+	// are fetched and verified against their predecessor.
+	NotifyTRC(ctx context.Context, id cppki.TRCID, opts ...trust.Option) error
+}
+
+// TRCAnnouncementServer handles TRC announcements.
+type TRCAnnouncementServer struct {
+	// Notifier is notified about the announced TRCs.
+	Notifier TRCNotifier
+}
+
+// TRCAnnouncement fetches the announced TRC, if it is newer than the latest
+// TRC of the ISD that is known locally. TRCs announced by control services in
+// other ASes are fetched from the announcing control service. TRCs announced
+// from within the AS are resolved through the regular lookup.
+func (s TRCAnnouncementServer) TRCAnnouncement(
+	ctx context.Context,
+	req *cppb.TRCAnnouncementRequest,
+) (*cppb.TRCAnnouncementResponse, error) {
+
+	logger := log.FromCtx(ctx)
+	id, err := announcementToID(req)
+	if err != nil {
+		logger.Debug("Invalid TRC announcement", "err", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var server net.Addr
+	if p, ok := peer.FromContext(ctx); ok {
+		if a, ok := p.Addr.(*snet.UDPAddr); ok {
+			server = &snet.SVCAddr{
+				IA:      a.IA,
+				Path:    a.Path,
+				NextHop: a.NextHop,
+				SVC:     addr.SvcCS,
+			}
+		}
+	}
+	logger.Debug("Received TRC announcement", "id", id, "server", server)
+	if err := s.Notifier.NotifyTRC(ctx, id, trust.Server(server)); err != nil {
+		logger.Debug("Failed to resolve announced TRC", "id", id, "err", err)
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return &cppb.TRCAnnouncementResponse{}, nil
+}
+
+// TRCAnnouncementSender sends TRC announcements.
+type TRCAnnouncementSender struct {
+	// Dialer dials a new gRPC connection.
+	Dialer grpc.Dialer
+}
+
+// SendTRCAnnouncement announces the TRC to the remote.
+func (s TRCAnnouncementSender) SendTRCAnnouncement(
+	ctx context.Context,
+	id cppki.TRCID,
+	remote net.Addr,
+) error {
+
+	conn, err := s.Dialer.Dial(ctx, remote)
+	if err != nil {
+		return serrors.Wrap("dialing gRPC conn", err)
+	}
+	defer conn.Close()
+	client := cppb.NewTRCAnnouncementServiceClient(conn)
+	_, err = client.TRCAnnouncement(ctx, idToAnnouncement(id), grpc.RetryProfile...)
+	return err
+}
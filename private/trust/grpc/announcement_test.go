@@ -0,0 +1,102 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	libgrpc "github.com/scionproto/scion/pkg/grpc"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	cppb "github.com/scionproto/scion/pkg/proto/control_plane"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	"github.com/scionproto/scion/private/trust"
+	trustgrpc "github.com/scionproto/scion/private/trust/grpc"
+)
+
+func TestTRCAnnouncementServer(t *testing.T) {
+	testCases := map[string]struct {
+		Request   *cppb.TRCAnnouncementRequest
+		NotifyErr error
+		Expected  []cppki.TRCID
+		Code      codes.Code
+	}{
+		"valid": {
+			Request:  &cppb.TRCAnnouncementRequest{Isd: 1, Base: 1, Serial: 2},
+			Expected: []cppki.TRCID{{ISD: 1, Base: 1, Serial: 2}},
+			Code:     codes.OK,
+		},
+		"wildcard ISD": {
+			Request: &cppb.TRCAnnouncementRequest{Isd: 0, Base: 1, Serial: 2},
+			Code:    codes.InvalidArgument,
+		},
+		"latest": {
+			Request: &cppb.TRCAnnouncementRequest{Isd: 1},
+			Code:    codes.InvalidArgument,
+		},
+		"notify error": {
+			Request:   &cppb.TRCAnnouncementRequest{Isd: 1, Base: 1, Serial: 3},
+			NotifyErr: serrors.New("verification failed"),
+			Expected:  []cppki.TRCID{{ISD: 1, Base: 1, Serial: 3}},
+			Code:      codes.Unavailable,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			n := &recordingNotifier{err: tc.NotifyErr}
+			s := trustgrpc.TRCAnnouncementServer{Notifier: n}
+			_, err := s.TRCAnnouncement(context.Background(), tc.Request)
+			assert.Equal(t, tc.Code, status.Code(err))
+			assert.Equal(t, tc.Expected, n.ids)
+		})
+	}
+}
+
+func TestTRCAnnouncementSender(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	n := &recordingNotifier{}
+	server := grpc.NewServer()
+	cppb.RegisterTRCAnnouncementServiceServer(server,
+		trustgrpc.TRCAnnouncementServer{Notifier: n})
+	go func() { _ = server.Serve(lis) }()
+	defer server.Stop()
+
+	s := trustgrpc.TRCAnnouncementSender{Dialer: libgrpc.SimpleDialer{}}
+	id := cppki.TRCID{ISD: 1, Base: 1, Serial: 2}
+	err = s.SendTRCAnnouncement(context.Background(), id, lis.Addr())
+	require.NoError(t, err)
+	assert.Equal(t, []cppki.TRCID{id}, n.ids)
+}
+
+type recordingNotifier struct {
+	ids []cppki.TRCID
+	err error
+}
+
+func (n *recordingNotifier) NotifyTRC(_ context.Context, id cppki.TRCID,
+	_ ...trust.Option) error {
+
+	n.ids = append(n.ids, id)
+	return n.err
+}
@@ -143,6 +143,50 @@ func (f Fetcher) TRC(ctx context.Context, id cppki.TRCID,
 	return trc, nil
 }
 
+// CRLs fetches the certificate revocation lists of the CAs in the ISD over the
+// network. The CRLs are validated, but not verified.
+func (f Fetcher) CRLs(ctx context.Context, isd addr.ISD,
+	server net.Addr) ([]trust.CRL, error) {
+
+	labels := requestLabels{
+		Type:    trustmetrics.CRLReq,
+		Trigger: trustmetrics.FromCtx(ctx),
+		Peer:    trustmetrics.PeerToLabel(server, f.IA),
+	}
+	span, ctx := addCRLsSpan(ctx, isd)
+	defer span.Finish()
+
+	logger := log.FromCtx(ctx)
+	logger.Debug("Fetch CRLs from remote", "isd", isd, "server", server)
+
+	conn, err := f.Dialer.Dial(ctx, server)
+	if err != nil {
+		f.updateMetric(span, labels.WithResult(trustmetrics.ErrTransmit), err)
+		return nil, serrors.Wrap("dialing", err)
+	}
+	defer conn.Close()
+	client := cppb.NewTrustMaterialServiceClient(conn)
+	rep, err := client.CRLs(ctx, &cppb.CRLsRequest{Isd: uint32(isd)}, grpc.RetryProfile...)
+	if err != nil {
+		f.updateMetric(span, labels.WithResult(trustmetrics.ErrTransmit), err)
+		return nil, serrors.Wrap("receiving CRLs", err)
+	}
+
+	crls, res, err := repToCRLs(rep.Crls)
+	if err != nil {
+		f.updateMetric(span, labels.WithResult(res), err)
+		return nil, err
+	}
+	logger.Debug("Received CRLs from remote", "isd", isd, "crls", len(crls))
+
+	if err := checkCRLsMatchISD(isd, crls); err != nil {
+		f.updateMetric(span, labels.WithResult(trustmetrics.ErrMismatch), err)
+		return nil, serrors.Wrap("CRLs do not match query", err)
+	}
+	f.updateMetric(span, labels.WithResult(trustmetrics.Success), nil)
+	return crls, nil
+}
+
 func (f Fetcher) updateMetric(span opentracing.Span, l requestLabels, err error) {
 	if f.Requests != nil {
 		f.Requests.With(l.Expand()...).Add(1)
@@ -175,6 +219,27 @@ func addTRCSpan(ctx context.Context,
 	return span, ctx
 }
 
+func addCRLsSpan(ctx context.Context, isd addr.ISD) (opentracing.Span, context.Context) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "trustengine.fetch_crls")
+	tracing.Component(span, "trust")
+	span.SetTag("query.isd", isd)
+	span.SetTag("msgr.stack", "grpc")
+	return span, ctx
+}
+
+func checkCRLsMatchISD(isd addr.ISD, crls []trust.CRL) error {
+	for i, crl := range crls {
+		ia, err := cppki.ExtractIA(crl.CA.Subject)
+		if err != nil {
+			return serrors.Wrap("extracting ISD-AS", err, "index", i)
+		}
+		if ia.ISD() != isd {
+			return serrors.New("ISD mismatch", "index", i, "expected", isd, "actual", ia.ISD())
+		}
+	}
+	return nil
+}
+
 func checkChainsMatchQuery(query trust.ChainQuery, chains [][]*x509.Certificate) error {
 	for i, chain := range chains {
 		ia, err := cppki.ExtractIA(chain[0].Subject)
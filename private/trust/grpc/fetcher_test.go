@@ -17,6 +17,7 @@ package grpc_test
 import (
 	"context"
 	"crypto/x509"
+	"math/big"
 	"net"
 	"os"
 	"path/filepath"
@@ -267,3 +268,95 @@ func TestFetcherTRC(t *testing.T) {
 		})
 	}
 }
+
+func TestFetcherCRLs(t *testing.T) {
+	dir := genCrypto(t)
+	caDir := filepath.Join(dir, "ISD1/ASff00_0_110/crypto/ca")
+	ca := xtest.LoadChain(t, filepath.Join(caDir, "ISD1-ASff00_0_110.ca.crt"))[0]
+	list, err := cppki.CAPolicy{
+		Certificate: ca,
+		Signer:      xtest.LoadSigner(t, filepath.Join(caDir, "cp-ca.key")),
+	}.CreateCRL(big.NewInt(1), nil, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	crl := trust.CRL{RevocationList: list, CA: ca}
+
+	testCases := map[string]struct {
+		Server    func(*gomock.Controller) *mock_cp.MockTrustMaterialServiceServer
+		ISD       addr.ISD
+		Assertion assert.ErrorAssertionFunc
+		Expected  []trust.CRL
+	}{
+		"RPC fail": {
+			Server: func(mctrl *gomock.Controller) *mock_cp.MockTrustMaterialServiceServer {
+				srv := mock_cp.NewMockTrustMaterialServiceServer(mctrl)
+				srv.EXPECT().CRLs(gomock.Any(), gomock.Any()).Return(
+					nil, serrors.New("internal"),
+				)
+				return srv
+			},
+			ISD:       1,
+			Assertion: assert.Error,
+		},
+		"garbage CRL": {
+			Server: func(mctrl *gomock.Controller) *mock_cp.MockTrustMaterialServiceServer {
+				srv := mock_cp.NewMockTrustMaterialServiceServer(mctrl)
+				srv.EXPECT().CRLs(gomock.Any(), gomock.Any()).Return(
+					&cppb.CRLsResponse{Crls: []*cppb.CRL{
+						{Crl: []byte("garbage"), CaCert: ca.Raw},
+					}},
+					nil,
+				)
+				return srv
+			},
+			ISD:       1,
+			Assertion: assert.Error,
+		},
+		"mismatching ISD": {
+			Server: func(mctrl *gomock.Controller) *mock_cp.MockTrustMaterialServiceServer {
+				srv := mock_cp.NewMockTrustMaterialServiceServer(mctrl)
+				srv.EXPECT().CRLs(gomock.Any(), gomock.Any()).Return(
+					&cppb.CRLsResponse{Crls: []*cppb.CRL{
+						{Crl: list.Raw, CaCert: ca.Raw},
+					}},
+					nil,
+				)
+				return srv
+			},
+			ISD:       2,
+			Assertion: assert.Error,
+		},
+		"valid CRLs": {
+			Server: func(mctrl *gomock.Controller) *mock_cp.MockTrustMaterialServiceServer {
+				srv := mock_cp.NewMockTrustMaterialServiceServer(mctrl)
+				srv.EXPECT().CRLs(gomock.Any(), gomock.Any()).Return(
+					&cppb.CRLsResponse{Crls: []*cppb.CRL{
+						{Crl: list.Raw, CaCert: ca.Raw},
+					}},
+					nil,
+				)
+				return srv
+			},
+			ISD:       1,
+			Assertion: assert.NoError,
+			Expected:  []trust.CRL{crl},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mctrl := gomock.NewController(t)
+
+			svc := xtest.NewGRPCService()
+			cppb.RegisterTrustMaterialServiceServer(svc.Server(), tc.Server(mctrl))
+			svc.Start(t)
+
+			f := trustgrpc.Fetcher{Dialer: svc}
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			crls, err := f.CRLs(ctx, tc.ISD, &net.UDPAddr{})
+			tc.Assertion(t, err)
+			assert.Equal(t, tc.Expected, crls)
+		})
+	}
+}
@@ -19,8 +19,10 @@ import (
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/scionproto/scion/pkg/addr"
 	"github.com/scionproto/scion/pkg/private/serrors"
 	cppb "github.com/scionproto/scion/pkg/proto/control_plane"
+	"github.com/scionproto/scion/pkg/scrypto"
 	"github.com/scionproto/scion/pkg/scrypto/cppki"
 	"github.com/scionproto/scion/private/trust"
 	trustmetrics "github.com/scionproto/scion/private/trust/internal/metrics"
@@ -61,3 +63,46 @@ func idToReq(id cppki.TRCID) *cppb.TRCRequest {
 		Serial: uint64(id.Serial),
 	}
 }
+
+func idToAnnouncement(id cppki.TRCID) *cppb.TRCAnnouncementRequest {
+	return &cppb.TRCAnnouncementRequest{
+		Isd:    uint32(id.ISD),
+		Base:   uint64(id.Base),
+		Serial: uint64(id.Serial),
+	}
+}
+
+func announcementToID(req *cppb.TRCAnnouncementRequest) (cppki.TRCID, error) {
+	if req.Isd > uint32(addr.MaxISD) {
+		return cppki.TRCID{}, serrors.New("announced ISD not in range",
+			"max", addr.MaxISD, "isd", req.Isd)
+	}
+	id := cppki.TRCID{
+		ISD:    addr.ISD(req.Isd),
+		Base:   scrypto.Version(req.Base),
+		Serial: scrypto.Version(req.Serial),
+	}
+	if err := id.Validate(); err != nil {
+		return cppki.TRCID{}, err
+	}
+	return id, nil
+}
+
+func repToCRLs(pbCRLs []*cppb.CRL) ([]trust.CRL, string, error) {
+	crls := make([]trust.CRL, 0, len(pbCRLs))
+	for _, c := range pbCRLs {
+		list, err := x509.ParseRevocationList(c.Crl)
+		if err != nil {
+			return nil, trustmetrics.ErrParse, serrors.Wrap("parsing CRL", err)
+		}
+		ca, err := x509.ParseCertificate(c.CaCert)
+		if err != nil {
+			return nil, trustmetrics.ErrParse, serrors.Wrap("parsing CA certificate", err)
+		}
+		if err := cppki.ValidateCRL(list); err != nil {
+			return nil, trustmetrics.ErrValidate, err
+		}
+		crls = append(crls, trust.CRL{RevocationList: list, CA: ca})
+	}
+	return crls, "", nil
+}
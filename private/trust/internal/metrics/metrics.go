@@ -29,12 +29,14 @@ const Namespace = "trustengine"
 const (
 	Chain = "chain"
 	TRC   = "trc"
+	CRL   = "crl"
 )
 
 // Request types
 const (
 	TRCReq    = "trc_request"
 	ChainReq  = "chain_request"
+	CRLReq    = "crl_request"
 	NotifyTRC = "trc_notify"
 	LatestTRC = "latest_trc_number"
 )
@@ -61,6 +63,7 @@ const (
 	ErrNotAllowed = "err_not_allowed"
 	ErrNotFound   = "err_not_found"
 	ErrParse      = prom.ErrParse
+	ErrRevoked    = "err_revoked"
 	ErrTransmit   = "err_transmit"
 	ErrValidate   = prom.ErrValidate
 	ErrVerify     = prom.ErrVerify
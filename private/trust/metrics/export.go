@@ -33,4 +33,19 @@ var (
 		},
 		[]string{"type", prom.LabelResult},
 	)
+	RevokedChainChecksTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "trustengine_revoked_chain_checks_total",
+			Help: "Total number of certificate chain checks that failed because " +
+				"the certificate chain is revoked.",
+		},
+	)
+	TimeToExpirySeconds = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "trustengine_time_to_expiry_seconds",
+			Help: "Remaining validity of the trust material of the local AS in seconds. " +
+				"Negative if the material has expired.",
+		},
+		[]string{"type", "subject", "id"},
+	)
 )
@@ -40,6 +40,21 @@ func (m *MockDB) EXPECT() *MockDBMockRecorder {
 	return m.recorder
 }
 
+// CRLs mocks base method.
+func (m *MockDB) CRLs(arg0 context.Context, arg1 trust.CRLQuery) ([]trust.CRL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CRLs", arg0, arg1)
+	ret0, _ := ret[0].([]trust.CRL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CRLs indicates an expected call of CRLs.
+func (mr *MockDBMockRecorder) CRLs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CRLs", reflect.TypeOf((*MockDB)(nil).CRLs), arg0, arg1)
+}
+
 // Chains mocks base method.
 func (m *MockDB) Chains(arg0 context.Context, arg1 trust.ChainQuery) ([][]*x509.Certificate, error) {
 	m.ctrl.T.Helper()
@@ -55,6 +70,21 @@ func (mr *MockDBMockRecorder) Chains(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Chains", reflect.TypeOf((*MockDB)(nil).Chains), arg0, arg1)
 }
 
+// InsertCRL mocks base method.
+func (m *MockDB) InsertCRL(arg0 context.Context, arg1 trust.CRL) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertCRL", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertCRL indicates an expected call of InsertCRL.
+func (mr *MockDBMockRecorder) InsertCRL(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertCRL", reflect.TypeOf((*MockDB)(nil).InsertCRL), arg0, arg1)
+}
+
 // InsertChain mocks base method.
 func (m *MockDB) InsertChain(arg0 context.Context, arg1 []*x509.Certificate) (bool, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +153,21 @@ func (m *MockFetcher) EXPECT() *MockFetcherMockRecorder {
 	return m.recorder
 }
 
+// CRLs mocks base method.
+func (m *MockFetcher) CRLs(arg0 context.Context, arg1 addr.ISD, arg2 net.Addr) ([]trust.CRL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CRLs", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]trust.CRL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CRLs indicates an expected call of CRLs.
+func (mr *MockFetcherMockRecorder) CRLs(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CRLs", reflect.TypeOf((*MockFetcher)(nil).CRLs), arg0, arg1, arg2)
+}
+
 // Chains mocks base method.
 func (m *MockFetcher) Chains(arg0 context.Context, arg1 trust.ChainQuery, arg2 net.Addr) ([][]*x509.Certificate, error) {
 	m.ctrl.T.Helper()
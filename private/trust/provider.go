@@ -32,8 +32,8 @@ type Provider interface {
 	// GetChains returns certificate chains that match the chain query. If no
 	// chain is locally available, the provider can resolve them over the
 	// network. By default, the provider only returns certificate chains that
-	// are verifiable with the currently active TRCs. To configure the behavior,
-	// options can be provided.
+	// are verifiable with the currently active TRCs and that are not revoked.
+	// To configure the behavior, options can be provided.
 	GetChains(context.Context, ChainQuery, ...Option) ([][]*x509.Certificate, error)
 	// GetSignedTRC returns the TRC with the given ID. If the TRC is not
 	// available, the provider can resolve it over the network.
@@ -16,6 +16,7 @@ package trust
 
 import (
 	"context"
+	"crypto/x509"
 	"encoding/pem"
 	"errors"
 	"fmt"
@@ -174,6 +175,70 @@ func loadTRCs(
 	return res, nil
 }
 
+// LoadCRLs loads all *.crl files located in a directory in the database. Every
+// file must contain a PEM-encoded CRL followed by the PEM-encoded certificate of
+// the CA that issued it. CRLs that are not verifiable against the active TRCs,
+// and CRLs that are not newer than the CRL of the same CA in the database are
+// ignored.
+func LoadCRLs(ctx context.Context, dir string, db DB) (LoadResult, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return LoadResult{}, serrors.Wrap("stating directory", err, "dir", dir)
+	}
+
+	files, err := filepath.Glob(fmt.Sprintf("%s/*.crl", dir))
+	if err != nil {
+		return LoadResult{}, serrors.Wrap("searching for CRLs", err, "dir", dir)
+	}
+
+	res := LoadResult{Ignored: map[string]error{}}
+	for _, f := range files {
+		raw, err := os.ReadFile(f)
+		if err != nil {
+			return res, serrors.Wrap("reading CRL", err, "file", f)
+		}
+		crl, err := ParsePEMCRL(raw)
+		if err != nil {
+			res.Ignored[f] = err
+			continue
+		}
+		if err := VerifyCRL(ctx, db, crl); err != nil {
+			res.Ignored[f] = err
+			continue
+		}
+		inserted, err := db.InsertCRL(ctx, crl)
+		if err != nil {
+			return res, serrors.Wrap("inserting CRL", err, "file", f)
+		}
+		if !inserted {
+			res.Ignored[f] = ErrAlreadyExists
+			continue
+		}
+		res.Loaded = append(res.Loaded, f)
+	}
+	return res, nil
+}
+
+// ParsePEMCRL parses a PEM-encoded CRL that is followed by the PEM-encoded
+// certificate of the CA that issued it.
+func ParsePEMCRL(raw []byte) (CRL, error) {
+	block, rest := pem.Decode(raw)
+	if block == nil || block.Type != "X509 CRL" {
+		return CRL{}, serrors.New("no PEM-encoded CRL found")
+	}
+	list, err := x509.ParseRevocationList(block.Bytes)
+	if err != nil {
+		return CRL{}, serrors.Wrap("parsing CRL", err)
+	}
+	certs, err := cppki.ParsePEMCerts(rest)
+	if err != nil {
+		return CRL{}, serrors.Wrap("parsing CA certificate", err)
+	}
+	if len(certs) != 1 {
+		return CRL{}, serrors.New("expected exactly one CA certificate", "actual", len(certs))
+	}
+	return CRL{RevocationList: list, CA: certs[0]}, nil
+}
+
 // TRCLoader loads TRCs from a directory and stores them in the database. It
 // tracks files that it has already loaded and does not load them again.
 type TRCLoader struct {
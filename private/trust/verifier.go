@@ -17,6 +17,7 @@ package trust
 import (
 	"context"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"math/rand/v2"
 	"net"
@@ -107,6 +108,13 @@ func (v Verifier) Verify(ctx context.Context, signedMsg *cryptopb.SignedMessage,
 		Validity:     v.BoundValidity,
 	}
 	chains, err := v.getChains(ctx, query)
+	if errors.Is(err, ErrRevoked) {
+		metrics.Verifier.Verify(l.WithResult(metrics.ErrRevoked)).Inc()
+		return nil, serrors.Wrap("certificate chains revoked", err,
+			"query.isd_as", query.IA,
+			"query.subject_key_id", fmt.Sprintf("%x", query.SubjectKeyID),
+		)
+	}
 	if err != nil {
 		metrics.Verifier.Verify(l.WithResult(metrics.ErrInternal)).Inc()
 		return nil, serrors.Wrap("getting chains", err,
@@ -31,4 +31,6 @@ type Metrics struct {
 	Up prometheus.Gauge
 	// StateChanges reports the total number of state changes of the session.
 	StateChanges prometheus.Counter
+	// RTT reports the smoothed round-trip time of the link in seconds, or 0 if it is unknown.
+	RTT prometheus.Gauge
 }
@@ -22,6 +22,7 @@ import (
 	"math"
 	"math/big"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gopacket/gopacket/layers"
@@ -41,6 +42,12 @@ const (
 	// session that is down does not change the state. However, having such a timer
 	// simplifies the Go implementation's timer Stop/Reset code.
 	defaultDetectionTimeout = time.Minute
+	// defaultRTTProbeEvery is the default number of periodic BFD control packets between two
+	// round-trip time probes.
+	defaultRTTProbeEvery = 10
+	// rttSmoothingFactor is the inverse of the weight given to a new round-trip time sample when
+	// updating the smoothed round-trip time (see RFC 6298).
+	rttSmoothingFactor = 8
 )
 
 // ErrAlreadyRunning is the error returned by session run function when called repeatedly.
@@ -62,6 +69,12 @@ var ErrAlreadyRunning = errors.New("is running")
 //
 // Session does not support the BFD Echo function. Therefore, the Required Min Echo RX field is
 // always set to 0.
+//
+// The Poll Sequence is only used to measure the round-trip time of the link: every RTTProbeEvery
+// periodic packets, a single packet with the Poll bit set is sent, and the time until the remote
+// responds with a packet with the Final bit set is taken as a round-trip time sample. Received
+// Poll packets are answered immediately with a Final packet. Parameter changes are never
+// signaled via Poll Sequences.
 type Session struct {
 	// Sender is used by the Session to send BFD messages to the other end of the point to point
 	// link.
@@ -125,6 +138,14 @@ type Session struct {
 	// until the session is ready to read it.
 	ReceiveQueueSize int
 
+	// RTTProbeEvery is the number of periodic BFD control packets sent between two round-trip
+	// time probes. Probes are only sent while the session is Up. If it is 0, the round-trip time
+	// is not measured.
+	//
+	// Implementations that do not support the Poll Sequence discard probes, which then count as
+	// lost packets. This value should therefore be large compared to DetectMult.
+	RTTProbeEvery int
+
 	messagesOnce sync.Once
 	// messages is the channel on which the session receives BFD packets.
 	messages chan bfdMessage
@@ -152,6 +173,14 @@ type Session struct {
 	// remote system in a BFD Control packet.
 	remoteMinRxInterval time.Duration
 
+	// probeSent is the time at which the outstanding round-trip time probe has been sent. It is
+	// zero if there is no outstanding probe.
+	probeSent time.Time
+	// sentSinceProbe is the number of periodic packets sent since the last probe.
+	sentSinceProbe int
+	// rtt is the smoothed round-trip time in nanoseconds, or 0 if it has not been measured yet.
+	rtt atomic.Int64
+
 	// Metrics is used by the session to report information about internal operation.
 	//
 	// If a metric is not initialized, it is not reported.
@@ -185,6 +214,7 @@ func NewSession(s Sender, cfg control.BFD, metrics Metrics) (*Session, error) {
 		RequiredMinRxInterval: cfg.RequiredMinRxInterval,
 		LocalDiscriminator:    disc,
 		ReceiveQueueSize:      10,
+		RTTProbeEvery:         defaultRTTProbeEvery,
 		Metrics:               metrics,
 	}, nil
 }
@@ -256,6 +286,10 @@ MainLoop:
 				s.setRemoteDiscriminator(msg.MyDiscriminator)
 				logger.Debug("Bootstrapped")
 			}
+			if msg.Final && !s.probeSent.IsZero() {
+				s.updateRTT(msg.Received.Sub(s.probeSent))
+				s.probeSent = time.Time{}
+			}
 
 			// If we transitioned out of the down state, we cancel the current send timer
 			// (because it might send too late to keep the session up) and set up a new
@@ -270,36 +304,19 @@ MainLoop:
 				}
 				sendTimer.Reset(s.computeNextSendInterval())
 			}
+			if s.getLocalState() != stateUp {
+				s.resetRTT()
+			}
+			if msg.Poll {
+				// The Final packet is sent out of band, i.e., it does not affect the periodic
+				// transmission schedule.
+				s.send(ctx, pkt, false, true)
+			}
 		case <-sendTimer.C:
 			// Send timer guaranteed to be expired, so we can reset.
 			sendTimer.Reset(s.computeNextSendInterval())
 
-			// These conversions are guaranteed to not return an error, because the input has been
-			// sanitized.
-			desiredMinTxInterval, _ := durationToBFDInterval(s.desiredMinTXInterval)
-			requiredMinRxInterval, _ := durationToBFDInterval(s.RequiredMinRxInterval)
-
-			*pkt = layers.BFD{
-				Version:               1,
-				State:                 layers.BFDState(s.getLocalState()),
-				DetectMultiplier:      s.DetectMult,
-				MyDiscriminator:       s.LocalDiscriminator,
-				YourDiscriminator:     s.remoteDiscriminator,
-				DesiredMinTxInterval:  desiredMinTxInterval,
-				RequiredMinRxInterval: requiredMinRxInterval,
-			}
-
-			if err := s.Sender.Send(pkt); err != nil {
-				logger.Debug("error sending message", "err", err)
-				continue
-			}
-			if s.testLogger != nil {
-				s.testLogger.Debug("heartbeat sent", "desired_min_tx_interval",
-					pkt.DesiredMinTxInterval, "required_min_rx_interval", pkt.RequiredMinRxInterval)
-			}
-			if s.Metrics.PacketsSent != nil {
-				s.Metrics.PacketsSent.Add(1)
-			}
+			s.send(ctx, pkt, s.shouldProbe(), false)
 		case <-detectionTimer.C:
 			// detection timer guaranteed to be expired, so we can reset. We reset s.t. if some
 			// other branch wants to stop this timer, it can assume it hasn't been drained.
@@ -307,6 +324,7 @@ MainLoop:
 
 			s.transition(ctx, eventTimer)
 			s.setRemoteDiscriminator(0)
+			s.resetRTT()
 			if s.getLocalState() == stateDown {
 				// Change the desired interval back to the default transmission interval, to
 				// avoid flooding the network while the session is down.
@@ -317,6 +335,95 @@ MainLoop:
 	return nil
 }
 
+// send sends a BFD control packet reflecting the current session state. The poll and final
+// arguments set the respective bits of the packet. The pkt argument is reused to avoid allocations.
+func (s *Session) send(ctx context.Context, pkt *layers.BFD, poll, final bool) {
+	// These conversions are guaranteed to not return an error, because the input has been
+	// sanitized.
+	desiredMinTxInterval, _ := durationToBFDInterval(s.desiredMinTXInterval)
+	requiredMinRxInterval, _ := durationToBFDInterval(s.RequiredMinRxInterval)
+
+	*pkt = layers.BFD{
+		Version:               1,
+		State:                 layers.BFDState(s.getLocalState()),
+		Poll:                  poll,
+		Final:                 final,
+		DetectMultiplier:      s.DetectMult,
+		MyDiscriminator:       s.LocalDiscriminator,
+		YourDiscriminator:     s.getRemoteDiscriminator(),
+		DesiredMinTxInterval:  desiredMinTxInterval,
+		RequiredMinRxInterval: requiredMinRxInterval,
+	}
+
+	if err := s.Sender.Send(pkt); err != nil {
+		log.FromCtx(ctx).Debug("error sending message", "err", err)
+		return
+	}
+	if poll {
+		s.probeSent = time.Now()
+	}
+	if s.testLogger != nil {
+		s.testLogger.Debug("heartbeat sent", "desired_min_tx_interval",
+			pkt.DesiredMinTxInterval, "required_min_rx_interval", pkt.RequiredMinRxInterval,
+			"poll", poll, "final", final)
+	}
+	if s.Metrics.PacketsSent != nil {
+		s.Metrics.PacketsSent.Add(1)
+	}
+}
+
+// shouldProbe returns whether the next periodic packet should be a round-trip time probe. A probe
+// that has not been answered within the detection time is considered lost.
+func (s *Session) shouldProbe() bool {
+	if s.RTTProbeEvery <= 0 || s.getLocalState() != stateUp {
+		return false
+	}
+	timeout := time.Duration(s.DetectMult) * max(s.desiredMinTXInterval, s.remoteMinRxInterval)
+	if !s.probeSent.IsZero() && time.Since(s.probeSent) < timeout {
+		return false
+	}
+	s.sentSinceProbe++
+	if s.sentSinceProbe < s.RTTProbeEvery {
+		return false
+	}
+	s.sentSinceProbe = 0
+	return true
+}
+
+// updateRTT updates the smoothed round-trip time with a new sample.
+func (s *Session) updateRTT(sample time.Duration) {
+	if sample <= 0 {
+		return
+	}
+	srtt := time.Duration(s.rtt.Load())
+	if srtt == 0 {
+		srtt = sample
+	} else {
+		srtt += (sample - srtt) / rttSmoothingFactor
+	}
+	s.rtt.Store(int64(srtt))
+	if s.Metrics.RTT != nil {
+		s.Metrics.RTT.Set(srtt.Seconds())
+	}
+}
+
+// resetRTT discards the round-trip time measurements, e.g., because the session went down.
+func (s *Session) resetRTT() {
+	s.probeSent = time.Time{}
+	s.sentSinceProbe = 0
+	s.rtt.Store(0)
+	if s.Metrics.RTT != nil {
+		s.Metrics.RTT.Set(0)
+	}
+}
+
+// RTT returns the smoothed round-trip time of the link, as measured with BFD Poll Sequences.
+// It returns 0 if the round-trip time is not known, e.g., because the session is not Up or
+// because measuring is disabled. It is safe to call RTT while Run is executed.
+func (s *Session) RTT() time.Duration {
+	return time.Duration(s.rtt.Load())
+}
+
 func (s *Session) Close() error {
 	s.initMessages()
 	close(s.messages)
@@ -429,6 +536,9 @@ func (s *Session) ReceiveMessage(msg *layers.BFD) {
 		YourDiscriminator:     msg.YourDiscriminator,
 		DesiredMinTxInterval:  msg.DesiredMinTxInterval,
 		RequiredMinRxInterval: msg.RequiredMinRxInterval,
+		Poll:                  msg.Poll,
+		Final:                 msg.Final,
+		Received:              time.Now(),
 	}
 }
 
@@ -446,6 +556,9 @@ func (s *Session) initMetrics() {
 	if s.Metrics.StateChanges != nil {
 		s.Metrics.StateChanges.Add(0)
 	}
+	if s.Metrics.RTT != nil {
+		s.Metrics.RTT.Set(0)
+	}
 }
 
 func (s *Session) initMessages() {
@@ -553,12 +666,9 @@ func shouldDiscard(pkt *layers.BFD) (bool, string) {
 				"Packet will be discarded."
 	}
 
-	// Poll/final sequences are not supported (see Anapaya/scion#3281).
-	if pkt.Poll {
-		return true, "Received Poll packet, but poll mechanism is not supported."
-	}
-	if pkt.Final {
-		return true, "Received Final packet, but poll mechanism is not supported."
+	// A packet can not be part of both a local and a remote Poll Sequence.
+	if pkt.Poll && pkt.Final {
+		return true, ""
 	}
 
 	// Echo function is not supported. We discard such packets to ensure that the
@@ -603,4 +713,9 @@ type bfdMessage struct {
 	YourDiscriminator     layers.BFDDiscriminator
 	DesiredMinTxInterval  layers.BFDTimeInterval
 	RequiredMinRxInterval layers.BFDTimeInterval
+	Poll                  bool
+	Final                 bool
+	// Received is the time at which the message has been enqueued. It is used to compute
+	// round-trip time samples independently of the processing delay.
+	Received time.Time
 }
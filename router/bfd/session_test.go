@@ -404,6 +404,69 @@ func TestSessionDebootstrap(t *testing.T) {
 	wg.Wait()
 }
 
+func TestSessionRTT(t *testing.T) {
+	// Session A probes the round-trip time, session B only answers the probes.
+	sessionA := &bfd.Session{
+		DetectMult:            3,
+		DesiredMinTxInterval:  50 * time.Millisecond,
+		RequiredMinRxInterval: 50 * time.Millisecond,
+		LocalDiscriminator:    1,
+		RemoteDiscriminator:   2,
+		ReceiveQueueSize:      10,
+		RTTProbeEvery:         2,
+	}
+	sessionB := &bfd.Session{
+		DetectMult:            3,
+		DesiredMinTxInterval:  50 * time.Millisecond,
+		RequiredMinRxInterval: 50 * time.Millisecond,
+		LocalDiscriminator:    2,
+		RemoteDiscriminator:   1,
+		ReceiveQueueSize:      10,
+	}
+	loggerA := testlog.NewLogger(t).New("session", "loggerA")
+	loggerB := testlog.NewLogger(t).New("session", "loggerB")
+	sessionA.SetLogger(loggerA)
+	sessionB.SetLogger(loggerB)
+
+	linkAToB := &redirectSender{Destination: sessionB}
+	linkBToA := &redirectSender{Destination: sessionA}
+	sessionA.Sender = linkAToB
+	sessionB.Sender = linkBToA
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		err := sessionA.Run(log.CtxWith(context.Background(), loggerA))
+		require.NoError(t, err)
+	}()
+	go func() {
+		defer wg.Done()
+		err := sessionB.Run(log.CtxWith(context.Background(), loggerB))
+		require.NoError(t, err)
+	}()
+
+	assert.Zero(t, sessionA.RTT())
+	linkAToB.Sending(true)
+	linkBToA.Sending(true)
+	time.Sleep(2 * time.Second)
+
+	assert.True(t, sessionA.IsUp())
+	assert.True(t, sessionB.IsUp())
+	assert.Greater(t, sessionA.RTT(), time.Duration(0))
+	assert.Zero(t, sessionB.RTT())
+
+	// Once the session goes down, the measurement is discarded.
+	linkBToA.Sending(false)
+	time.Sleep(time.Second)
+	assert.False(t, sessionA.IsUp())
+	assert.Zero(t, sessionA.RTT())
+
+	linkAToB.Close()
+	linkBToA.Close()
+	wg.Wait()
+}
+
 func TestSessionRun(t *testing.T) {
 	testCases := map[string]struct {
 		session *bfd.Session
@@ -732,16 +795,25 @@ func TestShouldDiscard(t *testing.T) {
 				pkt.Poll = true
 				return pkt
 			},
-			shouldDiscard: true,
-			hasReason:     assert.NotEmpty,
+			shouldDiscard: false,
+			hasReason:     assert.Empty,
 		},
 		"final bit set": {
 			packetEdit: func(pkt layers.BFD) layers.BFD {
 				pkt.Final = true
 				return pkt
 			},
+			shouldDiscard: false,
+			hasReason:     assert.Empty,
+		},
+		"poll and final bits set": {
+			packetEdit: func(pkt layers.BFD) layers.BFD {
+				pkt.Poll = true
+				pkt.Final = true
+				return pkt
+			},
 			shouldDiscard: true,
-			hasReason:     assert.NotEmpty,
+			hasReason:     assert.Empty,
 		},
 		"echo function enabled": {
 			packetEdit: func(pkt layers.BFD) layers.BFD {
@@ -0,0 +1,265 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package router
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/slayers"
+	"github.com/scionproto/scion/router/bfd"
+	"github.com/scionproto/scion/router/control"
+)
+
+var errResolveOnBundle = errors.New("resolve on a link bundle")
+
+// bundleLink is an external link made of several underlay links to the same neighbor router, the
+// members. Each member has its own BFD session. Packets are spread across the members that are
+// up by flow hash, so that the packets of a flow are not reordered. The bundle is up as long as
+// one of its members is up.
+//
+// Packets received on a member are attributed to the member link, not to the bundle; the
+// members report the interface ID of the bundle.
+type bundleLink struct {
+	ifID    uint16
+	members []Link
+	metrics *InterfaceMetrics
+
+	// upMtx serializes the updates of interfaceUp.
+	upMtx       sync.Mutex
+	interfaceUp prometheus.Gauge
+}
+
+func (b *bundleLink) IsUp() bool {
+	for _, m := range b.members {
+		if m.IsUp() {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *bundleLink) IfID() uint16 {
+	return b.ifID
+}
+
+func (b *bundleLink) Metrics() *InterfaceMetrics {
+	return b.metrics
+}
+
+func (b *bundleLink) Scope() LinkScope {
+	return External
+}
+
+// BFDSession returns the session of the first member that is up, or that of the first member if
+// none is up.
+func (b *bundleLink) BFDSession() *bfd.Session {
+	for _, m := range b.members {
+		if m.IsUp() {
+			return m.BFDSession()
+		}
+	}
+	return b.members[0].BFDSession()
+}
+
+func (b *bundleLink) Resolve(p *Packet, dst addr.Host, port uint16) error {
+	return errResolveOnBundle
+}
+
+func (b *bundleLink) Send(p *Packet) bool {
+	return b.member(p).Send(p)
+}
+
+func (b *bundleLink) SendBlocking(p *Packet) {
+	b.member(p).SendBlocking(p)
+}
+
+// member selects the member that sends the packet. It picks one of the members that are up by
+// flow hash. If no member is up, it picks one of all the members, by flow hash too.
+func (b *bundleLink) member(p *Packet) Link {
+	up := 0
+	for _, m := range b.members {
+		if m.IsUp() {
+			up++
+		}
+	}
+	h := flowHash(p.RawPacket)
+	if up == 0 {
+		return b.members[h%uint32(len(b.members))]
+	}
+	i := int(h % uint32(up))
+	for _, m := range b.members {
+		if !m.IsUp() {
+			continue
+		}
+		if i == 0 {
+			return m
+		}
+		i--
+	}
+	// The members changed state while we looked. Any member will do.
+	return b.members[0]
+}
+
+// updateInterfaceUp reports whether the bundle is up.
+func (b *bundleLink) updateInterfaceUp() {
+	if b.interfaceUp == nil {
+		return
+	}
+	b.upMtx.Lock()
+	defer b.upMtx.Unlock()
+	if b.IsUp() {
+		b.interfaceUp.Set(1)
+	} else {
+		b.interfaceUp.Set(0)
+	}
+}
+
+// flowHash returns the FNV-1a hash of the flow ID and the address header of a SCION packet. It
+// returns 0 if the packet is too short.
+func flowHash(data []byte) uint32 {
+	if len(data) < slayers.CmnHdrLen {
+		return 0
+	}
+	dstHostAddrLen := slayers.AddrType(data[9] >> 4 & 0xf).Length()
+	srcHostAddrLen := slayers.AddrType(data[9] & 0xf).Length()
+	addrHdrLen := 2*addr.IABytes + srcHostAddrLen + dstHostAddrLen
+	if len(data) < slayers.CmnHdrLen+addrHdrLen {
+		return 0
+	}
+	h := uint32(2166136261)
+	hashByte := func(c byte) {
+		h ^= uint32(c)
+		h *= 16777619
+	}
+	hashByte(data[1] & 0xf) // The left 4 bits aren't part of the flowID.
+	hashByte(data[2])
+	hashByte(data[3])
+	for _, c := range data[slayers.CmnHdrLen : slayers.CmnHdrLen+addrHdrLen] {
+		hashByte(c)
+	}
+	return h
+}
+
+// bundleMemberGauge reports the state of the BFD session of a bundle member, and updates the state
+// of the bundle whenever the state of the member changes.
+type bundleMemberGauge struct {
+	prometheus.Gauge
+	bundle *bundleLink
+}
+
+func (g bundleMemberGauge) Set(v float64) {
+	g.Gauge.Set(v)
+	g.bundle.updateInterfaceUp()
+}
+
+// addBundle adds a link bundle for the given interface ID, with one external link and BFD
+// session per member. It must be called with the lock held, by AddExternalInterface.
+func (d *dataPlane) addBundle(
+	ifID uint16, link control.LinkInfo, localHost, remoteHost addr.Host,
+) error {
+	iMetrics := newInterfaceMetrics(d.Metrics, ifID, d.localIA, "", d.neighborIAs[ifID])
+	bundle := &bundleLink{ifID: ifID, metrics: iMetrics}
+	if d.Metrics != nil {
+		bundle.interfaceUp = d.Metrics.InterfaceUp.With(prometheus.Labels{
+			"interface":       fmt.Sprint(ifID),
+			"isd_as":          d.localIA.String(),
+			"neighbor_isd_as": link.Remote.IA.String(),
+		})
+	}
+	for _, m := range link.Bundle {
+		if m.Remote == "" {
+			return errEmptyValue
+		}
+		memberInfo := link
+		memberInfo.Provider = m.Provider
+		memberInfo.Local.Addr = m.Local
+		memberInfo.Remote.Addr = m.Remote
+		memberInfo.Bundle = nil
+
+		// The BFD packets of all members carry the same SCION host addresses. The receiving
+		// router attributes them to a member by the underlay connection they arrive on.
+		session, send, err := d.newBundleMemberBFD(ifID, memberInfo, bundle, localHost, remoteHost)
+		if err != nil {
+			return serrors.Wrap("adding bundle member BFD", err,
+				"if_id", ifID, "remote", m.Remote)
+		}
+		underlay := d.underlay(m.Provider)
+		lk, err := underlay.NewExternalLink(
+			d.newEgressQueue(ifID, ""),
+			session,
+			m.Local,
+			m.Remote,
+			ifID,
+			iMetrics)
+		if err != nil {
+			return err
+		}
+		if send != nil {
+			send.link = lk
+		}
+		bundle.members = append(bundle.members, lk)
+		d.numInterfaces++
+	}
+	d.linkTypes[ifID] = link.LinkTo
+	d.interfaces[ifID] = bundle
+	return nil
+}
+
+// newBundleMemberBFD returns the BFD session of a bundle member. The BFD counters of the members
+// are those of the interface. The state of each member is reported separately, and the state of
+// the interface is derived from the states of the members.
+func (d *dataPlane) newBundleMemberBFD(
+	ifID uint16,
+	link control.LinkInfo,
+	bundle *bundleLink,
+	localHost, remoteHost addr.Host,
+) (*bfd.Session, *bfdSend, error) {
+	if *link.BFD.Disable {
+		return nil, nil, nil
+	}
+	var m bfd.Metrics
+	if d.Metrics != nil {
+		labels := prometheus.Labels{
+			"interface":       fmt.Sprint(ifID),
+			"isd_as":          d.localIA.String(),
+			"neighbor_isd_as": link.Remote.IA.String(),
+		}
+		memberLabels := prometheus.Labels{"member": link.Remote.Addr}
+		m = bfd.Metrics{
+			Up: bundleMemberGauge{
+				Gauge:  d.Metrics.BundleMemberUp.MustCurryWith(labels).With(memberLabels),
+				bundle: bundle,
+			},
+			StateChanges:    d.Metrics.BFDInterfaceStateChanges.With(labels),
+			PacketsSent:     d.Metrics.BFDPacketsSent.With(labels),
+			PacketsReceived: d.Metrics.BFDPacketsReceived.With(labels),
+		}
+	}
+	s, err := newBFDSend(d, link, localHost, remoteHost, ifID, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	session, err := bfd.NewSession(s, link.BFD, m)
+	if err != nil {
+		return nil, nil, err
+	}
+	return session, s, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package router
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/scionproto/scion/pkg/slayers"
+)
+
+type bundleTestMember struct {
+	MockLink
+	up   bool
+	sent int
+}
+
+func (l *bundleTestMember) IsUp() bool { return l.up }
+
+func (l *bundleTestMember) Send(p *Packet) bool {
+	l.sent++
+	return true
+}
+
+// bundleTestPacket returns a packet with IPv4 host addresses and the given flow ID.
+func bundleTestPacket(flowID byte) *Packet {
+	raw := make([]byte, slayers.CmnHdrLen+24)
+	raw[3] = flowID
+	return &Packet{RawPacket: raw}
+}
+
+func TestBundleLink(t *testing.T) {
+	m1 := &bundleTestMember{up: true}
+	m2 := &bundleTestMember{up: true}
+	m3 := &bundleTestMember{up: true}
+	b := &bundleLink{ifID: 1, members: []Link{m1, m2, m3}}
+	reset := func() { m1.sent, m2.sent, m3.sent = 0, 0, 0 }
+
+	t.Run("spread across members", func(t *testing.T) {
+		reset()
+		for i := range 256 {
+			assert.True(t, b.Send(bundleTestPacket(byte(i))))
+		}
+		assert.NotZero(t, m1.sent)
+		assert.NotZero(t, m2.sent)
+		assert.NotZero(t, m3.sent)
+	})
+	t.Run("same flow same member", func(t *testing.T) {
+		reset()
+		for range 10 {
+			b.Send(bundleTestPacket(42))
+		}
+		assert.ElementsMatch(t, []int{10, 0, 0}, []int{m1.sent, m2.sent, m3.sent})
+	})
+	t.Run("down members are skipped", func(t *testing.T) {
+		reset()
+		m2.up = false
+		defer func() { m2.up = true }()
+		for i := range 256 {
+			b.Send(bundleTestPacket(byte(i)))
+		}
+		assert.Zero(t, m2.sent)
+		assert.Equal(t, 256, m1.sent+m3.sent)
+		assert.True(t, b.IsUp())
+	})
+	t.Run("down when all members are down", func(t *testing.T) {
+		reset()
+		m1.up, m2.up, m3.up = false, false, false
+		defer func() { m1.up, m2.up, m3.up = true, true, true }()
+		assert.False(t, b.IsUp())
+		// Packets are still sent, in case BFD is wrong.
+		assert.True(t, b.Send(bundleTestPacket(1)))
+		assert.Equal(t, 1, m1.sent+m2.sent+m3.sent)
+	})
+}
+
+func TestFlowHash(t *testing.T) {
+	p1 := bundleTestPacket(1)
+	p2 := bundleTestPacket(1)
+	// The bits above the flow ID and the payload are ignored.
+	p2.RawPacket[1] |= 0xf0
+	p2.RawPacket = append(p2.RawPacket, 1, 2, 3)
+	assert.Equal(t, flowHash(p1.RawPacket), flowHash(p2.RawPacket))
+
+	assert.NotEqual(t, flowHash(p1.RawPacket), flowHash(bundleTestPacket(2).RawPacket))
+	assert.Zero(t, flowHash(p1.RawPacket[:slayers.CmnHdrLen]))
+}
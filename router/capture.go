@@ -0,0 +1,285 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package router
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gopacket/gopacket"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/slayers"
+	"github.com/scionproto/scion/router/control"
+)
+
+// Limits and defaults of captures. The limits bound the memory used by a capture to
+// MaxCapturePackets * MaxCaptureSnapLen.
+const (
+	MaxCapturePackets      = 10000
+	MaxCaptureDuration     = time.Hour
+	MaxCaptureSnapLen      = 9216
+	DefaultCapturePackets  = 100
+	DefaultCaptureDuration = time.Minute
+	DefaultCaptureSnapLen  = 1500
+)
+
+// linkTypeUser0 is the pcap link type of captured packets. The packets start with the SCION
+// header; the underlay headers are not captured.
+const linkTypeUser0 = 147
+
+// capture records the packets that match its filter, together with the verdict of the
+// processor. It stops by itself after the configured number of packets or duration.
+type capture struct {
+	cfg     control.CaptureConfig
+	start   time.Time
+	end     time.Time
+	stopped atomic.Bool
+
+	mtx     sync.Mutex
+	records []captureRecord
+}
+
+type captureRecord struct {
+	ts      time.Time
+	data    []byte
+	origLen int
+	ia      addr.IA
+	ingress uint16
+	verdict string
+}
+
+func newCapture(cfg control.CaptureConfig, now time.Time) *capture {
+	return &capture{
+		cfg:     cfg,
+		start:   now,
+		end:     now.Add(cfg.Duration),
+		records: make([]captureRecord, 0, min(cfg.MaxPackets, DefaultCapturePackets)),
+	}
+}
+
+// active reports whether the capture still records packets.
+func (c *capture) active(now time.Time) bool {
+	return !c.stopped.Load() && now.Before(c.end)
+}
+
+func (c *capture) stop() {
+	c.stopped.Store(true)
+}
+
+// matches reports whether the packet matches the filter. last is the last decoded layer, or nil
+// if the packet could not be decoded; such packets only match filters on the ingress interface.
+func (c *capture) matches(
+	ia addr.IA,
+	ingress uint16,
+	s *slayers.SCION,
+	last gopacket.DecodingLayer,
+) bool {
+
+	f := &c.cfg.Filter
+	if !f.IA.IsZero() && f.IA != ia {
+		return false
+	}
+	if f.Ingress != nil && uint64(*f.Ingress) != uint64(ingress) {
+		return false
+	}
+	if f.SrcIA.IsZero() && f.DstIA.IsZero() && !f.Host.IsValid() && f.Port == 0 {
+		return true
+	}
+	if last == nil {
+		return false
+	}
+	if !f.SrcIA.IsZero() && f.SrcIA != s.SrcIA {
+		return false
+	}
+	if !f.DstIA.IsZero() && f.DstIA != s.DstIA {
+		return false
+	}
+	if f.Host.IsValid() {
+		src, srcErr := s.SrcAddr()
+		dst, dstErr := s.DstAddr()
+		srcMatch := srcErr == nil && src.Type() == addr.HostTypeIP && src.IP() == f.Host
+		dstMatch := dstErr == nil && dst.Type() == addr.HostTypeIP && dst.IP() == f.Host
+		if !srcMatch && !dstMatch {
+			return false
+		}
+	}
+	if f.Port != 0 {
+		switch nextHdr(last) {
+		case slayers.L4UDP, slayers.L4TCP:
+		default:
+			return false
+		}
+		// UDP and TCP headers start with the source and destination ports.
+		pld := last.LayerPayload()
+		if len(pld) < 4 {
+			return false
+		}
+		if binary.BigEndian.Uint16(pld[0:]) != f.Port && binary.BigEndian.Uint16(pld[2:]) != f.Port {
+			return false
+		}
+	}
+	return true
+}
+
+// add records a packet. It copies the data.
+func (c *capture) add(
+	now time.Time,
+	data []byte,
+	ia addr.IA,
+	ingress uint16,
+	verdict string,
+) {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if len(c.records) >= c.cfg.MaxPackets {
+		c.stop()
+		return
+	}
+	c.records = append(c.records, captureRecord{
+		ts:      now,
+		data:    append([]byte(nil), data[:min(len(data), c.cfg.SnapLen)]...),
+		origLen: len(data),
+		ia:      ia,
+		ingress: ingress,
+		verdict: verdict,
+	})
+	if len(c.records) >= c.cfg.MaxPackets {
+		c.stop()
+	}
+}
+
+func (c *capture) status(now time.Time) control.CaptureStatus {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return control.CaptureStatus{
+		Config:  c.cfg,
+		Start:   c.start,
+		Running: c.active(now),
+		Packets: len(c.records),
+	}
+}
+
+// writeTo writes the recorded packets in the pcapng format. Each packet carries a comment with
+// the ISD-AS and the ingress interface that received it, and the verdict.
+func (c *capture) writeTo(w io.Writer) error {
+	c.mtx.Lock()
+	records := c.records[:len(c.records):len(c.records)]
+	c.mtx.Unlock()
+
+	var buf []byte
+	// Section header block, with an unspecified section length.
+	buf = appendPcapngBlock(buf, 0x0a0d0d0a, func(b []byte) []byte {
+		b = binary.LittleEndian.AppendUint32(b, 0x1a2b3c4d)
+		b = binary.LittleEndian.AppendUint16(b, 1)
+		b = binary.LittleEndian.AppendUint16(b, 0)
+		return binary.LittleEndian.AppendUint64(b, ^uint64(0))
+	})
+	// Interface description block.
+	buf = appendPcapngBlock(buf, 1, func(b []byte) []byte {
+		b = binary.LittleEndian.AppendUint16(b, linkTypeUser0)
+		b = binary.LittleEndian.AppendUint16(b, 0)
+		return binary.LittleEndian.AppendUint32(b, uint32(c.cfg.SnapLen))
+	})
+	if _, err := w.Write(buf); err != nil {
+		return err
+	}
+	for _, r := range records {
+		buf = appendPcapngBlock(buf[:0], 6, func(b []byte) []byte {
+			// Timestamps are in microseconds, the default resolution.
+			ts := uint64(r.ts.UnixMicro())
+			b = binary.LittleEndian.AppendUint32(b, 0)
+			b = binary.LittleEndian.AppendUint32(b, uint32(ts>>32))
+			b = binary.LittleEndian.AppendUint32(b, uint32(ts))
+			b = binary.LittleEndian.AppendUint32(b, uint32(len(r.data)))
+			b = binary.LittleEndian.AppendUint32(b, uint32(r.origLen))
+			b = appendPadded(b, r.data)
+			comment := fmt.Sprintf("isd_as %s ingress %d: %s", r.ia, r.ingress, r.verdict)
+			b = binary.LittleEndian.AppendUint16(b, 1) // opt_comment
+			b = binary.LittleEndian.AppendUint16(b, uint16(len(comment)))
+			b = appendPadded(b, []byte(comment))
+			return binary.LittleEndian.AppendUint32(b, 0) // opt_endofopt
+		})
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendPcapngBlock appends a block of the given type with the body appended by body.
+func appendPcapngBlock(b []byte, blockType uint32, body func([]byte) []byte) []byte {
+	start := len(b)
+	b = binary.LittleEndian.AppendUint32(b, blockType)
+	b = binary.LittleEndian.AppendUint32(b, 0) // Length, set below.
+	b = body(b)
+	length := uint32(len(b) - start + 4)
+	binary.LittleEndian.PutUint32(b[start+4:], length)
+	return binary.LittleEndian.AppendUint32(b, length)
+}
+
+// appendPadded appends data, padded with zeros to a multiple of 4 bytes.
+func appendPadded(b, data []byte) []byte {
+	b = append(b, data...)
+	for len(b)%4 != 0 {
+		b = append(b, 0)
+	}
+	return b
+}
+
+// verdict describes the disposition of the packet just processed, for captures.
+func (p *scionPacketProcessor) verdict(pkt *Packet, disp disposition) string {
+	switch disp {
+	case pForward:
+		return fmt.Sprintf("forward to interface %d", pkt.egress)
+	case pSlowPath:
+		switch t := pkt.slowPathRequest.spType; t {
+		case slowPathRouterAlertIngress, slowPathRouterAlertEgress:
+			return "slow path: router alert"
+		default:
+			tc := slayers.CreateSCMPTypeCode(slayers.SCMPType(t), pkt.slowPathRequest.code)
+			return "slow path: SCMP " + tc.String()
+		}
+	case pDone:
+		return "done"
+	case pRateLimited:
+		return "rate limited"
+	case pDiscard:
+		if p.cause != nil {
+			return "discard: " + p.cause.Error()
+		}
+		return "discard"
+	}
+	return fmt.Sprintf("unknown disposition %d", disp)
+}
+
+// capturePacket records the packet just processed if it matches the capture. It removes the
+// capture from the data plane once the capture has stopped.
+func (p *scionPacketProcessor) capturePacket(c *capture, pkt *Packet, disp disposition) {
+	now := time.Now()
+	if !c.active(now) {
+		p.d.capture.CompareAndSwap(c, nil)
+		return
+	}
+	if !c.matches(p.d.localIA, p.ingressFromLink, &p.scionLayer, p.lastLayer) {
+		return
+	}
+	c.add(now, pkt.RawPacket, p.d.localIA, p.ingressFromLink, p.verdict(pkt, disp))
+}
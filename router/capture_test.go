@@ -0,0 +1,190 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package router
+
+import (
+	"bytes"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/pcapgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/segment/iface"
+	"github.com/scionproto/scion/pkg/slayers"
+	"github.com/scionproto/scion/router/control"
+)
+
+func TestCaptureMatches(t *testing.T) {
+	localIA := addr.MustParseIA("1-ff00:0:110")
+	srcIA := addr.MustParseIA("1-ff00:0:111")
+	dstIA := addr.MustParseIA("1-ff00:0:112")
+
+	// A UDP packet from 192.0.2.1:30041 to 192.0.2.2:31000.
+	var scionL slayers.SCION
+	scionL.SrcIA, scionL.DstIA = srcIA, dstIA
+	require.NoError(t, scionL.SetSrcAddr(addr.HostIP(netip.MustParseAddr("192.0.2.1"))))
+	require.NoError(t, scionL.SetDstAddr(addr.HostIP(netip.MustParseAddr("192.0.2.2"))))
+	scionL.NextHdr = slayers.L4UDP
+	scionL.Payload = []byte{0x75, 0x59, 0x79, 0x18, 0, 8, 0, 0}
+
+	ingress := func(id iface.ID) *iface.ID { return &id }
+	testCases := map[string]struct {
+		filter  control.CaptureFilter
+		decoded bool
+		match   bool
+	}{
+		"empty": {
+			decoded: true,
+			match:   true,
+		},
+		"ia": {
+			filter:  control.CaptureFilter{IA: localIA},
+			decoded: true,
+			match:   true,
+		},
+		"other ia": {
+			filter:  control.CaptureFilter{IA: srcIA},
+			decoded: true,
+		},
+		"ingress": {
+			filter: control.CaptureFilter{Ingress: ingress(1)},
+			match:  true,
+		},
+		"other ingress": {
+			filter:  control.CaptureFilter{Ingress: ingress(0)},
+			decoded: true,
+		},
+		"src and dst ia": {
+			filter:  control.CaptureFilter{SrcIA: srcIA, DstIA: dstIA},
+			decoded: true,
+			match:   true,
+		},
+		"swapped ia": {
+			filter:  control.CaptureFilter{SrcIA: dstIA},
+			decoded: true,
+		},
+		"not decoded": {
+			filter: control.CaptureFilter{SrcIA: srcIA},
+		},
+		"src host": {
+			filter:  control.CaptureFilter{Host: netip.MustParseAddr("192.0.2.1")},
+			decoded: true,
+			match:   true,
+		},
+		"dst host": {
+			filter:  control.CaptureFilter{Host: netip.MustParseAddr("192.0.2.2")},
+			decoded: true,
+			match:   true,
+		},
+		"other host": {
+			filter:  control.CaptureFilter{Host: netip.MustParseAddr("192.0.2.3")},
+			decoded: true,
+		},
+		"src port": {
+			filter:  control.CaptureFilter{Port: 30041},
+			decoded: true,
+			match:   true,
+		},
+		"dst port": {
+			filter:  control.CaptureFilter{Port: 31000},
+			decoded: true,
+			match:   true,
+		},
+		"other port": {
+			filter:  control.CaptureFilter{Port: 30042},
+			decoded: true,
+		},
+		"all fields": {
+			filter: control.CaptureFilter{
+				IA:      localIA,
+				Ingress: ingress(1),
+				SrcIA:   srcIA,
+				DstIA:   dstIA,
+				Host:    netip.MustParseAddr("192.0.2.2"),
+				Port:    30041,
+			},
+			decoded: true,
+			match:   true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c := newCapture(control.CaptureConfig{Filter: tc.filter}, time.Now())
+			var last gopacket.DecodingLayer
+			if tc.decoded {
+				last = &scionL
+			}
+			assert.Equal(t, tc.match, c.matches(localIA, 1, &scionL, last))
+		})
+	}
+}
+
+func TestCapture(t *testing.T) {
+	now := time.Now()
+	ia := addr.MustParseIA("1-ff00:0:110")
+	c := newCapture(control.CaptureConfig{
+		MaxPackets: 2,
+		Duration:   time.Minute,
+		SnapLen:    4,
+	}, now)
+	assert.True(t, c.active(now))
+
+	c.add(now, []byte{1, 2, 3, 4, 5, 6}, ia, 1, "discard: MAC verification failed")
+	assert.True(t, c.active(now))
+	c.add(now.Add(time.Second), []byte{1, 2}, ia, 0, "forward to interface 2")
+	// The capture stops once it is full.
+	assert.False(t, c.active(now))
+	c.add(now, []byte{1}, ia, 0, "forward to interface 2")
+
+	status := c.status(now)
+	assert.False(t, status.Running)
+	assert.Equal(t, 2, status.Packets)
+
+	var buf bytes.Buffer
+	require.NoError(t, c.writeTo(&buf))
+	r, err := pcapgo.NewNgReader(&buf, pcapgo.DefaultNgReaderOptions)
+	require.NoError(t, err)
+	assert.EqualValues(t, linkTypeUser0, r.LinkType())
+
+	data, ci, err := r.ReadPacketData()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1, 2, 3, 4}, data)
+	assert.Equal(t, 6, ci.Length)
+	assert.Equal(t, now.UnixMicro(), ci.Timestamp.UnixMicro())
+
+	data, ci, err = r.ReadPacketData()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1, 2}, data)
+	assert.Equal(t, 2, ci.Length)
+
+	_, _, err = r.ReadPacketData()
+	assert.Error(t, err)
+
+	t.Run("expires", func(t *testing.T) {
+		c := newCapture(control.CaptureConfig{MaxPackets: 2, Duration: time.Minute}, now)
+		assert.True(t, c.active(now.Add(59*time.Second)))
+		assert.False(t, c.active(now.Add(time.Minute)))
+	})
+	t.Run("stopped", func(t *testing.T) {
+		c := newCapture(control.CaptureConfig{MaxPackets: 2, Duration: time.Minute}, now)
+		c.stop()
+		assert.False(t, c.active(now))
+	})
+}
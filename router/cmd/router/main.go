@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	_ "net/http/pprof"
 
@@ -37,6 +38,7 @@ import (
 	"github.com/scionproto/scion/router"
 	"github.com/scionproto/scion/router/config"
 	"github.com/scionproto/scion/router/control"
+	"github.com/scionproto/scion/router/flowexport"
 	api "github.com/scionproto/scion/router/mgmtapi"
 	_ "github.com/scionproto/scion/router/underlayproviders/udpip"
 )
@@ -55,24 +57,42 @@ func main() {
 }
 
 func realMain(ctx context.Context) error {
-	controlConfig, err := loadControlConfig()
-	if err != nil {
-		return err
-	}
 	g, errCtx := errgroup.WithContext(ctx)
 	dp := router.NewConnector(globalCfg.Router, globalCfg.Features)
-	iaCtx := &control.IACtx{
-		Config: controlConfig,
-		DP:     dp,
+
+	// The ISD-AS of the general configuration comes first, followed by the
+	// additional ISD-ASes served by this router.
+	confDirs := []string{globalCfg.General.ConfigDir}
+	ids := []string{globalCfg.General.ID}
+	for _, ia := range globalCfg.Router.AdditionalIAs {
+		confDirs = append(confDirs, ia.ConfigDir)
+		ids = append(ids, ia.ID)
 	}
-	if err := iaCtx.Configure(); err != nil {
-		return serrors.Wrap("configuring dataplane", err)
+	iaCtxs := make([]*control.IACtx, 0, len(confDirs))
+	for i, confDir := range confDirs {
+		controlConfig, err := loadControlConfig(ids[i], confDir)
+		if err != nil {
+			return err
+		}
+		iaCtx := &control.IACtx{
+			Config: controlConfig,
+			DP:     dp,
+		}
+		if err := iaCtx.Configure(); err != nil {
+			return serrors.Wrap("configuring dataplane", err, "isd_as", controlConfig.IA)
+		}
+		iaCtxs = append(iaCtxs, iaCtx)
 	}
 	statusPages := service.StatusPages{
 		"info":      service.NewInfoStatusPage(),
 		"config":    service.NewConfigStatusPage(globalCfg),
 		"log/level": service.NewLogLevelStatusPage(),
-		"topology":  topologyHandler(iaCtx.Config.Topo),
+		"topology":  topologyHandler(iaCtxs[0].Config.Topo),
+	}
+	// The topologies of the additional ISD-ASes are served on their own pages.
+	for _, iaCtx := range iaCtxs[1:] {
+		statusPages[fmt.Sprintf("topology/%s", iaCtx.Config.IA)] =
+			topologyHandler(iaCtx.Config.Topo)
 	}
 	if err := statusPages.Register(http.DefaultServeMux, globalCfg.General.ID); err != nil {
 		return err
@@ -98,6 +118,18 @@ func realMain(ctx context.Context) error {
 			Info:      service.NewInfoStatusPage().Handler,
 			LogLevel:  service.NewLogLevelStatusPage().Handler,
 			Dataplane: dp,
+			Capture:   dp,
+			RolloverKey: func() (bool, error) {
+				var changed bool
+				for i, iaCtx := range iaCtxs {
+					c, err := iaCtx.RolloverKey(confDirs[i])
+					if err != nil {
+						return changed, err
+					}
+					changed = changed || c
+				}
+				return changed, nil
+			},
 		}
 		log.Info("Exposing API", "addr", globalCfg.API.Addr)
 		h := api.HandlerFromMuxWithBaseURL(&server, r, "/api/v1")
@@ -119,25 +151,55 @@ func realMain(ctx context.Context) error {
 		defer log.HandlePanic()
 		return globalCfg.Metrics.ServePrometheus(errCtx)
 	})
+	if cache := dp.FlowCache(); cache != nil {
+		exporter, err := newFlowExporter(globalCfg.Router.FlowExport, cache)
+		if err != nil {
+			return err
+		}
+		log.Info("Exporting flows", "collector", globalCfg.Router.FlowExport.Collector)
+		g.Go(func() error {
+			defer log.HandlePanic()
+			defer exporter.Conn.Close()
+			return exporter.Run(errCtx)
+		})
+	}
 	g.Go(func() error {
 		defer log.HandlePanic()
-		if err := dp.DataPlane.Run(errCtx); err != nil {
-			return serrors.Wrap("running dataplane", err)
-		}
-		return nil
+		return dp.Run(errCtx)
 	})
 
 	return g.Wait()
 }
 
-func loadControlConfig() (*control.Config, error) {
-	newConf, err := control.LoadConfig(globalCfg.General.ID, globalCfg.General.ConfigDir)
+func loadControlConfig(id, confDir string) (*control.Config, error) {
+	newConf, err := control.LoadConfig(id, confDir)
 	if err != nil {
-		return nil, serrors.Wrap("loading topology", err)
+		return nil, serrors.Wrap("loading topology", err, "config_dir", confDir)
 	}
 	return newConf, nil
 }
 
+func newFlowExporter(
+	cfg config.FlowExport,
+	cache *flowexport.Cache,
+) (*flowexport.Exporter, error) {
+
+	conn, err := net.Dial("udp", cfg.Collector)
+	if err != nil {
+		return nil, serrors.Wrap("connecting to flow collector", err, "collector", cfg.Collector)
+	}
+	return &flowexport.Exporter{
+		Cache: cache,
+		Conn:  conn,
+		Encoder: &flowexport.Encoder{
+			ObservationDomainID: cfg.ObservationDomainID,
+			EnterpriseNumber:    cfg.EnterpriseNumber,
+			SamplingInterval:    uint32(cfg.SamplingInterval),
+		},
+		Interval: cfg.ExportInterval.Duration,
+	}, nil
+}
+
 func topologyHandler(topo topology.Topology) service.StatusPage {
 	handler := func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -150,7 +212,7 @@ func topologyHandler(topo topology.Topology) service.StatusPage {
 		fmt.Fprint(w, string(bytes)+"\n")
 	}
 	return service.StatusPage{
-		Info:    "SCION topology",
+		Info:    fmt.Sprintf("SCION topology of %s", topo.IA()),
 		Handler: handler,
 	}
 }
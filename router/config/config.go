@@ -19,12 +19,14 @@ package config
 
 import (
 	"io"
+	"net"
 	"runtime"
 	"time"
 
 	"github.com/scionproto/scion/pkg/log"
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/pkg/private/util"
+	"github.com/scionproto/scion/pkg/slayers/path"
 	"github.com/scionproto/scion/private/config"
 	"github.com/scionproto/scion/private/env"
 	api "github.com/scionproto/scion/private/mgmtapi"
@@ -42,18 +44,37 @@ type Config struct {
 }
 
 type RouterConfig struct {
-	ReceiveBufferSize     int `toml:"receive_buffer_size,omitempty"`
-	SendBufferSize        int `toml:"send_buffer_size,omitempty"`
-	NumProcessors         int `toml:"num_processors,omitempty"`
-	NumSlowPathProcessors int `toml:"num_slow_processors,omitempty"`
-	BatchSize             int `toml:"batch_size,omitempty"`
-	BFD                   BFD `toml:"bfd,omitempty"`
+	ReceiveBufferSize     int        `toml:"receive_buffer_size,omitempty"`
+	SendBufferSize        int        `toml:"send_buffer_size,omitempty"`
+	NumProcessors         int        `toml:"num_processors,omitempty"`
+	NumSlowPathProcessors int        `toml:"num_slow_processors,omitempty"`
+	BatchSize             int        `toml:"batch_size,omitempty"`
+	BFD                   BFD        `toml:"bfd,omitempty"`
+	QoS                   QoS        `toml:"qos,omitempty"`
+	RateLimit             RateLimit  `toml:"rate_limit,omitempty"`
+	FlowExport            FlowExport `toml:"flow_export,omitempty"`
+	// KeyGracePeriod is the time during which hop fields created with the
+	// previous forwarding key are still accepted after a key rollover.
+	KeyGracePeriod util.DurWrap `toml:"key_grace_period,omitempty"`
 	// TODO: These two values were introduced to override the port range for
 	// configured router in the context of acceptance tests. However, this
 	// introduces two sources for the port configuration. We should remove this
 	// and adapt the acceptance tests.
 	DispatchedPortStart *int `toml:"dispatched_port_start,omitempty"`
 	DispatchedPortEnd   *int `toml:"dispatched_port_end,omitempty"`
+	// AdditionalIAs are the ISD-ASes that are served by this router process in
+	// addition to the ISD-AS of the general configuration.
+	AdditionalIAs []IAConfig `toml:"additional_ias,omitempty"`
+}
+
+// IAConfig is the configuration of an additional ISD-AS served by the router.
+// The ISD-AS is determined by the topology file in the configuration directory.
+type IAConfig struct {
+	// ID is the identifier of the router in the topology of the ISD-AS.
+	ID string `toml:"id"`
+	// ConfigDir is the directory that contains the topology and keys of the
+	// ISD-AS.
+	ConfigDir string `toml:"config_dir"`
 }
 
 // BFD configuration. Unfortunately cannot be shared with topology.BFD
@@ -65,6 +86,49 @@ type BFD struct {
 	RequiredMinRxInterval util.DurWrap `toml:"required_min_rx_interval,omitempty"`
 }
 
+// QoS configures the scheduling weights of the QoS classes of the egress queues. When all classes
+// have packets queued, each class gets a share of the link proportional to its weight.
+type QoS struct {
+	ControlWeight    int `toml:"control_weight,omitempty"`
+	SCMPWeight       int `toml:"scmp_weight,omitempty"`
+	PriorityWeight   int `toml:"priority_weight,omitempty"`
+	BestEffortWeight int `toml:"best_effort_weight,omitempty"`
+}
+
+// RateLimit configures the token-bucket rate limits of the router, in packets per second. The
+// burst is the number of packets that may exceed the rate at once. A rate of 0 disables the
+// limit.
+type RateLimit struct {
+	// SlowPathInterfaceRate limits the slow-path work (SCMP errors, traceroute
+	// and one-hop path processing) per ingress interface.
+	SlowPathInterfaceRate  float64 `toml:"slow_path_interface_rate,omitempty"`
+	SlowPathInterfaceBurst int     `toml:"slow_path_interface_burst,omitempty"`
+	// SlowPathSourceASRate limits the slow-path work per source ISD-AS.
+	SlowPathSourceASRate  float64 `toml:"slow_path_source_as_rate,omitempty"`
+	SlowPathSourceASBurst int     `toml:"slow_path_source_as_burst,omitempty"`
+	// SourceASRate limits all packets, except BFD, per source ISD-AS.
+	SourceASRate  float64 `toml:"source_as_rate,omitempty"`
+	SourceASBurst int     `toml:"source_as_burst,omitempty"`
+}
+
+// FlowExport configures the export of sampled flows as IPFIX records. The export is disabled if
+// no collector is configured.
+type FlowExport struct {
+	// Collector is the UDP address (host:port) of the IPFIX collector.
+	Collector string `toml:"collector,omitempty"`
+	// SamplingInterval is the number of forwarded packets per sampled packet.
+	SamplingInterval int `toml:"sampling_interval,omitempty"`
+	// ExportInterval is the time between exports of the flows.
+	ExportInterval util.DurWrap `toml:"export_interval,omitempty"`
+	// MaxFlows is the maximum number of flows between exports.
+	MaxFlows int `toml:"max_flows,omitempty"`
+	// ObservationDomainID is the observation domain of the IPFIX messages.
+	ObservationDomainID uint32 `toml:"observation_domain_id,omitempty"`
+	// EnterpriseNumber is the private enterprise number of the SCION-specific
+	// information elements. It is required if the export is enabled.
+	EnterpriseNumber uint32 `toml:"enterprise_number,omitempty"`
+}
+
 func (cfg *RouterConfig) ConfigName() string {
 	return "router"
 }
@@ -82,6 +146,24 @@ func (cfg *RouterConfig) Validate() error {
 	if cfg.NumProcessors < 0 {
 		return serrors.New("Provided router config is invalid. NumProcessors < 0")
 	}
+	if cfg.KeyGracePeriod.Duration < 0 {
+		return serrors.New("Provided router config is invalid. KeyGracePeriod < 0")
+	}
+	if cfg.QoS.ControlWeight < 1 || cfg.QoS.SCMPWeight < 1 || cfg.QoS.PriorityWeight < 1 ||
+		cfg.QoS.BestEffortWeight < 1 {
+
+		return serrors.New("Provided router config is invalid. QoS weight < 1")
+	}
+	rl := cfg.RateLimit
+	if rl.SlowPathInterfaceRate < 0 || rl.SlowPathSourceASRate < 0 || rl.SourceASRate < 0 {
+		return serrors.New("Provided router config is invalid. Rate limit < 0")
+	}
+	if rl.SlowPathInterfaceBurst < 0 || rl.SlowPathSourceASBurst < 0 || rl.SourceASBurst < 0 {
+		return serrors.New("Provided router config is invalid. Rate limit burst < 0")
+	}
+	if err := cfg.FlowExport.Validate(); err != nil {
+		return err
+	}
 	if cfg.NumSlowPathProcessors < 1 {
 		return serrors.New("Provided router config is invalid. NumSlowPathProcessors < 1")
 	}
@@ -106,6 +188,16 @@ func (cfg *RouterConfig) Validate() error {
 				"EndHostStartPort is nil; EndHostEndPort isn't")
 		}
 	}
+	for i, ia := range cfg.AdditionalIAs {
+		if ia.ID == "" {
+			return serrors.New("provided router config is invalid. AdditionalIAs.ID is empty",
+				"index", i)
+		}
+		if ia.ConfigDir == "" {
+			return serrors.New("provided router config is invalid. "+
+				"AdditionalIAs.ConfigDir is empty", "index", i)
+		}
+	}
 	return nil
 }
 
@@ -138,6 +230,31 @@ func (cfg *RouterConfig) InitDefaults() {
 	if cfg.BatchSize == 0 {
 		cfg.BatchSize = 256
 	}
+	if cfg.KeyGracePeriod.Duration == 0 {
+		// Hop fields cannot be valid for longer than this.
+		cfg.KeyGracePeriod = util.DurWrap{Duration: path.MaxTTL}
+	}
+	if cfg.QoS.ControlWeight == 0 {
+		cfg.QoS.ControlWeight = 8
+	}
+	if cfg.QoS.SCMPWeight == 0 {
+		cfg.QoS.SCMPWeight = 2
+	}
+	if cfg.QoS.PriorityWeight == 0 {
+		cfg.QoS.PriorityWeight = 4
+	}
+	if cfg.QoS.BestEffortWeight == 0 {
+		cfg.QoS.BestEffortWeight = 1
+	}
+	if cfg.FlowExport.SamplingInterval == 0 {
+		cfg.FlowExport.SamplingInterval = 1000
+	}
+	if cfg.FlowExport.ExportInterval.Duration == 0 {
+		cfg.FlowExport.ExportInterval = util.DurWrap{Duration: time.Minute}
+	}
+	if cfg.FlowExport.MaxFlows == 0 {
+		cfg.FlowExport.MaxFlows = 65536
+	}
 	if cfg.BFD.DetectMult == 0 {
 		cfg.BFD.DetectMult = 3
 	}
@@ -149,6 +266,30 @@ func (cfg *RouterConfig) InitDefaults() {
 	}
 }
 
+func (cfg *FlowExport) Validate() error {
+	if cfg.SamplingInterval < 1 {
+		return serrors.New("Provided router config is invalid. Flow sampling interval < 1")
+	}
+	if cfg.ExportInterval.Duration <= 0 {
+		return serrors.New("Provided router config is invalid. Flow export interval <= 0")
+	}
+	if cfg.MaxFlows < 1 {
+		return serrors.New("Provided router config is invalid. Max flows < 1")
+	}
+	if cfg.Collector == "" {
+		return nil
+	}
+	if _, _, err := net.SplitHostPort(cfg.Collector); err != nil {
+		return serrors.Wrap("Provided router config is invalid. Invalid flow collector", err,
+			"collector", cfg.Collector)
+	}
+	if cfg.EnterpriseNumber == 0 {
+		return serrors.New("Provided router config is invalid. " +
+			"Flow export requires an enterprise number")
+	}
+	return nil
+}
+
 func (cfg *RouterConfig) Sample(dst io.Writer, path config.Path, ctx config.CtxMap) {
 	config.WriteString(dst, routerConfigSample)
 }
@@ -35,4 +35,77 @@ num_slow_processors = 1
 # read or write from / to the network socket.
 # (default 256)
 batch_size = 256
+
+# The time during which hop fields created with the previous forwarding key
+# are still accepted after a key rollover.
+# (default 24h)
+key_grace_period = "24h"
+
+# The scheduling weights of the QoS classes of the egress queues. When all
+# classes have packets queued, each class gets a share of the link
+# proportional to its weight.
+# [router.qos]
+# (default 8)
+# control_weight = 8
+# (default 2)
+# scmp_weight = 2
+# (default 4)
+# priority_weight = 4
+# (default 1)
+# best_effort_weight = 1
+
+# The token-bucket rate limits, in packets per second. The burst is the number
+# of packets that may exceed the rate at once; 0 means the same as the rate.
+# A rate of 0 disables the limit. Packets that exceed a limit are dropped.
+# [router.rate_limit]
+# Slow-path work (SCMP errors, traceroute and one-hop path processing) per
+# ingress interface.
+# (default 0)
+# slow_path_interface_rate = 1000
+# (default 0)
+# slow_path_interface_burst = 100
+# Slow-path work per source ISD-AS.
+# (default 0)
+# slow_path_source_as_rate = 100
+# (default 0)
+# slow_path_source_as_burst = 50
+# All packets, except BFD, per source ISD-AS.
+# (default 0)
+# source_as_rate = 0
+# (default 0)
+# source_as_burst = 0
+
+# The export of sampled flows as IPFIX records over UDP. Forwarded packets are
+# sampled and aggregated by source and destination ISD-AS, ingress and egress
+# interface, traffic class and L4 protocol. The export is disabled if no
+# collector is configured.
+# [router.flow_export]
+# The UDP address (host:port) of the IPFIX collector.
+# (default "")
+# collector = "192.0.2.10:4739"
+# The number of forwarded packets per sampled packet.
+# (default 1000)
+# sampling_interval = 1000
+# The time between exports of the flows.
+# (default 1m)
+# export_interval = "1m"
+# The maximum number of flows between exports.
+# (default 65536)
+# max_flows = 65536
+# The observation domain of the IPFIX messages.
+# (default 0)
+# observation_domain_id = 0
+# The private enterprise number of the SCION-specific information elements.
+# Required if the export is enabled.
+# (default 0)
+# enterprise_number = 0
+
+# Additional ISD-ASes served by this router process. Each ISD-AS has its own
+# topology and keys in its configuration directory, and its own data plane
+# with separate sockets and packet processors. The ISD-AS of the general
+# configuration is always served.
+# (default [])
+# [[router.additional_ias]]
+# id = "br1-ff00_0_112-1"
+# config_dir = "/etc/scion/ff00_0_112"
 `
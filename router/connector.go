@@ -15,7 +15,14 @@
 package router
 
 import (
+	"context"
+	"io"
+	"maps"
+	"slices"
 	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
 
 	"github.com/scionproto/scion/pkg/addr"
 	"github.com/scionproto/scion/pkg/log"
@@ -24,18 +31,22 @@ import (
 	"github.com/scionproto/scion/private/env"
 	"github.com/scionproto/scion/router/config"
 	"github.com/scionproto/scion/router/control"
+	"github.com/scionproto/scion/router/flowexport"
 )
 
 // Connector implements the Dataplane interface used by the router control API. It sets
-// up connections for the data plane.
+// up connections for the data plane. A connector can serve several ISD-ASes. Each ISD-AS has
+// its own data plane with its own key, internal interface, external links and sockets.
 type Connector struct {
-	DataPlane dataPlane
-
-	ia                 addr.IA
+	ias                []addr.IA
+	dataPlanes         map[addr.IA]*dataPlane
+	runConfig          RunConfig
+	authSCMP           bool
 	mtx                sync.Mutex
 	internalInterfaces []control.InternalInterface
-	externalInterfaces map[uint16]control.ExternalInterface
-	siblingInterfaces  map[uint16]control.SiblingInterface
+	externalInterfaces map[ifKey]control.ExternalInterface
+	siblingInterfaces  map[ifKey]control.SiblingInterface
+	capture            *capture
 	ReceiveBufferSize  int
 	SendBufferSize     int
 
@@ -44,28 +55,77 @@ type Connector struct {
 	DispatchedPortEnd   *int
 }
 
-var errMultiIA = serrors.New("different IA not allowed")
+// ifKey identifies an interface of one of the ISD-ASes served by the connector. Interface IDs
+// are only unique within an ISD-AS.
+type ifKey struct {
+	ia   addr.IA
+	ifID uint16
+}
+
+var (
+	errDuplicateIA = serrors.New("ISD-AS already exists")
+	errUnknownIA   = serrors.New("unknown ISD-AS")
+)
 
 // NewConnector returns a new connector: a data plane decorated with
 // a configuration interface.
 func NewConnector(config config.RouterConfig, features env.Features) *Connector {
-	return &Connector{
-		DataPlane: makeDataPlane(
-			RunConfig{
-				NumProcessors:         config.NumProcessors,
-				NumSlowPathProcessors: config.NumSlowPathProcessors,
-				BatchSize:             config.BatchSize,
-				ReceiveBufferSize:     config.ReceiveBufferSize,
-				SendBufferSize:        config.SendBufferSize,
+	c := &Connector{
+		dataPlanes: make(map[addr.IA]*dataPlane),
+		runConfig: RunConfig{
+			NumProcessors:         config.NumProcessors,
+			NumSlowPathProcessors: config.NumSlowPathProcessors,
+			BatchSize:             config.BatchSize,
+			ReceiveBufferSize:     config.ReceiveBufferSize,
+			SendBufferSize:        config.SendBufferSize,
+			KeyGracePeriod:        config.KeyGracePeriod.Duration,
+			QoSWeights: QoSWeights{
+				QoSClassBestEffort: config.QoS.BestEffortWeight,
+				QoSClassPriority:   config.QoS.PriorityWeight,
+				QoSClassSCMP:       config.QoS.SCMPWeight,
+				QoSClassControl:    config.QoS.ControlWeight,
+			},
+			RateLimits: RateLimits{
+				SlowPathInterface: RateLimit{
+					Rate:  config.RateLimit.SlowPathInterfaceRate,
+					Burst: config.RateLimit.SlowPathInterfaceBurst,
+				},
+				SlowPathSourceAS: RateLimit{
+					Rate:  config.RateLimit.SlowPathSourceASRate,
+					Burst: config.RateLimit.SlowPathSourceASBurst,
+				},
+				SourceAS: RateLimit{
+					Rate:  config.RateLimit.SourceASRate,
+					Burst: config.RateLimit.SourceASBurst,
+				},
 			},
-			features.ExperimentalSCMPAuthentication,
-		),
+			FlowSamplingInterval: config.FlowExport.SamplingInterval,
+		},
+		authSCMP:            features.ExperimentalSCMPAuthentication,
 		ReceiveBufferSize:   config.ReceiveBufferSize,
 		SendBufferSize:      config.SendBufferSize,
 		BFD:                 config.BFD,
 		DispatchedPortStart: config.DispatchedPortStart,
 		DispatchedPortEnd:   config.DispatchedPortEnd,
 	}
+	if config.FlowExport.Collector != "" {
+		c.runConfig.FlowCache = flowexport.NewCache(config.FlowExport.MaxFlows)
+	}
+	return c
+}
+
+// FlowCache returns the cache of the sampled flows, or nil if the flow export is disabled.
+func (c *Connector) FlowCache() *flowexport.Cache {
+	return c.runConfig.FlowCache
+}
+
+// dataPlane returns the data plane of the given ISD-AS. The caller must hold the lock.
+func (c *Connector) dataPlane(ia addr.IA) (*dataPlane, error) {
+	d, ok := c.dataPlanes[ia]
+	if !ok {
+		return nil, serrors.JoinNoStack(errUnknownIA, nil, "isd_as", ia)
+	}
+	return d, nil
 }
 
 // CreateIACtx creates the context for ISD-AS.
@@ -73,11 +133,16 @@ func (c *Connector) CreateIACtx(ia addr.IA) error {
 	c.mtx.Lock()
 	defer c.mtx.Unlock()
 	log.Debug("CreateIACtx", "isd_as", ia)
-	if !c.ia.IsZero() {
-		return serrors.JoinNoStack(errMultiIA, nil, "current", c.ia, "new", ia)
+	if _, ok := c.dataPlanes[ia]; ok {
+		return serrors.JoinNoStack(errDuplicateIA, nil, "isd_as", ia)
+	}
+	d := newDataPlane(c.runConfig, c.authSCMP)
+	if err := d.SetIA(ia); err != nil {
+		return err
 	}
-	c.ia = ia
-	return c.DataPlane.SetIA(ia)
+	c.dataPlanes[ia] = d
+	c.ias = append(c.ias, ia)
+	return nil
 }
 
 // AddInternalInterface adds the internal interface.
@@ -87,15 +152,19 @@ func (c *Connector) AddInternalInterface(
 	c.mtx.Lock()
 	defer c.mtx.Unlock()
 	log.Debug("Adding internal interface", "isd_as", ia, "local", localAddr)
-	if !c.ia.Equal(ia) {
-		return serrors.JoinNoStack(errMultiIA, nil, "current", c.ia, "new", ia)
+	d, err := c.dataPlane(ia)
+	if err != nil {
+		return err
+	}
+	if err := d.AddInternalInterface(localHost, provider, localAddr); err != nil {
+		return err
 	}
 	c.internalInterfaces = append(c.internalInterfaces, control.InternalInterface{
 		IA:       ia,
 		Provider: provider,
 		Addr:     localAddr,
 	})
-	return c.DataPlane.AddInternalInterface(localHost, provider, localAddr)
+	return nil
 }
 
 // AddExternalInterface adds a link between the local and remote address.
@@ -113,19 +182,22 @@ func (c *Connector) AddExternalInterface(
 		"link_bfd_enabled", link.BFD.Disable == nil || !*link.BFD.Disable,
 		"dataplane_bfd_enabled", !c.BFD.Disable)
 
-	if !c.ia.Equal(link.Local.IA) {
-		return serrors.JoinNoStack(errMultiIA, nil, "current", c.ia, "new", link.Local.IA)
+	d, err := c.dataPlane(link.Local.IA)
+	if err != nil {
+		return err
 	}
-	if err := c.DataPlane.AddNeighborIA(intf, link.Remote.IA); err != nil {
+	if err := d.AddNeighborIA(intf, link.Remote.IA); err != nil {
 		return serrors.Wrap("adding neighboring IA", err, "if_id", localIfID)
 	}
 
+	key := ifKey{ia: link.Local.IA, ifID: intf}
 	link.BFD = c.applyBFDDefaults(link.BFD)
 	if !owned {
 		if len(c.siblingInterfaces) == 0 {
-			c.siblingInterfaces = make(map[uint16]control.SiblingInterface)
+			c.siblingInterfaces = make(map[ifKey]control.SiblingInterface)
 		}
-		c.siblingInterfaces[intf] = control.SiblingInterface{
+		c.siblingInterfaces[key] = control.SiblingInterface{
+			IA:              link.Local.IA,
 			IfID:            intf,
 			InternalAddress: link.Remote.Addr, // address of the sibling router
 			Relationship:    link.LinkTo,
@@ -133,18 +205,18 @@ func (c *Connector) AddExternalInterface(
 			NeighborIA:      link.Remote.IA,
 			State:           control.InterfaceDown,
 		}
-		return c.DataPlane.AddNextHop(intf, link, localHost, remoteHost)
+		return d.AddNextHop(intf, link, localHost, remoteHost)
 	}
 
 	if len(c.externalInterfaces) == 0 {
-		c.externalInterfaces = make(map[uint16]control.ExternalInterface)
+		c.externalInterfaces = make(map[ifKey]control.ExternalInterface)
 	}
-	c.externalInterfaces[intf] = control.ExternalInterface{
+	c.externalInterfaces[key] = control.ExternalInterface{
 		IfID:  intf,
 		Link:  link,
 		State: control.InterfaceDown,
 	}
-	return c.DataPlane.AddExternalInterface(intf, link, localHost, remoteHost)
+	return d.AddExternalInterface(intf, link, localHost, remoteHost)
 }
 
 // AddSvc adds the service address for the given ISD-AS.
@@ -152,10 +224,11 @@ func (c *Connector) AddSvc(ia addr.IA, svc addr.SVC, a addr.Host, p uint16) erro
 	c.mtx.Lock()
 	defer c.mtx.Unlock()
 	log.Debug("Adding service", "isd_as", ia, "svc", svc, "address", a)
-	if !c.ia.Equal(ia) {
-		return serrors.JoinNoStack(errMultiIA, nil, "current", c.ia, "new", a)
+	d, err := c.dataPlane(ia)
+	if err != nil {
+		return err
 	}
-	return c.DataPlane.AddSvc(svc, a, p)
+	return d.AddSvc(svc, a, p)
 }
 
 // DelSvc deletes the service entry for the given ISD-AS and IP pair.
@@ -163,24 +236,34 @@ func (c *Connector) DelSvc(ia addr.IA, svc addr.SVC, a addr.Host, p uint16) erro
 	c.mtx.Lock()
 	defer c.mtx.Unlock()
 	log.Debug("Deleting service", "isd_as", ia, "svc", svc, "address", a)
-	if !c.ia.Equal(ia) {
-		return serrors.JoinNoStack(errMultiIA, nil, "current", c.ia, "new", a)
+	d, err := c.dataPlane(ia)
+	if err != nil {
+		return err
 	}
-	return c.DataPlane.DelSvc(svc, a, p)
+	return d.DelSvc(svc, a, p)
 }
 
-// SetKey sets the key for the given ISD-AS at the given index.
+// SetKey sets the key for the given ISD-AS at the given index. Index 0 is the
+// current key, which is used for MAC computation and verification. Index 1 is
+// the previous key, which is only accepted for MAC verification during the key
+// grace period. Setting a new current key while the data plane is running rolls
+// the keys over, i.e., the old current key becomes the previous key.
 func (c *Connector) SetKey(ia addr.IA, index int, key []byte) error {
 	c.mtx.Lock()
 	defer c.mtx.Unlock()
 	log.Debug("Setting key", "isd_as", ia, "index", index)
-	if !c.ia.Equal(ia) {
-		return serrors.JoinNoStack(errMultiIA, nil, "current", c.ia, "new", ia)
+	d, err := c.dataPlane(ia)
+	if err != nil {
+		return err
 	}
-	if index != 0 {
-		return serrors.New("currently only index 0 key is supported")
+	switch index {
+	case 0:
+		return d.SetKey(key)
+	case 1:
+		return d.SetPreviousKey(key)
+	default:
+		return serrors.New("invalid key index", "index", index)
 	}
-	return c.DataPlane.SetKey(key)
 }
 
 func (c *Connector) ListInternalInterfaces() ([]control.InternalInterface, error) {
@@ -198,8 +281,10 @@ func (c *Connector) ListExternalInterfaces() ([]control.ExternalInterface, error
 	defer c.mtx.Unlock()
 
 	externalInterfaceList := make([]control.ExternalInterface, 0, len(c.externalInterfaces))
-	for _, externalInterface := range c.externalInterfaces {
-		externalInterface.State = c.DataPlane.getInterfaceState(externalInterface.IfID)
+	for key, externalInterface := range c.externalInterfaces {
+		d := c.dataPlanes[key.ia]
+		externalInterface.State = d.getInterfaceState(externalInterface.IfID)
+		externalInterface.RTT = d.getInterfaceRTT(externalInterface.IfID)
 		externalInterfaceList = append(externalInterfaceList, externalInterface)
 	}
 	return externalInterfaceList, nil
@@ -210,8 +295,8 @@ func (c *Connector) ListSiblingInterfaces() ([]control.SiblingInterface, error)
 	defer c.mtx.Unlock()
 
 	siblingInterfaceList := make([]control.SiblingInterface, 0, len(c.siblingInterfaces))
-	for _, siblingInterface := range c.siblingInterfaces {
-		siblingInterface.State = c.DataPlane.getInterfaceState(siblingInterface.IfID)
+	for key, siblingInterface := range c.siblingInterfaces {
+		siblingInterface.State = c.dataPlanes[key.ia].getInterfaceState(siblingInterface.IfID)
 		siblingInterfaceList = append(siblingInterfaceList, siblingInterface)
 	}
 	return siblingInterfaceList, nil
@@ -240,7 +325,8 @@ func (c *Connector) applyBFDDefaults(cfg control.BFD) control.BFD {
 	return cfg
 }
 
-func (c *Connector) SetPortRange(start, end uint16) {
+// SetPortRange sets the range of ports of the dispatched end hosts for the given ISD-AS.
+func (c *Connector) SetPortRange(ia addr.IA, start, end uint16) error {
 	c.mtx.Lock()
 	defer c.mtx.Unlock()
 	if c.DispatchedPortStart != nil {
@@ -249,6 +335,111 @@ func (c *Connector) SetPortRange(start, end uint16) {
 	if c.DispatchedPortEnd != nil {
 		end = uint16(*c.DispatchedPortEnd)
 	}
-	log.Debug("Endhost port range configuration", "startPort", start, "endPort", end)
-	c.DataPlane.SetPortRange(start, end)
+	log.Debug("Endhost port range configuration", "isd_as", ia, "startPort", start, "endPort", end)
+	d, err := c.dataPlane(ia)
+	if err != nil {
+		return err
+	}
+	d.SetPortRange(start, end)
+	return nil
+}
+
+// StartCapture starts capturing the packets that match the filter on the data planes of all
+// ISD-ASes. It replaces the previous capture. Zero values of the limits are replaced by their
+// defaults.
+func (c *Connector) StartCapture(cfg control.CaptureConfig) error {
+	if cfg.MaxPackets == 0 {
+		cfg.MaxPackets = DefaultCapturePackets
+	}
+	if cfg.Duration == 0 {
+		cfg.Duration = DefaultCaptureDuration
+	}
+	if cfg.SnapLen == 0 {
+		cfg.SnapLen = DefaultCaptureSnapLen
+	}
+	if cfg.MaxPackets < 0 || cfg.MaxPackets > MaxCapturePackets {
+		return serrors.New("invalid number of packets", "packets", cfg.MaxPackets,
+			"max", MaxCapturePackets)
+	}
+	if cfg.Duration < 0 || cfg.Duration > MaxCaptureDuration {
+		return serrors.New("invalid duration", "duration", cfg.Duration,
+			"max", MaxCaptureDuration)
+	}
+	if cfg.SnapLen < 0 || cfg.SnapLen > MaxCaptureSnapLen {
+		return serrors.New("invalid snap length", "snap_length", cfg.SnapLen,
+			"max", MaxCaptureSnapLen)
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if !cfg.Filter.IA.IsZero() {
+		if _, err := c.dataPlane(cfg.Filter.IA); err != nil {
+			return err
+		}
+	}
+	if c.capture != nil {
+		c.capture.stop()
+	}
+	c.capture = newCapture(cfg, time.Now())
+	for _, d := range c.dataPlanes {
+		d.capture.Store(c.capture)
+	}
+	log.Info("Started packet capture", "filter", cfg.Filter, "packets", cfg.MaxPackets,
+		"duration", cfg.Duration)
+	return nil
+}
+
+// StopCapture stops the current capture. The captured packets are kept until the next capture
+// starts.
+func (c *Connector) StopCapture() error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.capture == nil {
+		return control.ErrNoCapture
+	}
+	c.capture.stop()
+	return nil
+}
+
+// CaptureStatus returns the status of the current capture.
+func (c *Connector) CaptureStatus() (control.CaptureStatus, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.capture == nil {
+		return control.CaptureStatus{}, control.ErrNoCapture
+	}
+	return c.capture.status(time.Now()), nil
+}
+
+// WriteCapture writes the packets of the current capture in the pcapng format.
+func (c *Connector) WriteCapture(w io.Writer) error {
+	c.mtx.Lock()
+	capture := c.capture
+	c.mtx.Unlock()
+	if capture == nil {
+		return control.ErrNoCapture
+	}
+	return capture.writeTo(w)
+}
+
+// Run runs the data planes of all ISD-ASes until the context is canceled. Each
+// data plane has its own sockets and packet processors.
+func (c *Connector) Run(ctx context.Context) error {
+	c.mtx.Lock()
+	ias := slices.Clone(c.ias)
+	dataPlanes := maps.Clone(c.dataPlanes)
+	c.mtx.Unlock()
+
+	g, ctx := errgroup.WithContext(ctx)
+	for _, ia := range ias {
+		d := dataPlanes[ia]
+		g.Go(func() error {
+			defer log.HandlePanic()
+			if err := d.Run(ctx); err != nil {
+				return serrors.Wrap("running dataplane", err, "isd_as", ia)
+			}
+			return nil
+		})
+	}
+	return g.Wait()
 }
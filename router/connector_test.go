@@ -0,0 +1,121 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package router_test
+
+import (
+	"bytes"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/private/env"
+	"github.com/scionproto/scion/private/topology"
+	"github.com/scionproto/scion/router"
+	"github.com/scionproto/scion/router/config"
+	"github.com/scionproto/scion/router/control"
+)
+
+func TestConnectorMultipleIAs(t *testing.T) {
+	ia1 := addr.MustParseIA("1-ff00:0:110")
+	ia2 := addr.MustParseIA("1-ff00:0:111")
+	local := addr.HostIP(netip.MustParseAddr("127.0.0.1"))
+
+	var cfg config.RouterConfig
+	cfg.InitDefaults()
+	c := router.NewConnector(cfg, env.Features{})
+
+	require.NoError(t, c.CreateIACtx(ia1))
+	require.NoError(t, c.CreateIACtx(ia2))
+	assert.Error(t, c.CreateIACtx(ia1), "duplicate ISD-AS")
+
+	for _, ia := range []addr.IA{ia1, ia2} {
+		require.NoError(t, c.SetKey(ia, 0, []byte("testkey_xxxxxxxx")))
+		require.NoError(t, c.AddInternalInterface(ia, local, "udpip", "127.0.0.1:0"))
+		require.NoError(t, c.SetPortRange(ia, 31000, 32767))
+	}
+
+	// Interface IDs are only unique within an ISD-AS.
+	disable := true
+	for _, ia := range []addr.IA{ia1, ia2} {
+		link := control.LinkInfo{
+			Provider: "udpip",
+			Local:    control.LinkEnd{IA: ia, Addr: "127.0.0.1:0", IfID: 1},
+			Remote:   control.LinkEnd{IA: addr.MustParseIA("1-ff00:0:112"), Addr: "127.0.0.1:0"},
+			LinkTo:   topology.Child,
+			BFD:      control.BFD{Disable: &disable},
+			MTU:      1400,
+		}
+		require.NoError(t, c.AddExternalInterface(1, link, local, local, true))
+	}
+
+	internal, err := c.ListInternalInterfaces()
+	require.NoError(t, err)
+	require.Len(t, internal, 2)
+	assert.Equal(t, ia1, internal[0].IA)
+	assert.Equal(t, ia2, internal[1].IA)
+
+	external, err := c.ListExternalInterfaces()
+	require.NoError(t, err)
+	require.Len(t, external, 2)
+	ias := []addr.IA{external[0].Link.Local.IA, external[1].Link.Local.IA}
+	assert.ElementsMatch(t, []addr.IA{ia1, ia2}, ias)
+
+	unknown := addr.MustParseIA("1-ff00:0:112")
+	assert.Error(t, c.SetKey(unknown, 0, []byte("testkey_xxxxxxxx")))
+	assert.Error(t, c.AddInternalInterface(unknown, local, "udpip", "127.0.0.1:0"))
+	assert.Error(t, c.AddSvc(unknown, addr.SvcCS, local, 30252))
+	assert.Error(t, c.SetPortRange(unknown, 31000, 32767))
+}
+
+func TestConnectorCapture(t *testing.T) {
+	ia := addr.MustParseIA("1-ff00:0:110")
+	var cfg config.RouterConfig
+	cfg.InitDefaults()
+	c := router.NewConnector(cfg, env.Features{})
+	require.NoError(t, c.CreateIACtx(ia))
+
+	_, err := c.CaptureStatus()
+	assert.ErrorIs(t, err, control.ErrNoCapture)
+	assert.ErrorIs(t, c.StopCapture(), control.ErrNoCapture)
+
+	assert.Error(t, c.StartCapture(control.CaptureConfig{MaxPackets: router.MaxCapturePackets + 1}))
+	assert.Error(t, c.StartCapture(control.CaptureConfig{Duration: -time.Second}))
+	assert.Error(t, c.StartCapture(control.CaptureConfig{
+		Filter: control.CaptureFilter{IA: addr.MustParseIA("1-ff00:0:111")},
+	}))
+
+	require.NoError(t, c.StartCapture(control.CaptureConfig{
+		Filter: control.CaptureFilter{IA: ia},
+	}))
+	status, err := c.CaptureStatus()
+	require.NoError(t, err)
+	assert.True(t, status.Running)
+	assert.Equal(t, router.DefaultCapturePackets, status.Config.MaxPackets)
+	assert.Equal(t, router.DefaultCaptureDuration, status.Config.Duration)
+	assert.Equal(t, router.DefaultCaptureSnapLen, status.Config.SnapLen)
+
+	require.NoError(t, c.StopCapture())
+	status, err = c.CaptureStatus()
+	require.NoError(t, err)
+	assert.False(t, status.Running)
+
+	var buf bytes.Buffer
+	require.NoError(t, c.WriteCapture(&buf))
+	assert.NotZero(t, buf.Len())
+}
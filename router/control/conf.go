@@ -18,8 +18,10 @@ package control
 
 import (
 	"crypto/sha256"
+	"io"
 	"net/netip"
 	"sort"
+	"time"
 
 	"golang.org/x/crypto/pbkdf2"
 
@@ -39,7 +41,7 @@ type Dataplane interface {
 	AddSvc(ia addr.IA, svc addr.SVC, a addr.Host, port uint16) error
 	DelSvc(ia addr.IA, svc addr.SVC, a addr.Host, port uint16) error
 	SetKey(ia addr.IA, index int, key []byte) error
-	SetPortRange(start, end uint16)
+	SetPortRange(ia addr.IA, start, end uint16) error
 }
 
 // BFD is the configuration for the BFD sessions.
@@ -51,6 +53,9 @@ type LinkInfo struct {
 	Provider string
 	Local    LinkEnd
 	Remote   LinkEnd
+	// Bundle lists the underlay connections of a link bundle, nil if the link has a single
+	// connection. Provider, Local.Addr and Remote.Addr are those of the first member.
+	Bundle   []topology.BundleMember
 	Instance string
 	LinkTo   topology.LinkType
 	BFD      BFD
@@ -70,6 +75,54 @@ type ObservableDataplane interface {
 	ListSiblingInterfaces() ([]SiblingInterface, error)
 }
 
+// ErrNoCapture is returned by the PacketCapturer if no capture has been started.
+var ErrNoCapture = serrors.New("no capture")
+
+// PacketCapturer captures the packets that match a filter, together with the verdict of the
+// data plane, e.g. the SCMP error the packet caused. At most one capture exists at a time.
+type PacketCapturer interface {
+	// StartCapture starts a capture. It replaces the previous capture, if any.
+	StartCapture(cfg CaptureConfig) error
+	// StopCapture stops the current capture. The captured packets are kept.
+	StopCapture() error
+	// CaptureStatus returns the status of the current capture.
+	CaptureStatus() (CaptureStatus, error)
+	// WriteCapture writes the captured packets in the pcapng format.
+	WriteCapture(w io.Writer) error
+}
+
+// CaptureFilter selects the packets to capture. Fields with the zero value match any packet.
+type CaptureFilter struct {
+	// IA is the local ISD-AS of the data plane that processes the packet.
+	IA addr.IA
+	// Ingress is the interface on which the packet is received; 0 is the internal interface.
+	Ingress *iface.ID
+	SrcIA   addr.IA
+	DstIA   addr.IA
+	// Host matches the source or destination host address.
+	Host netip.Addr
+	// Port matches the source or destination UDP or TCP port.
+	Port uint16
+}
+
+// CaptureConfig is the configuration of a capture. The capture stops after MaxPackets packets
+// or when Duration has passed, whichever comes first.
+type CaptureConfig struct {
+	Filter     CaptureFilter
+	MaxPackets int
+	Duration   time.Duration
+	// SnapLen is the maximum number of bytes captured per packet.
+	SnapLen int
+}
+
+// CaptureStatus is the status of a capture.
+type CaptureStatus struct {
+	Config  CaptureConfig
+	Start   time.Time
+	Running bool
+	Packets int
+}
+
 // InternalInterface represents the internal underlay interface of a router.
 type InternalInterface struct {
 	IA       addr.IA
@@ -85,12 +138,16 @@ type ExternalInterface struct {
 	Link LinkInfo
 	// State indicates the interface state.
 	State InterfaceState
+	// RTT is the round-trip time of the link as measured by BFD. It is 0 if it is not known.
+	RTT time.Duration
 }
 
 // SiblingInterface represents an external interface owned by another router in the same AS. This
 // data structure informs the creation of a sibling link between the local router and the sibling
 // router for the purpose of directing traffic to the sibling interface via the sibling router.
 type SiblingInterface struct {
+	// IA is the ISD-AS number of the local AS this interface belongs to.
+	IA addr.IA
 	// InterfaceID is the identifier of the external interface.
 	IfID uint16
 	// InternalAddress is the local address of an inner-facing interface of the sibling router that
@@ -130,13 +187,21 @@ func ConfigDataplane(dp Dataplane, cfg *Config) error {
 		return err
 	}
 	// Set Keys
-	// XXX HSR currently only support 1 key, so use Key0
+	// Key0 is the current key. Key1 is the previous key, it is accepted during
+	// the key grace period such that hop fields created before a key rollover
+	// stay valid across a restart.
 	// Should it be an error if no key is set?
 	if len(cfg.MasterKeys.Key0) > 0 {
 		key0 := DeriveHFMacKey(cfg.MasterKeys.Key0)
 		if err := dp.SetKey(cfg.IA, 0, key0); err != nil {
 			return err
 		}
+		if len(cfg.MasterKeys.Key1) > 0 {
+			key1 := DeriveHFMacKey(cfg.MasterKeys.Key1)
+			if err := dp.SetKey(cfg.IA, 1, key1); err != nil {
+				return err
+			}
+		}
 	}
 
 	// Add internal interfaces
@@ -162,8 +227,8 @@ func ConfigDataplane(dp Dataplane, cfg *Config) error {
 		return err
 	}
 	// Set Endhost port range
-	dp.SetPortRange(cfg.Topo.PortRange())
-	return nil
+	start, end := cfg.Topo.PortRange()
+	return dp.SetPortRange(cfg.IA, start, end)
 }
 
 // DeriveHFMacKey derives the MAC key from the given key.
@@ -205,6 +270,7 @@ func confExternalInterfaces(dp Dataplane, cfg *Config) error {
 				Addr: iface.Remote,
 				IfID: iface.RemoteIfID,
 			},
+			Bundle:   iface.Bundle,
 			Instance: iface.BRName,
 			BFD:      BFD(iface.BFD),
 			LinkTo:   iface.LinkType,
@@ -243,6 +309,7 @@ func confExternalInterfaces(dp Dataplane, cfg *Config) error {
 			linkInfo.Remote.Addr = iface.InternalAddr.String() // i.e. via sibling router.
 			localHost = addr.HostIP(cfg.BR.InternalAddr.Addr())
 			remoteHost = addr.HostIP(iface.InternalAddr.Addr())
+			linkInfo.Bundle = nil
 
 			// The link is between two AS-local routers. TODO(multi_underlay): double check it's
 			// not used for other purposes where the far router's AS is expected.
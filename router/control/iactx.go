@@ -15,9 +15,11 @@
 package control
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"sync"
 
 	"github.com/scionproto/scion/pkg/addr"
 	"github.com/scionproto/scion/pkg/log"
@@ -98,6 +100,9 @@ type IACtx struct {
 	Config *Config
 	// DP is the underlying data plane.
 	DP Dataplane
+
+	// keyMtx serializes key rollovers.
+	keyMtx sync.Mutex
 }
 
 // Configure configures the dataplane for the given context.
@@ -120,6 +125,33 @@ func (iac *IACtx) Configure() error {
 	return nil
 }
 
+// RolloverKey loads the master keys from the config directory and switches the
+// data plane to the forwarding key derived from master0.key. The data plane
+// keeps accepting the key that was used before during the key grace period.
+// The returned value indicates whether the key changed.
+func (iac *IACtx) RolloverKey(confDir string) (bool, error) {
+	iac.keyMtx.Lock()
+	defer iac.keyMtx.Unlock()
+
+	cfg := iac.Config
+	if cfg == nil {
+		return false, serrors.New("empty configuration")
+	}
+	keys, err := keyconf.LoadMaster(filepath.Join(confDir, "keys"))
+	if err != nil {
+		return false, serrors.Wrap("loading master keys", err)
+	}
+	if bytes.Equal(keys.Key0, cfg.MasterKeys.Key0) {
+		return false, nil
+	}
+	if err := iac.DP.SetKey(cfg.IA, 0, DeriveHFMacKey(keys.Key0)); err != nil {
+		return false, serrors.Wrap("setting key", err)
+	}
+	cfg.MasterKeys = keys
+	log.Info("Forwarding key rolled over")
+	return true, nil
+}
+
 func dumpConfig(cfg *Config) (string, error) {
 	if cfg == nil {
 		return "", serrors.New("empty configuration")
@@ -0,0 +1,82 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control_test
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/private/keyconf"
+	"github.com/scionproto/scion/router/control"
+)
+
+func TestIACtxRolloverKey(t *testing.T) {
+	ia := addr.MustParseIA("1-ff00:0:110")
+	dir := t.TempDir()
+	writeKey := func(name, key string) {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "keys"), 0o755))
+		enc := base64.StdEncoding.EncodeToString([]byte(key))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "keys", name), []byte(enc), 0o644))
+	}
+	writeKey(keyconf.MasterKey0, "old_key_xxxxxxxx")
+	writeKey(keyconf.MasterKey1, "older_key_xxxxxx")
+
+	keys, err := keyconf.LoadMaster(filepath.Join(dir, "keys"))
+	require.NoError(t, err)
+	dp := &keyDataplane{}
+	iaCtx := &control.IACtx{
+		Config: &control.Config{IA: ia, MasterKeys: keys},
+		DP:     dp,
+	}
+
+	changed, err := iaCtx.RolloverKey(dir)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Empty(t, dp.keys)
+
+	writeKey(keyconf.MasterKey1, "old_key_xxxxxxxx")
+	writeKey(keyconf.MasterKey0, "new_key_xxxxxxxx")
+	changed, err = iaCtx.RolloverKey(dir)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, [][]byte{control.DeriveHFMacKey([]byte("new_key_xxxxxxxx"))}, dp.keys)
+	assert.Equal(t, []byte("new_key_xxxxxxxx"), iaCtx.Config.MasterKeys.Key0)
+
+	changed, err = iaCtx.RolloverKey(dir)
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	_, err = iaCtx.RolloverKey(t.TempDir())
+	assert.Error(t, err)
+}
+
+// keyDataplane records the current keys that are set.
+type keyDataplane struct {
+	control.Dataplane
+	keys [][]byte
+}
+
+func (d *keyDataplane) SetKey(_ addr.IA, index int, key []byte) error {
+	if index == 0 {
+		d.keys = append(d.keys, key)
+	}
+	return nil
+}
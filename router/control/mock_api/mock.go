@@ -1,10 +1,11 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/scionproto/scion/router/control (interfaces: ObservableDataplane)
+// Source: github.com/scionproto/scion/router/control (interfaces: ObservableDataplane,PacketCapturer)
 
 // Package mock_api is a generated GoMock package.
 package mock_api
 
 import (
+	io "io"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -78,3 +79,83 @@ func (mr *MockObservableDataplaneMockRecorder) ListSiblingInterfaces() *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSiblingInterfaces", reflect.TypeOf((*MockObservableDataplane)(nil).ListSiblingInterfaces))
 }
+
+// MockPacketCapturer is a mock of PacketCapturer interface.
+type MockPacketCapturer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPacketCapturerMockRecorder
+}
+
+// MockPacketCapturerMockRecorder is the mock recorder for MockPacketCapturer.
+type MockPacketCapturerMockRecorder struct {
+	mock *MockPacketCapturer
+}
+
+// NewMockPacketCapturer creates a new mock instance.
+func NewMockPacketCapturer(ctrl *gomock.Controller) *MockPacketCapturer {
+	mock := &MockPacketCapturer{ctrl: ctrl}
+	mock.recorder = &MockPacketCapturerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPacketCapturer) EXPECT() *MockPacketCapturerMockRecorder {
+	return m.recorder
+}
+
+// CaptureStatus mocks base method.
+func (m *MockPacketCapturer) CaptureStatus() (control.CaptureStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureStatus")
+	ret0, _ := ret[0].(control.CaptureStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureStatus indicates an expected call of CaptureStatus.
+func (mr *MockPacketCapturerMockRecorder) CaptureStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureStatus", reflect.TypeOf((*MockPacketCapturer)(nil).CaptureStatus))
+}
+
+// StartCapture mocks base method.
+func (m *MockPacketCapturer) StartCapture(arg0 control.CaptureConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartCapture", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartCapture indicates an expected call of StartCapture.
+func (mr *MockPacketCapturerMockRecorder) StartCapture(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartCapture", reflect.TypeOf((*MockPacketCapturer)(nil).StartCapture), arg0)
+}
+
+// StopCapture mocks base method.
+func (m *MockPacketCapturer) StopCapture() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopCapture")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopCapture indicates an expected call of StopCapture.
+func (mr *MockPacketCapturerMockRecorder) StopCapture() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopCapture", reflect.TypeOf((*MockPacketCapturer)(nil).StopCapture))
+}
+
+// WriteCapture mocks base method.
+func (m *MockPacketCapturer) WriteCapture(arg0 io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteCapture", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteCapture indicates an expected call of WriteCapture.
+func (mr *MockPacketCapturerMockRecorder) WriteCapture(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteCapture", reflect.TypeOf((*MockPacketCapturer)(nil).WriteCapture), arg0)
+}
@@ -17,6 +17,7 @@
 package router
 
 import (
+	"bytes"
 	"context"
 	"crypto/subtle"
 	"encoding/binary"
@@ -53,6 +54,7 @@ import (
 	underlayconn "github.com/scionproto/scion/private/underlay/conn"
 	"github.com/scionproto/scion/router/bfd"
 	"github.com/scionproto/scion/router/control"
+	"github.com/scionproto/scion/router/flowexport"
 )
 
 const (
@@ -108,6 +110,7 @@ const (
 	pForward
 	pSlowPath
 	pDone
+	pRateLimited
 )
 
 // Packet aggregates buffers and ancillary metadata related to one packet.
@@ -133,6 +136,9 @@ type Packet struct {
 	RemoteAddr unsafe.Pointer
 	// The ingest link; which can give us the ifID, scope, bfdSession...
 	Link Link
+	// Pad to 64 bytes. For 64bit arch, nothing is needed. For 32bit arch, add 28 bytes. This
+	// cannot be the last field: a zero-sized last field is padded.
+	_ [is32bit * 28]byte
 	// Additional metadata in case the packet is put on the slow path. Updated in-place.
 	slowPathRequest slowPathRequest
 	// The egress on which this packet must leave. This is set by the processing routine.
@@ -140,8 +146,9 @@ type Packet struct {
 	// The type of traffic. This is used for metrics at the forwarding stage, but is most
 	// economically determined at the processing stage. So store it here. It's 2 bytes long.
 	trafficType trafficType
-	// Pad to 64 bytes. For 64bit arch, add 1 byte. For 32bit arch, add 29 bytes.
-	_ [1 + is32bit*28]byte
+	// The QoS class, which selects the egress queue. Like the traffic type, it is determined at
+	// the processing stage.
+	qosClass QoSClass
 }
 
 // Keep this 4 bytes long. See comment for packet.
@@ -227,14 +234,17 @@ type dataPlane struct {
 	numInterfaces       int
 	linkTypes           [math.MaxUint16 + 1]topology.LinkType
 	neighborIAs         [math.MaxUint16 + 1]addr.IA
+	knownIAs            map[addr.IA]struct{}
 	localHost           addr.Host
-	macFactory          func() hash.Hash
+	macKeys             atomic.Pointer[macKeys]
 	localIA             addr.IA
 	mtx                 sync.Mutex
 	running             atomic.Bool
 	Metrics             *Metrics
 	dispatchedPortStart uint16
 	dispatchedPortEnd   uint16
+	rateLimiters        rateLimiters
+	capture             atomic.Pointer[capture]
 
 	ExperimentalSCMPAuthentication bool
 	RunConfig                      RunConfig
@@ -290,6 +300,10 @@ var (
 	zeroBuffer = make([]byte, 16)
 
 	metrics = NewMetrics() // There can be only one currently.
+
+	// processMetricsOnce makes sure that the process-wide metrics collector is only initialized
+	// once, even if the process runs several data planes.
+	processMetricsOnce sync.Once
 )
 
 type drkeyProvider interface {
@@ -305,7 +319,7 @@ type drkeyProvider interface {
 // newDataPlane returns a zero-valued data plane structure. The difference between
 // that and &dataPlane{} is that there are no nil pointers (i.e. maps are empty but exist and some
 // key objects like the underlay provider have been created) except for such things that cannot be
-// initialized at the beginning (i.e. packet pool and macFactory). Do not use a true zero valued
+// initialized at the beginning (i.e. packet pool and MAC keys). Do not use a true zero valued
 // struct for anything. Support for lazy initialization has been removed. It was much too
 // bug-friendly.
 func newDataPlane(runConfig RunConfig, authSCMP bool) *dataPlane {
@@ -330,6 +344,7 @@ func makeDataPlane(runConfig RunConfig, authSCMP bool) dataPlane {
 			),
 		},
 		Metrics:                        metrics,
+		rateLimiters:                   newRateLimiters(runConfig.RateLimits),
 		ExperimentalSCMPAuthentication: authSCMP,
 		RunConfig:                      runConfig,
 	}
@@ -380,34 +395,94 @@ func (d *dataPlane) SetIA(ia addr.IA) error {
 		return errAlreadySet
 	}
 	d.localIA = ia
+	d.addKnownIA(ia)
 	return nil
 }
 
-// SetKey sets the key used for MAC verification. The key provided here should
-// already be derived as in scrypto.HFMacFactory.
+// SetKey sets the key used for MAC computation and verification. The key
+// provided here should already be derived as in scrypto.HFMacFactory. If a
+// different key is already set, it becomes the previous key and is still
+// accepted for MAC verification during the key grace period. Unlike most other
+// settings, the key can be changed while the data plane is running.
 func (d *dataPlane) SetKey(key []byte) error {
 	d.mtx.Lock()
 	defer d.mtx.Unlock()
-	if d.isRunning() {
-		return errModifyExisting
-	}
 	if len(key) == 0 {
 		return errEmptyValue
 	}
-	if d.macFactory != nil {
-		return errAlreadySet
-	}
 	// First check for MAC creation errors.
 	if _, err := scrypto.InitMac(key); err != nil {
 		return err
 	}
-	d.macFactory = func() hash.Hash {
-		mac, _ := scrypto.InitMac(key)
-		return mac
+	old := d.macKeys.Load()
+	if old == nil {
+		d.macKeys.Store(&macKeys{current: key})
+		return nil
+	}
+	if bytes.Equal(old.current, key) {
+		return nil
+	}
+	d.macKeys.Store(&macKeys{
+		current:  key,
+		previous: old.current,
+		graceEnd: time.Now().Add(d.RunConfig.KeyGracePeriod),
+	})
+	return nil
+}
+
+// SetPreviousKey sets the previous key. It is accepted for MAC verification
+// during the key grace period, but it is never used for MAC computation. This
+// keeps hop fields that were created before a key rollover valid across a
+// restart of the router. SetKey must be called first.
+func (d *dataPlane) SetPreviousKey(key []byte) error {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	if len(key) == 0 {
+		return errEmptyValue
+	}
+	if _, err := scrypto.InitMac(key); err != nil {
+		return err
+	}
+	old := d.macKeys.Load()
+	if old == nil {
+		return serrors.New("current key not set")
+	}
+	if bytes.Equal(old.current, key) {
+		return nil
 	}
+	d.macKeys.Store(&macKeys{
+		current:  old.current,
+		previous: key,
+		graceEnd: time.Now().Add(d.RunConfig.KeyGracePeriod),
+	})
 	return nil
 }
 
+// macKeys is an immutable set of the keys used for MAC computation and
+// verification. The data plane replaces the whole set whenever a key changes,
+// the packet processors pick up the new set on the next packet.
+type macKeys struct {
+	// current is the key used for MAC computation and verification.
+	current []byte
+	// previous is the key that was used before the current one. It is only
+	// used for MAC verification until graceEnd. It is nil if there is none.
+	previous []byte
+	graceEnd time.Time
+}
+
+func (k *macKeys) currentMAC() hash.Hash {
+	mac, _ := scrypto.InitMac(k.current)
+	return mac
+}
+
+func (k *macKeys) previousMAC() hash.Hash {
+	if k.previous == nil {
+		return nil
+	}
+	mac, _ := scrypto.InitMac(k.previous)
+	return mac
+}
+
 func (d *dataPlane) SetPortRange(start, end uint16) {
 	d.dispatchedPortStart = start
 	d.dispatchedPortEnd = end
@@ -435,7 +510,7 @@ func (d *dataPlane) AddInternalInterface(localHost addr.Host, provider, localAdd
 		return serrors.JoinNoStack(errNoSuchUnderlay, nil, "provider", provider)
 	}
 	iMetrics := newInterfaceMetrics(d.Metrics, 0, d.localIA, "", d.neighborIAs[0])
-	lk, err := internalUnderlay.NewInternalLink(localAddr, d.RunConfig.BatchSize, iMetrics)
+	lk, err := internalUnderlay.NewInternalLink(localAddr, d.newEgressQueue(0, ""), iMetrics)
 	if err != nil {
 		return err
 	}
@@ -446,6 +521,13 @@ func (d *dataPlane) AddInternalInterface(localHost addr.Host, provider, localAdd
 	return nil
 }
 
+// newEgressQueue returns an egress queue for a link. The metrics are labeled like the ones of the
+// link.
+func (d *dataPlane) newEgressQueue(ifID uint16, sibling string) *EgressQueue {
+	return newEgressQueue(d.RunConfig.BatchSize, d.RunConfig.QoSWeights,
+		newQoSMetrics(d.Metrics, ifID, d.localIA, sibling, d.neighborIAs[ifID]))
+}
+
 // AddExternalInterface adds the inter AS connection for the given interface ID.
 // If a connection for the given ID is already set this method will return an
 // error. This can only be called on a not yet running dataplane.
@@ -458,6 +540,12 @@ func (d *dataPlane) AddExternalInterface(
 	if d.isRunning() {
 		return errModifyExisting
 	}
+	if len(link.Bundle) > 0 {
+		if d.interfaces[ifID] != nil {
+			return serrors.JoinNoStack(errAlreadySet, nil, "ifID", ifID)
+		}
+		return d.addBundle(ifID, link, localHost, remoteHost)
+	}
 	bfd, err := d.newExternalInterfaceBFD(ifID, link, localHost, remoteHost)
 	if err != nil {
 		return serrors.Wrap("adding external BFD", err, "if_id", ifID)
@@ -469,24 +557,12 @@ func (d *dataPlane) AddExternalInterface(
 		return errEmptyValue
 	}
 
-	underlay, instantiated := d.underlays[link.Provider]
-	if !instantiated {
-		underlayProvider, exists := underlayProviders[link.Provider]
-		if !exists {
-			panic(fmt.Sprintf("no provider for underlay: %q", link.Provider))
-		}
-		underlay = underlayProvider(
-			d.RunConfig.BatchSize,
-			d.RunConfig.SendBufferSize,
-			d.RunConfig.ReceiveBufferSize,
-		)
-		d.underlays[link.Provider] = underlay
-	}
+	underlay := d.underlay(link.Provider)
 	d.linkTypes[ifID] = link.LinkTo
 
 	iMetrics := newInterfaceMetrics(d.Metrics, ifID, d.localIA, "", d.neighborIAs[ifID])
 	lk, err := underlay.NewExternalLink(
-		d.RunConfig.BatchSize,
+		d.newEgressQueue(ifID, ""),
 		bfd,
 		link.Local.Addr,
 		link.Remote.Addr,
@@ -500,6 +576,24 @@ func (d *dataPlane) AddExternalInterface(
 	return nil
 }
 
+// underlay returns the underlay provider of the given name, instantiating it if needed.
+func (d *dataPlane) underlay(provider string) UnderlayProvider {
+	underlay, instantiated := d.underlays[provider]
+	if !instantiated {
+		underlayProvider, exists := underlayProviders[provider]
+		if !exists {
+			panic(fmt.Sprintf("no provider for underlay: %q", provider))
+		}
+		underlay = underlayProvider(
+			d.RunConfig.BatchSize,
+			d.RunConfig.SendBufferSize,
+			d.RunConfig.ReceiveBufferSize,
+		)
+		d.underlays[provider] = underlay
+	}
+	return underlay
+}
+
 // AddNeighborIA adds the neighboring IA for a given interface ID. If an IA for
 // the given ID is already set, this method will return an error. This can only
 // be called on a not yet running dataplane.
@@ -516,9 +610,19 @@ func (d *dataPlane) AddNeighborIA(ifID uint16, remote addr.IA) error {
 		return serrors.JoinNoStack(errAlreadySet, nil, "ifID", ifID)
 	}
 	d.neighborIAs[ifID] = remote
+	d.addKnownIA(remote)
 	return nil
 }
 
+// addKnownIA adds the IA to the configured IAs that are used as metric labels of the source of
+// packets. The caller must hold the lock.
+func (d *dataPlane) addKnownIA(ia addr.IA) {
+	if d.knownIAs == nil {
+		d.knownIAs = make(map[addr.IA]struct{})
+	}
+	d.knownIAs[ia] = struct{}{}
+}
+
 // newExternalInterfaceBFD adds the inter AS connection BFD session.
 func (d *dataPlane) newExternalInterfaceBFD(
 	ifID uint16, link control.LinkInfo, localHost, remoteHost addr.Host,
@@ -538,9 +642,10 @@ func (d *dataPlane) newExternalInterfaceBFD(
 			StateChanges:    d.Metrics.BFDInterfaceStateChanges.With(labels),
 			PacketsSent:     d.Metrics.BFDPacketsSent.With(labels),
 			PacketsReceived: d.Metrics.BFDPacketsReceived.With(labels),
+			RTT:             d.Metrics.BFDRTT.With(labels),
 		}
 	}
-	s, err := newBFDSend(d, link, localHost, remoteHost, ifID, false, d.macFactory())
+	s, err := newBFDSend(d, link, localHost, remoteHost, ifID, false)
 	if err != nil {
 		return nil, err
 	}
@@ -557,6 +662,20 @@ func (d *dataPlane) getInterfaceState(ifID uint16) control.InterfaceState {
 	return control.InterfaceUp
 }
 
+// getInterfaceRTT returns the round-trip time of the link of the input interfaceID, as
+// measured by the relevant BFD session. It returns 0 if there is no BFD session or if the
+// round-trip time is not known.
+func (d *dataPlane) getInterfaceRTT(ifID uint16) time.Duration {
+	link := d.interfaces[ifID]
+	if link == nil {
+		return 0
+	}
+	if s := link.BFDSession(); s != nil {
+		return s.RTT()
+	}
+	return 0
+}
+
 // AddSvc adds the address for the given service. This can be called multiple
 // times for the same service, with the address added to the list of addresses
 // that provide the service.
@@ -615,28 +734,17 @@ func (d *dataPlane) AddNextHop(
 	if link.Remote.Addr == "" {
 		return errEmptyValue
 	}
-	underlay, instantiated := d.underlays[link.Provider]
-	if !instantiated {
-		underlayProvider, exists := underlayProviders[link.Provider]
-		if !exists {
-			panic(fmt.Sprintf("no provider for underlay: %q", link.Provider))
-		}
-		underlay = underlayProvider(
-			d.RunConfig.BatchSize,
-			d.RunConfig.SendBufferSize,
-			d.RunConfig.ReceiveBufferSize,
-		)
-		d.underlays[link.Provider] = underlay
-	}
+	underlay := d.underlay(link.Provider)
 	d.linkTypes[ifID] = link.LinkTo
 
 	// Note that a link to the same sibling router might already exist. If so, it will be
-	// returned instead of creating a new one. As a result, the bfd session and metrics will be
-	// ignored and simply garbage collected.
+	// returned instead of creating a new one. As a result, the bfd session, egress queue, and
+	// metrics will be ignored and simply garbage collected.
 	iMetrics := newInterfaceMetrics(
 		d.Metrics, ifID, d.localIA, link.Remote.Addr, d.neighborIAs[ifID])
+	egressQ := d.newEgressQueue(ifID, link.Remote.Addr)
 	lk, err := underlay.NewSiblingLink(
-		d.RunConfig.BatchSize, bfd, link.Local.Addr, link.Remote.Addr, iMetrics)
+		egressQ, bfd, link.Local.Addr, link.Remote.Addr, iMetrics)
 	if err != nil {
 		return err
 	}
@@ -665,7 +773,7 @@ func (d *dataPlane) newNextHopBFD(
 		}
 	}
 
-	s, err := newBFDSend(d, link, localHost, remoteHost, ifID, true, d.macFactory())
+	s, err := newBFDSend(d, link, localHost, remoteHost, ifID, true)
 	if err != nil {
 		return nil, err
 	}
@@ -685,6 +793,19 @@ type RunConfig struct {
 	BatchSize             int
 	ReceiveBufferSize     int
 	SendBufferSize        int
+	// KeyGracePeriod is the time during which the previous key is still
+	// accepted for MAC verification after the key changed.
+	KeyGracePeriod time.Duration
+	// QoSWeights are the weights of the QoS classes in the egress queues of
+	// the links. Classes without a positive weight use DefaultQoSWeights.
+	QoSWeights QoSWeights
+	// RateLimits are the rate limits for slow-path work and per source AS.
+	RateLimits RateLimits
+	// FlowCache, if not nil, aggregates sampled forwarded packets into flows for export.
+	FlowCache *flowexport.Cache
+	// FlowSamplingInterval is the number of forwarded packets per sampled packet, counted per
+	// processor.
+	FlowSamplingInterval int
 }
 
 func (d *dataPlane) Run(ctx context.Context) error {
@@ -697,11 +818,12 @@ func (d *dataPlane) Run(ctx context.Context) error {
 
 	// Start our custom /proc/pid/stat collector to export iowait time and (in the future) other
 	// process-wide metrics that prometheus does not.
-	err := processmetrics.Init()
-	// we can live without these metrics. Just log the error.
-	if err != nil {
-		log.Error("Could not initialize processmetrics", "err", err)
-	}
+	processMetricsOnce.Do(func() {
+		// we can live without these metrics. Just log the error.
+		if err := processmetrics.Init(); err != nil {
+			log.Error("Could not initialize processmetrics", "err", err)
+		}
+	})
 
 	numConnections := 0
 	for _, u := range d.underlays {
@@ -739,9 +861,11 @@ func (d *dataPlane) Run(ctx context.Context) error {
 // current dataplane settings and allocates all the buffers
 func (d *dataPlane) initPacketPool(processorQueueSize int) {
 	// collect pool size and headroom reqs
+	// Each interface has a receive batch, an egress queue per QoS class (plus the packet at
+	// its head), and a send batch.
 	poolSize := d.numInterfaces*d.RunConfig.BatchSize +
 		(d.RunConfig.NumProcessors+d.RunConfig.NumSlowPathProcessors)*(processorQueueSize+1) +
-		d.numInterfaces*2*d.RunConfig.BatchSize
+		d.numInterfaces*(int(NumQoSClasses)*(d.RunConfig.BatchSize+1)+d.RunConfig.BatchSize)
 	headroom := 0
 	for _, u := range d.underlays {
 		h := u.Headroom()
@@ -788,6 +912,9 @@ func (d *dataPlane) runProcessor(id int, q <-chan *Packet, slowQ chan<- *Packet)
 			continue
 		}
 		disp := processor.processPkt(p)
+		if c := d.capture.Load(); c != nil {
+			processor.capturePacket(c, p, disp)
+		}
 
 		sc := ClassOfSize(len(p.RawPacket))
 		metrics := p.Link.Metrics()
@@ -797,7 +924,15 @@ func (d *dataPlane) runProcessor(id int, q <-chan *Packet, slowQ chan<- *Packet)
 		case pForward:
 			// Normal processing proceeds.
 		case pSlowPath:
-			// Not an error, processing continues on the slow path.
+			// Not an error, processing continues on the slow path, unless the sender exceeds
+			// its share of it.
+			ok, reason := d.rateLimiters.allowSlowPath(
+				processor.ingressFromLink, processor.scionLayer.SrcIA, time.Now())
+			if !ok {
+				processor.rateLimited(reason)
+				d.packetPool.Put(p)
+				continue
+			}
 			select {
 			case slowQ <- p:
 			default:
@@ -808,6 +943,9 @@ func (d *dataPlane) runProcessor(id int, q <-chan *Packet, slowQ chan<- *Packet)
 		case pDone: // Packets that don't need more processing (e.g. BFD)
 			d.packetPool.Put(p)
 			continue
+		case pRateLimited: // Packets dropped by a rate limit. Already counted.
+			d.packetPool.Put(p)
+			continue
 		case pDiscard: // Everything else
 			metrics[sc].DroppedPacketsInvalid.Inc()
 			d.packetPool.Put(p)
@@ -824,6 +962,9 @@ func (d *dataPlane) runProcessor(id int, q <-chan *Packet, slowQ chan<- *Packet)
 			metrics[sc].DroppedPacketsInvalid.Inc()
 			continue
 		}
+		if d.RunConfig.FlowCache != nil {
+			processor.sampleFlow(p)
+		}
 		if !fwLink.Send(p) {
 			d.packetPool.Put(p)
 			metrics[sc].DroppedPacketsBusyForwarder.Inc()
@@ -857,6 +998,7 @@ func (d *dataPlane) runSlowPathProcessor(id int, q <-chan *Packet) {
 			d.packetPool.Put(p)
 			continue
 		}
+		p.qosClass = QoSClassSCMP
 		if !egressLink.Send(p) {
 			d.packetPool.Put(p)
 		}
@@ -975,8 +1117,8 @@ func (p *slowPathPacketProcessor) processPacket(pkt *Packet) error {
 func newPacketProcessor(d *dataPlane) *scionPacketProcessor {
 	p := &scionPacketProcessor{
 		d:              d,
-		mac:            d.macFactory(),
 		macInputBuffer: make([]byte, max(path.MACBufferSize, libepic.MACBufferSize)),
+		prevMacBuffer:  make([]byte, path.MACBufferSize),
 	}
 	p.scionLayer.RecyclePaths()
 	return p
@@ -985,12 +1127,19 @@ func newPacketProcessor(d *dataPlane) *scionPacketProcessor {
 func (p *scionPacketProcessor) reset() error {
 	p.pkt = nil
 	p.ingressFromLink = 0
+	p.lastLayer = nil
+	p.cause = nil
 	// p.scionLayer // cannot easily be reset
 	p.path = nil
 	p.hopField = path.HopField{}
 	p.infoField = path.InfoField{}
 	p.effectiveXover = false
 	p.peering = false
+	if keys := p.d.macKeys.Load(); keys != p.macKeys {
+		p.macKeys = keys
+		p.mac = keys.currentMAC()
+		p.prevMac = keys.previousMAC()
+	}
 	p.mac.Reset()
 	p.cachedMac = nil
 	// Reset hbh layer
@@ -1007,9 +1156,16 @@ func errorDiscard(ctx ...any) disposition {
 	return pDiscard
 }
 
+// discard logs the error and returns the pDiscard disposition. The error is kept as the cause of
+// the verdict, in case the packet is captured.
+func (p *scionPacketProcessor) discard(err error) disposition {
+	p.cause = err
+	return errorDiscard("error", err)
+}
+
 func (p *scionPacketProcessor) processPkt(pkt *Packet) disposition {
 	if err := p.reset(); err != nil {
-		return errorDiscard("error", err)
+		return p.discard(err)
 	}
 	p.pkt = pkt
 	p.ingressFromLink = pkt.Link.IfID()
@@ -1018,7 +1174,15 @@ func (p *scionPacketProcessor) processPkt(pkt *Packet) disposition {
 	var err error
 	p.lastLayer, err = decodeLayers(pkt.RawPacket, &p.scionLayer, &p.hbhLayer, &p.e2eLayer)
 	if err != nil {
-		return errorDiscard("error", err)
+		return p.discard(err)
+	}
+	pkt.qosClass = classifyPacket(&p.scionLayer, p.lastLayer.NextLayerType())
+
+	// BFD is exempt, else a busy neighbor would lose its link.
+	if p.d.rateLimiters.sourceAS != nil && p.lastLayer.NextLayerType() != layers.LayerTypeBFD &&
+		!p.d.rateLimiters.sourceAS.allow(p.scionLayer.SrcIA, time.Now()) {
+
+		return p.rateLimited(rlSourceAS)
 	}
 
 	pld := p.lastLayer.LayerPayload()
@@ -1029,34 +1193,75 @@ func (p *scionPacketProcessor) processPkt(pkt *Packet) disposition {
 		if p.lastLayer.NextLayerType() == layers.LayerTypeBFD {
 			return p.processBFD(pld)
 		}
-		return errorDiscard("error", errUnsupportedPathTypeNextHeader)
+		return p.discard(errUnsupportedPathTypeNextHeader)
 
 	case onehop.PathType:
 		if p.lastLayer.NextLayerType() == layers.LayerTypeBFD {
 			_, ok := p.scionLayer.Path.(*onehop.Path)
 			if !ok {
-				return errorDiscard("error", errMalformedPath)
+				return p.discard(errMalformedPath)
 			}
 			return p.processBFD(pld)
 		}
+		ok, reason := p.d.rateLimiters.allowSlowPath(
+			p.ingressFromLink, p.scionLayer.SrcIA, time.Now())
+		if !ok {
+			return p.rateLimited(reason)
+		}
 		return p.processOHP()
 	case scion.PathType:
 		return p.processSCION()
 	case epic.PathType:
 		return p.processEPIC()
 	default:
-		return errorDiscard("error", errUnsupportedPathType)
+		return p.discard(errUnsupportedPathType)
+	}
+}
+
+// rateLimited counts the packet as dropped by a rate limit and returns the pRateLimited
+// disposition.
+func (p *scionPacketProcessor) rateLimited(reason rateLimitReason) disposition {
+	p.d.Metrics.RateLimitedPackets.WithLabelValues(
+		p.d.localIA.String(), p.d.sourceLabel(p.scionLayer.SrcIA), reason.String()).Inc()
+	return pRateLimited
+}
+
+// sourceLabel returns the metric label of the source IA of a packet. The source IA can be spoofed,
+// so only the local IA and the neighbor IAs, of which there is a fixed set, are used as labels.
+// All other IAs are labeled "other", so that the number of metric series is bounded.
+func (d *dataPlane) sourceLabel(src addr.IA) string {
+	if _, ok := d.knownIAs[src]; ok {
+		return src.String()
 	}
+	return "other"
+}
+
+// sampleFlow accounts every FlowSamplingInterval-th forwarded packet to its flow.
+func (p *scionPacketProcessor) sampleFlow(pkt *Packet) {
+	p.flowCount++
+	if p.flowCount < p.d.RunConfig.FlowSamplingInterval {
+		return
+	}
+	p.flowCount = 0
+	p.d.RunConfig.FlowCache.Add(flowexport.Key{
+		IA:           p.d.localIA,
+		SrcIA:        p.scionLayer.SrcIA,
+		DstIA:        p.scionLayer.DstIA,
+		Ingress:      p.ingressFromLink,
+		Egress:       pkt.egress,
+		TrafficClass: p.scionLayer.TrafficClass,
+		Proto:        uint8(nextHdr(p.lastLayer)),
+	}, len(pkt.RawPacket), time.Now())
 }
 
 func (p *scionPacketProcessor) processBFD(data []byte) disposition {
 	session := p.pkt.Link.BFDSession()
 	if session == nil {
-		return errorDiscard("error", errNoBFDSessionFound)
+		return p.discard(errNoBFDSessionFound)
 	}
 	bfd := &p.bfdLayer
 	if err := bfd.DecodeFromBytes(data, gopacket.NilDecodeFeedback); err != nil {
-		return errorDiscard("error", err)
+		return p.discard(err)
 	}
 	session.ReceiveMessage(bfd)
 	return pDone // All's fine. That packet's journey ends here.
@@ -1067,7 +1272,7 @@ func (p *scionPacketProcessor) processSCION() disposition {
 	p.path, ok = p.scionLayer.Path.(*scion.Raw)
 	if !ok {
 		// TODO(lukedirtwalker) parameter problem invalid path?
-		return errorDiscard("error", errMalformedPath)
+		return p.discard(errMalformedPath)
 	}
 	return p.process()
 }
@@ -1075,12 +1280,12 @@ func (p *scionPacketProcessor) processSCION() disposition {
 func (p *scionPacketProcessor) processEPIC() disposition {
 	epicPath, ok := p.scionLayer.Path.(*epic.Path)
 	if !ok {
-		return errorDiscard("error", errMalformedPath)
+		return p.discard(errMalformedPath)
 	}
 
 	p.path = epicPath.ScionPath
 	if p.path == nil {
-		return errorDiscard("error", errMalformedPath)
+		return p.discard(errMalformedPath)
 	}
 
 	isPenultimate := p.path.IsPenultimateHop()
@@ -1094,14 +1299,14 @@ func (p *scionPacketProcessor) processEPIC() disposition {
 	if isPenultimate || isLast {
 		firstInfo, err := p.path.GetInfoField(0)
 		if err != nil {
-			return errorDiscard("error", err)
+			return p.discard(err)
 		}
 
 		timestamp := time.Unix(int64(firstInfo.Timestamp), 0)
 		err = libepic.VerifyTimestamp(timestamp, epicPath.PktID.Timestamp, time.Now())
 		if err != nil {
 			// TODO(mawyss): Send back SCMP packet
-			return errorDiscard("error", err)
+			return p.discard(err)
 		}
 
 		HVF := epicPath.PHVF
@@ -1112,7 +1317,7 @@ func (p *scionPacketProcessor) processEPIC() disposition {
 			&p.scionLayer, firstInfo.Timestamp, HVF, p.macInputBuffer[:libepic.MACBufferSize])
 		if err != nil {
 			// TODO(mawyss): Send back SCMP packet
-			return errorDiscard("error", err)
+			return p.discard(err)
 		}
 	}
 
@@ -1126,7 +1331,9 @@ type scionPacketProcessor struct {
 	d               *dataPlane    // The dataplane instance that initiated this processor.
 	pkt             *Packet       // Packet currently being processed by this processor.
 	ingressFromLink uint16        // IfID associated with the ingress link, if any.
+	macKeys         *macKeys      // keys from which mac and prevMac were created.
 	mac             hash.Hash     // hasher for the MAC computation.
+	prevMac         hash.Hash     // hasher for the previous key, nil if there is none.
 	scionLayer      slayers.SCION // scionLayer is the SCION gopacket layer.
 	hbhLayer        slayers.HopByHopExtnSkipper
 	e2eLayer        slayers.EndToEndExtnSkipper
@@ -1138,7 +1345,10 @@ type scionPacketProcessor struct {
 	peering         bool                   // Whether the current hop field is a peering hop field.
 	cachedMac       []byte                 // Full MAC. For a Xover, that of the down segment.
 	macInputBuffer  []byte                 // Reusable buffer for MAC computation.
+	prevMacBuffer   []byte                 // Reusable buffer for MAC computation with prevMac.
 	bfdLayer        layers.BFD             // Reusable buffer for parsing BFD messages
+	flowCount       int                    // Forwarded packets since the last sampled one.
+	cause           error                  // Why the packet was discarded, for captures.
 }
 
 type slowPathType int8
@@ -1187,12 +1397,12 @@ func (p *scionPacketProcessor) parsePath() disposition {
 	p.hopField, err = p.path.GetCurrentHopField()
 	if err != nil {
 		// TODO(lukedirtwalker) parameter problem invalid path?
-		return errorDiscard("error", err)
+		return p.discard(err)
 	}
 	p.infoField, err = p.path.GetCurrentInfoField()
 	if err != nil {
 		// TODO(lukedirtwalker) parameter problem invalid path?
-		return errorDiscard("error", err)
+		return p.discard(err)
 	}
 	// Segments without the Peering flag must consist of at least two HFs:
 	// https://github.com/scionproto/scion/issues/4524
@@ -1200,10 +1410,10 @@ func (p *scionPacketProcessor) parsePath() disposition {
 		p.path.PathMeta.SegLen[1] == 1 ||
 		p.path.PathMeta.SegLen[2] == 1
 	if !p.infoField.Peer && hasSingletonSegment {
-		return errorDiscard("error", errMalformedPath)
+		return p.discard(errMalformedPath)
 	}
 	if !p.path.CurrINFMatchesCurrHF() {
-		return errorDiscard("error", errMalformedPath)
+		return p.discard(errMalformedPath)
 	}
 	return pForward
 }
@@ -1236,7 +1446,7 @@ func (p *scionPacketProcessor) determinePeer() disposition {
 	peer, err := determinePeer(p.path.PathMeta, p.infoField)
 	p.peering = peer
 	if err != nil {
-		return errorDiscard("error", err)
+		return p.discard(err)
 	}
 	return pForward
 }
@@ -1343,7 +1553,7 @@ func (p *scionPacketProcessor) validateTransitUnderlaySrc() disposition {
 	// comparison should be cheap. Links are implemented by pointers.
 	if ingressLink != p.pkt.Link {
 		// Drop
-		return errorDiscard("error", errInvalidSrcAddrForTransit)
+		return p.discard(errInvalidSrcAddrForTransit)
 	}
 	return pForward
 }
@@ -1442,7 +1652,7 @@ func (p *scionPacketProcessor) updateNonConsDirIngressSegID() disposition {
 	if !p.infoField.ConsDir && p.ingressFromLink != 0 && !p.peering {
 		p.infoField.UpdateSegID(p.hopField.Mac)
 		if err := p.path.SetInfoField(p.infoField, int(p.path.PathMeta.CurrINF)); err != nil {
-			return errorDiscard("error", err)
+			return p.discard(err)
 		}
 	}
 	return pForward
@@ -1458,9 +1668,28 @@ func (p *scionPacketProcessor) currentHopPointer() uint16 {
 		scion.MetaLen + path.InfoLen*p.path.NumINF + path.HopLen*int(p.path.PathMeta.CurrHF))
 }
 
+// previousKeyMAC returns the hasher for the previous key if that key is still
+// accepted for MAC verification. Otherwise, it returns nil.
+func (p *scionPacketProcessor) previousKeyMAC() hash.Hash {
+	if p.prevMac == nil || !time.Now().Before(p.macKeys.graceEnd) {
+		return nil
+	}
+	return p.prevMac
+}
+
 func (p *scionPacketProcessor) verifyCurrentMAC() disposition {
 	fullMac := path.FullMAC(p.mac, p.infoField, p.hopField, p.macInputBuffer[:path.MACBufferSize])
 	if subtle.ConstantTimeCompare(p.hopField.Mac[:path.MacLen], fullMac[:path.MacLen]) == 0 {
+		// Hop fields created with the previous key are valid during the key grace period.
+		if prevMac := p.previousKeyMAC(); prevMac != nil {
+			prevFullMac := path.FullMAC(prevMac, p.infoField, p.hopField, p.prevMacBuffer)
+			if subtle.ConstantTimeCompare(
+				p.hopField.Mac[:path.MacLen], prevFullMac[:path.MacLen]) == 1 {
+
+				p.cachedMac = prevFullMac
+				return pForward
+			}
+		}
 		log.Debug("SCMP response", "cause", errMacVerificationFailed,
 			"expected", fullMac[:path.MacLen],
 			"actual", p.hopField.Mac[:path.MacLen],
@@ -1503,7 +1732,7 @@ func (p *scionPacketProcessor) resolveInbound() disposition {
 		}
 		return pSlowPath
 	default:
-		return errorDiscard("error", err)
+		return p.discard(err)
 	}
 }
 
@@ -1517,12 +1746,12 @@ func (p *scionPacketProcessor) processEgress() disposition {
 		p.infoField.UpdateSegID(p.hopField.Mac)
 		if err := p.path.SetInfoField(p.infoField, int(p.path.PathMeta.CurrINF)); err != nil {
 			// TODO parameter problem invalid path
-			return errorDiscard("error", err)
+			return p.discard(err)
 		}
 	}
 	if err := p.path.IncPath(); err != nil {
 		// TODO parameter problem invalid path
-		return errorDiscard("error", err)
+		return p.discard(err)
 	}
 	return pForward
 }
@@ -1531,16 +1760,16 @@ func (p *scionPacketProcessor) doXover() disposition {
 	p.effectiveXover = true
 	if err := p.path.IncPath(); err != nil {
 		// TODO parameter problem invalid path
-		return errorDiscard("error", err)
+		return p.discard(err)
 	}
 	var err error
 	if p.hopField, err = p.path.GetCurrentHopField(); err != nil {
 		// TODO parameter problem invalid path
-		return errorDiscard("error", err)
+		return p.discard(err)
 	}
 	if p.infoField, err = p.path.GetCurrentInfoField(); err != nil {
 		// TODO parameter problem invalid path
-		return errorDiscard("error", err)
+		return p.discard(err)
 	}
 	return pForward
 }
@@ -1603,7 +1832,7 @@ func (p *scionPacketProcessor) handleIngressRouterAlert() disposition {
 	}
 	*alert = false
 	if err := p.path.SetHopField(p.hopField, int(p.path.PathMeta.CurrHF)); err != nil {
-		return errorDiscard("error", err)
+		return p.discard(err)
 	}
 	p.pkt.slowPathRequest = slowPathRequest{
 		spType: slowPathRouterAlertIngress,
@@ -1629,7 +1858,7 @@ func (p *scionPacketProcessor) handleEgressRouterAlert() disposition {
 	}
 	*alert = false
 	if err := p.path.SetHopField(p.hopField, int(p.path.PathMeta.CurrHF)); err != nil {
-		return errorDiscard("error", err)
+		return p.discard(err)
 	}
 	p.pkt.slowPathRequest = slowPathRequest{
 		spType: slowPathRouterAlertEgress,
@@ -1824,36 +2053,41 @@ func (p *scionPacketProcessor) processOHP() disposition {
 	ohp, ok := s.Path.(*onehop.Path)
 	if !ok {
 		// TODO parameter problem -> invalid path
-		return errorDiscard("error", errMalformedPath)
+		return p.discard(errMalformedPath)
 	}
 	if !ohp.Info.ConsDir {
 		// TODO parameter problem -> invalid path
-		return errorDiscard("error", errMalformedPath)
+		return p.discard(errMalformedPath)
 	}
 
 	// OHP leaving our IA
 	if p.ingressFromLink == 0 {
 		if !p.d.localIA.Equal(s.SrcIA) {
 			// TODO parameter problem -> invalid path
-			return errorDiscard("error", errCannotRoute)
+			return p.discard(errCannotRoute)
 		}
 		neighborIA := p.d.neighborIAs[ohp.FirstHop.ConsEgress]
 		if neighborIA.IsZero() {
 			// TODO parameter problem invalid interface
-			return errorDiscard("error", errCannotRoute)
+			return p.discard(errCannotRoute)
 		}
 		if !neighborIA.Equal(s.DstIA) {
-			return errorDiscard("error", errCannotRoute)
+			return p.discard(errCannotRoute)
 		}
 		mac := path.MAC(p.mac, ohp.Info, ohp.FirstHop, p.macInputBuffer[:path.MACBufferSize])
+		if subtle.ConstantTimeCompare(ohp.FirstHop.Mac[:], mac[:]) == 0 {
+			if prevMac := p.previousKeyMAC(); prevMac != nil {
+				mac = path.MAC(prevMac, ohp.Info, ohp.FirstHop, p.prevMacBuffer)
+			}
+		}
 		if subtle.ConstantTimeCompare(ohp.FirstHop.Mac[:], mac[:]) == 0 {
 			// TODO parameter problem -> invalid MAC
-			return errorDiscard("error", errMacVerificationFailed)
+			return p.discard(errMacVerificationFailed)
 		}
 		ohp.Info.UpdateSegID(ohp.FirstHop.Mac)
 
 		if err := updateSCIONLayer(p.pkt.RawPacket, s); err != nil {
-			return errorDiscard("error", err)
+			return p.discard(err)
 		}
 		p.pkt.egress = ohp.FirstHop.ConsEgress
 		return pForward
@@ -1861,11 +2095,11 @@ func (p *scionPacketProcessor) processOHP() disposition {
 
 	// OHP entering our IA
 	if !p.d.localIA.Equal(s.DstIA) {
-		return errorDiscard("error", errCannotRoute)
+		return p.discard(errCannotRoute)
 	}
 	neighborIA := p.d.neighborIAs[p.ingressFromLink]
 	if !neighborIA.Equal(s.SrcIA) {
-		return errorDiscard("error", errCannotRoute)
+		return p.discard(errCannotRoute)
 	}
 
 	ohp.SecondHop = path.HopField{
@@ -1879,11 +2113,11 @@ func (p *scionPacketProcessor) processOHP() disposition {
 		p.macInputBuffer[:path.MACBufferSize])
 
 	if err := updateSCIONLayer(p.pkt.RawPacket, s); err != nil {
-		return errorDiscard("error", err)
+		return p.discard(err)
 	}
 	err := p.d.resolveLocalDst(p.pkt, s, p.lastLayer)
 	if err != nil {
-		return errorDiscard("error", err)
+		return p.discard(err)
 	}
 
 	return pForward
@@ -2079,8 +2313,12 @@ type bfdSend struct {
 	dataPlane *dataPlane
 	name      string // for logs
 	ifID      uint16
+	// link is the link of a bundle member, nil otherwise. The BFD packets of a member are sent
+	// on the member, bypassing the bundle.
+	link      Link
 	scn       *slayers.SCION
 	ohp       *onehop.Path
+	macKeys   *macKeys
 	mac       hash.Hash
 	macBuffer []byte
 }
@@ -2092,7 +2330,6 @@ func newBFDSend(
 	localHost, remoteHost addr.Host,
 	ifID uint16,
 	isIntraAS bool,
-	mac hash.Hash,
 ) (*bfdSend, error) {
 	scn := &slayers.SCION{
 		Version:      0,
@@ -2142,7 +2379,6 @@ func newBFDSend(
 		ifID:      ifID,
 		scn:       scn,
 		ohp:       ohp,
-		mac:       mac,
 		macBuffer: make([]byte, path.MACBufferSize),
 	}, nil
 }
@@ -2159,6 +2395,10 @@ func (b *bfdSend) Send(bfd *layers.BFD) error {
 		// Subtract 10 seconds to deal with possible clock drift.
 		ohp := b.ohp
 		ohp.Info.Timestamp = uint32(time.Now().Unix() - 10)
+		if keys := b.dataPlane.macKeys.Load(); keys != b.macKeys {
+			b.macKeys = keys
+			b.mac = keys.currentMAC()
+		}
 		ohp.FirstHop.Mac = path.MAC(b.mac, ohp.Info, ohp.FirstHop, b.macBuffer)
 	}
 
@@ -2176,10 +2416,14 @@ func (b *bfdSend) Send(bfd *layers.BFD) error {
 	// The useful part of the buffer is given by Bytes. We don't copy the bytes; just the slice's
 	// metadata.
 	p.RawPacket = serBuf.Bytes()
+	p.qosClass = QoSClassControl
 
 	// BfdControllers and fwQs are initialized from the same set of ifIDs. So not finding
 	// the forwarding queue is an serious internal error. Let that panic.
-	fwLink := b.dataPlane.interfaces[b.ifID]
+	fwLink := b.link
+	if fwLink == nil {
+		fwLink = b.dataPlane.interfaces[b.ifID]
+	}
 
 	if !fwLink.Send(p) {
 		// We do not care if some BFD packets get bounced under high load. If it becomes a problem,
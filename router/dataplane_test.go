@@ -84,10 +84,10 @@ func TestDataPlaneAddInternalInterface(t *testing.T) {
 }
 
 func TestDataPlaneSetKey(t *testing.T) {
-	t.Run("fails after serve", func(t *testing.T) {
+	t.Run("works after serve", func(t *testing.T) {
 		d := router.NewDPRaw(router.RunConfig{}, false)
 		d.MockStart()
-		assert.Error(t, d.SetKey([]byte("dummy")))
+		assert.NoError(t, d.SetKey([]byte("dummy key xxxxxx")))
 	})
 	t.Run("setting nil value is not allowed", func(t *testing.T) {
 		d := router.NewDPRaw(router.RunConfig{}, false)
@@ -98,10 +98,19 @@ func TestDataPlaneSetKey(t *testing.T) {
 		d := router.NewDPRaw(router.RunConfig{}, false)
 		assert.NoError(t, d.SetKey([]byte("dummy key xxxxxx")))
 	})
-	t.Run("double set fails", func(t *testing.T) {
+	t.Run("double set rolls over", func(t *testing.T) {
 		d := router.NewDPRaw(router.RunConfig{}, false)
 		assert.NoError(t, d.SetKey([]byte("dummy key xxxxxx")))
-		assert.Error(t, d.SetKey([]byte("dummy key xxxxxx")))
+		assert.NoError(t, d.SetKey([]byte("dummy key yyyyyy")))
+	})
+	t.Run("previous key without current key fails", func(t *testing.T) {
+		d := router.NewDPRaw(router.RunConfig{}, false)
+		assert.Error(t, d.SetPreviousKey([]byte("dummy key xxxxxx")))
+	})
+	t.Run("previous key works", func(t *testing.T) {
+		d := router.NewDPRaw(router.RunConfig{}, false)
+		assert.NoError(t, d.SetKey([]byte("dummy key xxxxxx")))
+		assert.NoError(t, d.SetPreviousKey([]byte("dummy key yyyyyy")))
 	})
 }
 
@@ -198,6 +207,38 @@ func TestDataPlaneAddExternalInterface(t *testing.T) {
 		assert.Error(t,
 			d.AddExternalInterface(45, link1, lh, rh1))
 	})
+	bundle := control.LinkInfo{
+		Provider: "udpip",
+		Local:    l,
+		Remote:   r2,
+		Bundle: []topology.BundleMember{
+			{Provider: "udpip", Local: "10.0.0.100:0", Remote: "10.0.0.201:0"},
+			{Provider: "udpip", Local: "10.0.0.101:0", Remote: "10.0.0.202:0"},
+		},
+		BFD: control.BFD{Disable: ptr.To(false)},
+	}
+	t.Run("bundle works", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		d := router.NewDPRaw(router.RunConfig{}, false)
+		d.SetConnOpener("udpip", router.MockConnOpener{Ctrl: ctrl})
+		assert.NoError(t,
+			d.AddExternalInterface(42, link1, lh, rh1))
+		assert.NoError(t,
+			d.AddExternalInterface(45, bundle, lh, rh2))
+		assert.Error(t,
+			d.AddExternalInterface(45, bundle, lh, rh2))
+	})
+	t.Run("bundle reusing dst addr fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		d := router.NewDPRaw(router.RunConfig{}, false)
+		d.SetConnOpener("udpip", router.MockConnOpener{Ctrl: ctrl})
+		assert.NoError(t,
+			d.AddExternalInterface(42, link2, lh, rh2))
+		assert.Error(t,
+			d.AddExternalInterface(45, bundle, lh, rh2))
+	})
 }
 
 func TestDataPlaneAddSVC(t *testing.T) {
@@ -819,6 +860,11 @@ func notDiscarded(t *testing.T, disp router.Disposition) {
 	require.NotEqual(t, disp, router.PDiscard)
 }
 
+// Returns true if we expect the packet to be handed to the slow path.
+func slowPathed(t *testing.T, disp router.Disposition) {
+	require.Equal(t, disp, router.PSlowPath)
+}
+
 func TestProcessPkt(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -872,6 +918,69 @@ func TestProcessPkt(t *testing.T) {
 			},
 			assertFunc: notDiscarded,
 		},
+		"inbound previous key": {
+			prepareDP: func(ctrl *gomock.Controller) *router.DataPlane {
+				dp := router.NewDP(
+					mockExternalInterfaces,
+					nil,
+					nil, // No special connOpener.
+					mockInternalNextHops,
+					addr.MustParseIA("1-ff00:0:110"), nil, key)
+				dp.RunConfig.KeyGracePeriod = time.Hour
+				require.NoError(t, dp.SetKey(otherKey))
+				return dp
+			},
+			mockMsg: func(afterProcessing bool) *router.Packet {
+				spkt, dpath := prepBaseMsg(now)
+				spkt.DstIA = addr.MustParseIA("1-ff00:0:110")
+				dst := addr.MustParseHost("10.0.100.100")
+				assert.NoError(t, spkt.SetDstAddr(dst))
+				dpath.HopFields = []path.HopField{
+					{ConsIngress: 41, ConsEgress: 40},
+					{ConsIngress: 31, ConsEgress: 30},
+					{ConsIngress: 1, ConsEgress: 0},
+				}
+				dpath.Base.PathMeta.CurrHF = 2
+				dpath.HopFields[2].Mac = computeMAC(t, key, dpath.InfoFields[0], dpath.HopFields[2])
+
+				var dstAddr *net.UDPAddr
+				ingress := uint16(1)
+				egress := uint16(0)
+				if afterProcessing {
+					dstAddr = &net.UDPAddr{IP: dst.IP().AsSlice(), Port: dstUDPPort}
+				}
+				return router.NewPacket(toBytes(t, spkt, dpath), nil, dstAddr, ingress, egress)
+			},
+			assertFunc: notDiscarded,
+		},
+		"inbound previous key after grace period": {
+			prepareDP: func(ctrl *gomock.Controller) *router.DataPlane {
+				dp := router.NewDP(
+					mockExternalInterfaces,
+					nil,
+					nil, // No special connOpener.
+					mockInternalNextHops,
+					addr.MustParseIA("1-ff00:0:110"), nil, key)
+				// Without a grace period, the previous key is rejected right away.
+				require.NoError(t, dp.SetKey(otherKey))
+				return dp
+			},
+			mockMsg: func(afterProcessing bool) *router.Packet {
+				spkt, dpath := prepBaseMsg(now)
+				spkt.DstIA = addr.MustParseIA("1-ff00:0:110")
+				dst := addr.MustParseHost("10.0.100.100")
+				assert.NoError(t, spkt.SetDstAddr(dst))
+				dpath.HopFields = []path.HopField{
+					{ConsIngress: 41, ConsEgress: 40},
+					{ConsIngress: 31, ConsEgress: 30},
+					{ConsIngress: 1, ConsEgress: 0},
+				}
+				dpath.Base.PathMeta.CurrHF = 2
+				dpath.HopFields[2].Mac = computeMAC(t, key, dpath.InfoFields[0], dpath.HopFields[2])
+				return router.NewPacket(toBytes(t, spkt, dpath), nil, nil, 1, 0)
+			},
+			assertFunc: slowPathed,
+		},
 		"inbound_longpath": {
 			prepareDP: func(ctrl *gomock.Controller) *router.DataPlane {
 				return router.NewDP(
@@ -1732,7 +1841,9 @@ func TestProcessPkt(t *testing.T) {
 			pkt, want := tc.mockMsg(false), tc.mockMsg(true)
 			disp := dp.ProcessPkt(pkt)
 			tc.assertFunc(t, disp)
-			if disp == router.PDiscard {
+			// Packets handed to the slow path only differ in the internal slow path
+			// request, which is asserted by the assertFunc.
+			if disp == router.PDiscard || disp == router.PSlowPath {
 				return
 			}
 			assertPktEqual(t, want, pkt)
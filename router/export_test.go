@@ -45,6 +45,7 @@ func GetMetrics() *Metrics {
 type Disposition disposition
 
 const PDiscard = Disposition(pDiscard)
+const PSlowPath = Disposition(pSlowPath)
 
 // Implements the link interface minimally
 type MockLink struct {
@@ -269,8 +270,9 @@ func (d *DataPlane) ProcessPkt(pkt *Packet) Disposition {
 
 	p := newPacketProcessor(&d.dataPlane)
 	disp := p.processPkt(pkt)
-	// Erase trafficType; we don't set it in the expected results.
+	// Erase trafficType and qosClass; we don't set them in the expected results.
 	pkt.trafficType = ttOther
+	pkt.qosClass = QoSClassBestEffort
 	return Disposition(disp)
 }
 
@@ -0,0 +1,69 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowexport
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/private/serrors"
+)
+
+// Exporter periodically drains the flows of a cache and sends them to a collector.
+type Exporter struct {
+	// Cache holds the flows to export.
+	Cache *Cache
+	// Conn is the connection to the collector.
+	Conn net.Conn
+	// Encoder encodes the flows.
+	Encoder *Encoder
+	// Interval is the time between exports. Every flow is exported, and removed from the
+	// cache, once per interval; the records carry the packets sampled since the last export.
+	Interval time.Duration
+}
+
+// Run exports the flows until the context is canceled. It then exports the remaining flows and
+// returns. Errors while sending are logged, they do not stop the exporter.
+func (e *Exporter) Run(ctx context.Context) error {
+	if e.Interval <= 0 {
+		return serrors.New("invalid flow export interval", "interval", e.Interval)
+	}
+	ticker := time.NewTicker(e.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			e.export(time.Now())
+			return nil
+		case now := <-ticker.C:
+			e.export(now)
+		}
+	}
+}
+
+func (e *Exporter) export(now time.Time) {
+	flows, dropped := e.Cache.Drain()
+	if dropped > 0 {
+		log.Info("Flow cache full, sampled packets not exported", "packets", dropped)
+	}
+	for _, msg := range e.Encoder.Encode(flows, now) {
+		if _, err := e.Conn.Write(msg); err != nil {
+			log.Info("Failed to send IPFIX message", "err", err)
+			return
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package flowexport aggregates sampled packets of the border router into flows and exports them
+// as IPFIX (RFC 7011) records over UDP.
+package flowexport
+
+import (
+	"sync"
+	"time"
+
+	"github.com/scionproto/scion/pkg/addr"
+)
+
+// Key identifies a flow.
+type Key struct {
+	// IA is the ISD-AS of the router that observed the flow. Interface IDs are only unique
+	// within it.
+	IA           addr.IA
+	SrcIA        addr.IA
+	DstIA        addr.IA
+	Ingress      uint16 // 0 for the internal interface.
+	Egress       uint16 // 0 for the internal interface.
+	TrafficClass uint8
+	Proto        uint8 // The SCION L4 protocol number.
+}
+
+// Flow is the aggregate of the sampled packets of a flow since the last export.
+type Flow struct {
+	Key
+	Packets uint64
+	Bytes   uint64
+	Start   time.Time
+	End     time.Time
+}
+
+// Cache aggregates sampled packets into flows. It holds at most a fixed number of flows, the
+// packets of further flows are not accounted for until the cache is drained. It is safe for
+// concurrent use.
+type Cache struct {
+	maxFlows int
+
+	mtx     sync.Mutex
+	flows   map[Key]*Flow
+	dropped uint64
+}
+
+// NewCache returns a cache that holds up to maxFlows flows.
+func NewCache(maxFlows int) *Cache {
+	return &Cache{
+		maxFlows: maxFlows,
+		flows:    make(map[Key]*Flow),
+	}
+}
+
+// Add accounts a sampled packet of the given size to its flow.
+func (c *Cache) Add(k Key, size int, now time.Time) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	f, ok := c.flows[k]
+	if !ok {
+		if len(c.flows) >= c.maxFlows {
+			c.dropped++
+			return
+		}
+		f = &Flow{Key: k, Start: now}
+		c.flows[k] = f
+	}
+	f.Packets++
+	f.Bytes += uint64(size)
+	f.End = now
+}
+
+// Drain removes all flows from the cache and returns them, together with the number of sampled
+// packets that were not accounted for because the cache was full.
+func (c *Cache) Drain() ([]Flow, uint64) {
+	c.mtx.Lock()
+	flows, dropped := c.flows, c.dropped
+	c.flows = make(map[Key]*Flow, len(flows))
+	c.dropped = 0
+	c.mtx.Unlock()
+
+	result := make([]Flow, 0, len(flows))
+	for _, f := range flows {
+		result = append(result, *f)
+	}
+	return result, dropped
+}
@@ -0,0 +1,179 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowexport_test
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/router/flowexport"
+)
+
+var (
+	localIA = addr.MustParseIA("1-ff00:0:110")
+	srcIA   = addr.MustParseIA("1-ff00:0:111")
+	dstIA   = addr.MustParseIA("2-ff00:0:210")
+)
+
+func TestCache(t *testing.T) {
+	now := time.Unix(1000, 0)
+	k1 := flowexport.Key{IA: localIA, SrcIA: srcIA, DstIA: dstIA, Ingress: 1, Egress: 2, Proto: 17}
+	k2 := k1
+	k2.TrafficClass = 0xb8
+
+	c := flowexport.NewCache(1)
+	c.Add(k1, 100, now)
+	c.Add(k1, 200, now.Add(time.Second))
+	c.Add(k2, 100, now)
+
+	flows, dropped := c.Drain()
+	assert.Equal(t, uint64(1), dropped)
+	assert.Equal(t, []flowexport.Flow{{
+		Key:     k1,
+		Packets: 2,
+		Bytes:   300,
+		Start:   now,
+		End:     now.Add(time.Second),
+	}}, flows)
+
+	// The cache is empty after draining, and new flows fit again.
+	c.Add(k2, 100, now)
+	flows, dropped = c.Drain()
+	assert.Zero(t, dropped)
+	require.Len(t, flows, 1)
+	assert.Equal(t, k2, flows[0].Key)
+}
+
+func TestEncoder(t *testing.T) {
+	now := time.Unix(1000, 0)
+	e := &flowexport.Encoder{
+		ObservationDomainID: 7,
+		EnterpriseNumber:    12345,
+		SamplingInterval:    100,
+		MaxMessageSize:      250,
+	}
+
+	t.Run("template only", func(t *testing.T) {
+		msgs := e.Encode(nil, now)
+		require.Len(t, msgs, 1)
+		msg := msgs[0]
+		assert.Equal(t, uint16(flowexport.Version), binary.BigEndian.Uint16(msg[0:]))
+		assert.Equal(t, len(msg), int(binary.BigEndian.Uint16(msg[2:])))
+		assert.Equal(t, uint32(1000), binary.BigEndian.Uint32(msg[4:]))
+		assert.Equal(t, uint32(0), binary.BigEndian.Uint32(msg[8:]))
+		assert.Equal(t, uint32(7), binary.BigEndian.Uint32(msg[12:]))
+
+		// Template set with one template of 12 fields.
+		tmpl := msg[16:]
+		assert.Equal(t, uint16(2), binary.BigEndian.Uint16(tmpl[0:]))
+		assert.Equal(t, len(tmpl), int(binary.BigEndian.Uint16(tmpl[2:])))
+		assert.Equal(t, uint16(flowexport.TemplateID), binary.BigEndian.Uint16(tmpl[4:]))
+		assert.Equal(t, uint16(12), binary.BigEndian.Uint16(tmpl[6:]))
+		// The first field is the SCION-specific observation ISD-AS.
+		assert.Equal(t, uint16(0x8000|flowexport.IEObservationIA),
+			binary.BigEndian.Uint16(tmpl[8:]))
+		assert.Equal(t, uint16(8), binary.BigEndian.Uint16(tmpl[10:]))
+		assert.Equal(t, uint32(12345), binary.BigEndian.Uint32(tmpl[12:]))
+	})
+	t.Run("records", func(t *testing.T) {
+		flows := make([]flowexport.Flow, 5)
+		for i := range flows {
+			flows[i] = flowexport.Flow{
+				Key: flowexport.Key{
+					IA:           localIA,
+					SrcIA:        srcIA,
+					DstIA:        dstIA,
+					Ingress:      1,
+					Egress:       uint16(i),
+					TrafficClass: 0xb8,
+					Proto:        17,
+				},
+				Packets: 3,
+				Bytes:   4500,
+				Start:   now.Add(-time.Second),
+				End:     now,
+			}
+		}
+		msgs := e.Encode(flows, now)
+		// 250 bytes fit two records next to the header and the template.
+		require.Len(t, msgs, 3)
+		var records [][]byte
+		for i, msg := range msgs {
+			assert.Equal(t, len(msg), int(binary.BigEndian.Uint16(msg[2:])))
+			// The sequence number counts the records of the previous messages.
+			assert.Equal(t, uint32(2*i), binary.BigEndian.Uint32(msg[8:]))
+			tmplLen := int(binary.BigEndian.Uint16(msg[18:]))
+			data := msg[16+tmplLen:]
+			assert.Equal(t, uint16(flowexport.TemplateID), binary.BigEndian.Uint16(data[0:]))
+			assert.Equal(t, len(data), int(binary.BigEndian.Uint16(data[2:])))
+			for r := data[4:]; len(r) > 0; r = r[70:] {
+				records = append(records, r[:70])
+			}
+		}
+		require.Len(t, records, 5)
+		for i, r := range records {
+			assert.Equal(t, uint64(localIA), binary.BigEndian.Uint64(r[0:]))
+			assert.Equal(t, uint64(srcIA), binary.BigEndian.Uint64(r[8:]))
+			assert.Equal(t, uint64(dstIA), binary.BigEndian.Uint64(r[16:]))
+			assert.Equal(t, uint32(1), binary.BigEndian.Uint32(r[24:]))
+			assert.Equal(t, uint32(i), binary.BigEndian.Uint32(r[28:]))
+			assert.Equal(t, uint8(0xb8), r[32])
+			assert.Equal(t, uint8(17), r[33])
+			assert.Equal(t, uint64(3), binary.BigEndian.Uint64(r[34:]))
+			assert.Equal(t, uint64(4500), binary.BigEndian.Uint64(r[42:]))
+			assert.Equal(t, uint64(999000), binary.BigEndian.Uint64(r[50:]))
+			assert.Equal(t, uint64(1000000), binary.BigEndian.Uint64(r[58:]))
+			assert.Equal(t, uint32(100), binary.BigEndian.Uint32(r[66:]))
+		}
+		// The next stream position follows the last record.
+		msgs = e.Encode(nil, now)
+		assert.Equal(t, uint32(5), binary.BigEndian.Uint32(msgs[0][8:]))
+	})
+}
+
+func TestExporter(t *testing.T) {
+	collector, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+	defer collector.Close()
+	conn, err := net.DialUDP("udp", nil, collector.LocalAddr().(*net.UDPAddr))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	cache := flowexport.NewCache(10)
+	cache.Add(flowexport.Key{IA: localIA, SrcIA: srcIA, DstIA: dstIA}, 100, time.Now())
+	e := &flowexport.Exporter{
+		Cache:    cache,
+		Conn:     conn,
+		Encoder:  &flowexport.Encoder{EnterpriseNumber: 12345, SamplingInterval: 1},
+		Interval: time.Hour,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// The remaining flows are exported when the exporter stops.
+	require.NoError(t, e.Run(ctx))
+
+	require.NoError(t, collector.SetReadDeadline(time.Now().Add(time.Second)))
+	buf := make([]byte, 2000)
+	n, err := collector.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, 16+68+4+70, n)
+}
@@ -0,0 +1,190 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowexport
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+const (
+	// Version is the version number of IPFIX messages.
+	Version = 10
+	// TemplateID is the ID of the template that describes the flow records.
+	TemplateID = 256
+	// DefaultMaxMessageSize is the default size limit of an IPFIX message. It fits into a UDP
+	// datagram on a link with the minimum SCION MTU.
+	DefaultMaxMessageSize = 1232
+
+	templateSetID  = 2
+	headerLen      = 16
+	setHeaderLen   = 4
+	enterpriseBit  = 0x8000
+	flowRecordSize = 70
+)
+
+// Information element IDs of the IANA registry.
+const (
+	ieOctetDeltaCount        = 1
+	iePacketDeltaCount       = 2
+	ieProtocolIdentifier     = 4
+	ieIPClassOfService       = 5
+	ieIngressInterface       = 10
+	ieEgressInterface        = 14
+	ieFlowStartMilliseconds  = 152
+	ieFlowEndMilliseconds    = 153
+	ieSamplingPacketInterval = 305
+)
+
+// SCION-specific information elements. They are exported as enterprise-specific information
+// elements of the configured enterprise number. ISD-ASes are encoded as unsigned64, in the
+// same way as addr.IA.
+const (
+	IESourceIA      = 1
+	IEDestinationIA = 2
+	IEObservationIA = 3
+)
+
+type fieldSpec struct {
+	id         uint16
+	length     uint16
+	enterprise bool
+}
+
+// template is the field list of the flow records. The order must match encodeFlow.
+var template = []fieldSpec{
+	{id: IEObservationIA, length: 8, enterprise: true},
+	{id: IESourceIA, length: 8, enterprise: true},
+	{id: IEDestinationIA, length: 8, enterprise: true},
+	{id: ieIngressInterface, length: 4},
+	{id: ieEgressInterface, length: 4},
+	{id: ieIPClassOfService, length: 1},
+	{id: ieProtocolIdentifier, length: 1},
+	{id: iePacketDeltaCount, length: 8},
+	{id: ieOctetDeltaCount, length: 8},
+	{id: ieFlowStartMilliseconds, length: 8},
+	{id: ieFlowEndMilliseconds, length: 8},
+	{id: ieSamplingPacketInterval, length: 4},
+}
+
+// Encoder encodes flows as IPFIX messages. Every message carries the template, so that a
+// collector can decode the records even if messages are lost. The encoder keeps the sequence
+// number of the stream; it must not be used concurrently.
+type Encoder struct {
+	// ObservationDomainID is the observation domain of the messages.
+	ObservationDomainID uint32
+	// EnterpriseNumber is the private enterprise number of the SCION-specific information
+	// elements.
+	EnterpriseNumber uint32
+	// SamplingInterval is the number of packets per sampled packet. It is exported with every
+	// record, the packet and byte counts are not scaled.
+	SamplingInterval uint32
+	// MaxMessageSize is the size limit of a message. If it is zero, DefaultMaxMessageSize is
+	// used.
+	MaxMessageSize int
+
+	seq uint32
+}
+
+// Encode returns the messages that carry the flows. If there are no flows, it returns one
+// message that only carries the template.
+func (e *Encoder) Encode(flows []Flow, now time.Time) [][]byte {
+	maxSize := e.MaxMessageSize
+	if maxSize == 0 {
+		maxSize = DefaultMaxMessageSize
+	}
+	tmplLen := e.templateSetLen()
+	perMsg := max(1, (maxSize-headerLen-tmplLen-setHeaderLen)/flowRecordSize)
+
+	var msgs [][]byte
+	for {
+		n := min(perMsg, len(flows))
+		msgLen := headerLen + tmplLen
+		if n > 0 {
+			msgLen += setHeaderLen + n*flowRecordSize
+		}
+		b := make([]byte, msgLen)
+		binary.BigEndian.PutUint16(b[0:], Version)
+		binary.BigEndian.PutUint16(b[2:], uint16(msgLen))
+		binary.BigEndian.PutUint32(b[4:], uint32(now.Unix()))
+		binary.BigEndian.PutUint32(b[8:], e.seq)
+		binary.BigEndian.PutUint32(b[12:], e.ObservationDomainID)
+		off := headerLen + e.encodeTemplateSet(b[headerLen:])
+		if n > 0 {
+			binary.BigEndian.PutUint16(b[off:], TemplateID)
+			binary.BigEndian.PutUint16(b[off+2:], uint16(setHeaderLen+n*flowRecordSize))
+			off += setHeaderLen
+			for _, f := range flows[:n] {
+				e.encodeFlow(b[off:], f)
+				off += flowRecordSize
+			}
+		}
+		// The sequence number counts the data records sent before the message.
+		e.seq += uint32(n)
+		msgs = append(msgs, b)
+		flows = flows[n:]
+		if len(flows) == 0 {
+			return msgs
+		}
+	}
+}
+
+func (e *Encoder) templateSetLen() int {
+	l := setHeaderLen + 4
+	for _, f := range template {
+		l += 4
+		if f.enterprise {
+			l += 4
+		}
+	}
+	return l
+}
+
+func (e *Encoder) encodeTemplateSet(b []byte) int {
+	binary.BigEndian.PutUint16(b[0:], templateSetID)
+	binary.BigEndian.PutUint16(b[2:], uint16(e.templateSetLen()))
+	binary.BigEndian.PutUint16(b[4:], TemplateID)
+	binary.BigEndian.PutUint16(b[6:], uint16(len(template)))
+	off := setHeaderLen + 4
+	for _, f := range template {
+		id := f.id
+		if f.enterprise {
+			id |= enterpriseBit
+		}
+		binary.BigEndian.PutUint16(b[off:], id)
+		binary.BigEndian.PutUint16(b[off+2:], f.length)
+		off += 4
+		if f.enterprise {
+			binary.BigEndian.PutUint32(b[off:], e.EnterpriseNumber)
+			off += 4
+		}
+	}
+	return off
+}
+
+func (e *Encoder) encodeFlow(b []byte, f Flow) {
+	binary.BigEndian.PutUint64(b[0:], uint64(f.IA))
+	binary.BigEndian.PutUint64(b[8:], uint64(f.SrcIA))
+	binary.BigEndian.PutUint64(b[16:], uint64(f.DstIA))
+	binary.BigEndian.PutUint32(b[24:], uint32(f.Ingress))
+	binary.BigEndian.PutUint32(b[28:], uint32(f.Egress))
+	b[32] = f.TrafficClass
+	b[33] = f.Proto
+	binary.BigEndian.PutUint64(b[34:], f.Packets)
+	binary.BigEndian.PutUint64(b[42:], f.Bytes)
+	binary.BigEndian.PutUint64(b[50:], uint64(f.Start.UnixMilli()))
+	binary.BigEndian.PutUint64(b[58:], uint64(f.End.UnixMilli()))
+	binary.BigEndian.PutUint32(b[66:], e.SamplingInterval)
+}
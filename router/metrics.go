@@ -38,12 +38,18 @@ type Metrics struct {
 	BFDInterfaceStateChanges  *prometheus.CounterVec
 	BFDPacketsSent            *prometheus.CounterVec
 	BFDPacketsReceived        *prometheus.CounterVec
+	BFDRTT                    *prometheus.GaugeVec
+	BundleMemberUp            *prometheus.GaugeVec
 	ServiceInstanceCount      *prometheus.GaugeVec
 	ServiceInstanceChanges    *prometheus.CounterVec
 	SiblingReachable          *prometheus.GaugeVec
 	SiblingBFDPacketsSent     *prometheus.CounterVec
 	SiblingBFDPacketsReceived *prometheus.CounterVec
 	SiblingBFDStateChanges    *prometheus.CounterVec
+	EgressQueueLength         *prometheus.GaugeVec
+	EgressPacketsTotal        *prometheus.CounterVec
+	EgressDroppedPackets      *prometheus.CounterVec
+	RateLimitedPackets        *prometheus.CounterVec
 }
 
 // NewMetrics initializes the metrics for the Border Router, and registers them with the default
@@ -120,6 +126,20 @@ func NewMetrics() *Metrics {
 			},
 			[]string{"interface", "isd_as", "neighbor_isd_as"},
 		),
+		BFDRTT: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "router_bfd_rtt_seconds",
+				Help: "Smoothed round-trip time measured by BFD, or 0 if unknown.",
+			},
+			[]string{"interface", "isd_as", "neighbor_isd_as"},
+		),
+		BundleMemberUp: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "router_bundle_member_up",
+				Help: "Either zero or one depending on whether the member of a link bundle is up.",
+			},
+			[]string{"interface", "isd_as", "neighbor_isd_as", "member"},
+		),
 		ServiceInstanceCount: promauto.NewGaugeVec(
 			prometheus.GaugeOpts{
 				Name: "router_service_instance_count",
@@ -164,6 +184,35 @@ func NewMetrics() *Metrics {
 			},
 			[]string{"sibling", "isd_as"},
 		),
+		EgressQueueLength: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "router_egress_queue_length",
+				Help: "Number of packets in the egress queue of a QoS class.",
+			},
+			[]string{"interface", "isd_as", "neighbor_isd_as", "qos_class"},
+		),
+		EgressPacketsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "router_egress_pkts_total",
+				Help: "Total number of packets taken from the egress queue of a QoS class.",
+			},
+			[]string{"interface", "isd_as", "neighbor_isd_as", "qos_class"},
+		),
+		EgressDroppedPackets: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "router_egress_dropped_pkts_total",
+				Help: "Total number of packets dropped because the egress queue of a QoS class " +
+					"was full.",
+			},
+			[]string{"interface", "isd_as", "neighbor_isd_as", "qos_class"},
+		),
+		RateLimitedPackets: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "router_ratelimited_pkts_total",
+				Help: "Total number of packets dropped because they exceeded a rate limit.",
+			},
+			[]string{"isd_as", "src_isd_as", "reason"},
+		),
 	}
 }
 
@@ -357,6 +406,34 @@ func newOutputMetrics(
 	return om
 }
 
+// qosMetrics groups the metrics of the egress queue of a link, indexed by QoS class.
+type qosMetrics struct {
+	queueLength [NumQoSClasses]prometheus.Gauge
+	sent        [NumQoSClasses]prometheus.Counter
+	dropped     [NumQoSClasses]prometheus.Counter
+}
+
+func newQoSMetrics(
+	metrics *Metrics,
+	id uint16,
+	localIA addr.IA,
+	sibling string,
+	neighbor addr.IA) *qosMetrics {
+
+	ifLabels := interfaceLabels(id, localIA, sibling, neighbor)
+	m := qosMetrics{}
+	for c := QoSClassBestEffort; c < NumQoSClasses; c++ {
+		classLabels := prometheus.Labels{"qos_class": c.String()}
+		m.queueLength[c] = metrics.EgressQueueLength.MustCurryWith(ifLabels).With(classLabels)
+		m.sent[c] = metrics.EgressPacketsTotal.MustCurryWith(ifLabels).With(classLabels)
+		m.dropped[c] = metrics.EgressDroppedPackets.MustCurryWith(ifLabels).With(classLabels)
+		m.queueLength[c].Set(0)
+		m.sent[c].Add(0)
+		m.dropped[c].Add(0)
+	}
+	return &m
+}
+
 func interfaceLabels(
 	id uint16, localIA addr.IA, sibling string, neighbor addr.IA) prometheus.Labels {
 
@@ -17,9 +17,14 @@ package mgmtapi
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"net/netip"
+	"time"
 
 	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/segment/iface"
 	api "github.com/scionproto/scion/private/mgmtapi"
 	"github.com/scionproto/scion/router/control"
 )
@@ -30,6 +35,11 @@ type Server struct {
 	Info      http.HandlerFunc
 	LogLevel  http.HandlerFunc
 	Dataplane control.ObservableDataplane
+	// RolloverKey switches the data plane to the forwarding key stored in
+	// master0.key and reports whether the key changed.
+	RolloverKey func() (bool, error)
+	// Capture captures packets in the data plane.
+	Capture control.PacketCapturer
 }
 
 // GetConfig is an indirection to the http handler.
@@ -113,6 +123,9 @@ func (s *Server) GetInterfaces(w http.ResponseWriter, r *http.Request) {
 			ScionMtu:     intf.Link.MTU,
 			State:        LinkState(intf.State),
 		}
+		if intf.RTT > 0 {
+			newInterface.Bfd.RoundTripTime = api.StringRef(intf.RTT.String())
+		}
 
 		intfs = append(intfs, newInterface)
 	}
@@ -150,6 +163,219 @@ func (s *Server) GetInterfaces(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// RolloverForwardingKey switches the data plane to the forwarding key that is
+// stored in master0.key. The previous key is still accepted during the key
+// grace period.
+func (s *Server) RolloverForwardingKey(w http.ResponseWriter, r *http.Request) {
+	changed, err := s.RolloverKey()
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to roll over forwarding key",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(ForwardingKeyRollover{Changed: changed}); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// GetCapture gets the status of the packet capture.
+func (s *Server) GetCapture(w http.ResponseWriter, r *http.Request) {
+	status, err := s.Capture.CaptureStatus()
+	if err != nil {
+		captureErrorResponse(w, err, "unable to get packet capture")
+		return
+	}
+	writeCaptureStatus(w, status)
+}
+
+// StartCapture starts a packet capture. It replaces the previous capture.
+func (s *Server) StartCapture(w http.ResponseWriter, r *http.Request) {
+	var req CaptureRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusBadRequest,
+			Title:  "malformed request body",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	cfg, err := captureConfig(req)
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusBadRequest,
+			Title:  "invalid packet capture",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	if err := s.Capture.StartCapture(cfg); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusBadRequest,
+			Title:  "unable to start packet capture",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	s.GetCapture(w, r)
+}
+
+// StopCapture stops the packet capture. The captured packets are kept.
+func (s *Server) StopCapture(w http.ResponseWriter, r *http.Request) {
+	if err := s.Capture.StopCapture(); err != nil {
+		captureErrorResponse(w, err, "unable to stop packet capture")
+		return
+	}
+	s.GetCapture(w, r)
+}
+
+// GetCapturePcapng writes the captured packets in the pcapng format.
+func (s *Server) GetCapturePcapng(w http.ResponseWriter, r *http.Request) {
+	if _, err := s.Capture.CaptureStatus(); err != nil {
+		captureErrorResponse(w, err, "unable to get packet capture")
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", `attachment; filename="capture.pcapng"`)
+	// Once the body is being written, errors cannot be reported anymore.
+	_ = s.Capture.WriteCapture(w)
+}
+
+func captureConfig(req CaptureRequest) (control.CaptureConfig, error) {
+	var cfg control.CaptureConfig
+	if req.MaxPackets != nil {
+		cfg.MaxPackets = *req.MaxPackets
+	}
+	if req.SnapLength != nil {
+		cfg.SnapLen = *req.SnapLength
+	}
+	if req.Duration != nil {
+		d, err := time.ParseDuration(*req.Duration)
+		if err != nil {
+			return control.CaptureConfig{}, serrors.Wrap("parsing duration", err)
+		}
+		cfg.Duration = d
+	}
+	if req.Filter == nil {
+		return cfg, nil
+	}
+	f := req.Filter
+	parseIA := func(ia *IsdAs) (addr.IA, error) {
+		if ia == nil {
+			return 0, nil
+		}
+		return addr.ParseIA(string(*ia))
+	}
+	var err error
+	if cfg.Filter.IA, err = parseIA(f.IsdAs); err != nil {
+		return control.CaptureConfig{}, err
+	}
+	if cfg.Filter.SrcIA, err = parseIA(f.SrcIsdAs); err != nil {
+		return control.CaptureConfig{}, err
+	}
+	if cfg.Filter.DstIA, err = parseIA(f.DstIsdAs); err != nil {
+		return control.CaptureConfig{}, err
+	}
+	if f.IngressInterface != nil {
+		if *f.IngressInterface < 0 || *f.IngressInterface > 0xffff {
+			return control.CaptureConfig{}, serrors.New("invalid ingress interface",
+				"interface", *f.IngressInterface)
+		}
+		ingress := iface.ID(*f.IngressInterface)
+		cfg.Filter.Ingress = &ingress
+	}
+	if f.Host != nil {
+		if cfg.Filter.Host, err = netip.ParseAddr(*f.Host); err != nil {
+			return control.CaptureConfig{}, serrors.Wrap("parsing host", err)
+		}
+	}
+	if f.Port != nil {
+		if *f.Port < 1 || *f.Port > 0xffff {
+			return control.CaptureConfig{}, serrors.New("invalid port", "port", *f.Port)
+		}
+		cfg.Filter.Port = uint16(*f.Port)
+	}
+	return cfg, nil
+}
+
+func writeCaptureStatus(w http.ResponseWriter, status control.CaptureStatus) {
+	rep := CaptureStatus{
+		Duration:   status.Config.Duration.String(),
+		MaxPackets: status.Config.MaxPackets,
+		SnapLength: status.Config.SnapLen,
+		Start:      status.Start,
+		Running:    status.Running,
+		Packets:    status.Packets,
+	}
+	f := status.Config.Filter
+	isdAsRef := func(ia addr.IA) *IsdAs {
+		if ia.IsZero() {
+			return nil
+		}
+		s := IsdAs(ia.String())
+		return &s
+	}
+	rep.Filter.IsdAs = isdAsRef(f.IA)
+	rep.Filter.SrcIsdAs = isdAsRef(f.SrcIA)
+	rep.Filter.DstIsdAs = isdAsRef(f.DstIA)
+	if f.Ingress != nil {
+		ingress := int(*f.Ingress)
+		rep.Filter.IngressInterface = &ingress
+	}
+	if f.Host.IsValid() {
+		rep.Filter.Host = api.StringRef(f.Host.String())
+	}
+	if f.Port != 0 {
+		port := int(f.Port)
+		rep.Filter.Port = &port
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+func captureErrorResponse(w http.ResponseWriter, err error, title string) {
+	if errors.Is(err, control.ErrNoCapture) {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusNotFound,
+			Title:  title,
+			Type:   api.StringRef(api.NotFound),
+		})
+		return
+	}
+	ErrorResponse(w, Problem{
+		Detail: api.StringRef(err.Error()),
+		Status: http.StatusInternalServerError,
+		Title:  title,
+		Type:   api.StringRef(api.InternalError),
+	})
+}
+
 // Error creates an detailed error response.
 func ErrorResponse(w http.ResponseWriter, p Problem) {
 	w.Header().Set("Content-Type", "application/problem+json")
@@ -17,7 +17,9 @@ package mgmtapi
 import (
 	"net/http"
 	"net/http/httptest"
+	"net/netip"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -29,6 +31,7 @@ import (
 	"github.com/scionproto/scion/pkg/private/ptr"
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/pkg/private/xtest"
+	"github.com/scionproto/scion/pkg/segment/iface"
 	"github.com/scionproto/scion/private/topology"
 	"github.com/scionproto/scion/router/control"
 	"github.com/scionproto/scion/router/control/mock_api"
@@ -39,7 +42,9 @@ var update = xtest.UpdateGoldenFiles()
 func TestAPI(t *testing.T) {
 	testCases := map[string]struct {
 		Handler            func(t *testing.T, ctrl *gomock.Controller) http.Handler
+		Method             string
 		RequestURL         string
+		RequestBody        string
 		ResponseFile       string
 		Status             int
 		IgnoreResponseBody bool
@@ -120,6 +125,94 @@ func TestAPI(t *testing.T) {
 			ResponseFile: "testdata/interfaces-sibling-error.json",
 			Status:       500,
 		},
+		"forwarding key rollover": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				s := &Server{
+					RolloverKey: func() (bool, error) { return true, nil },
+				}
+				return Handler(s)
+			},
+			Method:       "POST",
+			RequestURL:   "/forwarding-key/rollover",
+			ResponseFile: "testdata/forwarding-key-rollover.json",
+			Status:       200,
+		},
+		"forwarding key rollover error": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				s := &Server{
+					RolloverKey: func() (bool, error) {
+						return false, serrors.New("loading master keys")
+					},
+				}
+				return Handler(s)
+			},
+			Method:       "POST",
+			RequestURL:   "/forwarding-key/rollover",
+			ResponseFile: "testdata/forwarding-key-rollover-error.json",
+			Status:       500,
+		},
+		"start capture": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				capturer := mock_api.NewMockPacketCapturer(ctrl)
+				s := &Server{
+					Capture: capturer,
+				}
+				ingress := iface.ID(1)
+				cfg := control.CaptureConfig{
+					Filter: control.CaptureFilter{
+						Ingress: &ingress,
+						SrcIA:   addr.MustParseIA("1-ff00:0:111"),
+						Host:    netip.MustParseAddr("192.0.2.1"),
+						Port:    30041,
+					},
+					MaxPackets: 10,
+					Duration:   30 * time.Second,
+				}
+				capturer.EXPECT().StartCapture(cfg).Return(nil)
+				status := control.CaptureStatus{
+					Config:  cfg,
+					Start:   time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+					Running: true,
+				}
+				status.Config.SnapLen = 1500
+				capturer.EXPECT().CaptureStatus().Return(status, nil)
+				return Handler(s)
+			},
+			Method:     "POST",
+			RequestURL: "/capture",
+			RequestBody: `{"filter": {"ingress_interface": 1, "src_isd_as": "1-ff00:0:111",
+				"host": "192.0.2.1", "port": 30041}, "max_packets": 10, "duration": "30s"}`,
+			ResponseFile: "testdata/capture-start.json",
+			Status:       200,
+		},
+		"start capture invalid": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				s := &Server{
+					Capture: mock_api.NewMockPacketCapturer(ctrl),
+				}
+				return Handler(s)
+			},
+			Method:       "POST",
+			RequestURL:   "/capture",
+			RequestBody:  `{"filter": {"host": "192.0.2"}}`,
+			ResponseFile: "testdata/capture-start-invalid.json",
+			Status:       400,
+		},
+		"capture not found": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				capturer := mock_api.NewMockPacketCapturer(ctrl)
+				s := &Server{
+					Capture: capturer,
+				}
+				capturer.EXPECT().CaptureStatus().Return(
+					control.CaptureStatus{}, control.ErrNoCapture,
+				)
+				return Handler(s)
+			},
+			RequestURL:   "/capture/pcapng",
+			ResponseFile: "testdata/capture-not-found.json",
+			Status:       404,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -127,7 +220,12 @@ func TestAPI(t *testing.T) {
 			t.Parallel()
 			ctrl := gomock.NewController(t)
 
-			req, err := http.NewRequest("GET", tc.RequestURL, nil)
+			method := tc.Method
+			if method == "" {
+				method = "GET"
+			}
+			req, err := http.NewRequest(method, tc.RequestURL,
+				strings.NewReader(tc.RequestBody))
 			require.NoError(t, err)
 
 			rr := httptest.NewRecorder()
@@ -172,6 +270,7 @@ func createExternalIntfs(t *testing.T) []control.ExternalInterface {
 				MTU: 1472,
 			},
 			State: control.InterfaceUp,
+			RTT:   1500 * time.Microsecond,
 		},
 		{
 			IfID: 2,
@@ -12,9 +12,24 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Stop the packet capture
+	// (DELETE /capture)
+	StopCapture(w http.ResponseWriter, r *http.Request)
+	// Get the status of the packet capture
+	// (GET /capture)
+	GetCapture(w http.ResponseWriter, r *http.Request)
+	// Start a packet capture
+	// (POST /capture)
+	StartCapture(w http.ResponseWriter, r *http.Request)
+	// Download the captured packets
+	// (GET /capture/pcapng)
+	GetCapturePcapng(w http.ResponseWriter, r *http.Request)
 	// Prints the TOML configuration file.
 	// (GET /config)
 	GetConfig(w http.ResponseWriter, r *http.Request)
+	// Roll over the forwarding key.
+	// (POST /forwarding-key/rollover)
+	RolloverForwardingKey(w http.ResponseWriter, r *http.Request)
 	// Basic information page about the control service process.
 	// (GET /info)
 	GetInfo(w http.ResponseWriter, r *http.Request)
@@ -33,12 +48,42 @@ type ServerInterface interface {
 
 type Unimplemented struct{}
 
+// Stop the packet capture
+// (DELETE /capture)
+func (_ Unimplemented) StopCapture(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the status of the packet capture
+// (GET /capture)
+func (_ Unimplemented) GetCapture(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start a packet capture
+// (POST /capture)
+func (_ Unimplemented) StartCapture(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download the captured packets
+// (GET /capture/pcapng)
+func (_ Unimplemented) GetCapturePcapng(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Prints the TOML configuration file.
 // (GET /config)
 func (_ Unimplemented) GetConfig(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Roll over the forwarding key.
+// (POST /forwarding-key/rollover)
+func (_ Unimplemented) RolloverForwardingKey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Basic information page about the control service process.
 // (GET /info)
 func (_ Unimplemented) GetInfo(w http.ResponseWriter, r *http.Request) {
@@ -72,6 +117,62 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// StopCapture operation middleware
+func (siw *ServerInterfaceWrapper) StopCapture(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StopCapture(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCapture operation middleware
+func (siw *ServerInterfaceWrapper) GetCapture(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCapture(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StartCapture operation middleware
+func (siw *ServerInterfaceWrapper) StartCapture(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartCapture(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCapturePcapng operation middleware
+func (siw *ServerInterfaceWrapper) GetCapturePcapng(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCapturePcapng(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetConfig operation middleware
 func (siw *ServerInterfaceWrapper) GetConfig(w http.ResponseWriter, r *http.Request) {
 
@@ -86,6 +187,20 @@ func (siw *ServerInterfaceWrapper) GetConfig(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
+// RolloverForwardingKey operation middleware
+func (siw *ServerInterfaceWrapper) RolloverForwardingKey(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RolloverForwardingKey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetInfo operation middleware
 func (siw *ServerInterfaceWrapper) GetInfo(w http.ResponseWriter, r *http.Request) {
 
@@ -255,9 +370,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/capture", wrapper.StopCapture)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/capture", wrapper.GetCapture)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/capture", wrapper.StartCapture)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/capture/pcapng", wrapper.GetCapturePcapng)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/config", wrapper.GetConfig)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/forwarding-key/rollover", wrapper.RolloverForwardingKey)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/info", wrapper.GetInfo)
 	})
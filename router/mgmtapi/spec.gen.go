@@ -18,45 +18,66 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xZ23LjNhL9FRSSh0yFutgz2WT05rE9iapmxipfKg+J1wURLRIxCDAAKFvr1b9vNQBS",
-	"JEVfZnaT1ObJFgmgD06fbnSDDzTVRakVKGfp7IEasKVWFvyPd4yfw+8VWIe/Uq0cKP8vK0spUuaEVpPf",
-	"rFb4zKY5FAz/+9rAis7oV5Pd0pPw1k4uHFOcGX5qjDZ0u90mlINNjShxMTpDm8REo/g2TvRw3p/gn9Lo",
-	"EowTASMHKwzwm0IoUVTFjbu/EcqBWTMZX7cWv8yBxIGkHkWW4O4AFHGGKVsIa4VWRK/Iu/cnBPdstCQl",
-	"S2/BWeJy5ojLgSAE5rQhwb4dk8tcWLJmsgIiLGF8jRgtcOK0n1ECmITk+g7WYPwTlrqKyR2QCkcLS2wJ",
-	"qVgJ4GS5IY7dCpX58QW798j1Klrlo7iZkbsfNcswxf3wgEWv/A8DhXbgme1MNJCCWMMOhJ81pgmFe1aU",
-	"EuiMHk6nhaUJdZsSf1pnhMqo95yDFKm9KSrpRCkFmGHSVVUswSCYDpNFZR1Zok9sZIpDKpkB4pBNC8EZ",
-	"zBKu7xRyDKQxusO80oFQ9Fg9R1iSMplWkrlAZIS4qdns0KMg0074oR0Z7ESyCZD26XndEIODMzDIDCi2",
-	"lMD3yZgrHgMHTd/l4HIwHriwJM7yHky1WomsMsCJVsG2B7Niade+MxU0EJZaS2AKIdSubiIjuvozoyLO",
-	"4k+FA7pqYx0UxOa6kpzYqiy1cc8HRZQlxgY+EoEd6Mh95dOBSjfkGzGGcdLFOgpYGuCvGuSPAkYkaQql",
-	"Q7ZrJFKnTMZtvEj+LYrp7Jcn89AjkbKTyRPeuk6oE84DeSe4MGEZJsl7be6Y4SjnkyYkatU0CmOqK5u4",
-	"Cb38DVKHMpnXb/dT63LFn0vnmJK3CW1M3IgBzV8cz88+7WAQwUE5THDm+UDysxSTN6KNc1+/jHMD1uKW",
-	"6ymkb7dOhbpyPdP04O3h+OAfP4wPx4ez1wfT6XQo3SkQWb7U5jlSGko/1RO8VqR3is1F+dwCH4S6PW+P",
-	"9+egV4+rnj1hceDHyys/yTEHL7F24Qf2Nd1xa2v/bTSJl0ltqrfPQf+1FB08NN95SLU89KRaP7V80VVt",
-	"VMK+TK5OFpP5glSKg5Fs05YMGu1h+QJ9CMtvQqXypDosP7L7VIe5SQO/xVK9Vwz1vqZB8VIL5epdSKFu",
-	"n45zex5LvH3qmmXDLweFfbHW0UY0yoxhGy8/sZRCZTdfsO5FmPrE8tsdQfWOiBTWIUshmeMxGiGQFoQh",
-	"crxPZg9tj49Wq+l0Np0dHKCzS+ZQyHRG//nrr/zb0Te/sNFqOnp7/XCQvNnOXj0cbruPXv0bx31Ndyjn",
-	"Fyejowsyb7LfkIb2Qh9BqapAjRyfnZ/ShB7/NP9wQhO6ODo//XSJ/5yenqNeduDrIYPLX9RJoV73akET",
-	"enL286fuIv7x/go6+wBrkPvqkfXjbth90FnmfeJfJ41VDssq8xlipfGxbwg6AOKbp8/dsOz1gFMXRi8l",
-	"FEMtg2NiAOkRyauCYcnDuC8N4L6UTIWzNBblaagXhCU6TStjQO0OljIYbIqMHGS5qiTOQEHGsqYeherM",
-	"sPRmfC1C7sv1HQ4ujU4B+Jj8bIRzgCc4OVWZFDb3sxp8WPeCyoQCMDYhla2YlBuitCO2EljM4giFWRXS",
-	"XAlf4Th2C7mWHIz1q+FoHy/iX8C7We9YKxULCyzNmWNLZoE4UWBVWrnBJKisY2romD4iV+dzYmAFgbVA",
-	"Ux0N1pPTsPwouwmBcTbGepxxX/wwsjIsK0C1FjNEG2Kr5ahkLm8asNo9mxLG5CPbYOdRxWK05SCjdUyn",
-	"wjaTRDiZrK5MCiTVvHdATOLASdpwNvKS/srpW1Aj1PIIHTfy7I0CeyttCubojFZGjBpmhmjF47Wyw7XP",
-	"T5eXCxIGeGQkA4XdaWwgsVs1IhOKWDDYe4Z26SkJd/b23fR1QmMxTmffvX2b0Fik0tnBdDpUtcWUt68A",
-	"m2uD4iwKZjZ7ceMd81eL/gKMj8crxdZMSLQ55JDwAHe4YpVEH7KlrtxsKZm6pclLtF8p8XsFctMPgjYf",
-	"RCscENTnb2DuXYu3teDAydFiPiZnZalbnVUdSSy2yuT8/fHo+x+m3ydE+OykQPje00CqiwIUD3OX2GHX",
-	"QD3hyFeoMZwmLOTIUeMOrtMKgy/YUdqQTOqld0nYX9Odd9z8suD5jBDpHQsxXmopDp0PTaE83BDH7rNz",
-	"HVAp5E6R5caB9RsL9VhsL2O/a6A0YLGcqfftdKqlT6BhiW8WJ1evuoWnZBswnmthG1G3bjCYbSCdot8U",
-	"OFKyjdSMkxGZL8hPwDgYMiJXJ/WPDssHb74/HIrVvUrr8bLwL+nu5nU716vX68ruD2/nIkF/s2ZugPpH",
-	"O7xeTxeAtNu46Ir5k0V2n8d9nf33/dP/umvqXlfvIYb6cVeyfjQpwFqWPZ+qmsq3Z327jcXx/jm6mDdZ",
-	"NWztvOmY65bIPyD1YXa0mNOErsHYsMJ0PB0f4AZ1CYqVgs7o6/F0fBg6ndxvbhIuk/DfDPy1f7j0FlrN",
-	"OZ3RH8EdhxFJ97PB4XTa+16Ap9aklEz0vhT0idn7GnBRpSlYi1X0WW0cYb8JJoZ00kCZtD5f+C8Joeqg",
-	"M7owok7Ol2cfP/RuzVZChqsylln0Dx6PWtFrXGNSO+QxRuahZ/n/4uMdsyIluDU8a5GDkmVAfEHTFB5G",
-	"S19Aopx8h2LtEyy1G/7IVa8tFNa1BLybEWojZmDvCrx9dTdAfCv3PEP/l3++GrhFGfCS35te7W1t3HLV",
-	"I3BiJfTt58GqW90BLHO1ZlI039TGPdc/6oaWa1tXd967UmeTptl/LBCae4I/0BuNjT8tUn4E7Bu6Fxp7",
-	"EZDQshog5aJHil//neabP4WP+hqmbT+cQM5UsP1beeniJV7yU3xDjM8faGUkndHcuXI2mTzk2rrt7KHU",
-	"xm0nrBST9QEeoMwI7Ho8Rzik2wH6jtI/Rg1o03v9evrmzSGycN3A2asc1mA2Lvd1LYTC3+mBPJJQxYq6",
-	"mK4vSPuLHfut4tlP4D40h8tNXCxm8vZSkZnt9fY/AQAA//9q2WLTFSAAAA==",
+	"H4sIAAAAAAAC/9xaW3PbOpL+KyjOPCQ11MWOM5nozbGdPap1EpVl13mYybogskliDAIcAJSj9eq/bzUu",
+	"FEnRsj2b5JyzT4loXBpff93oCx6iRJaVFCCMjmYPkQJdSaHB/vhA0yv4Vw3a4K9ECgPC/pdWFWcJNUyK",
+	"yT+1FPhNJwWUFP/3ZwVZNIv+NNktPXF/1ZOloSKlKr1QSqpou93GUQo6UazCxaIZ7kmU33QbR3NhQAnK",
+	"f54AYUeyBLUGRcLA2G/gkPl4jv9USlagDHNwpaCZgvS2ZIKVdXlrvt0yXGztxO9uc10A8QNJGEVWYO4B",
+	"BDGKCl0yrZkURGbkw8dzgqdXkpOKJndgNDEFNcQUQFAEaqQibn89JtcF02RNeQ2EaULTNcqoISVG2hkV",
+	"gIpJIe8BD4hfaGJqyneC1DiaaaIrSFjGICWrDTH0joncji/pNyu5zPyu6cgfZmS+jZplqEjtcCeLzOwP",
+	"BaU0YJXcmaggAbaGnRB21jiKI/hGy4pDNIuOp9NSR3FkNhX+1EYxkUdWhwYShPa2rLlhFWeghkEXdbkC",
+	"hcJ0kCxrbcgKdaI9UikknCogBtHU4JRBNUnlvUCMgTSb7mTOpAMUNRbmME0SypOaU+OA9CJuApodeATk",
+	"0jA7tEODHUk2TqR9eN40wODgHBQiA4KuOKT7YMxF6k0It74vwBSgrOBMEz/LajCRImN5rSAlUri9rTAZ",
+	"Tbr7G1VDI8JKSg5UoAhB1Y1leFW/0Cr8rPSQOaCqNtpASXQha54SXVeVVOZpo/C0rAAUfmIOHejQPcOT",
+	"gEg25BUbwzjuyjpysjSCv24kf1RglCRJoDKIdpCEy4Ryf4xn0l/JWqS3RrHq1rDyEWB1KaUpICV29AhH",
+	"ExwdmMeZuIuR4SVQbdW92vTJPCZzK7UsmUGKsiwwJmXaUUYq/Goh7e1TUE2ERDMDsdtkA6Z7yqPx26FT",
+	"togUzf5+0Ns+4g92xnCAk1/jyDBjBfnAUqbcMpSTj1LdU5Wi0Z43hh9so7EjKrrG4Q8hV/+ExN5oZ7Qy",
+	"tYKPjJshF7UEDomlB+yoIkniZo3Jqf/qHFZJTVIQyrkdn7M1CLK7lOLW/x3fqAKrATfSzxYbv+aYMJ3e",
+	"Uo3q3BFxvjwfnS7d/ErJBLSGtnx4yt5FqM2tW+mp23iu01ONqBRSm30wLGtlrRIg7n4zTDikcTyZLwhN",
+	"UwVa9wj0/ng8HR+Pj4ZMhYkcZ9w2Whretvkz+rz7giVF68gIUPBGYzINeLEQOgy7x6Mh9/xCnNCXvQSn",
+	"m/MFfro+WxDrBjvXxXR6MiiTVsnL9Lfd2YzjtbWEAFbg7gFraIWZPSp50xo+s/UqNMMNdyry2xFtZKXD",
+	"RZ3Rmlu1HZVxx6UfFT3ulEOkyRprPYRG17S3cVTSb7feiJ8bjLzwNNNp7zjT6XTaZd10OqhjQatbDiI3",
+	"xSPXsF9xJ+JqY0AHgVJSgWr8Rl+st3253h8f/bUr1tshuVpEWnTIgz5QG+oY/BiLloaaWh8m0c/R9FPo",
+	"P5MUO6zd+A6CJ8dDK6taCDzK3sq/+gCvSyrGOVGQSJXqwU0eC+l69HlCrXFkdXfIiM0g56kykKJAmVQl",
+	"NdEsSqmBEU55MkDwmuzqJt6RoXuIIOIOwZ2WWiGBo9iz3dsuZvhP2FxJzuUa1D5Bk4KKHNLDSsuatcgd",
+	"bIifEwKyjHINGHiVVBtQ0zGOcfrFyJMy4e4o/NyEnlwBTTeECVLr54TyPYSD2F8HTj5vX6/d066y9Cn7",
+	"wvza3tV+kVs2AM7ybP7lc+uiZikIwzIG6umsKFzVT4UBPr5AhbOmMNDbVzbRrgG1H4kc/fVv4+Px8ewN",
+	"+uYhlyOA5cVKPul0Gkg/hwlWH9zSWResemqBSyburtrjbVHDBsmmfrJwggM/Xd94azbwnN2WdmCfNx21",
+	"ts7flia2NAlb9c45qL+2lVoNzdsh3E5D0SG2fm7postaz4R9mtycLybzBalFCorTTZsyuGlPln+DHy8N",
+	"xTpQu7lxI34LpXBW9Ch9ToNIK8mEaeeG44PI6StfOtyHrlnW/TJQ6mdzPdo2m1Kl6AZ/a7biTOS3/8a6",
+	"Szf1wPKtECSciHCmDaLkEiKsiXgRSEuEIXCsTrpRxyjLptPZdHZ0NLVXjEEiR7Pov/7xj/Qvo1d/p6Ns",
+	"Onr/9eEoPtnOXj8cb7ufXv8PjvtztJPS52fzxvsNcWjP9FEoUZfIkbMvVxdRHJ39Mr88j+JocXp18fka",
+	"/3NxcYV82QkfhgwuvwxOIax7s4ji6PzLr5+7i9wsBleQ+SWsge+zh4fPXbO7lHludWL/HDe7prCqc+sh",
+	"Momfbfm2I4D/y+HowS07dLMtlFxxKIfqv4ayAUlPSVGXVBC8bG2dB75VnPoEzVdYE1f8YZrIJKmVArG7",
+	"WCq3YXNtF8CrrOY4g8umRhVGITsxvccSF3O+r5D3ONgm7xgx/KqYMSDw3r8QOWe6sLMa+bCICSJnAkDp",
+	"mNS6ppxvbOFA18xAakcI9KqQFILZcpWhd1BInoLSdjUcbe2F/bcL4Vo0kkL4+gnWWamhK6pdIJgSWZvh",
+	"tF0bKoau6VNyczUnCjJwqDmYgjW4qKdB+VF0YwLjfIwVL5raGIuSTNG8BNFaTBGpiK5Xo4qaoqmmB/Vs",
+	"KhiTT3RDVuAK6F0FKSm9O2W6mcTczeST90SmvQti4gdOkgazkaX0n4y8AzFCLo9QcTYmTkcOvSZarhUb",
+	"NcgMwaqblGk/9vnl+npB3AArGclBgApFbBRbKpYzQbTrlLja9yEKd872dvomjnx+GM3evn8fR74W92ja",
+	"5F3ePgN0IRWSsyyp2uzZjVXMb016bCehPd4IuqaM455DCnEfHiKfTUeziK5kbWYrTsVdFD+H+7Vg/6qB",
+	"b/pG0MaDSME3gX22sfbNtHBbsxRScrqYj8mXqpKtMnmwJOr7HuTq49no3d+m72LCrHcSwGzKoiCRZQki",
+	"dXNXQFIIglrAES8XYxhJqPORo0YdqUxqND63j5CK5FyurErc+ZpWS0fNzzOeF5hI71rw9hKoOHQ/NIHy",
+	"wbJKp7dTC8RO+AoLHszFYyEjd80LBZUCDcI06jQykdw6ULfEq8X5zetu4MnpBpTFmumG1K12FNWNSBeo",
+	"NwGGVHTDJU3JCCusvwBNQZGRLSW6H91Czsm7wULEXqT1eFj4m2R3TZ+3F6+HyO6Hp3MeoP9nydwA9I9m",
+	"eL2czgnSTuO8KuYHg+w+jvs8+7/nT987a+q+QtiTGMLnLmXtaFKC1jR/2lU1kW9v9+3WB8f79+hi3nhV",
+	"d7SrJmMOKZH9QMJldrqYR3G0BqXdCtPxdHyEB5QVCFqxaBa9wVaMy3QKe7iJL5i5/TmYAeNcGlm5q8kG",
+	"EqZfa7MF53511Ha37qCyNWL3KoJJMU8tRLIahX3j7iuX4+n0u70u6RaiB16X9KraKFeFkcI2jk6mJwfk",
+	"8FfZX14mT8hVBiT5LHug2vasbc02dVec5YOqtlK685AdNNe2IOi/fN3GUQ4D9d7/APdmRTdl1LaSpSKc",
+	"ajNQWO1qMwfzu1DmsnOKvtR/BJUO6+NZ+q0GO7ZL3MfPDM9buu9sbM8ZP7v6fEyMzF2R+54Z95c1qJQl",
+	"pltYdQla7J3TpwWx/q1dhrc5l3dfCqjG+NSQe2rfJiRUpZB2HIfrqfmOW6tJ0AgssREHLkYObQMLaUW1",
+	"hjR2LQv7iCqRJWiSMaXNmFgQXA4ZtlJQcbzAfOQGayYRb+HCYGY63Ze+76Kqy3fbK/0g0833pnpow267",
+	"d4lRNWx/V14zkBlNbPozTWwu1pSz5onivotE+tPnWNA2bm7CSZXQyvXsBr3mubwXNiA3Q7eeT+LcGsTl",
+	"NY7nYYTFa2de7movXFyP9Ks1NOGKyw5vlhdXU/Lq6OTd6zG5oEmxO5FSDDQy22Z3rWV9HTK8+dtFRdbu",
+	"m8dbzDRDBu38oLsfeaReREaZGDAjbRTQskuCJglcMYEK3A+p9ghwtteS/SP4+YMMepye9nFTi5YDenFD",
+	"ntQH1hcmFaesh8CTeC/rJAGtsd75JezesvshBBtRJq0HzF08FoqFNPr6y6fL3jOujHEYt1GRZSmFB2XX",
+	"hh3dwWai2k3dwSvxMuDuOrPYgdUkU7IMtZfWzu69mVQb12S4Z/aylEP93xSUtSe7Uqvp6684/9pQ+0C9",
+	"Dm+2BNzb6Rjn47uxQlYkY8BTjZaZKKAG/J2Iu/knJ8400WBdOak1i+aUCW06i6PwdwBVeN2IMremuF3S",
+	"netoLkScXAvDeNOrzhW6kAoUk2nr7h2TU869WE3ccrpsnvE66LBSCZlUQMw+JgNXbVDmqKvkHxlkDr8Q",
+	"GDCEj131o6gY7qzRY27j6O1z7KF5Td+1BtzWrjTAs0ftIKSQj7oG32b5YzmGD1SzhKDoqnQmWdEciK3B",
+	"DpEovIg8AFO7Rzl4vV8ybVoX827G7s1m/wn24cuy05T8Ycwd6PwOqMkeTmZ7Z/sdBm+P6qGl29ZzA6te",
+	"LvNJ06B81BS4zEehT/nD9NF0UH+asWD2yHtt2D0jiKOqHoBF78Hy/bOaQ4iE9vFla/+fk+38fD0tn6Mn",
+	"O8U28vD7Q1QrHs2iwphqNpk8FFKb7eyhkspsJ7RikzU+rl5TxbBbYzHaPeEOnSvbCbOfOy+Xw5/fTE9O",
+	"jhGFr404exXPNaiNKVBwWyx2vaR9XxJHgpahCRAedjwcziJ93mS7J9iPh9YyfszAImcWLyx8YhvfdsZW",
+	"Gy9RO7AIC9nh0fbr9n8HANjufshqNwAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -3,6 +3,10 @@
 // Code generated by unknown module path version unknown version DO NOT EDIT.
 package mgmtapi
 
+import (
+	"time"
+)
+
 // Defines values for LinkRelationship.
 const (
 	CHILD  LinkRelationship = "CHILD"
@@ -37,6 +41,65 @@ type BFD struct {
 
 	// RequiredMinimumReceive The minimum interval between received BFD control packets that this system should support. This value is advertised to the remote peer to indicate the maximum frequency (i.e., minimum inter-packet interval) between BFD control packets that is acceptable to the local system.
 	RequiredMinimumReceive string `json:"required_minimum_receive"`
+
+	// RoundTripTime The smoothed round-trip time of the link, as measured by the BFD session. It is omitted if BFD is disabled or if the round-trip time has not been measured yet.
+	RoundTripTime *string `json:"round_trip_time,omitempty"`
+}
+
+// CaptureFilter Selects the packets to capture. A packet must match all the given properties, properties that are not given match any packet. isd_as is the local ISD-AS that processes the packet.
+type CaptureFilter struct {
+	DstIsdAs *IsdAs `json:"dst_isd_as,omitempty"`
+
+	// Host The source or destination host IP address.
+	Host *string `json:"host,omitempty"`
+
+	// IngressInterface The interface on which the packet is received. 0 is the internal interface.
+	IngressInterface *int   `json:"ingress_interface,omitempty"`
+	IsdAs            *IsdAs `json:"isd_as,omitempty"`
+
+	// Port The source or destination UDP or TCP port.
+	Port     *int   `json:"port,omitempty"`
+	SrcIsdAs *IsdAs `json:"src_isd_as,omitempty"`
+}
+
+// CaptureRequest defines model for CaptureRequest.
+type CaptureRequest struct {
+	// Duration The time after which the capture stops. The default is 1m, the maximum 1h.
+	Duration *string `json:"duration,omitempty"`
+
+	// Filter Selects the packets to capture. A packet must match all the given properties, properties that are not given match any packet. isd_as is the local ISD-AS that processes the packet.
+	Filter *CaptureFilter `json:"filter,omitempty"`
+
+	// MaxPackets The number of packets after which the capture stops. The default is 100, the maximum 10000.
+	MaxPackets *int `json:"max_packets,omitempty"`
+
+	// SnapLength The maximum number of bytes captured per packet. The default is 1500, the maximum 9216.
+	SnapLength *int `json:"snap_length,omitempty"`
+}
+
+// CaptureStatus defines model for CaptureStatus.
+type CaptureStatus struct {
+	Duration string `json:"duration"`
+
+	// Filter Selects the packets to capture. A packet must match all the given properties, properties that are not given match any packet. isd_as is the local ISD-AS that processes the packet.
+	Filter     CaptureFilter `json:"filter"`
+	MaxPackets int           `json:"max_packets"`
+
+	// Packets The number of captured packets.
+	Packets int `json:"packets"`
+
+	// Running Whether the capture still records packets.
+	Running    bool `json:"running"`
+	SnapLength int  `json:"snap_length"`
+
+	// Start The time at which the capture started.
+	Start time.Time `json:"start"`
+}
+
+// ForwardingKeyRollover defines model for ForwardingKeyRollover.
+type ForwardingKeyRollover struct {
+	// Changed Whether the forwarding key changed. It is false if master0.key still contains the key that is already in use.
+	Changed bool `json:"changed"`
 }
 
 // Interface defines model for Interface.
@@ -137,5 +200,11 @@ type StandardError struct {
 // BadRequest defines model for BadRequest.
 type BadRequest = StandardError
 
+// Internal defines model for Internal.
+type Internal = StandardError
+
+// StartCaptureJSONRequestBody defines body for StartCapture for application/json ContentType.
+type StartCaptureJSONRequestBody = CaptureRequest
+
 // SetLogLevelJSONRequestBody defines body for SetLogLevel for application/json ContentType.
 type SetLogLevelJSONRequestBody = LogLevel
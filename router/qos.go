@@ -0,0 +1,240 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package router
+
+import (
+	"encoding/binary"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/slayers"
+)
+
+// QoSClass is the class of service of a packet on egress. Each link has one egress queue per
+// class, and the queues are served by weighted fair queueing. The zero value is the best-effort
+// class, so that packets that are not classified are treated as ordinary traffic.
+type QoSClass uint8
+
+const (
+	QoSClassBestEffort QoSClass = iota // Everything else.
+	QoSClassPriority                   // Packets with a high-priority SCION traffic class.
+	QoSClassSCMP                       // SCMP messages, including the ones made by the router.
+	QoSClassControl                    // BFD and packets to the control or discovery service.
+	NumQoSClasses
+)
+
+// Returns a human-friendly representation of the given class. It is used as metrics label.
+func (c QoSClass) String() string {
+	switch c {
+	case QoSClassPriority:
+		return "priority"
+	case QoSClassSCMP:
+		return "scmp"
+	case QoSClassControl:
+		return "control"
+	}
+	return "best_effort"
+}
+
+// QoSWeights are the scheduling weights of the QoS classes, indexed by class. When all classes
+// have packets queued, each class gets a share of the link that is proportional to its weight.
+// Classes without queued packets leave their share to the others.
+type QoSWeights [NumQoSClasses]int
+
+// DefaultQoSWeights are the weights that are used for the classes with no (or a non-positive)
+// configured weight.
+var DefaultQoSWeights = QoSWeights{
+	QoSClassBestEffort: 1,
+	QoSClassPriority:   4,
+	QoSClassSCMP:       2,
+	QoSClassControl:    8,
+}
+
+// dscpPriority is the smallest DSCP value that is classified as priority traffic. It includes
+// the class selectors CS5 to CS7 and expedited forwarding (EF, 46).
+const dscpPriority = 40
+
+// qosQuantum is the number of bytes a class with weight 1 may send per scheduling round.
+const qosQuantum = 1500
+
+// classifyPacket returns the QoS class of a decoded packet. next is the type of the layer that
+// follows the SCION header and its extensions.
+func classifyPacket(s *slayers.SCION, next gopacket.LayerType) QoSClass {
+	if next == layers.LayerTypeBFD {
+		return QoSClassControl
+	}
+	if s.DstAddrType == slayers.T4Svc && len(s.RawDstAddr) >= 2 {
+		svc := addr.SVC(binary.BigEndian.Uint16(s.RawDstAddr)).Base()
+		if svc == addr.SvcCS || svc == addr.SvcDS {
+			return QoSClassControl
+		}
+	}
+	if next == slayers.LayerTypeSCMP {
+		return QoSClassSCMP
+	}
+	// The upper 6 bits of the traffic class are the DSCP.
+	if s.TrafficClass>>2 >= dscpPriority {
+		return QoSClassPriority
+	}
+	return QoSClassBestEffort
+}
+
+// QoSClass returns the QoS class of the packet.
+func (p *Packet) QoSClass() QoSClass {
+	return p.qosClass
+}
+
+// EgressQueue is the egress queue of a link. It has one queue per QoS class. Packets are taken
+// from the queues by deficit round robin, which approximates weighted fair queueing: in every
+// round, each class may send a number of bytes proportional to its weight. A class that is empty
+// does not accumulate credit. Any number of goroutines may enqueue packets, but only one may
+// dequeue them.
+type EgressQueue struct {
+	classes [NumQoSClasses]chan *Packet
+	quantum [NumQoSClasses]int
+	metrics *qosMetrics
+	ready   chan struct{} // Signals that a packet might have been queued.
+	done    chan struct{} // Closed by Close.
+
+	// The scheduling state. Only accessed by the dequeuing goroutine.
+	head    [NumQoSClasses]*Packet // Packet taken from the class queue but not sent yet.
+	deficit [NumQoSClasses]int
+	current QoSClass
+	served  bool // Whether the current class got its quantum in this round.
+}
+
+// newEgressQueue returns an egress queue that holds up to size packets per class.
+func newEgressQueue(size int, weights QoSWeights, metrics *qosMetrics) *EgressQueue {
+	q := &EgressQueue{
+		metrics: metrics,
+		ready:   make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+	for c := range q.classes {
+		q.classes[c] = make(chan *Packet, size)
+		w := weights[c]
+		if w <= 0 {
+			w = DefaultQoSWeights[c]
+		}
+		q.quantum[c] = w * qosQuantum
+	}
+	return q
+}
+
+// Enqueue queues the packet according to its QoS class. It returns false, without queueing the
+// packet, if the queue of the class is full.
+func (q *EgressQueue) Enqueue(p *Packet) bool {
+	select {
+	case q.classes[p.qosClass] <- p:
+	default:
+		q.metrics.dropped[p.qosClass].Inc()
+		return false
+	}
+	q.signal()
+	return true
+}
+
+// EnqueueBlocking queues the packet according to its QoS class. It blocks while the queue of the
+// class is full.
+func (q *EgressQueue) EnqueueBlocking(p *Packet) {
+	q.classes[p.qosClass] <- p
+	q.signal()
+}
+
+func (q *EgressQueue) signal() {
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+// Dequeue fills pkts with up to len(pkts) packets and returns their number. If block is true and
+// no packet is queued, it waits until one is queued or the queue is closed. Only one goroutine
+// may call Dequeue.
+func (q *EgressQueue) Dequeue(pkts []*Packet, block bool) int {
+	for {
+		n := q.dequeue(pkts)
+		if n > 0 || !block {
+			return n
+		}
+		select {
+		case <-q.ready:
+		case <-q.done:
+			return q.dequeue(pkts)
+		}
+	}
+}
+
+// Close unblocks Dequeue. Packets that are still queued are not sent.
+func (q *EgressQueue) Close() {
+	close(q.done)
+}
+
+func (q *EgressQueue) dequeue(pkts []*Packet) int {
+	var sent [NumQoSClasses]int
+	n := 0
+	idle := 0 // Number of consecutive classes found empty.
+	for n < len(pkts) && idle < int(NumQoSClasses) {
+		c := q.current
+		p := q.head[c]
+		if p == nil {
+			select {
+			case p = <-q.classes[c]:
+				q.head[c] = p
+			default:
+			}
+		}
+		if p == nil {
+			q.deficit[c] = 0
+			q.next()
+			idle++
+			continue
+		}
+		idle = 0
+		if !q.served {
+			q.deficit[c] += q.quantum[c]
+			q.served = true
+		}
+		size := len(p.RawPacket)
+		if size > q.deficit[c] {
+			// Keep the deficit for the next round.
+			q.next()
+			continue
+		}
+		q.deficit[c] -= size
+		q.head[c] = nil
+		pkts[n] = p
+		n++
+		sent[c]++
+	}
+	for c := range q.classes {
+		length := len(q.classes[c])
+		if q.head[c] != nil {
+			length++
+		}
+		q.metrics.queueLength[c].Set(float64(length))
+		if sent[c] > 0 {
+			q.metrics.sent[c].Add(float64(sent[c]))
+		}
+	}
+	return n
+}
+
+func (q *EgressQueue) next() {
+	q.current = (q.current + 1) % NumQoSClasses
+	q.served = false
+}
@@ -0,0 +1,148 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package router
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/slayers"
+)
+
+func TestClassifyPacket(t *testing.T) {
+	svc := func(s addr.SVC) *slayers.SCION {
+		raw := make([]byte, 4)
+		binary.BigEndian.PutUint16(raw, uint16(s))
+		return &slayers.SCION{DstAddrType: slayers.T4Svc, RawDstAddr: raw}
+	}
+	testCases := map[string]struct {
+		scion    *slayers.SCION
+		next     gopacket.LayerType
+		expected QoSClass
+	}{
+		"bfd": {
+			scion:    &slayers.SCION{},
+			next:     layers.LayerTypeBFD,
+			expected: QoSClassControl,
+		},
+		"control service": {
+			scion:    svc(addr.SvcCS),
+			next:     slayers.LayerTypeSCIONUDP,
+			expected: QoSClassControl,
+		},
+		"discovery service multicast": {
+			scion:    svc(addr.SvcDS.Multicast()),
+			next:     slayers.LayerTypeSCIONUDP,
+			expected: QoSClassControl,
+		},
+		"scmp": {
+			scion:    &slayers.SCION{TrafficClass: 0xb8},
+			next:     slayers.LayerTypeSCMP,
+			expected: QoSClassSCMP,
+		},
+		"expedited forwarding": {
+			scion:    &slayers.SCION{TrafficClass: 0xb8},
+			next:     slayers.LayerTypeSCIONUDP,
+			expected: QoSClassPriority,
+		},
+		"af41": {
+			scion:    &slayers.SCION{TrafficClass: 0x88},
+			next:     slayers.LayerTypeSCIONUDP,
+			expected: QoSClassBestEffort,
+		},
+		"default": {
+			scion:    &slayers.SCION{},
+			next:     slayers.LayerTypeSCIONUDP,
+			expected: QoSClassBestEffort,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, classifyPacket(tc.scion, tc.next))
+		})
+	}
+}
+
+func TestEgressQueue(t *testing.T) {
+	newQueue := func(size int, weights QoSWeights) *EgressQueue {
+		return newEgressQueue(size, weights,
+			newQoSMetrics(metrics, 1, addr.MustParseIA("1-ff00:0:110"), "",
+				addr.MustParseIA("1-ff00:0:111")))
+	}
+	newPacket := func(c QoSClass, size int) *Packet {
+		return &Packet{RawPacket: make([]byte, size), qosClass: c}
+	}
+	count := func(pkts []*Packet) map[QoSClass]int {
+		counts := make(map[QoSClass]int)
+		for _, p := range pkts {
+			counts[p.qosClass]++
+		}
+		return counts
+	}
+
+	t.Run("weighted", func(t *testing.T) {
+		q := newQueue(100, QoSWeights{
+			QoSClassBestEffort: 1,
+			QoSClassPriority:   3,
+		})
+		for range 100 {
+			assert.True(t, q.Enqueue(newPacket(QoSClassBestEffort, 1000)))
+			assert.True(t, q.Enqueue(newPacket(QoSClassPriority, 1000)))
+		}
+		pkts := make([]*Packet, 40)
+		assert.Equal(t, 40, q.Dequeue(pkts, false))
+		counts := count(pkts)
+		assert.InDelta(t, 10, counts[QoSClassBestEffort], 2)
+		assert.InDelta(t, 30, counts[QoSClassPriority], 2)
+	})
+	t.Run("work conserving", func(t *testing.T) {
+		q := newQueue(100, QoSWeights{})
+		for range 10 {
+			assert.True(t, q.Enqueue(newPacket(QoSClassBestEffort, 9000)))
+		}
+		pkts := make([]*Packet, 20)
+		assert.Equal(t, 10, q.Dequeue(pkts, false))
+		assert.Equal(t, 0, q.Dequeue(pkts, false))
+	})
+	t.Run("drop when class is full", func(t *testing.T) {
+		q := newQueue(2, QoSWeights{})
+		assert.True(t, q.Enqueue(newPacket(QoSClassBestEffort, 100)))
+		assert.True(t, q.Enqueue(newPacket(QoSClassBestEffort, 100)))
+		assert.False(t, q.Enqueue(newPacket(QoSClassBestEffort, 100)))
+		assert.True(t, q.Enqueue(newPacket(QoSClassControl, 100)))
+	})
+	t.Run("blocking", func(t *testing.T) {
+		q := newQueue(2, QoSWeights{})
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			q.EnqueueBlocking(newPacket(QoSClassSCMP, 100))
+		}()
+		pkts := make([]*Packet, 2)
+		assert.Equal(t, 1, q.Dequeue(pkts, true))
+		assert.Equal(t, QoSClassSCMP, pkts[0].qosClass)
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			q.Close()
+		}()
+		assert.Equal(t, 0, q.Dequeue(pkts, true))
+	})
+}
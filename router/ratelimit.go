@@ -0,0 +1,164 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package router
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/scionproto/scion/pkg/addr"
+)
+
+// RateLimit is the configuration of a token bucket. Rate is the number of packets per second that
+// are allowed on average, and Burst is the number of packets that are allowed in a burst. A burst
+// of zero is the same as the rate, rounded up. A rate of zero disables the limit.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// RateLimits are the rate limits applied by the data plane.
+type RateLimits struct {
+	// SlowPathInterface limits the slow-path work (SCMP errors, traceroute and one-hop path
+	// processing) per ingress interface. Internal and sibling links share interface 0.
+	SlowPathInterface RateLimit
+	// SlowPathSourceAS limits the slow-path work per source ISD-AS.
+	SlowPathSourceAS RateLimit
+	// SourceAS limits all packets per source ISD-AS, except BFD.
+	SourceAS RateLimit
+}
+
+// rateLimitReason labels the packets dropped by a rate limit.
+type rateLimitReason uint8
+
+const (
+	rlSlowPathInterface rateLimitReason = iota
+	rlSlowPathSourceAS
+	rlSourceAS
+)
+
+// Returns a human-friendly representation of the given reason. It is used as metrics label.
+func (r rateLimitReason) String() string {
+	switch r {
+	case rlSlowPathInterface:
+		return "slow_path_interface"
+	case rlSlowPathSourceAS:
+		return "slow_path_source_as"
+	}
+	return "source_as"
+}
+
+// maxRateLimitBuckets is the maximum number of token buckets of a rate limiter. It bounds the
+// memory used when packets arrive from many (possibly spoofed) sources.
+const maxRateLimitBuckets = 4096
+
+// tokenBucket is the state of a token bucket. It is guarded by the rate limiter.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// rateLimiter keeps a token bucket per key. All keys have the same limit.
+type rateLimiter[K comparable] struct {
+	limit   RateLimit
+	mtx     sync.Mutex
+	buckets map[K]*tokenBucket
+	// overflow is shared by the keys that have no bucket once maxRateLimitBuckets is reached.
+	overflow tokenBucket
+}
+
+// newRateLimiter returns a rate limiter with the given limit, or nil if the limit is disabled.
+// All methods of a nil rate limiter allow every packet.
+func newRateLimiter[K comparable](limit RateLimit) *rateLimiter[K] {
+	if limit.Rate <= 0 {
+		return nil
+	}
+	if limit.Burst < 1 {
+		limit.Burst = max(1, int(math.Ceil(limit.Rate)))
+	}
+	return &rateLimiter[K]{
+		limit:    limit,
+		buckets:  make(map[K]*tokenBucket),
+		overflow: tokenBucket{tokens: float64(limit.Burst)},
+	}
+}
+
+// allow takes a token from the bucket of the key and reports whether there was one.
+func (r *rateLimiter[K]) allow(key K, now time.Time) bool {
+	if r == nil {
+		return true
+	}
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	b, ok := r.buckets[key]
+	if !ok {
+		if len(r.buckets) >= maxRateLimitBuckets {
+			r.evictIdle(now)
+		}
+		if len(r.buckets) >= maxRateLimitBuckets {
+			b = &r.overflow
+		} else {
+			b = &tokenBucket{tokens: float64(r.limit.Burst), last: now}
+			r.buckets[key] = b
+		}
+	}
+	b.tokens = min(float64(r.limit.Burst), b.tokens+now.Sub(b.last).Seconds()*r.limit.Rate)
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// evictIdle removes the buckets that are full again, i.e., whose keys have not sent packets
+// recently. The caller must hold the lock.
+func (r *rateLimiter[K]) evictIdle(now time.Time) {
+	for key, b := range r.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*r.limit.Rate >= float64(r.limit.Burst) {
+			delete(r.buckets, key)
+		}
+	}
+}
+
+// rateLimiters groups the rate limiters of the data plane.
+type rateLimiters struct {
+	slowPathInterface *rateLimiter[uint16]
+	slowPathSourceAS  *rateLimiter[addr.IA]
+	sourceAS          *rateLimiter[addr.IA]
+}
+
+func newRateLimiters(limits RateLimits) rateLimiters {
+	return rateLimiters{
+		slowPathInterface: newRateLimiter[uint16](limits.SlowPathInterface),
+		slowPathSourceAS:  newRateLimiter[addr.IA](limits.SlowPathSourceAS),
+		sourceAS:          newRateLimiter[addr.IA](limits.SourceAS),
+	}
+}
+
+// allowSlowPath reports whether slow-path work for a packet from the given ingress interface and
+// source ISD-AS is allowed. If it is not, it returns the reason.
+func (r *rateLimiters) allowSlowPath(
+	ingress uint16, src addr.IA, now time.Time) (bool, rateLimitReason) {
+
+	if !r.slowPathInterface.allow(ingress, now) {
+		return false, rlSlowPathInterface
+	}
+	if !r.slowPathSourceAS.allow(src, now) {
+		return false, rlSlowPathSourceAS
+	}
+	return true, 0
+}
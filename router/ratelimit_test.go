@@ -0,0 +1,119 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package router
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/scionproto/scion/pkg/addr"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Now()
+	ia1 := addr.MustParseIA("1-ff00:0:110")
+	ia2 := addr.MustParseIA("1-ff00:0:111")
+
+	t.Run("disabled", func(t *testing.T) {
+		r := newRateLimiter[addr.IA](RateLimit{})
+		assert.Nil(t, r)
+		for range 100 {
+			assert.True(t, r.allow(ia1, now))
+		}
+	})
+	t.Run("burst and refill", func(t *testing.T) {
+		r := newRateLimiter[addr.IA](RateLimit{Rate: 10, Burst: 5})
+		for range 5 {
+			assert.True(t, r.allow(ia1, now))
+		}
+		assert.False(t, r.allow(ia1, now))
+		// Other keys have their own bucket.
+		assert.True(t, r.allow(ia2, now))
+
+		// 10 packets per second is one every 100ms.
+		assert.True(t, r.allow(ia1, now.Add(100*time.Millisecond)))
+		assert.False(t, r.allow(ia1, now.Add(100*time.Millisecond)))
+		// The bucket never holds more than the burst.
+		for range 5 {
+			assert.True(t, r.allow(ia1, now.Add(time.Hour)))
+		}
+		assert.False(t, r.allow(ia1, now.Add(time.Hour)))
+	})
+	t.Run("default burst", func(t *testing.T) {
+		r := newRateLimiter[uint16](RateLimit{Rate: 2.5})
+		for range 3 {
+			assert.True(t, r.allow(1, now))
+		}
+		assert.False(t, r.allow(1, now))
+	})
+	t.Run("bounded number of buckets", func(t *testing.T) {
+		r := newRateLimiter[uint64](RateLimit{Rate: 1, Burst: 1})
+		for k := range uint64(maxRateLimitBuckets) {
+			assert.True(t, r.allow(k, now))
+		}
+		// All buckets are busy, new keys share the overflow bucket.
+		assert.True(t, r.allow(maxRateLimitBuckets, now))
+		assert.False(t, r.allow(maxRateLimitBuckets+1, now))
+		assert.Len(t, r.buckets, maxRateLimitBuckets)
+
+		// Idle buckets are evicted.
+		later := now.Add(time.Second)
+		assert.True(t, r.allow(maxRateLimitBuckets+1, later))
+		assert.Len(t, r.buckets, 1)
+	})
+}
+
+func TestAllowSlowPath(t *testing.T) {
+	now := time.Now()
+	ia1 := addr.MustParseIA("1-ff00:0:110")
+	ia2 := addr.MustParseIA("1-ff00:0:111")
+	r := newRateLimiters(RateLimits{
+		SlowPathInterface: RateLimit{Rate: 3, Burst: 3},
+		SlowPathSourceAS:  RateLimit{Rate: 2, Burst: 2},
+	})
+	assert.Nil(t, r.sourceAS)
+
+	for range 2 {
+		ok, _ := r.allowSlowPath(1, ia1, now)
+		assert.True(t, ok)
+	}
+	ok, reason := r.allowSlowPath(1, ia1, now)
+	assert.False(t, ok)
+	assert.Equal(t, rlSlowPathSourceAS, reason)
+
+	// The third packet on interface 1 spent the last token of the interface.
+	ok, reason = r.allowSlowPath(1, ia2, now)
+	assert.False(t, ok)
+	assert.Equal(t, rlSlowPathInterface, reason)
+
+	ok, _ = r.allowSlowPath(2, ia2, now)
+	assert.True(t, ok)
+}
+
+func TestSourceLabel(t *testing.T) {
+	local := addr.MustParseIA("1-ff00:0:110")
+	neighbor := addr.MustParseIA("1-ff00:0:111")
+	d := newDataPlane(RunConfig{}, false)
+	assert.NoError(t, d.SetIA(local))
+	assert.NoError(t, d.AddNeighborIA(1, neighbor))
+
+	assert.Equal(t, "1-ff00:0:110", d.sourceLabel(local))
+	assert.Equal(t, "1-ff00:0:111", d.sourceLabel(neighbor))
+	// Any other, possibly spoofed, source shares a label.
+	assert.Equal(t, "other", d.sourceLabel(addr.MustParseIA("1-ff00:0:112")))
+	assert.Equal(t, "other", d.sourceLabel(addr.MustParseIA("2-ff00:0:113")))
+}
@@ -59,9 +59,11 @@ type Link interface {
 	BFDSession() *bfd.Session
 	// Resolve finds and sets the packet's internal underlay destination for the given dst and port.
 	Resolve(p *Packet, dst addr.Host, port uint16) error
-	// Send queues the packet for sending over this link; discarding if the queue is full.
+	// Send queues the packet for sending over this link; discarding if the queue of the packet's
+	// QoS class is full.
 	Send(p *Packet) bool
-	// SendBlocking queues the packet for sending over this link; blocking while the queue is full.
+	// SendBlocking queues the packet for sending over this link; blocking while the queue of the
+	// packet's QoS class is full.
 	SendBlocking(p *Packet)
 }
 
@@ -70,6 +72,10 @@ type Link interface {
 // For any given underlay, there are three kinds of Link implementations to choose from. The
 // difference between them is the intent regarding addressing.
 //
+// Each link is given an egress queue at creation. The link's Send methods must queue packets in
+// it, and the provider must send the packets in the order in which the queue's Dequeue method
+// returns them. That is how egress scheduling is applied regardless of the underlay.
+//
 // TODO(multi_underlay): The local internal address is explicitly a udpip underlay address as the
 // main router code as well as the entire end-host stack still assume that the internal network
 // underlay is always "udp/ip".
@@ -123,7 +129,7 @@ type UnderlayProvider interface {
 	// do not need an underlay destination as metadata. Incoming packets have a defined ingress
 	// ifID.
 	NewExternalLink(
-		qSize int,
+		egressQ *EgressQueue,
 		bfd *bfd.Session,
 		local string,
 		remote string,
@@ -136,7 +142,7 @@ type UnderlayProvider interface {
 	// that of the sibling router. Outgoing packets do not need an underlay destination as metadata.
 	// Incoming packets have no defined ingress ifID.
 	NewSiblingLink(
-		qSize int,
+		egressQ *EgressQueue,
 		bfd *bfd.Session,
 		local string,
 		remote string,
@@ -146,7 +152,7 @@ type UnderlayProvider interface {
 	// NewInternalLink returns a link that addresses any host internal to the enclosing AS, so it is
 	// given neither ifID nor remote address. Outgoing packets need to have a destination address as
 	// metadata. Incoming packets have no defined ingress ifID.
-	NewInternalLink(localAddr string, qSize int, metrics *InterfaceMetrics) (Link, error)
+	NewInternalLink(localAddr string, egressQ *EgressQueue, metrics *InterfaceMetrics) (Link, error)
 }
 
 // NewProviderFn is a function that instantiates an underlay provider.
@@ -198,7 +198,7 @@ func (u *provider) Stop() {
 	}
 }
 
-// udpConnection is essentially a BatchConn with a sending queue and a demultiplexer. The rest is
+// udpConnection is essentially a BatchConn with an egress queue and a demultiplexer. The rest is
 // about logs and metrics. This allows UDP connections to be shared between links when needed (for
 // example, only linux allows UDP connected sockets to share the same local address, which is needed
 // if sibling links are to have distinct connections).
@@ -207,7 +207,7 @@ type udpConnection struct {
 	name         string                     // for logs. It's more informative than ifID.
 	link         udpLink                    // Link with exclusive use of the connection.
 	links        map[netip.AddrPort]udpLink // Links that share this connection
-	queue        chan *router.Packet
+	queue        *router.EgressQueue
 	metrics      *router.InterfaceMetrics
 	receiverDone chan struct{}
 	senderDone   chan struct{}
@@ -246,8 +246,8 @@ func (u *udpConnection) stop() {
 	wasRunning := u.running.Swap(false)
 
 	if wasRunning {
-		u.conn.Close() // Unblock receiver
-		close(u.queue) // Unblock sender
+		u.conn.Close()  // Unblock receiver
+		u.queue.Close() // Unblock sender
 		<-u.receiverDone
 		<-u.senderDone
 	}
@@ -315,31 +315,6 @@ func (u *udpConnection) receive(batchSize int, pool router.PacketPool) {
 	}
 }
 
-func readUpTo(queue <-chan *router.Packet, n int, needsBlocking bool, pkts []*router.Packet) int {
-	i := 0
-	if needsBlocking {
-		p, ok := <-queue
-		if !ok {
-			return i
-		}
-		pkts[i] = p
-		i++
-	}
-
-	for ; i < n; i++ {
-		select {
-		case p, ok := <-queue:
-			if !ok {
-				return i
-			}
-			pkts[i] = p
-		default:
-			return i
-		}
-	}
-	return i
-}
-
 func (u *udpConnection) send(batchSize int, pool router.PacketPool) {
 	log.Debug("Send", "connection", u.name)
 
@@ -360,7 +335,7 @@ func (u *udpConnection) send(batchSize int, pool router.PacketPool) {
 
 	for u.running.Load() {
 		// Top-up our batch.
-		toWrite += readUpTo(queue, batchSize-toWrite, toWrite == 0, pkts[toWrite:])
+		toWrite += queue.Dequeue(pkts[toWrite:], toWrite == 0)
 
 		// Turn the packets into underlay messages that WriteBatch can send.
 		for i, p := range pkts[:toWrite] {
@@ -422,7 +397,7 @@ func makeHashSeed() uint32 {
 type connectedLink struct {
 	procQs     []chan *router.Packet
 	name       string // For logs
-	egressQ    chan<- *router.Packet
+	egressQ    *router.EgressQueue
 	metrics    *router.InterfaceMetrics
 	pool       router.PacketPool
 	bfdSession *bfd.Session
@@ -434,7 +409,7 @@ type connectedLink struct {
 // NewExternalLink returns an external link over the UDP/IP underlay. It is always implemented with
 // a connectedLink.
 func (u *provider) NewExternalLink(
-	qSize int,
+	egressQ *router.EgressQueue,
 	bfd *bfd.Session,
 	local string,
 	remote string,
@@ -458,11 +433,12 @@ func (u *provider) NewExternalLink(
 	if l := u.allLinks[remoteAddr]; l != nil {
 		return nil, serrors.Join(errDuplicateRemote, nil, "addr", remote)
 	}
-	return u.newConnectedLink(qSize, bfd, localAddr, remoteAddr, ifID, metrics, router.External)
+	return u.newConnectedLink(
+		egressQ, bfd, localAddr, remoteAddr, ifID, metrics, router.External)
 }
 
 func (u *provider) newConnectedLink(
-	qSize int,
+	egressQ *router.EgressQueue,
 	bfd *bfd.Session,
 	localAddr netip.AddrPort,
 	remoteAddr netip.AddrPort,
@@ -476,10 +452,9 @@ func (u *provider) newConnectedLink(
 	if err != nil {
 		return nil, err
 	}
-	queue := make(chan *router.Packet, qSize)
 	el := &connectedLink{
 		name:       remoteAddr.String(),
-		egressQ:    queue,
+		egressQ:    egressQ,
 		metrics:    metrics,
 		bfdSession: bfd,
 		seed:       makeHashSeed(),
@@ -491,7 +466,7 @@ func (u *provider) newConnectedLink(
 		name: el.name,
 		link: el,
 		// links: nil; no demux lookup ever for this connection
-		queue:        queue,
+		queue:        egressQ,
 		metrics:      metrics, // send() needs them :-(
 		receiverDone: make(chan struct{}),
 		senderDone:   make(chan struct{}),
@@ -555,17 +530,12 @@ func (l *connectedLink) Resolve(p *router.Packet, host addr.Host, port uint16) e
 }
 
 func (l *connectedLink) Send(p *router.Packet) bool {
-	select {
-	case l.egressQ <- p:
-	default:
-		return false
-	}
-	return true
+	return l.egressQ.Enqueue(p)
 }
 
 func (l *connectedLink) SendBlocking(p *router.Packet) {
 	// We use a bound and connected socket so we don't need to specify the destination.
-	l.egressQ <- p
+	l.egressQ.EnqueueBlocking(p)
 }
 
 func (l *connectedLink) receive(size int, srcAddr *net.UDPAddr, p *router.Packet) {
@@ -598,7 +568,7 @@ func (l *connectedLink) receive(size int, srcAddr *net.UDPAddr, p *router.Packet
 type detachedLink struct {
 	procQs     []chan *router.Packet
 	name       string // For logs
-	egressQ    chan<- *router.Packet
+	egressQ    *router.EgressQueue
 	metrics    *router.InterfaceMetrics
 	pool       router.PacketPool
 	bfdSession *bfd.Session
@@ -613,7 +583,7 @@ type detachedLink struct {
 // it away (there are no persistent resources attached to it). This could be fixed by moving some
 // BFD related code in-here.
 func (u *provider) NewSiblingLink(
-	qSize int,
+	egressQ *router.EgressQueue,
 	bfd *bfd.Session,
 	local string,
 	remote string,
@@ -640,7 +610,8 @@ func (u *provider) NewSiblingLink(
 	// If we have linux support, we use connected links, even though the local address is the same
 	// for all sibling links.
 	if u.connOpener.UDPCanReuseLocal() {
-		return u.newConnectedLink(qSize, bfd, localAddr, remoteAddr, 0, metrics, router.Sibling)
+		return u.newConnectedLink(
+			egressQ, bfd, localAddr, remoteAddr, 0, metrics, router.Sibling)
 	}
 	return u.newDetachedLink(bfd, remoteAddr, metrics)
 }
@@ -729,18 +700,13 @@ func (l *detachedLink) Send(p *router.Packet) bool {
 	// is pointless: if we loan l.remote we avoid a copy and still discard at most one address. This
 	// is safe because we treat p.RemoteAddr as immutable and the router main code doesn't touch it.
 	p.RemoteAddr = unsafe.Pointer(l.remote)
-	select {
-	case l.egressQ <- p:
-	default:
-		return false
-	}
-	return true
+	return l.egressQ.Enqueue(p)
 }
 
 func (l *detachedLink) SendBlocking(p *router.Packet) {
 	// Same as Send(). We must supply the destination address.
 	p.RemoteAddr = unsafe.Pointer(l.remote)
-	l.egressQ <- p
+	l.egressQ.EnqueueBlocking(p)
 }
 
 func (l *detachedLink) receive(size int, srcAddr *net.UDPAddr, p *router.Packet) {
@@ -769,7 +735,7 @@ func (l *detachedLink) receive(size int, srcAddr *net.UDPAddr, p *router.Packet)
 
 type internalLink struct {
 	procQs           []chan *router.Packet
-	egressQ          chan *router.Packet
+	egressQ          *router.EgressQueue
 	metrics          *router.InterfaceMetrics
 	pool             router.PacketPool
 	svc              *router.Services[netip.AddrPort]
@@ -784,7 +750,7 @@ type internalLink struct {
 // TODO(multi_underlay): We still go with the assumption that internal links are always
 // udpip, so we don't expect a string here. That should change.
 func (u *provider) NewInternalLink(
-	local string, qSize int, metrics *router.InterfaceMetrics,
+	local string, egressQ *router.EgressQueue, metrics *router.InterfaceMetrics,
 ) (router.Link, error) {
 	u.mu.Lock()
 	defer u.mu.Unlock()
@@ -804,9 +770,8 @@ func (u *provider) NewInternalLink(
 		return nil, err
 	}
 	u.internalHashSeed = makeHashSeed()
-	queue := make(chan *router.Packet, qSize)
 	il := &internalLink{
-		egressQ:          queue,
+		egressQ:          egressQ,
 		metrics:          metrics,
 		svc:              u.svc,
 		seed:             u.internalHashSeed,
@@ -819,7 +784,7 @@ func (u *provider) NewInternalLink(
 		name: "internal",
 		link: il,
 		// links: see below.
-		queue:        queue,
+		queue:        egressQ,
 		metrics:      metrics, // send() needs them :-(
 		receiverDone: make(chan struct{}),
 		senderDone:   make(chan struct{}),
@@ -919,17 +884,12 @@ func (l *internalLink) Resolve(p *router.Packet, dst addr.Host, port uint16) err
 
 // The packet's destination is already in the packet's meta-data.
 func (l *internalLink) Send(p *router.Packet) bool {
-	select {
-	case l.egressQ <- p:
-	default:
-		return false
-	}
-	return true
+	return l.egressQ.Enqueue(p)
 }
 
 // The packet's destination is already in the packet's meta-data.
 func (l *internalLink) SendBlocking(p *router.Packet) {
-	l.egressQ <- p
+	l.egressQ.EnqueueBlocking(p)
 }
 
 func (l *internalLink) receive(size int, srcAddr *net.UDPAddr, p *router.Packet) {
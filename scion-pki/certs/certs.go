@@ -56,6 +56,7 @@ func Cmd(pather command.Pather) *cobra.Command {
 		newRenewCmd(joined),
 		newMatchCmd(joined),
 		newSignCmd(joined),
+		newRevokeCmd(joined),
 		newFingerprintCmd(joined),
 		newInspectCmd(joined),
 	)
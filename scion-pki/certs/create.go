@@ -368,7 +368,7 @@ offset from the current time.`,
 		"The path to the existing private key to use instead of creating a new one",
 	)
 	cmd.Flags().StringVar(&flags.curve, "curve", "P-256",
-		"The elliptic curve to use (P-256|P-384|P-521)",
+		"The elliptic curve to use (P-256|P-384|P-521|Ed25519)",
 	)
 	cmd.Flags().BoolVar(&flags.bundle, "bundle", false,
 		"Bundle the certificate with the issuer certificate as a certificate chain",
@@ -30,7 +30,6 @@ import (
 	"github.com/quic-go/quic-go"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/resolver"
-	"google.golang.org/protobuf/proto"
 
 	"github.com/scionproto/scion/pkg/addr"
 	"github.com/scionproto/scion/pkg/daemon"
@@ -39,7 +38,6 @@ import (
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/pkg/private/util"
 	cppb "github.com/scionproto/scion/pkg/proto/control_plane"
-	"github.com/scionproto/scion/pkg/scrypto/cms/protocol"
 	"github.com/scionproto/scion/pkg/scrypto/cppki"
 	"github.com/scionproto/scion/pkg/scrypto/signed"
 	"github.com/scionproto/scion/pkg/snet"
@@ -48,7 +46,6 @@ import (
 	"github.com/scionproto/scion/private/app"
 	infraenv "github.com/scionproto/scion/private/app/appnet"
 	"github.com/scionproto/scion/private/app/command"
-	"github.com/scionproto/scion/private/app/feature"
 	"github.com/scionproto/scion/private/app/flag"
 	"github.com/scionproto/scion/private/app/path"
 	"github.com/scionproto/scion/private/ca/renewal"
@@ -104,8 +101,6 @@ type SubjectVars struct {
 	StreetAddress      string  `json:"street_address,omitempty"`
 }
 
-type Features struct{}
-
 func newRenewCmd(pather command.Pather) *cobra.Command {
 	var envFlags flag.SCIONEnvironment
 	var flags struct {
@@ -126,9 +121,8 @@ func newRenewCmd(pather command.Pather) *cobra.Command {
 		tracer   string
 		logLevel string
 
-		force    bool
-		backup   bool
-		features []string
+		force  bool
+		backup bool
 
 		interactive bool
 		noColor     bool
@@ -220,11 +214,6 @@ The template is expressed in JSON. A valid example::
 
 			cmd.SilenceUsage = true
 
-			var features Features
-			if err := feature.Parse(flags.features, &features); err != nil {
-				return err
-			}
-
 			if !flags.backup && !flags.force {
 				certSet, keySet := flags.out != "", flags.outKey != ""
 				switch {
@@ -580,7 +569,7 @@ The template is expressed in JSON. A valid example::
 			"--remote is mutually exclusive with --ca.",
 	)
 	cmd.Flags().StringVar(&flags.curve, "curve", "P-256",
-		"The elliptic curve to use (P-256|P-384|P-521)",
+		"The elliptic curve to use (P-256|P-384|P-521|Ed25519)",
 	)
 	cmd.Flags().StringVar(&flags.expiresIn, "expires-in", "",
 		"Remaining time threshold for renewal",
@@ -594,9 +583,6 @@ The template is expressed in JSON. A valid example::
 	cmd.Flags().DurationVar(&flags.timeout, "timeout", 10*time.Second,
 		"The timeout for the renewal request per CA",
 	)
-	cmd.Flags().StringSliceVar(&flags.features, "features", nil,
-		fmt.Sprintf("enable development features (%v)", feature.String(&Features{}, "|")),
-	)
 	cmd.Flags().StringVar(&flags.tracer, "tracing.agent", "",
 		"The tracing agent address",
 	)
@@ -801,7 +787,7 @@ func (r *renewer) doRequest(
 	if err != nil {
 		return nil, serrors.Wrap("requesting certificate chain", err, "remote", c.Target())
 	}
-	renewed, err := extractChain(reply)
+	renewed, err := renewal.ExtractChainFromResponse(reply)
 	if err != nil {
 		return nil, serrors.Wrap("extracting certificate chain from response", err)
 	}
@@ -878,58 +864,6 @@ func loadChain(trcs []*cppki.TRC, file string) ([]*x509.Certificate, error) {
 	return chain, nil
 }
 
-func extractChain(rep *cppb.ChainRenewalResponse) ([]*x509.Certificate, error) {
-	// XXX(karampok). We should verify the signature on the payload, but that
-	// implies having a full trust engine that is capable of resolving missing
-	// certificate chains for the CA. We skip it, since the chain itself is
-	// verified against the TRC, and thus, risk is very low.
-	if len(rep.CmsSignedResponse) == 0 {
-		return extractChainLegacy(rep)
-	}
-	ci, err := protocol.ParseContentInfo(rep.CmsSignedResponse)
-	if err != nil {
-		return nil, err
-	}
-	sd, err := ci.SignedDataContent()
-	if err != nil {
-		return nil, err
-	}
-	raw, err := sd.EncapContentInfo.DataEContent()
-	if err != nil {
-		return nil, err
-	}
-	chain, err := x509.ParseCertificates(raw)
-	if err != nil {
-		return nil, err
-	}
-	if err := cppki.ValidateChain(chain); err != nil {
-		return nil, err
-	}
-	return chain, nil
-}
-
-func extractChainLegacy(rep *cppb.ChainRenewalResponse) ([]*x509.Certificate, error) {
-	body, err := signed.ExtractUnverifiedBody(rep.SignedResponse)
-	if err != nil {
-		return nil, err
-	}
-	var replyBody cppb.ChainRenewalResponseBody
-	if err := proto.Unmarshal(body, &replyBody); err != nil {
-		return nil, err
-	}
-	chain := make([]*x509.Certificate, 2)
-	if chain[0], err = x509.ParseCertificate(replyBody.Chain.AsCert); err != nil {
-		return nil, serrors.Wrap("parsing AS certificate", err)
-	}
-	if chain[1], err = x509.ParseCertificate(replyBody.Chain.CaCert); err != nil {
-		return nil, serrors.Wrap("parsing CA certificate", err)
-	}
-	if err := cppki.ValidateChain(chain); err != nil {
-		return nil, err
-	}
-	return chain, nil
-}
-
 func subjectFromVars(vars SubjectVars) (pkix.Name, error) {
 	if vars.IA.IsZero() {
 		return pkix.Name{}, serrors.New("isd_as required in template")
@@ -32,6 +32,7 @@ import (
 	"github.com/scionproto/scion/pkg/scrypto"
 	"github.com/scionproto/scion/pkg/scrypto/cppki"
 	"github.com/scionproto/scion/pkg/scrypto/signed"
+	"github.com/scionproto/scion/private/ca/renewal"
 	"github.com/scionproto/scion/private/trust"
 	"github.com/scionproto/scion/scion-pki/key"
 )
@@ -180,7 +181,7 @@ func TestExtractChain(t *testing.T) {
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			rep := tc.Response(t)
-			renewed, err := extractChain(rep)
+			renewed, err := renewal.ExtractChainFromResponse(rep)
 			tc.ErrAssertion(t, err)
 			assert.Equal(t, tc.Expected, renewed)
 		})
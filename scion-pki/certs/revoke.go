@@ -0,0 +1,205 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certs
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	"github.com/scionproto/scion/private/app/command"
+	"github.com/scionproto/scion/private/app/flag"
+	scionpki "github.com/scionproto/scion/scion-pki"
+	"github.com/scionproto/scion/scion-pki/file"
+	"github.com/scionproto/scion/scion-pki/key"
+)
+
+// newRevokeCmd returns a cobra command that creates a certificate revocation
+// list.
+func newRevokeCmd(pather command.Pather) *cobra.Command {
+	now := time.Now().UTC()
+	var flags struct {
+		ca         string
+		caKey      string
+		caKms      string
+		number     uint64
+		serials    []string
+		nextUpdate flag.Time
+		out        string
+		force      bool
+	}
+	flags.nextUpdate = flag.Time{
+		Time:    now.Add(7 * 24 * time.Hour),
+		Current: now,
+	}
+
+	var cmd = &cobra.Command{
+		Use:   "revoke [flags] [<cert-file>...]",
+		Short: "Create a certificate revocation list",
+		Example: fmt.Sprintf(`  %[1]s revoke --ca cp-ca.crt --ca-key cp-ca.key --number 1 \
+    --out ISD1-ASff00_0_110.crl ISD1-ASff00_0_111.pem
+  %[1]s revoke --ca cp-ca.crt --ca-key cp-ca.key --number 2 \
+    --serial 0x4c1bb5ad --out ISD1-ASff00_0_110.crl`,
+			pather.CommandPath(),
+		),
+		Long: `'revoke' creates a certificate revocation list (CRL) that revokes AS
+certificates issued by a CA.
+
+The command takes the following positional arguments:
+
+- <cert-file> is the file path of an AS certificate or certificate chain that is
+  revoked. The first certificate in the file is revoked.
+
+Additional AS certificates can be revoked by their serial number with the
+\--serial flag. All AS certificates must be issued by the CA certificate that
+is provided with the \--ca flag. A CRL replaces the CRLs previously issued by
+the same CA, thus, it must list all AS certificates that are still revoked.
+
+The \--ca, \--ca-key and \--number flags are required. The number must be larger
+than the number of any CRL previously issued by the CA.
+
+The CRL is written in PEM format, followed by the PEM-encoded CA certificate.
+The control service loads files with the .crl extension from its certs
+directory and distributes the CRL to the other ASes.
+
+The \--next-update flag can either be a timestamp or a relative time offset
+from the current time.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if flags.number == 0 {
+				return serrors.New("CRL number must be positive")
+			}
+			cmd.SilenceUsage = true
+
+			caCertRaw, err := os.ReadFile(flags.ca)
+			if err != nil {
+				return serrors.Wrap("read CA certificate", err)
+			}
+			caCert, err := parseCertificate(caCertRaw)
+			if err != nil {
+				return serrors.Wrap("parsing CA certificate", err)
+			}
+			if ct, err := cppki.ValidateCert(caCert); err != nil {
+				return serrors.Wrap("validating CA certificate", err)
+			} else if ct != cppki.CA {
+				return serrors.New("issuer certificate of invalid type",
+					"expected", cppki.CA, "actual", ct)
+			}
+			caKey, err := key.LoadPrivateKey(flags.caKms, flags.caKey)
+			if err != nil {
+				return serrors.Wrap("loading CA private key", err)
+			}
+
+			var revoked []x509.RevocationListEntry
+			for _, s := range flags.serials {
+				serial, ok := new(big.Int).SetString(s, 0)
+				if !ok {
+					return serrors.New("invalid serial number", "serial", s)
+				}
+				revoked = append(revoked, x509.RevocationListEntry{
+					SerialNumber:   serial,
+					RevocationTime: now,
+				})
+			}
+			for _, f := range args {
+				raw, err := os.ReadFile(f)
+				if err != nil {
+					return serrors.Wrap("read certificate", err, "file", f)
+				}
+				cert, err := parseCertificate(raw)
+				if err != nil {
+					return serrors.Wrap("parsing certificate", err, "file", f)
+				}
+				if err := cert.CheckSignatureFrom(caCert); err != nil {
+					return serrors.Wrap("certificate not issued by CA", err, "file", f)
+				}
+				revoked = append(revoked, x509.RevocationListEntry{
+					SerialNumber:   cert.SerialNumber,
+					RevocationTime: now,
+				})
+			}
+
+			crl, err := cppki.CAPolicy{
+				Certificate: caCert,
+				Signer:      caKey,
+				CurrentTime: now,
+			}.CreateCRL(new(big.Int).SetUint64(flags.number), revoked, flags.nextUpdate.Time)
+			if err != nil {
+				return err
+			}
+
+			encoded := pem.EncodeToMemory(&pem.Block{
+				Type:  "X509 CRL",
+				Bytes: crl.Raw,
+			})
+			encoded = append(encoded, pem.EncodeToMemory(&pem.Block{
+				Type:  "CERTIFICATE",
+				Bytes: caCert.Raw,
+			})...)
+			if flags.out == "" {
+				fmt.Fprint(cmd.OutOrStdout(), string(encoded))
+				return nil
+			}
+			if err := file.WriteFile(flags.out, encoded, 0644,
+				file.WithForce(flags.force)); err != nil {
+
+				return serrors.Wrap("writing CRL", err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "CRL successfully written to %q\n", flags.out)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&flags.ca, "ca", "",
+		"The path to the CA certificate that issued the revoked certificates",
+	)
+	cmd.Flags().StringVar(&flags.caKey, "ca-key", "",
+		"The path to the CA private key used to sign the CRL",
+	)
+	cmd.Flags().Uint64Var(&flags.number, "number", 0,
+		"The CRL number, must be larger than the number of the previous CRL of the CA",
+	)
+	cmd.Flags().StringSliceVar(&flags.serials, "serial", nil,
+		"The serial number of a revoked certificate, decimal or hex with 0x prefix",
+	)
+	cmd.Flags().Var(&flags.nextUpdate, "next-update",
+		`The time the next CRL is issued at the latest. Can either be a timestamp or
+an offset.
+
+If the value is a timestamp, it is expected to either be an RFC 3339 formatted
+timestamp or a unix timestamp. If the value is a duration, it is used as the
+offset from the current time.`,
+	)
+	cmd.Flags().StringVar(&flags.out, "out", "",
+		"The path to write the CRL to. If not set, the CRL is written to stdout",
+	)
+	cmd.Flags().BoolVar(&flags.force, "force", false,
+		"Force overwriting existing files",
+	)
+	scionpki.BindFlagKmsCA(cmd.Flags(), &flags.caKms)
+	for _, f := range []string{"ca", "ca-key", "number"} {
+		if err := cmd.MarkFlagRequired(f); err != nil {
+			panic(err)
+		}
+	}
+	return cmd
+}
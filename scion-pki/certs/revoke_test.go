@@ -0,0 +1,117 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certs
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/pkg/private/xtest"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	"github.com/scionproto/scion/private/app/command"
+)
+
+func TestNewRevokeCmd(t *testing.T) {
+	ca := xtest.LoadChain(t, "testdata/revoke/ISD1-ASff00_0_110.ca.crt")[0]
+	as111 := xtest.LoadChain(t, "testdata/revoke/ISD1-ASff00_0_111.pem")[0]
+	as112 := xtest.LoadChain(t, "testdata/revoke/ISD1-ASff00_0_112.pem")[0]
+	caFlags := []string{
+		"--ca", "testdata/revoke/ISD1-ASff00_0_110.ca.crt",
+		"--ca-key", "testdata/revoke/cp-ca.key",
+	}
+
+	testCases := map[string]struct {
+		Args         []string
+		ErrAssertion assert.ErrorAssertionFunc
+		Revoked      []*x509.Certificate
+		NotRevoked   []*x509.Certificate
+	}{
+		"revoke file": {
+			Args:         []string{"--number", "1", "testdata/revoke/ISD1-ASff00_0_111.pem"},
+			ErrAssertion: assert.NoError,
+			Revoked:      []*x509.Certificate{as111},
+			NotRevoked:   []*x509.Certificate{as112},
+		},
+		"revoke serial": {
+			Args: []string{
+				"--number", "2",
+				"--serial", fmt.Sprintf("0x%x", as112.SerialNumber),
+				"testdata/revoke/ISD1-ASff00_0_111.pem",
+			},
+			ErrAssertion: assert.NoError,
+			Revoked:      []*x509.Certificate{as111, as112},
+		},
+		"empty": {
+			Args:         []string{"--number", "3"},
+			ErrAssertion: assert.NoError,
+			NotRevoked:   []*x509.Certificate{as111, as112},
+		},
+		"number not set": {
+			Args:         []string{"testdata/revoke/ISD1-ASff00_0_111.pem"},
+			ErrAssertion: assert.Error,
+		},
+		"invalid serial": {
+			Args:         []string{"--number", "1", "--serial", "garbage"},
+			ErrAssertion: assert.Error,
+		},
+		"not issued by CA": {
+			Args:         []string{"--number", "1", "testdata/revoke/ISD1-ASff00_0_110.ca.crt"},
+			ErrAssertion: assert.Error,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			out := filepath.Join(t.TempDir(), "ISD1-ASff00_0_110.crl")
+
+			cmd := newRevokeCmd(command.StringPather("scion-pki certificate"))
+			cmd.SetArgs(append(append([]string{"--out", out}, caFlags...), tc.Args...))
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+			err := cmd.Execute()
+			tc.ErrAssertion(t, err)
+			if err != nil {
+				return
+			}
+
+			raw, err := os.ReadFile(out)
+			require.NoError(t, err)
+			block, rest := pem.Decode(raw)
+			require.NotNil(t, block)
+			assert.Equal(t, "X509 CRL", block.Type)
+			crl, err := x509.ParseRevocationList(block.Bytes)
+			require.NoError(t, err)
+			certs, err := cppki.ParsePEMCerts(rest)
+			require.NoError(t, err)
+			assert.Equal(t, []*x509.Certificate{ca}, certs)
+
+			assert.NoError(t, cppki.ValidateCRL(crl))
+			for _, cert := range tc.Revoked {
+				assert.True(t, cppki.IsRevoked(crl, cert))
+			}
+			for _, cert := range tc.NotRevoked {
+				assert.False(t, cppki.IsRevoked(crl, cert))
+			}
+		})
+	}
+}
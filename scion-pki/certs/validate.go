@@ -25,10 +25,12 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/private/util"
 	"github.com/scionproto/scion/pkg/scrypto/cppki"
 	"github.com/scionproto/scion/private/app"
 	"github.com/scionproto/scion/private/app/command"
 	"github.com/scionproto/scion/private/app/flag"
+	"github.com/scionproto/scion/private/trust/expiry"
 )
 
 func newValidateCmd(pather command.Pather) *cobra.Command {
@@ -37,6 +39,7 @@ func newValidateCmd(pather command.Pather) *cobra.Command {
 		certType    string
 		checkTime   bool
 		currentTime flag.Time
+		warnBefore  string
 	}
 	flags.currentTime = flag.Time{
 		Time:    now,
@@ -53,15 +56,28 @@ the output.
 
 By default, the command does not check that the certificate is in its validity
 period. This can be enabled by specifying the \--check-time flag.
+
+The \--warn-before flag prints a warning if the certificate expires within the
+given duration after the time specified by \--current-time. For certificate
+chains, the chain expires with the first certificate in the chain that expires.
 `,
 		Example: fmt.Sprintf(`  %[1]s validate --type cp-root /tmp/certs/cp-root.crt
-  %[1]s validate --type any /tmp/certs/cp-root.crt`, pather.CommandPath()),
+  %[1]s validate --type any /tmp/certs/cp-root.crt
+  %[1]s validate --type chain --warn-before 3d /tmp/certs/ISD1-ASff00_0_110.pem`,
+			pather.CommandPath()),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			expectedType, checkType := certTypes[flags.certType]
 			if !checkType && (flags.certType != "any" && flags.certType != "chain") {
 				return serrors.New("invalid type flag", "type", flags.certType)
 			}
+			var warnBefore time.Duration
+			if flags.warnBefore != "" {
+				var err error
+				if warnBefore, err = util.ParseDuration(flags.warnBefore); err != nil {
+					return serrors.Wrap("parsing --warn-before", err)
+				}
+			}
 			cmd.SilenceUsage = true
 
 			filename := args[0]
@@ -90,13 +106,20 @@ period. This can be enabled by specifying the \--check-time flag.
 					return err
 				}
 				fmt.Printf("Valid certificate chain: %q\n", filename)
-
+				if warnBefore != 0 {
+					checkExpiry(expiry.ChainEntry(certs, filename),
+						flags.currentTime.Time, warnBefore)
+				}
 			} else {
 				ct, err := validateCert(certs, expectedType, checkType)
 				if err != nil {
 					return err
 				}
 				fmt.Printf("Valid %s certificate: %q\n", ct, filename)
+				if warnBefore != 0 {
+					checkExpiry(expiry.CertificateEntry(certs[0], filename),
+						flags.currentTime.Time, warnBefore)
+				}
 			}
 			return nil
 		},
@@ -115,6 +138,10 @@ Can either be a timestamp or an offset.
 If the value is a timestamp, it is expected to either be an RFC 3339 formatted
 timestamp or a unix timestamp. If the value is a duration, it is used as the
 offset from the current time.`,
+	)
+	cmd.Flags().StringVar(&flags.warnBefore, "warn-before", "",
+		`Print a warning if the certificate expires within the given duration,
+e.g., "3d" or "72h".`,
 	)
 	if err := cmd.MarkFlagRequired("type"); err != nil {
 		panic(err)
@@ -158,6 +185,17 @@ func validateCert(
 	return ct, nil
 }
 
+func checkExpiry(e expiry.Entry, now time.Time, warnBefore time.Duration) {
+	switch e.State(now, warnBefore) {
+	case expiry.Expired:
+		fmt.Printf("WARNING: Certificate expired at %s\n", e.NotAfter.UTC().Format(time.RFC3339))
+	case expiry.Expiring:
+		fmt.Printf("WARNING: Certificate expires in %s at %s\n",
+			e.TimeToExpiry(now).Round(time.Second),
+			e.NotAfter.UTC().Format(time.RFC3339))
+	}
+}
+
 func checkAlgorithm(cert *x509.Certificate) {
 	if cert.PublicKeyAlgorithm != x509.ECDSA {
 		return
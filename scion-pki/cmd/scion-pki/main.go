@@ -22,19 +22,29 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/scionproto/scion/pkg/scrypto"
 	"github.com/scionproto/scion/private/app"
+	"github.com/scionproto/scion/private/app/feature"
 	"github.com/scionproto/scion/scion-pki/certs"
 	"github.com/scionproto/scion/scion-pki/key"
 	"github.com/scionproto/scion/scion-pki/testcrypto"
 	"github.com/scionproto/scion/scion-pki/trcs"
 )
 
+// Features are the development features that can be enabled on the command line.
+type Features struct {
+	// Ed25519 enables the experimental support for Ed25519 keys and
+	// signatures.
+	Ed25519 bool `feature:"ed25519"`
+}
+
 // CommandPather returns the path to a command.
 type CommandPather interface {
 	CommandPath() string
 }
 
 func main() {
+	var features []string
 	executable := filepath.Base(os.Args[0])
 	cmd := &cobra.Command{
 		Use:   executable,
@@ -48,7 +58,18 @@ func main() {
 		// that are not caused by malformed input.
 		// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413.
 		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			var f Features
+			if err := feature.Parse(features, &f); err != nil {
+				return err
+			}
+			scrypto.EnableEd25519(f.Ed25519)
+			return nil
+		},
 	}
+	cmd.PersistentFlags().StringSliceVar(&features, "features", nil,
+		fmt.Sprintf("enable development features (%v)", feature.String(&Features{}, "|")),
+	)
 
 	cmd.AddCommand(
 		newVersion(),
@@ -17,6 +17,7 @@ package key
 import (
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/x509"
@@ -28,6 +29,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/scrypto"
 	"github.com/scionproto/scion/private/app/command"
 	"github.com/scionproto/scion/scion-pki/file"
 )
@@ -75,7 +77,7 @@ The contents are the private key in PKCS #8 ASN.1 DER format.
 		},
 	}
 	cmd.Flags().StringVar(&flags.curve, "curve", "P-256",
-		"The elliptic curve to use (P-256|P-384|P-521)",
+		"The elliptic curve to use (P-256|P-384|P-521|Ed25519)",
 	)
 	cmd.Flags().BoolVar(&flags.force, "force", false,
 		"Force overwritting existing private key",
@@ -83,7 +85,8 @@ The contents are the private key in PKCS #8 ASN.1 DER format.
 	return cmd
 }
 
-// GeneratePrivateKey generates a new private key.
+// GeneratePrivateKey generates a new private key. Ed25519 keys are only
+// supported if the experimental Ed25519 support is enabled.
 func GeneratePrivateKey(curve string) (crypto.Signer, error) {
 	switch strings.ToLower(curve) {
 	case "p-256", "p256":
@@ -92,6 +95,12 @@ func GeneratePrivateKey(curve string) (crypto.Signer, error) {
 		return ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
 	case "p-521", "p521":
 		return ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	case "ed25519":
+		if !scrypto.Ed25519Enabled() {
+			return nil, serrors.New("ed25519 support not enabled", "curve", curve)
+		}
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		return key, err
 	default:
 		return nil, serrors.New("unsupported curve", "curve", curve)
 	}
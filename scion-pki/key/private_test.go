@@ -21,6 +21,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/scionproto/scion/pkg/scrypto"
 	"github.com/scionproto/scion/private/app/command"
 	"github.com/scionproto/scion/scion-pki/key"
 )
@@ -31,6 +32,7 @@ func TestNewPrivateCmd(t *testing.T) {
 	testCases := map[string]struct {
 		Prepare      func(t *testing.T)
 		Args         []string
+		Ed25519      bool
 		ErrAssertion assert.ErrorAssertionFunc
 	}{
 		"private not set": {
@@ -74,12 +76,23 @@ func TestNewPrivateCmd(t *testing.T) {
 			Args:         []string{"--curve", "p-521", dir + "/p-521.key"},
 			ErrAssertion: assert.NoError,
 		},
+		"ed25519 not enabled": {
+			Args:         []string{"--curve", "ed25519", dir + "/ed25519-disabled.key"},
+			ErrAssertion: assert.Error,
+		},
+		"ed25519": {
+			Args:         []string{"--curve", "ed25519", dir + "/ed25519.key"},
+			Ed25519:      true,
+			ErrAssertion: assert.NoError,
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			if tc.Prepare != nil {
 				tc.Prepare(t)
 			}
+			scrypto.EnableEd25519(tc.Ed25519)
+			defer scrypto.EnableEd25519(false)
 
 			cmd := key.NewPrivateCmd(command.StringPather("test"))
 			cmd.SetArgs(tc.Args)
@@ -0,0 +1,707 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trcs
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/scrypto/cms/protocol"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	"github.com/scionproto/scion/private/app/command"
+	"github.com/scionproto/scion/scion-pki/conf"
+)
+
+const (
+	ceremonyStateFile       = "ceremony.yaml"
+	ceremonyPayloadFile     = "payload.der"
+	ceremonyPredecessorFile = "predecessor.trc"
+	ceremonySignaturesDir   = "signatures"
+)
+
+// Signature types required in a TRC ceremony.
+const (
+	sigVote              = "vote"
+	sigProofOfPossession = "proof of possession"
+	sigAcknowledgement   = "acknowledgement"
+)
+
+func newCeremony(pather command.Pather) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ceremony",
+		Short: "Run a TRC signing ceremony",
+		Long: `'ceremony' guides through a TRC signing ceremony.
+
+The ceremony state is kept in a directory. It holds the TRC payload, the
+predecessor TRC in case of a TRC update, the collected signatures, and a log
+of all ceremony events.
+
+A ceremony proceeds in the following steps:
+
+1. 'init' creates the TRC payload from the template and the predecessor TRC.
+2. 'add' adds the partially signed TRCs of the voters as they arrive. Every
+   signature is checked against the payload and the set of required signers.
+3. 'status' reports the collected signatures and the ones that are still
+   missing.
+4. 'finalize' combines the signatures, verifies the TRC, and writes the TRC
+   together with an audit report.
+
+Base TRCs, regular TRC updates, and sensitive TRC updates are supported.
+`,
+	}
+	joined := command.Join(pather, cmd)
+	cmd.AddCommand(
+		newCeremonyInit(joined),
+		newCeremonyAdd(joined),
+		newCeremonyStatus(joined),
+		newCeremonyFinalize(joined),
+	)
+	return cmd
+}
+
+func newCeremonyInit(pather command.Pather) *cobra.Command {
+	var flags struct {
+		tmpl string
+		pred string
+	}
+
+	cmd := &cobra.Command{
+		Use:   "init [flags] <state-dir>",
+		Short: "Initialize a TRC signing ceremony",
+		Example: fmt.Sprintf(`  %[1]s init -t ISD1-B1-S1.toml ceremony
+  %[1]s init -t ISD1-B1-S2.toml -p ISD1-B1-S1.trc ceremony`,
+			pather.CommandPath()),
+		Long: `'init' creates the TRC payload and initializes the ceremony state directory.
+
+To update an existing TRC the predecessor TRC needs to be specified. The
+signatures that are required to complete the ceremony are displayed.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return runCeremonyInit(cmd.OutOrStdout(), args[0], flags.tmpl, flags.pred)
+		},
+	}
+
+	cmd.Flags().StringVarP(&flags.tmpl, "template", "t", "", "Template file (required)")
+	cmd.MarkFlagRequired("template")
+	cmd.Flags().StringVarP(&flags.pred, "predecessor", "p", "", "Predecessor TRC")
+	return cmd
+}
+
+func newCeremonyAdd(pather command.Pather) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <state-dir> <signed-file>...",
+		Short: "Add partially signed TRCs to a TRC signing ceremony",
+		Example: fmt.Sprintf(`  %[1]s add ceremony ISD1-B1-S1.ff00_0_110-sensitive.trc`,
+			pather.CommandPath()),
+		Long: `'add' adds partially signed TRCs to the ceremony.
+
+Every signature is verified against the ceremony payload. Signatures by signers
+that are not required in the ceremony, and signatures that were already
+collected are rejected. If any of the files is rejected, none of them are
+added.
+`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return runCeremonyAdd(cmd.OutOrStdout(), args[0], args[1:])
+		},
+	}
+	return cmd
+}
+
+func newCeremonyStatus(pather command.Pather) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "status <state-dir>",
+		Short:   "Display the status of a TRC signing ceremony",
+		Example: fmt.Sprintf(`  %[1]s status ceremony`, pather.CommandPath()),
+		Long: `'status' displays the collected signatures and the signatures that are
+still missing to complete the ceremony.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			c, err := loadCeremony(args[0])
+			if err != nil {
+				return err
+			}
+			status, err := c.status()
+			if err != nil {
+				return err
+			}
+			return status.print(cmd.OutOrStdout())
+		},
+	}
+	return cmd
+}
+
+func newCeremonyFinalize(pather command.Pather) *cobra.Command {
+	var flags struct {
+		out    string
+		format string
+	}
+
+	cmd := &cobra.Command{
+		Use:     "finalize [flags] <state-dir>",
+		Short:   "Finalize a TRC signing ceremony",
+		Example: fmt.Sprintf(`  %[1]s finalize ceremony`, pather.CommandPath()),
+		Long: `'finalize' combines the collected signatures into the final TRC.
+
+The TRC is verified against the predecessor TRC, or in case of a base TRC, it
+is checked that all voters have proven possession of their keys. An audit
+report that lists the signatures and the ceremony events is written next to
+the TRC.
+
+By default, the TRC is written to the state directory with the following naming
+pattern::
+
+	ISD<isd>-B<base_version>-S<serial_number>.trc
+
+An alternative name can be specified with the \--out flag.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return runCeremonyFinalize(cmd.OutOrStdout(), args[0], flags.out, flags.format)
+		},
+	}
+
+	cmd.Flags().StringVarP(&flags.out, "out", "o", "", "Output file path")
+	cmd.Flags().StringVar(&flags.format, "format", "der", "Output format (der|pem)")
+	return cmd
+}
+
+// signatureDesc describes a signature on a TRC.
+type signatureDesc struct {
+	Type   string `yaml:"type"`
+	CN     string `yaml:"common name"`
+	Serial string `yaml:"serial number"`
+}
+
+func describeSignature(sigType string, cert *x509.Certificate) signatureDesc {
+	return signatureDesc{
+		Type:   sigType,
+		CN:     cert.Subject.CommonName,
+		Serial: fmt.Sprintf("% X", cert.SerialNumber.Bytes()),
+	}
+}
+
+// ceremonyState is the persistent state of a TRC ceremony.
+type ceremonyState struct {
+	TRC    string          `yaml:"trc"`
+	Type   string          `yaml:"type"`
+	Events []ceremonyEvent `yaml:"events"`
+}
+
+// ceremonyEvent is an entry in the ceremony log.
+type ceremonyEvent struct {
+	Time       string          `yaml:"time"`
+	Action     string          `yaml:"action"`
+	File       string          `yaml:"file,omitempty"`
+	SHA256     string          `yaml:"sha256,omitempty"`
+	Signatures []signatureDesc `yaml:"signatures,omitempty"`
+}
+
+// requirement is a signature that is required to complete the ceremony.
+type requirement struct {
+	Type string
+	Cert *x509.Certificate
+}
+
+type ceremony struct {
+	dir      string
+	state    ceremonyState
+	trc      cppki.TRC
+	pred     *cppki.TRC
+	required []requirement
+}
+
+func runCeremonyInit(w io.Writer, dir, tmpl, predFile string) error {
+	if _, err := os.Stat(filepath.Join(dir, ceremonyStateFile)); err == nil {
+		return serrors.New("ceremony already initialized", "dir", dir)
+	}
+	cfg, err := conf.LoadTRC(tmpl)
+	if err != nil {
+		return serrors.Wrap("failed to load template file", err)
+	}
+	pred, err := loadPredecessor(cfg.SerialVersion == cfg.BaseVersion, predFile)
+	if err != nil {
+		return err
+	}
+	prepareCfg(&cfg, pred)
+	trc, err := CreatePayload(cfg, pred)
+	if err != nil {
+		return serrors.Wrap("failed to marshal TRC", err)
+	}
+	updateType, required, err := requiredSignatures(trc, pred)
+	if err != nil {
+		return err
+	}
+	raw, err := trc.Encode()
+	if err != nil {
+		return serrors.Wrap("encoding payload", err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, ceremonySignaturesDir), 0755); err != nil {
+		return serrors.Wrap("creating state directory", err, "dir", dir)
+	}
+	if err := os.WriteFile(filepath.Join(dir, ceremonyPayloadFile), raw, 0644); err != nil {
+		return serrors.Wrap("writing payload", err)
+	}
+	if pred != nil {
+		rawPred, err := os.ReadFile(predFile)
+		if err != nil {
+			return serrors.Wrap("reading predecessor TRC", err, "file", predFile)
+		}
+		err = os.WriteFile(filepath.Join(dir, ceremonyPredecessorFile), rawPred, 0644)
+		if err != nil {
+			return serrors.Wrap("writing predecessor TRC", err)
+		}
+	}
+	// Decode the encoded payload such that the raw bytes are populated.
+	decoded, err := cppki.DecodeTRC(raw)
+	if err != nil {
+		return serrors.Wrap("decoding payload", err)
+	}
+	c := &ceremony{
+		dir: dir,
+		state: ceremonyState{
+			TRC:  trc.ID.String(),
+			Type: updateType,
+		},
+		trc:      decoded,
+		pred:     pred,
+		required: required,
+	}
+	c.log(ceremonyEvent{
+		Action: "init",
+		File:   tmpl,
+		SHA256: sha256Hex(raw),
+	})
+	if err := c.save(); err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "Successfully initialized %s ceremony for %s in %s\n",
+		updateType, c.state.TRC, dir)
+	status, err := c.status()
+	if err != nil {
+		return err
+	}
+	return status.print(w)
+}
+
+func runCeremonyAdd(w io.Writer, dir string, files []string) error {
+	c, err := loadCeremony(dir)
+	if err != nil {
+		return err
+	}
+	parts, err := c.signatures()
+	if err != nil {
+		return err
+	}
+	collected := c.collected(parts)
+
+	type addition struct {
+		name  string
+		raw   []byte
+		event ceremonyEvent
+	}
+	var additions []addition
+	for _, file := range files {
+		// Partially signed TRCs of different voters might share the same file
+		// name. Prefix them with a sequence number to keep them apart.
+		name := fmt.Sprintf("%03d-%s", len(parts)+1, filepath.Base(file))
+		raw, err := os.ReadFile(file)
+		if err != nil {
+			return serrors.Wrap("reading signed TRC", err, "file", file)
+		}
+		signed, err := DecodeFromFile(file)
+		if err != nil {
+			return serrors.Wrap("decoding signed TRC", err, "file", file)
+		}
+		descs, err := c.checkSigned(signed, collected)
+		if err != nil {
+			return serrors.Wrap("checking signed TRC", err, "file", file)
+		}
+		parts[name] = signed
+		additions = append(additions, addition{
+			name: name,
+			raw:  raw,
+			event: ceremonyEvent{
+				Action:     "add",
+				File:       file,
+				SHA256:     sha256Hex(raw),
+				Signatures: descs,
+			},
+		})
+	}
+	for _, a := range additions {
+		out := filepath.Join(c.dir, ceremonySignaturesDir, a.name)
+		if err := os.WriteFile(out, a.raw, 0644); err != nil {
+			return serrors.Wrap("writing signed TRC", err, "file", out)
+		}
+		c.log(a.event)
+		fmt.Fprintf(w, "Added %s\n", a.name)
+	}
+	if err := c.save(); err != nil {
+		return err
+	}
+	status, err := c.status()
+	if err != nil {
+		return err
+	}
+	return status.print(w)
+}
+
+func runCeremonyFinalize(w io.Writer, dir, out, format string) error {
+	c, err := loadCeremony(dir)
+	if err != nil {
+		return err
+	}
+	status, err := c.status()
+	if err != nil {
+		return err
+	}
+	if !status.Complete {
+		names := make([]string, 0, len(status.Missing))
+		for _, m := range status.Missing {
+			names = append(names, fmt.Sprintf("%s (%s)", m.CN, m.Type))
+		}
+		return serrors.New("missing signatures", "missing", names)
+	}
+	parts, err := c.signatures()
+	if err != nil {
+		return err
+	}
+	packed, err := CombineSignedPayloads(parts)
+	if err != nil {
+		return err
+	}
+	signed, err := cppki.DecodeSignedTRC(packed)
+	if err != nil {
+		return serrors.Wrap("decoding combined TRC", err)
+	}
+	if err := signed.Verify(c.pred); err != nil {
+		return serrors.Wrap("verifying combined TRC", err)
+	}
+	if format == "pem" {
+		packed = pem.EncodeToMemory(&pem.Block{
+			Type:  "TRC",
+			Bytes: packed,
+		})
+	}
+	if out == "" {
+		out = filepath.Join(c.dir, c.state.TRC+".trc")
+	}
+	if err := os.WriteFile(out, packed, 0644); err != nil {
+		return serrors.Wrap("writing TRC", err)
+	}
+	c.log(ceremonyEvent{
+		Action: "finalize",
+		File:   out,
+		SHA256: sha256Hex(packed),
+	})
+	if err := c.save(); err != nil {
+		return err
+	}
+
+	report := struct {
+		TRC           string          `yaml:"trc"`
+		Type          string          `yaml:"type"`
+		Description   string          `yaml:"description"`
+		Predecessor   string          `yaml:"predecessor,omitempty"`
+		PayloadSHA256 string          `yaml:"payload sha256"`
+		TRCSHA256     string          `yaml:"trc sha256"`
+		Signatures    []signatureDesc `yaml:"signatures"`
+		Events        []ceremonyEvent `yaml:"events"`
+	}{
+		TRC:           c.state.TRC,
+		Type:          c.state.Type,
+		Description:   c.trc.Description,
+		PayloadSHA256: sha256Hex(c.trc.Raw),
+		TRCSHA256:     sha256Hex(packed),
+		Signatures:    status.Collected,
+		Events:        c.state.Events,
+	}
+	if c.pred != nil {
+		report.Predecessor = c.pred.ID.String()
+	}
+	rawReport, err := yaml.Marshal(report)
+	if err != nil {
+		return serrors.Wrap("encoding audit report", err)
+	}
+	reportFile := filepath.Join(c.dir, c.state.TRC+".audit.yaml")
+	if err := os.WriteFile(reportFile, rawReport, 0644); err != nil {
+		return serrors.Wrap("writing audit report", err)
+	}
+	fmt.Fprintf(w, "Successfully finalized TRC at %s\n", out)
+	fmt.Fprintf(w, "Audit report written to %s\n", reportFile)
+	return nil
+}
+
+// requiredSignatures determines the update type and the signatures that are
+// required for the TRC.
+func requiredSignatures(trc *cppki.TRC, pred *cppki.TRC) (string, []requirement, error) {
+	if pred == nil {
+		if err := trc.Validate(); err != nil {
+			return "", nil, serrors.Wrap("validating payload", err)
+		}
+		var required []requirement
+		for _, cert := range trc.Certificates {
+			ct, err := cppki.ValidateCert(cert)
+			if err != nil {
+				return "", nil, serrors.Wrap("validating certificate", err,
+					"common_name", cert.Subject.CommonName)
+			}
+			if ct == cppki.Sensitive || ct == cppki.Regular {
+				required = append(required, requirement{Type: sigProofOfPossession, Cert: cert})
+			}
+		}
+		return "base", required, nil
+	}
+	update, err := trc.ValidateUpdate(pred)
+	if err != nil {
+		return "", nil, serrors.Wrap("validating update", err)
+	}
+	var required []requirement
+	for _, v := range []struct {
+		Type  string
+		Certs []*x509.Certificate
+	}{
+		{Type: sigVote, Certs: update.Votes},
+		{Type: sigProofOfPossession, Certs: update.NewVoters},
+		{Type: sigAcknowledgement, Certs: update.RootAcknowledgments},
+	} {
+		for _, cert := range v.Certs {
+			required = append(required, requirement{Type: v.Type, Cert: cert})
+		}
+	}
+	return update.Type.String(), required, nil
+}
+
+func loadCeremony(dir string) (*ceremony, error) {
+	raw, err := os.ReadFile(filepath.Join(dir, ceremonyStateFile))
+	if err != nil {
+		return nil, serrors.Wrap("reading ceremony state", err, "dir", dir)
+	}
+	var state ceremonyState
+	if err := yaml.UnmarshalStrict(raw, &state); err != nil {
+		return nil, serrors.Wrap("parsing ceremony state", err, "dir", dir)
+	}
+	rawPld, err := os.ReadFile(filepath.Join(dir, ceremonyPayloadFile))
+	if err != nil {
+		return nil, serrors.Wrap("reading payload", err, "dir", dir)
+	}
+	trc, err := cppki.DecodeTRC(rawPld)
+	if err != nil {
+		return nil, serrors.Wrap("decoding payload", err, "dir", dir)
+	}
+	var pred *cppki.TRC
+	if !trc.ID.IsBase() {
+		signed, err := DecodeFromFile(filepath.Join(dir, ceremonyPredecessorFile))
+		if err != nil {
+			return nil, serrors.Wrap("loading predecessor TRC", err, "dir", dir)
+		}
+		pred = &signed.TRC
+	}
+	_, required, err := requiredSignatures(&trc, pred)
+	if err != nil {
+		return nil, err
+	}
+	return &ceremony{
+		dir:      dir,
+		state:    state,
+		trc:      trc,
+		pred:     pred,
+		required: required,
+	}, nil
+}
+
+func (c *ceremony) log(event ceremonyEvent) {
+	event.Time = time.Now().UTC().Format(time.RFC3339)
+	c.state.Events = append(c.state.Events, event)
+}
+
+func (c *ceremony) save() error {
+	raw, err := yaml.Marshal(c.state)
+	if err != nil {
+		return serrors.Wrap("encoding ceremony state", err)
+	}
+	if err := os.WriteFile(filepath.Join(c.dir, ceremonyStateFile), raw, 0644); err != nil {
+		return serrors.Wrap("writing ceremony state", err)
+	}
+	return nil
+}
+
+// signatures loads the partially signed TRCs that were added to the ceremony.
+// They are keyed by file name.
+func (c *ceremony) signatures() (map[string]cppki.SignedTRC, error) {
+	sigDir := filepath.Join(c.dir, ceremonySignaturesDir)
+	entries, err := os.ReadDir(sigDir)
+	if err != nil {
+		return nil, serrors.Wrap("reading signatures directory", err, "dir", sigDir)
+	}
+	parts := make(map[string]cppki.SignedTRC, len(entries))
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		signed, err := DecodeFromFile(filepath.Join(sigDir, e.Name()))
+		if err != nil {
+			return nil, serrors.Wrap("decoding signed TRC", err, "file", e.Name())
+		}
+		parts[e.Name()] = signed
+	}
+	return parts, nil
+}
+
+// collected returns the indices of the required signatures that are present
+// in the partially signed TRCs.
+func (c *ceremony) collected(parts map[string]cppki.SignedTRC) map[int]struct{} {
+	collected := make(map[int]struct{})
+	for _, signed := range parts {
+		for _, si := range signed.SignerInfos {
+			for _, idx := range c.matching(si) {
+				collected[idx] = struct{}{}
+			}
+		}
+	}
+	return collected
+}
+
+// matching returns the indices of the required signatures that are satisfied
+// by the signer info.
+func (c *ceremony) matching(si protocol.SignerInfo) []int {
+	var indices []int
+	for i, req := range c.required {
+		if _, err := si.FindCertificate([]*x509.Certificate{req.Cert}); err == nil {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// checkSigned checks that the partially signed TRC signs the ceremony payload,
+// and that every signature is valid and required. The newly collected
+// signatures are marked in collected.
+func (c *ceremony) checkSigned(
+	signed cppki.SignedTRC,
+	collected map[int]struct{},
+) ([]signatureDesc, error) {
+
+	if !bytes.Equal(signed.TRC.Raw, c.trc.Raw) {
+		return nil, serrors.New("payload does not match ceremony payload")
+	}
+	if len(signed.SignerInfos) == 0 {
+		return nil, serrors.New("no signatures found")
+	}
+	var descs []signatureDesc
+	for i, si := range signed.SignerInfos {
+		indices := c.matching(si)
+		if len(indices) == 0 {
+			return nil, serrors.New("signature not required in ceremony", "index", i)
+		}
+		for _, idx := range indices {
+			req := c.required[idx]
+			if _, ok := collected[idx]; ok {
+				return nil, serrors.New("signature already collected", "index", i,
+					"type", req.Type, "common_name", req.Cert.Subject.CommonName)
+			}
+			err := verifySignerInfo(si, c.trc.Raw, []*x509.Certificate{req.Cert})
+			if err != nil {
+				return nil, serrors.Wrap("verifying signature", err, "index", i,
+					"type", req.Type, "common_name", req.Cert.Subject.CommonName)
+			}
+			collected[idx] = struct{}{}
+			descs = append(descs, describeSignature(req.Type, req.Cert))
+		}
+	}
+	return descs, nil
+}
+
+// ceremonyStatus summarizes the signatures of a ceremony.
+type ceremonyStatus struct {
+	TRC       string          `yaml:"trc"`
+	Type      string          `yaml:"type"`
+	Quorum    int             `yaml:"voting quorum,omitempty"`
+	Complete  bool            `yaml:"complete"`
+	Collected []signatureDesc `yaml:"collected signatures"`
+	Missing   []signatureDesc `yaml:"missing signatures"`
+}
+
+func (c *ceremony) status() (ceremonyStatus, error) {
+	parts, err := c.signatures()
+	if err != nil {
+		return ceremonyStatus{}, err
+	}
+	collected := c.collected(parts)
+	status := ceremonyStatus{
+		TRC:       c.state.TRC,
+		Type:      c.state.Type,
+		Collected: []signatureDesc{},
+		Missing:   []signatureDesc{},
+	}
+	if c.pred != nil {
+		status.Quorum = c.pred.Quorum
+	}
+	for i, req := range c.required {
+		desc := describeSignature(req.Type, req.Cert)
+		if _, ok := collected[i]; ok {
+			status.Collected = append(status.Collected, desc)
+		} else {
+			status.Missing = append(status.Missing, desc)
+		}
+	}
+	sortSignatureDescs(status.Collected)
+	sortSignatureDescs(status.Missing)
+	status.Complete = len(status.Missing) == 0
+	return status, nil
+}
+
+func (s ceremonyStatus) print(w io.Writer) error {
+	raw, err := yaml.Marshal(s)
+	if err != nil {
+		return serrors.Wrap("encoding status", err)
+	}
+	_, err = fmt.Fprintf(w, "\n%s", raw)
+	return err
+}
+
+func sortSignatureDescs(descs []signatureDesc) {
+	sort.SliceStable(descs, func(i, j int) bool {
+		if descs[i].Type != descs[j].Type {
+			return descs[i].Type < descs[j].Type
+		}
+		return descs[i].CN < descs[j].CN
+	})
+}
+
+func sha256Hex(raw []byte) string {
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,250 @@
+// Copyright 2026 SCION Association
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trcs_test
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+
+	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	"github.com/scionproto/scion/private/app/command"
+	"github.com/scionproto/scion/scion-pki/trcs"
+)
+
+func TestCeremonyBase(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "ceremony")
+	admin := "./testdata/admin"
+
+	_, err := runCeremony(t, "init", "-t", filepath.Join(admin, "ISD1-B1-S1.toml"), dir)
+	require.NoError(t, err)
+	_, err = runCeremony(t, "init", "-t", filepath.Join(admin, "ISD1-B1-S1.toml"), dir)
+	assert.Error(t, err, "already initialized")
+
+	pld, err := os.ReadFile(filepath.Join(dir, "payload.der"))
+	require.NoError(t, err)
+	expected, err := os.ReadFile(filepath.Join(admin, "ISD1-B1-S1.pld.der"))
+	require.NoError(t, err)
+	assert.Equal(t, expected, pld)
+	assert.Len(t, ceremonyMissing(t, dir), 6)
+
+	_, err = runCeremony(t, "add", dir,
+		filepath.Join(admin, "bern", "ISD1-B1-S1.regular.trc"),
+		filepath.Join(admin, "bern", "ISD1-B1-S1.sensitive.trc"),
+	)
+	require.NoError(t, err)
+	assert.Len(t, ceremonyMissing(t, dir), 4)
+
+	// The combined TRC contains signatures that were already collected.
+	_, err = runCeremony(t, "add", dir, filepath.Join(admin, "ISD1-B1-S1.trc"))
+	assert.Error(t, err)
+	_, err = runCeremony(t, "finalize", dir)
+	assert.Error(t, err)
+
+	_, err = runCeremony(t, "add", dir,
+		filepath.Join(admin, "geneva", "ISD1-B1-S1.regular.trc"),
+		filepath.Join(admin, "geneva", "ISD1-B1-S1.sensitive.trc"),
+		filepath.Join(admin, "zürich", "ISD1-B1-S1.regular.trc"),
+		filepath.Join(admin, "zürich", "ISD1-B1-S1.sensitive.trc"),
+	)
+	require.NoError(t, err)
+	assert.Empty(t, ceremonyMissing(t, dir))
+
+	_, err = runCeremony(t, "finalize", dir)
+	require.NoError(t, err)
+	signed, err := trcs.DecodeFromFile(filepath.Join(dir, "ISD1-B1-S1.trc"))
+	require.NoError(t, err)
+	assert.NoError(t, signed.Verify(nil))
+	assert.Len(t, signed.SignerInfos, 6)
+	assert.FileExists(t, filepath.Join(dir, "ISD1-B1-S1.audit.yaml"))
+}
+
+func TestCeremonyUpdate(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+	notBefore, notAfter := now.Add(-time.Hour), now.Add(2*time.Hour)
+
+	type voter struct {
+		cert *x509.Certificate
+		key  crypto.Signer
+	}
+	newVoter := func(t *testing.T, ct cppki.CertType, name string) voter {
+		priv := genKey(t, filepath.Join(dir, name+".key"))
+		cert := genCert(t, ct, priv, notBefore, notAfter, filepath.Join(dir, name+".crt"))
+		return voter{cert: cert, key: priv.(crypto.Signer)}
+	}
+	sensitive := newVoter(t, cppki.Sensitive, "sensitive-voting")
+	regular := newVoter(t, cppki.Regular, "regular-voting")
+	root := newVoter(t, cppki.Root, "cp-root")
+	newSensitive := newVoter(t, cppki.Sensitive, "sensitive-voting.new")
+	newRegular := newVoter(t, cppki.Regular, "regular-voting.new")
+	newRoot := newVoter(t, cppki.Root, "cp-root.new")
+
+	// Create the predecessor TRC.
+	base := cppki.TRC{
+		Version: 1,
+		ID:      cppki.TRCID{ISD: 1, Base: 1, Serial: 1},
+		Validity: cppki.Validity{
+			NotBefore: now.Add(-30 * time.Minute).Truncate(time.Second),
+			NotAfter:  now.Add(90 * time.Minute).Truncate(time.Second),
+		},
+		CoreASes:          []addr.AS{addr.MustParseAS("ff00:0:110")},
+		AuthoritativeASes: []addr.AS{addr.MustParseAS("ff00:0:110")},
+		Quorum:            1,
+		Description:       "Test ISD",
+		Certificates:      []*x509.Certificate{sensitive.cert, regular.cert, root.cert},
+	}
+	rawBase, err := base.Encode()
+	require.NoError(t, err)
+	baseParts := map[string]cppki.SignedTRC{}
+	for i, v := range []voter{sensitive, regular} {
+		raw, err := trcs.SignPayload(rawBase, v.key, v.cert)
+		require.NoError(t, err)
+		baseParts[fmt.Sprint(i)], err = cppki.DecodeSignedTRC(raw)
+		require.NoError(t, err)
+	}
+	rawPred, err := trcs.CombineSignedPayloads(baseParts)
+	require.NoError(t, err)
+	predFile := filepath.Join(dir, "ISD1-B1-S1.trc")
+	require.NoError(t, os.WriteFile(predFile, rawPred, 0o644))
+	pred, err := cppki.DecodeSignedTRC(rawPred)
+	require.NoError(t, err)
+	require.NoError(t, pred.Verify(nil))
+
+	testCases := map[string]struct {
+		certFiles string
+		votes     string
+		signers   []voter
+		invalid   voter
+	}{
+		"regular": {
+			certFiles: `["predecessor:0", "regular-voting.new.crt.pem", "cp-root.new.crt.pem"]`,
+			votes:     "[1]",
+			signers:   []voter{regular, newRegular, root},
+			invalid:   newRoot,
+		},
+		"sensitive": {
+			certFiles: `["sensitive-voting.new.crt.pem", "predecessor:1", "predecessor:2"]`,
+			votes:     "[0]",
+			signers:   []voter{sensitive, newSensitive},
+			invalid:   regular,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			state := filepath.Join(t.TempDir(), "ceremony")
+			tmpl := filepath.Join(dir, name+".toml")
+			require.NoError(t, os.WriteFile(tmpl, []byte(fmt.Sprintf(`
+isd                = 1
+description        = "Test ISD"
+serial_version     = 2
+base_version       = 1
+voting_quorum      = 1
+core_ases          = ["ff00:0:110"]
+authoritative_ases = ["ff00:0:110"]
+cert_files         = %s
+votes              = %s
+
+[validity]
+not_before = %d
+validity   = "1h"
+`, tc.certFiles, tc.votes, now.Unix())), 0o644))
+
+			_, err := runCeremony(t, "init", "-t", tmpl, "-p", predFile, state)
+			require.NoError(t, err)
+			assert.Len(t, ceremonyMissing(t, state), len(tc.signers))
+
+			pld, err := os.ReadFile(filepath.Join(state, "payload.der"))
+			require.NoError(t, err)
+			sign := func(t *testing.T, v voter, name string) string {
+				raw, err := trcs.SignPayload(pld, v.key, v.cert)
+				require.NoError(t, err)
+				file := filepath.Join(t.TempDir(), name)
+				require.NoError(t, os.WriteFile(file, raw, 0o644))
+				return file
+			}
+
+			_, err = runCeremony(t, "add", state, sign(t, tc.invalid, "invalid.trc"))
+			assert.Error(t, err)
+			_, err = runCeremony(t, "add", state, sign(t, tc.signers[0], "first.trc"))
+			require.NoError(t, err)
+			assert.Len(t, ceremonyMissing(t, state), len(tc.signers)-1)
+			_, err = runCeremony(t, "add", state, sign(t, tc.signers[0], "again.trc"))
+			assert.Error(t, err)
+			_, err = runCeremony(t, "finalize", state)
+			assert.Error(t, err)
+
+			for i, v := range tc.signers[1:] {
+				_, err = runCeremony(t, "add", state, sign(t, v, fmt.Sprintf("%d.trc", i)))
+				require.NoError(t, err)
+			}
+			assert.Empty(t, ceremonyMissing(t, state))
+
+			out := filepath.Join(state, "final.trc")
+			_, err = runCeremony(t, "finalize", state, "--out", out, "--format", "pem")
+			require.NoError(t, err)
+			signed, err := trcs.DecodeFromFile(out)
+			require.NoError(t, err)
+			assert.NoError(t, signed.Verify(&pred.TRC))
+
+			var report struct {
+				Type string `yaml:"type"`
+			}
+			raw, err := os.ReadFile(filepath.Join(state, "ISD1-B1-S2.audit.yaml"))
+			require.NoError(t, err)
+			require.NoError(t, yaml.Unmarshal(raw, &report))
+			assert.Equal(t, name, report.Type)
+		})
+	}
+}
+
+func runCeremony(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	cmd := trcs.Cmd(command.StringPather("scion-pki"))
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+	cmd.SetArgs(append([]string{"ceremony"}, args...))
+	err := cmd.Execute()
+	return buf.String(), err
+}
+
+func ceremonyMissing(t *testing.T, dir string) []string {
+	t.Helper()
+	out, err := runCeremony(t, "status", dir)
+	require.NoError(t, err, out)
+	var status struct {
+		Missing []struct {
+			Type string `yaml:"type"`
+			CN   string `yaml:"common name"`
+		} `yaml:"missing signatures"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(out), &status))
+	var missing []string
+	for _, m := range status.Missing {
+		missing = append(missing, m.Type+": "+m.CN)
+	}
+	return missing
+}
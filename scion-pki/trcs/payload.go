@@ -208,33 +208,24 @@ func mimicOrder(next, predecessor []addr.AS) []addr.AS {
 }
 
 func printUpdate(update cppki.Update) {
-	type desc struct {
-		Type   string `yaml:"type"`
-		CN     string `yaml:"common name"`
-		Serial string `yaml:"serial number"`
-	}
-	var descs []desc
+	var descs []signatureDesc
 	for _, v := range []struct {
 		Type  string
 		Certs []*x509.Certificate
 	}{
-		{Type: "vote", Certs: update.Votes},
-		{Type: "proof of possession", Certs: update.NewVoters},
-		{Type: "acknowledgement", Certs: update.RootAcknowledgments},
+		{Type: sigVote, Certs: update.Votes},
+		{Type: sigProofOfPossession, Certs: update.NewVoters},
+		{Type: sigAcknowledgement, Certs: update.RootAcknowledgments},
 	} {
 		sort.Slice(v.Certs, func(i, j int) bool {
 			return v.Certs[i].Subject.CommonName < v.Certs[j].Subject.CommonName
 		})
 		for _, cert := range v.Certs {
-			descs = append(descs, desc{
-				Type:   v.Type,
-				CN:     cert.Subject.CommonName,
-				Serial: fmt.Sprintf("% X", cert.SerialNumber.Bytes()),
-			})
+			descs = append(descs, describeSignature(v.Type, cert))
 		}
 
 	}
-	out, err := yaml.Marshal(map[string][]desc{"required signatures": descs})
+	out, err := yaml.Marshal(map[string][]signatureDesc{"required signatures": descs})
 	if err != nil {
 		return
 	}
@@ -28,6 +28,7 @@ func Cmd(pather command.Pather) *cobra.Command {
 	}
 	joined := command.Join(pather, cmd)
 	cmd.AddCommand(
+		newCeremony(joined),
 		newCombine(joined),
 		newHuman(joined),
 		newFormatCmd(joined),
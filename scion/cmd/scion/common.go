@@ -15,6 +15,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -26,8 +27,31 @@ import (
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/pkg/segment/iface"
 	"github.com/scionproto/scion/pkg/snet"
+	"github.com/scionproto/scion/pkg/snet/addrutil"
 )
 
+// resolveTimeout bounds the resolution of the host names given as arguments.
+const resolveTimeout = 5 * time.Second
+
+// hostNameHelp describes the host names accepted as arguments.
+const hostNameHelp = `The remote host can be given as a SCION address in the form ISD-AS,IP, or as a
+host name. Host names are looked up in the SCION hosts file /etc/scion/hosts,
+and then in the DNS TXT records of the name, of the form "scion=ISD-AS,IP".`
+
+// resolveAddr resolves the SCION address or host name of a remote host.
+func resolveAddr(s string) (addr.Addr, error) {
+	ctx, cancelF := context.WithTimeout(context.Background(), resolveTimeout)
+	defer cancelF()
+	return addrutil.ResolveAddr(ctx, s)
+}
+
+// resolveIA resolves an ISD-AS, or the host name of a host in the ISD-AS.
+func resolveIA(s string) (addr.IA, error) {
+	ctx, cancelF := context.WithTimeout(context.Background(), resolveTimeout)
+	defer cancelF()
+	return addrutil.ResolveIA(ctx, s)
+}
+
 // Path defines the base model for the `ping` and `traceroute` result path
 type Path struct {
 	// Hex-string representing the paths fingerprint.
@@ -94,7 +94,8 @@ func newPing(pather CommandPather) *cobra.Command {
 		Use:   "ping [flags] <remote>",
 		Short: "Test connectivity to a remote SCION host using SCMP echo packets",
 		Example: fmt.Sprintf(`  %[1]s ping 1-ff00:0:110,10.0.0.1
-  %[1]s ping 1-ff00:0:110,10.0.0.1 -c 5`, pather.CommandPath()),
+  %[1]s ping 1-ff00:0:110,10.0.0.1 -c 5
+  %[1]s ping server.example.org`, pather.CommandPath()),
 		Long: fmt.Sprintf(`'ping' test connectivity to a remote SCION host using SCMP echo packets.
 
 When the \--count option is set, ping sends the specified number of SCMP echo packets
@@ -103,15 +104,17 @@ and reports back the statistics.
 When the \--healthy-only option is set, ping first determines healthy paths through probing and
 chooses amongst them.
 
+%s
+
 If no reply packet is received at all, ping will exit with code 1.
 On other errors, ping will exit with code 2.
 
-%s`, app.SequenceHelp),
+%s`, hostNameHelp, app.SequenceHelp),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			remote, err := addr.ParseAddr(args[0])
+			remote, err := resolveAddr(args[0])
 			if err != nil {
-				return serrors.Wrap("parsing remote", err)
+				return serrors.Wrap("resolving remote", err)
 			}
 			if err := app.SetupLog(flags.logLevel); err != nil {
 				return serrors.Wrap("setting up logging", err)
@@ -25,7 +25,6 @@ import (
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v2"
 
-	"github.com/scionproto/scion/pkg/addr"
 	"github.com/scionproto/scion/pkg/log"
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/private/app"
@@ -57,9 +56,10 @@ func newShowpaths(pather CommandPather) *cobra.Command {
   %[1]s showpaths 1-ff00:0:111 --sequence="0-0#2 0*" # outgoing IfID=2
   %[1]s showpaths 1-ff00:0:111 --sequence="0* 0-0#41" # incoming IfID=41 at dstIA
   %[1]s showpaths 1-ff00:0:111 --sequence="0* 1-ff00:0:112 0*" # 1-ff00:0:112 on the path
-  %[1]s showpaths 1-ff00:0:110 --no-probe`, pather.CommandPath()),
+  %[1]s showpaths 1-ff00:0:110 --no-probe
+  %[1]s showpaths server.example.org # the AS of the host`, pather.CommandPath()),
 		Long: fmt.Sprintf(`'showpaths' lists available paths between the local and the specified
-SCION ASe a.
+SCION ASe a. The AS can also be given as the name of a host in it, see 'ping'.
 
 By default, the paths are probed. Paths served from the SCION Daemon's might not
 forward traffic successfully (e.g. if a network link went down, or there is a black
@@ -71,7 +71,7 @@ On other errors, showpaths will exit with code 2.
 
 %s`, app.SequenceHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			dst, err := addr.ParseIA(args[0])
+			dst, err := resolveIA(args[0])
 			if err != nil {
 				return serrors.Wrap("invalid destination ISD-AS", err)
 			}
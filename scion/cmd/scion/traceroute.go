@@ -75,19 +75,22 @@ func newTraceroute(pather CommandPather) *cobra.Command {
 		Use:     "traceroute [flags] <remote>",
 		Aliases: []string{"tr"},
 		Short:   "Trace the SCION route to a remote SCION AS using SCMP traceroute packets",
-		Example: fmt.Sprintf("  %[1]s traceroute 1-ff00:0:110,10.0.0.1", pather.CommandPath()),
+		Example: fmt.Sprintf(`  %[1]s traceroute 1-ff00:0:110,10.0.0.1
+  %[1]s traceroute server.example.org`, pather.CommandPath()),
 		Long: fmt.Sprintf(`'traceroute' traces the SCION path to a remote AS using
 SCMP traceroute packets.
 
+%s
+
 If any packet is dropped, traceroute will exit with code 1.
 On other errors, traceroute will exit with code 2.
-%s`, app.SequenceHelp),
+%s`, hostNameHelp, app.SequenceHelp),
 
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			remote, err := addr.ParseAddr(args[0])
+			remote, err := resolveAddr(args[0])
 			if err != nil {
-				return serrors.Wrap("parsing remote", err)
+				return serrors.Wrap("resolving remote", err)
 			}
 			if err := app.SetupLog(flags.logLevel); err != nil {
 				return serrors.Wrap("setting up logging", err)